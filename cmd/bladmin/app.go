@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/base"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/logger"
+	"github.com/dlankinl/bmstu-ppo-bl/repository/memory"
+	"github.com/dlankinl/bmstu-ppo-bl/repository/sqlite"
+	"github.com/dlankinl/bmstu-ppo-bl/services/activity_field"
+	"github.com/dlankinl/bmstu-ppo-bl/services/auth"
+	"github.com/dlankinl/bmstu-ppo-bl/services/company"
+	"github.com/dlankinl/bmstu-ppo-bl/services/fin_report"
+	"github.com/dlankinl/bmstu-ppo-bl/services/tax_schedule"
+	"github.com/dlankinl/bmstu-ppo-bl/services/user"
+	"github.com/dlankinl/bmstu-ppo-bl/services/user_activity_field"
+	"io"
+)
+
+const (
+	backendSQLite = "sqlite"
+	backendMemory = "memory"
+)
+
+type repositories struct {
+	users          domain.IUserRepository
+	auth           domain.IAuthRepository
+	companies      domain.ICompanyRepository
+	activityFields domain.IActivityFieldRepository
+	finReports     domain.IFinancialReportRepository
+	taxSchedules   domain.ITaxScheduleRepository
+	txManager      domain.ITxManager
+}
+
+// app хранит сервисы и настройки вывода одного запуска утилиты.
+// Утилита работает от имени администратора, поэтому сервисы не оборачиваются в authz.
+type app struct {
+	ctx          context.Context
+	in           io.Reader
+	out          io.Writer
+	errOut       io.Writer
+	format       string
+	locale       i18n.Locale
+	jurisdiction string
+
+	users      domain.IUserService
+	auth       domain.IRegistrationService
+	companies  domain.ICompanyService
+	fields     domain.IActivityFieldService
+	finReports domain.IFinancialReportService
+	taxes      domain.ITaxScheduleService
+	interactor domain.IInteractor
+
+	close func() error
+}
+
+func newApp(ctx context.Context, cfg *config, in io.Reader, out, errOut io.Writer) (*app, error) {
+	var (
+		repos *repositories
+		db    *sql.DB
+		err   error
+	)
+
+	rates, err := loadRates(cfg.rates)
+	if err != nil {
+		return nil, i18n.Wrap(err, i18n.CLIRates)
+	}
+
+	supported, err := rates.Supports(ctx, cfg.currency)
+	if err != nil {
+		return nil, i18n.Wrap(err, i18n.CLIRates)
+	}
+	if !supported {
+		return nil, domain.NewValidationError("report-currency", i18n.CurrencyUnsupported, cfg.currency)
+	}
+
+	switch cfg.backend {
+	case backendSQLite:
+		db, err = sqlite.Open(ctx, cfg.db)
+		if err != nil {
+			return nil, i18n.Wrap(err, i18n.CLIOpenBackend)
+		}
+		repos = &repositories{
+			users:          sqlite.NewUserRepository(db),
+			auth:           sqlite.NewAuthRepository(db),
+			companies:      sqlite.NewCompanyRepository(db),
+			activityFields: sqlite.NewActivityFieldRepository(db),
+			finReports:     sqlite.NewFinancialReportRepository(db),
+			taxSchedules:   sqlite.NewTaxScheduleRepository(db),
+			txManager:      sqlite.NewTxManager(db),
+		}
+	case backendMemory:
+		repos = &repositories{
+			users:          memory.NewUserRepository(),
+			auth:           memory.NewAuthRepository(),
+			companies:      memory.NewCompanyRepository(),
+			activityFields: memory.NewActivityFieldRepository(),
+			finReports:     memory.NewFinancialReportRepository(),
+			taxSchedules:   memory.NewTaxScheduleRepository(),
+			txManager:      memory.NewTxManager(),
+		}
+	default:
+		return nil, domain.NewValidationError("backend", i18n.CLIBackendUnknown, cfg.backend)
+	}
+
+	log := logger.NewLogger(cfg.logLevel, cfg.locale, errOut)
+
+	userSvc := user.NewService(repos.users, repos.companies, repos.activityFields, log)
+	compSvc := company.NewService(repos.companies, log)
+	fieldSvc := activity_field.NewService(repos.activityFields, repos.companies, log)
+	finSvc := fin_report.NewService(repos.finReports, repos.txManager, rates, cfg.currency, log)
+	taxSvc := tax_schedule.NewService(repos.taxSchedules, cfg.jurisdiction, domain.DefaultTaxSchedule(), log)
+
+	a := &app{
+		ctx:          i18n.WithLocale(ctx, cfg.locale),
+		in:           in,
+		out:          out,
+		errOut:       errOut,
+		format:       cfg.format,
+		locale:       cfg.locale,
+		jurisdiction: cfg.jurisdiction,
+		users:        userSvc,
+		auth:         auth.NewRegistrar(repos.auth, base.NewHashCrypto(), log),
+		companies:    compSvc,
+		fields:       fieldSvc,
+		finReports:   finSvc,
+		taxes:        taxSvc,
+		interactor:   user_activity_field.NewInteractor(userSvc, fieldSvc, compSvc, finSvc, taxSvc, rates, cfg.carryYears, log),
+		close: func() error {
+			return nil
+		},
+	}
+	if db != nil {
+		a.close = db.Close
+	}
+
+	return a, nil
+}
@@ -0,0 +1,494 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// errUsage возвращается при ошибке разбора флагов, описание уже выведено пакетом flag.
+var errUsage = errors.New("usage")
+
+type command struct {
+	name    string
+	summary string
+	run     func(a *app, args []string) error
+}
+
+var commands = []*command{
+	{name: "user create", summary: "создать пользователя", run: (*app).userCreate},
+	{name: "user list", summary: "список пользователей", run: (*app).userList},
+	{name: "user rating", summary: "рейтинг пользователя (CalculateUserRating)", run: (*app).userRating},
+	{name: "user report", summary: "финансовый отчет пользователя за период (GetUserFinancialReport)", run: (*app).userReport},
+	{name: "auth register", summary: "зарегистрировать учетную запись", run: (*app).authRegister},
+	{name: "field create", summary: "создать сферу деятельности", run: (*app).fieldCreate},
+	{name: "field list", summary: "список сфер деятельности", run: (*app).fieldList},
+	{name: "field set-cost", summary: "изменить вес сферы деятельности", run: (*app).fieldSetCost},
+	{name: "field delete", summary: "удалить сферу деятельности", run: (*app).fieldDelete},
+	{name: "company create", summary: "создать компанию", run: (*app).companyCreate},
+	{name: "company list", summary: "список компаний", run: (*app).companyList},
+	{name: "report import", summary: "импортировать финансовые отчеты из CSV", run: (*app).reportImport},
+	{name: "report list", summary: "отчеты компании за период", run: (*app).reportList},
+	{name: "tax create", summary: "добавить шкалу налогообложения", run: (*app).taxCreate},
+	{name: "tax list", summary: "шкалы налогообложения юрисдикции", run: (*app).taxList},
+	{name: "tax delete", summary: "удалить шкалу налогообложения", run: (*app).taxDelete},
+}
+
+func findCommand(args []string) (*command, []string) {
+	if len(args) < 2 {
+		return nil, nil
+	}
+
+	name := args[0] + " " + args[1]
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd, args[2:]
+		}
+	}
+
+	return nil, nil
+}
+
+func printCommands(w io.Writer) {
+	fmt.Fprintln(w, "\nкоманды:")
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, cmd := range commands {
+		fmt.Fprintf(tw, "  %s\t%s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintf(tw, "  %s\t%s\n", "shell", "выполнить команды из stdin построчно")
+	_ = tw.Flush()
+}
+
+func (a *app) flags(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(a.errOut)
+
+	return fs
+}
+
+func parse(fs *flag.FlagSet, args []string) error {
+	err := fs.Parse(args)
+	if err != nil {
+		return errUsage
+	}
+
+	if fs.NArg() > 0 {
+		fmt.Fprintf(fs.Output(), "лишние аргументы: %s\n", strings.Join(fs.Args(), " "))
+		fs.Usage()
+		return errUsage
+	}
+
+	return nil
+}
+
+func required(name, value string) error {
+	if value == "" {
+		return domain.NewValidationError(name, i18n.CLIArgRequired, name)
+	}
+
+	return nil
+}
+
+func parseID(name, value string) (uuid.UUID, error) {
+	err := required(name, value)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, domain.NewValidationError(name, i18n.CLIArgInvalid, name)
+	}
+
+	return id, nil
+}
+
+// parseQuarter разбирает квартал в формате 2024Q1.
+func parseQuarter(name, value string) (year, quarter int, err error) {
+	err = required(name, value)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	y, q, ok := strings.Cut(strings.ToUpper(value), "Q")
+	if ok {
+		year, err = strconv.Atoi(y)
+		if err == nil {
+			quarter, err = strconv.Atoi(q)
+		}
+	}
+	if !ok || err != nil {
+		return 0, 0, domain.NewValidationError(name, i18n.CLIArgInvalid, name)
+	}
+
+	return year, quarter, nil
+}
+
+func formatQuarter(year, quarter int) string {
+	return fmt.Sprintf("%dQ%d", year, quarter)
+}
+
+func parsePeriod(from, to string) (*domain.Period, error) {
+	startYear, startQuarter, err := parseQuarter("from", from)
+	if err != nil {
+		return nil, err
+	}
+
+	endYear, endQuarter, err := parseQuarter("to", to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Period{
+		StartYear:    startYear,
+		StartQuarter: startQuarter,
+		EndYear:      endYear,
+		EndQuarter:   endQuarter,
+	}, nil
+}
+
+func pageFlags(fs *flag.FlagSet) *domain.PageRequest {
+	req := &domain.PageRequest{}
+	fs.IntVar(&req.Limit, "limit", 0, "размер страницы")
+	fs.IntVar(&req.Offset, "offset", 0, "смещение")
+	fs.StringVar(&req.Cursor, "cursor", "", "курсор следующей страницы")
+	fs.StringVar(&req.Sort, "sort", "", "поле сортировки, - в начале для убывания")
+
+	return req
+}
+
+// resolveUser принимает id пользователя или его имя.
+func (a *app) resolveUser(value string) (uuid.UUID, error) {
+	err := required("user", value)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	id, err := uuid.Parse(value)
+	if err == nil {
+		return id, nil
+	}
+
+	user, err := a.users.GetByUsername(a.ctx, value)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return user.ID, nil
+}
+
+func (a *app) userCreate(args []string) error {
+	fs := a.flags("user create")
+	user := &domain.User{}
+	var birthday string
+	fs.StringVar(&user.Username, "username", "", "имя пользователя")
+	fs.StringVar(&user.FullName, "full-name", "", "ФИО из трех слов")
+	fs.StringVar(&user.Gender, "gender", "", "пол: m или w")
+	fs.StringVar(&birthday, "birthday", "", "дата рождения YYYY-MM-DD")
+	fs.StringVar(&user.City, "city", "", "город")
+	fs.StringVar(&user.Role, "role", domain.RoleEntrepreneur, "роль")
+	err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if birthday != "" {
+		user.Birthday, err = time.Parse(dateLayout, birthday)
+		if err != nil {
+			return domain.NewValidationError("birthday", i18n.CLIArgInvalid, "birthday")
+		}
+	}
+
+	err = a.users.Create(a.ctx, user)
+	if err != nil {
+		return err
+	}
+
+	return a.print(single(newUserView(user), userColumns))
+}
+
+func (a *app) userList(args []string) error {
+	fs := a.flags("user list")
+	filter := domain.UserFilter{}
+	fs.StringVar(&filter.City, "city", "", "город")
+	fs.StringVar(&filter.Gender, "gender", "", "пол: m или w")
+	fs.IntVar(&filter.MinAge, "min-age", 0, "минимальный возраст")
+	fs.IntVar(&filter.MaxAge, "max-age", 0, "максимальный возраст")
+	fs.StringVar(&filter.Search, "search", "", "поиск по имени")
+	req := pageFlags(fs)
+	err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	users, err := a.users.GetAll(a.ctx, filter, *req)
+	if err != nil {
+		return err
+	}
+
+	return a.print(pageOf(users, userColumns, newUserView))
+}
+
+func (a *app) userRating(args []string) error {
+	fs := a.flags("user rating")
+	ref := fs.String("user", "", "id или имя пользователя")
+	err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	id, err := a.resolveUser(*ref)
+	if err != nil {
+		return err
+	}
+
+	rating, err := a.interactor.CalculateUserRating(a.ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return a.print(single(&ratingView{UserID: id, Rating: rating}, ratingColumns))
+}
+
+func (a *app) userReport(args []string) error {
+	fs := a.flags("user report")
+	ref := fs.String("user", "", "id или имя пользователя")
+	from := fs.String("from", "", "первый квартал периода, например 2023Q1")
+	to := fs.String("to", "", "последний квартал периода, например 2023Q4")
+	err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	id, err := a.resolveUser(*ref)
+	if err != nil {
+		return err
+	}
+
+	period, err := parsePeriod(*from, *to)
+	if err != nil {
+		return err
+	}
+
+	report, err := a.interactor.GetUserFinancialReport(a.ctx, id, period)
+	if err != nil {
+		return err
+	}
+
+	return a.print(single(newUserFinReportView(id, report), userFinReportColumns))
+}
+
+func (a *app) authRegister(args []string) error {
+	fs := a.flags("auth register")
+	account := &domain.UserAuth{}
+	fs.StringVar(&account.Username, "username", "", "имя пользователя")
+	fs.StringVar(&account.Password, "password", "", "пароль")
+	fs.StringVar(&account.Role, "role", domain.RoleEntrepreneur, "роль: admin, entrepreneur или guest")
+	err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	err = a.auth.Register(a.ctx, account)
+	if err != nil {
+		return err
+	}
+
+	return a.print(single(&accountView{ID: account.ID, Username: account.Username, Role: account.Role}, accountColumns))
+}
+
+func (a *app) fieldCreate(args []string) error {
+	fs := a.flags("field create")
+	field := &domain.ActivityField{}
+	fs.StringVar(&field.Name, "name", "", "название")
+	fs.StringVar(&field.Description, "description", "", "описание")
+	cost := fs.Float64("cost", 0, "вес сферы деятельности")
+	err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	field.Cost = float32(*cost)
+
+	err = a.fields.Create(a.ctx, field)
+	if err != nil {
+		return err
+	}
+
+	return a.print(single(newActivityFieldView(field), activityFieldColumns))
+}
+
+func (a *app) fieldList(args []string) error {
+	fs := a.flags("field list")
+	req := pageFlags(fs)
+	err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	fields, err := a.fields.GetAll(a.ctx, *req)
+	if err != nil {
+		return err
+	}
+
+	return a.print(pageOf(fields, activityFieldColumns, newActivityFieldView))
+}
+
+func (a *app) fieldSetCost(args []string) error {
+	fs := a.flags("field set-cost")
+	rawId := fs.String("id", "", "id сферы деятельности")
+	cost := fs.Float64("cost", 0, "новый вес")
+	err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	id, err := parseID("id", *rawId)
+	if err != nil {
+		return err
+	}
+
+	field, err := a.fields.GetById(a.ctx, id)
+	if err != nil {
+		return err
+	}
+
+	field.Cost = float32(*cost)
+	err = a.fields.Update(a.ctx, field)
+	if err != nil {
+		return err
+	}
+
+	return a.print(single(newActivityFieldView(field), activityFieldColumns))
+}
+
+func (a *app) fieldDelete(args []string) error {
+	fs := a.flags("field delete")
+	rawId := fs.String("id", "", "id сферы деятельности")
+	err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	id, err := parseID("id", *rawId)
+	if err != nil {
+		return err
+	}
+
+	field, err := a.fields.GetById(a.ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = a.fields.DeleteById(a.ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return a.print(single(newActivityFieldView(field), activityFieldColumns))
+}
+
+func (a *app) companyCreate(args []string) error {
+	fs := a.flags("company create")
+	company := &domain.Company{}
+	owner := fs.String("owner", "", "id или имя владельца")
+	fieldId := fs.String("field", "", "id сферы деятельности")
+	fs.StringVar(&company.Name, "name", "", "название")
+	fs.StringVar(&company.City, "city", "", "город")
+	err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	company.OwnerID, err = a.resolveUser(*owner)
+	if err != nil {
+		return err
+	}
+
+	company.ActivityFieldId, err = parseID("field", *fieldId)
+	if err != nil {
+		return err
+	}
+
+	err = a.companies.Create(a.ctx, company)
+	if err != nil {
+		return err
+	}
+
+	return a.print(single(newCompanyView(company), companyColumns))
+}
+
+func (a *app) companyList(args []string) error {
+	fs := a.flags("company list")
+	filter := domain.CompanyFilter{}
+	owner := fs.String("owner", "", "id или имя владельца")
+	fieldId := fs.String("field", "", "id сферы деятельности")
+	fs.StringVar(&filter.City, "city", "", "город")
+	fs.StringVar(&filter.Search, "search", "", "поиск по названию")
+	req := pageFlags(fs)
+	err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if *owner != "" {
+		filter.OwnerId, err = a.resolveUser(*owner)
+		if err != nil {
+			return err
+		}
+	}
+	if *fieldId != "" {
+		filter.ActivityFieldId, err = parseID("field", *fieldId)
+		if err != nil {
+			return err
+		}
+	}
+
+	companies, err := a.companies.GetAll(a.ctx, filter, *req)
+	if err != nil {
+		return err
+	}
+
+	return a.print(pageOf(companies, companyColumns, newCompanyView))
+}
+
+func (a *app) reportList(args []string) error {
+	fs := a.flags("report list")
+	rawId := fs.String("company", "", "id компании")
+	from := fs.String("from", "", "первый квартал периода, например 2023Q1")
+	to := fs.String("to", "", "последний квартал периода, например 2023Q4")
+	err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	id, err := parseID("company", *rawId)
+	if err != nil {
+		return err
+	}
+
+	period, err := parsePeriod(*from, *to)
+	if err != nil {
+		return err
+	}
+
+	byPeriod, err := a.finReports.GetByCompany(a.ctx, id, period)
+	if err != nil {
+		return err
+	}
+
+	reports := make([]*domain.FinancialReport, len(byPeriod.Reports))
+	for i := range byPeriod.Reports {
+		reports[i] = &byPeriod.Reports[i]
+	}
+	page := &domain.Page[*domain.FinancialReport]{Items: reports, Total: len(reports)}
+
+	return a.print(pageOf(page, finReportColumns, newFinReportView))
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// importColumnNames — обязательные колонки CSV с отчетами, порядок колонок в файле произвольный.
+// Необязательная колонка currency задает валюту сумм, по умолчанию domain.DefaultCurrency.
+// Необязательная колонка granularity задает периодичность отчета, по умолчанию квартал:
+// квартальному отчету нужна колонка quarter, месячному — month, годовому достаточно года.
+var importColumnNames = []string{"company_id", "year", "revenue", "costs"}
+
+// reportImport загружает отчеты из CSV одной транзакцией: при ошибке в любой строке
+// не сохраняется ни один отчет.
+func (a *app) reportImport(args []string) error {
+	fs := a.flags("report import")
+	file := fs.String("file", "", "путь к CSV-файлу, - для чтения из stdin")
+	err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	err = required("file", *file)
+	if err != nil {
+		return err
+	}
+
+	in := a.in
+	if *file != "-" {
+		f, err := os.Open(*file)
+		if err != nil {
+			return i18n.Wrap(err, i18n.CLIImport)
+		}
+		defer f.Close()
+		in = f
+	}
+
+	reports, err := readReports(in)
+	if err != nil {
+		return i18n.Wrap(err, i18n.CLIImport)
+	}
+
+	err = a.finReports.CreateByPeriod(a.ctx, &domain.FinancialReportByPeriod{Reports: reports})
+	if err != nil {
+		return err
+	}
+
+	return a.print(single(&importView{Imported: len(reports)}, importColumns))
+}
+
+func readReports(in io.Reader) ([]domain.FinancialReport, error) {
+	reports := make([]domain.FinancialReport, 0)
+	err := readCSV(in, importColumnNames, func(field func(name string) string) error {
+		report, err := parseReport(field)
+		if err != nil {
+			return err
+		}
+		reports = append(reports, report)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
+
+// readCSV читает CSV с заголовком, в котором должны быть колонки names, и вызывает row для каждой строки.
+// Функция field возвращает значение колонки строки или пустую строку, если колонки в файле нет.
+func readCSV(in io.Reader, names []string, row func(field func(name string) string) error) error {
+	r := csv.NewReader(in)
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if errors.Is(err, io.EOF) {
+		return domain.NewValidationError("file", i18n.CLIImportHeader, strings.Join(names, ", "))
+	}
+	if err != nil {
+		return err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, name := range names {
+		if _, ok := columns[name]; !ok {
+			return domain.NewValidationError("file", i18n.CLIImportHeader, strings.Join(names, ", "))
+		}
+	}
+
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		field := func(name string) string {
+			i, ok := columns[name]
+			if !ok {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		line, _ := r.FieldPos(0)
+		err = row(field)
+		if err != nil {
+			return i18n.Wrap(err, i18n.CLIImportRow, line)
+		}
+	}
+}
+
+func parseReport(field func(name string) string) (report domain.FinancialReport, err error) {
+	invalid := func(name string) error {
+		return domain.NewValidationError(name, i18n.CLIColumnInvalid, name)
+	}
+
+	report.CompanyID, err = uuid.Parse(field("company_id"))
+	if err != nil {
+		return report, invalid("company_id")
+	}
+
+	report.Year, err = strconv.Atoi(field("year"))
+	if err != nil {
+		return report, invalid("year")
+	}
+
+	// квартал и месяц указываются не для всех периодичностей
+	optional := func(name string) (int, error) {
+		if field(name) == "" {
+			return 0, nil
+		}
+
+		value, err := strconv.Atoi(field(name))
+		if err != nil {
+			return 0, invalid(name)
+		}
+
+		return value, nil
+	}
+
+	report.Granularity = field("granularity")
+
+	report.Quarter, err = optional("quarter")
+	if err != nil {
+		return report, err
+	}
+
+	report.Month, err = optional("month")
+	if err != nil {
+		return report, err
+	}
+
+	currency := field("currency")
+	if currency == "" {
+		currency = domain.DefaultCurrency
+	}
+
+	report.Revenue, err = domain.ParseMoney(field("revenue"), currency)
+	if err != nil {
+		return report, invalid("revenue")
+	}
+
+	report.Costs, err = domain.ParseMoney(field("costs"), currency)
+	if err != nil {
+		return report, invalid("costs")
+	}
+
+	return report, nil
+}
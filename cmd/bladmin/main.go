@@ -0,0 +1,193 @@
+// Утилита bladmin администрирует данные без написания кода на Go.
+//
+//	bladmin [-backend sqlite|memory] [-db bl.db] [-format table|json] [-rates rates.csv] [-loss-carry-years 10] <команда> [флаги]
+//	bladmin -backend memory shell < commands.txt
+//
+// Хранилище memory живет только в рамках одного запуска, поэтому с ним
+// имеет смысл команда shell, которая читает команды построчно из stdin.
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/logger"
+	"github.com/dlankinl/bmstu-ppo-bl/services/user_activity_field"
+	"io"
+	"os"
+	"strings"
+)
+
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+type config struct {
+	backend      string
+	db           string
+	format       string
+	locale       i18n.Locale
+	logLevel     string
+	jurisdiction string
+	currency     string
+	rates        string
+	carryYears   int
+}
+
+func main() {
+	os.Exit(run(context.Background(), os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(ctx context.Context, args []string, in io.Reader, out, errOut io.Writer) int {
+	cfg := &config{}
+	var lang string
+
+	fs := flag.NewFlagSet("bladmin", flag.ContinueOnError)
+	fs.SetOutput(errOut)
+	fs.StringVar(&cfg.backend, "backend", backendSQLite, "хранилище: sqlite или memory")
+	fs.StringVar(&cfg.db, "db", "bl.db", "путь к файлу базы SQLite")
+	fs.StringVar(&cfg.format, "format", formatTable, "формат вывода: table или json")
+	fs.StringVar(&lang, "lang", string(i18n.DefaultLocale), "язык сообщений: ru или en")
+	fs.StringVar(&cfg.logLevel, "log-level", logger.ErrorLevel, "уровень журнала: error, warn или info")
+	fs.StringVar(&cfg.jurisdiction, "jurisdiction", domain.DefaultJurisdiction, "юрисдикция шкал налогообложения")
+	fs.StringVar(&cfg.currency, "report-currency", domain.DefaultCurrency, "валюта отчетности, к которой приводятся отчеты за период")
+	fs.StringVar(&cfg.rates, "rates", "", "путь к CSV-файлу курсов валют с колонками currency, year, quarter, rate")
+	fs.IntVar(&cfg.carryYears, "loss-carry-years", user_activity_field.DefaultLossCarryYears, "число лет, из которых убыток переносится на налоги периода")
+	fs.Usage = func() {
+		fmt.Fprintln(errOut, "использование: bladmin [флаги] <команда> [флаги команды]")
+		fs.PrintDefaults()
+		printCommands(errOut)
+	}
+
+	err := fs.Parse(args)
+	if err != nil {
+		return exitUsage
+	}
+	cfg.locale = i18n.ParseLocale(lang)
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	if cfg.format != formatTable && cfg.format != formatJSON {
+		printError(errOut, cfg.locale, domain.NewValidationError("format", i18n.CLIFormatUnknown, cfg.format))
+		return exitUsage
+	}
+
+	if cfg.carryYears < 0 {
+		printError(errOut, cfg.locale, domain.NewValidationError("loss-carry-years", i18n.CLIArgInvalid, "loss-carry-years"))
+		return exitUsage
+	}
+
+	a, err := newApp(ctx, cfg, in, out, errOut)
+	if err != nil {
+		printError(errOut, cfg.locale, err)
+		return exitError
+	}
+	defer a.close()
+
+	if fs.Arg(0) == "shell" {
+		return a.shell(in)
+	}
+
+	return a.exec(fs.Args())
+}
+
+// exec выполняет одну команду и возвращает код завершения.
+func (a *app) exec(args []string) int {
+	cmd, rest := findCommand(args)
+	if cmd == nil {
+		printError(a.errOut, a.locale, domain.NewValidationError("command", i18n.CLIUnknownCommand, strings.Join(args, " ")))
+		printCommands(a.errOut)
+		return exitUsage
+	}
+
+	err := cmd.run(a, rest)
+	switch {
+	case errors.Is(err, flag.ErrHelp):
+		return exitUsage
+	case errors.Is(err, errUsage):
+		return exitUsage
+	case err != nil:
+		printError(a.errOut, a.locale, err)
+		return exitError
+	}
+
+	return exitOK
+}
+
+// shell выполняет команды из in построчно и останавливается на первой ошибке.
+// Пустые строки и строки, начинающиеся с #, пропускаются.
+func (a *app) shell(in io.Reader) int {
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		args, err := splitArgs(line)
+		if err != nil {
+			printError(a.errOut, a.locale, err)
+			return exitUsage
+		}
+
+		code := a.exec(args)
+		if code != exitOK {
+			return code
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		printError(a.errOut, a.locale, err)
+		return exitError
+	}
+
+	return exitOK
+}
+
+// splitArgs разбивает строку на аргументы по пробелам с учетом двойных кавычек.
+func splitArgs(line string) ([]string, error) {
+	var (
+		args    []string
+		current strings.Builder
+		quoted  bool
+		started bool
+	)
+
+	for _, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			started = true
+		case (r == ' ' || r == '\t') && !quoted:
+			if started {
+				args = append(args, current.String())
+				current.Reset()
+				started = false
+			}
+		default:
+			current.WriteRune(r)
+			started = true
+		}
+	}
+	if quoted {
+		return nil, domain.NewValidationError("command", i18n.CLIQuoteUnclosed, line)
+	}
+	if started {
+		args = append(args, current.String())
+	}
+
+	return args, nil
+}
+
+func printError(w io.Writer, locale i18n.Locale, err error) {
+	fmt.Fprintln(w, "bladmin:", i18n.Localize(err, locale))
+}
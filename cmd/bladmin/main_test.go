@@ -0,0 +1,344 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+type result struct {
+	code   int
+	stdout string
+	stderr string
+}
+
+func runCLI(stdin string, args ...string) result {
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), args, strings.NewReader(stdin), &stdout, &stderr)
+
+	return result{code: code, stdout: stdout.String(), stderr: stderr.String()}
+}
+
+func decode[T any](t *testing.T, data string) T {
+	var v T
+	require.Nil(t, json.Unmarshal([]byte(data), &v), data)
+
+	return v
+}
+
+func TestRun_SQLite(t *testing.T) {
+	db := filepath.Join(t.TempDir(), "bl.db")
+	cli := func(args ...string) result {
+		return runCLI("", append([]string{"-db", db, "-format", "json", "-lang", "en"}, args...)...)
+	}
+
+	res := cli("user", "create", "-username", "ivan", "-full-name", "Иванов Иван Иванович", "-gender", "m",
+		"-birthday", "1990-01-02", "-city", "Москва")
+	require.Equal(t, exitOK, res.code, res.stderr)
+	user := decode[userView](t, res.stdout)
+	require.Equal(t, "1990-01-02", user.Birthday)
+
+	res = cli("field", "create", "-name", "IT", "-description", "информационные технологии", "-cost", "1.5")
+	require.Equal(t, exitOK, res.code, res.stderr)
+	it := decode[activityFieldView](t, res.stdout)
+
+	res = cli("field", "create", "-name", "Торговля", "-description", "розничная торговля", "-cost", "3")
+	require.Equal(t, exitOK, res.code, res.stderr)
+
+	res = cli("field", "set-cost", "-id", it.ID.String(), "-cost", "2.25")
+	require.Equal(t, exitOK, res.code, res.stderr)
+
+	// данные сохраняются между запусками
+	res = cli("field", "list", "-sort", "name")
+	require.Equal(t, exitOK, res.code, res.stderr)
+	fields := decode[pageView[activityFieldView]](t, res.stdout)
+	require.Equal(t, 2, fields.Total)
+	require.Equal(t, "IT", fields.Items[0].Name)
+	require.Equal(t, float32(2.25), fields.Items[0].Cost)
+
+	res = cli("company", "create", "-owner", "ivan", "-field", it.ID.String(), "-name", "Альфа", "-city", "Москва")
+	require.Equal(t, exitOK, res.code, res.stderr)
+	company := decode[companyView](t, res.stdout)
+	require.Equal(t, user.ID, company.OwnerID)
+
+	prevYear := time.Now().Year() - 1
+	csv := "company_id,year,quarter,revenue,costs,currency\n"
+	for q := 1; q <= 4; q++ {
+		csv += fmt.Sprintf("%s,%d,%d,%d.50,%d.50,RUB\n", company.ID, prevYear, q, 1_000_000, 400_000)
+	}
+	file := filepath.Join(t.TempDir(), "reports.csv")
+	require.Nil(t, os.WriteFile(file, []byte(csv), 0o644))
+
+	res = cli("report", "import", "-file", file)
+	require.Equal(t, exitOK, res.code, res.stderr)
+	require.Equal(t, 4, decode[importView](t, res.stdout).Imported)
+
+	period := []string{"-from", fmt.Sprintf("%dQ1", prevYear), "-to", fmt.Sprintf("%dQ4", prevYear)}
+	res = cli(append([]string{"report", "list", "-company", company.ID.String()}, period...)...)
+	require.Equal(t, exitOK, res.code, res.stderr)
+	// кварталы полного года выводятся одним годовым отчетом
+	require.Len(t, decode[pageView[finReportView]](t, res.stdout).Items, 1)
+
+	// месячные отчеты полного квартала выводятся одним квартальным отчетом
+	csv = "company_id,year,granularity,month,revenue,costs\n"
+	for month := 1; month <= 3; month++ {
+		csv += fmt.Sprintf("%s,%d,month,%d,100,40\n", company.ID, prevYear-1, month)
+	}
+	require.Nil(t, os.WriteFile(file, []byte(csv), 0o644))
+	res = cli("report", "import", "-file", file)
+	require.Equal(t, exitOK, res.code, res.stderr)
+	require.Equal(t, 3, decode[importView](t, res.stdout).Imported)
+
+	res = cli("report", "list", "-company", company.ID.String(), "-from", fmt.Sprintf("%dQ1", prevYear-1), "-to", fmt.Sprintf("%dQ1", prevYear-1))
+	require.Equal(t, exitOK, res.code, res.stderr)
+	months := decode[pageView[finReportView]](t, res.stdout).Items
+	require.Len(t, months, 1)
+	require.Equal(t, "quarter", months[0].Granularity)
+	require.Equal(t, json.Number("300.00"), months[0].Revenue)
+
+	res = cli(append([]string{"user", "report", "-user", user.ID.String()}, period...)...)
+	require.Equal(t, exitOK, res.code, res.stderr)
+	report := decode[userFinReportView](t, res.stdout)
+	require.Equal(t, json.Number("4000002.00"), report.Revenue)
+	require.Equal(t, json.Number("2400000.00"), report.Profit)
+	require.Len(t, report.Reports, 1)
+	require.Equal(t, json.Number("96000.00"), report.Taxes)
+
+	res = cli("tax", "create", "-year", fmt.Sprint(prevYear), "-field", it.ID.String(), "-method", "marginal",
+		"-brackets", "0:0, 1000000:10")
+	require.Equal(t, exitOK, res.code, res.stderr)
+	schedule := decode[taxScheduleView](t, res.stdout)
+	require.Equal(t, "RU", schedule.Jurisdiction)
+	require.Len(t, schedule.Brackets, 2)
+
+	res = cli("tax", "list")
+	require.Equal(t, exitOK, res.code, res.stderr)
+	require.Equal(t, 1, decode[pageView[taxScheduleView]](t, res.stdout).Total)
+
+	res = cli(append([]string{"user", "report", "-user", user.ID.String()}, period...)...)
+	require.Equal(t, exitOK, res.code, res.stderr)
+	require.Equal(t, json.Number("140000.00"), decode[userFinReportView](t, res.stdout).Taxes)
+
+	res = cli("user", "rating", "-user", "ivan")
+	require.Equal(t, exitOK, res.code, res.stderr)
+	require.Greater(t, decode[ratingView](t, res.stdout).Rating, float32(0))
+
+	res = cli("auth", "register", "-username", "admin", "-password", "secret", "-role", "admin")
+	require.Equal(t, exitOK, res.code, res.stderr)
+	require.Equal(t, "admin", decode[accountView](t, res.stdout).Role)
+
+	res = cli("user", "list", "-search", "иван")
+	require.Equal(t, exitOK, res.code, res.stderr)
+	require.Equal(t, 1, decode[pageView[userView]](t, res.stdout).Total)
+}
+
+func TestRun_Rates(t *testing.T) {
+	dir := t.TempDir()
+	rates := filepath.Join(dir, "rates.csv")
+	require.Nil(t, os.WriteFile(rates, []byte("currency,year,quarter,rate\nUSD,2023,1,75\nUSD,2023,2,80\nUSD,2023,3,90\nUSD,2023,4,100\n"), 0o644))
+
+	db := filepath.Join(dir, "bl.db")
+	cli := func(currency string, args ...string) result {
+		return runCLI("", append([]string{"-db", db, "-format", "json", "-rates", rates, "-report-currency", currency}, args...)...)
+	}
+
+	res := cli("RUB", "user", "create", "-username", "ivan", "-full-name", "Иванов Иван Иванович", "-gender", "m",
+		"-birthday", "1990-01-02", "-city", "Москва")
+	require.Equal(t, exitOK, res.code, res.stderr)
+	user := decode[userView](t, res.stdout)
+
+	res = cli("RUB", "field", "create", "-name", "IT", "-description", "информационные технологии", "-cost", "1")
+	require.Equal(t, exitOK, res.code, res.stderr)
+	it := decode[activityFieldView](t, res.stdout)
+
+	res = cli("RUB", "company", "create", "-owner", "ivan", "-field", it.ID.String(), "-name", "Альфа", "-city", "Москва")
+	require.Equal(t, exitOK, res.code, res.stderr)
+	company := decode[companyView](t, res.stdout)
+
+	csv := "company_id,year,quarter,revenue,costs,currency\n"
+	for q := 1; q <= 4; q++ {
+		csv += fmt.Sprintf("%s,2023,%d,10000,2000,USD\n", company.ID, q)
+	}
+	file := filepath.Join(dir, "reports.csv")
+	require.Nil(t, os.WriteFile(file, []byte(csv), 0o644))
+
+	res = cli("RUB", "report", "import", "-file", file)
+	require.Equal(t, exitOK, res.code, res.stderr)
+
+	period := []string{"-user", user.ID.String(), "-from", "2023Q1", "-to", "2023Q4"}
+	res = cli("RUB", append([]string{"user", "report"}, period...)...)
+	require.Equal(t, exitOK, res.code, res.stderr)
+	report := decode[userFinReportView](t, res.stdout)
+	require.Equal(t, "RUB", report.Currency)
+	require.Equal(t, json.Number("3450000.00"), report.Revenue)
+	require.Equal(t, json.Number("110400.00"), report.Taxes)
+
+	res = cli("USD", append([]string{"user", "report"}, period...)...)
+	require.Equal(t, exitOK, res.code, res.stderr)
+	report = decode[userFinReportView](t, res.stdout)
+	require.Equal(t, "USD", report.Currency)
+	require.Equal(t, json.Number("40000.00"), report.Revenue)
+	require.Equal(t, json.Number("1104.00"), report.Taxes)
+}
+
+func TestRun_MemoryShell(t *testing.T) {
+	script := `
+# пустые строки и комментарии пропускаются
+field create -name IT -description "информационные технологии" -cost 1.5
+field create -name Торговля -description "розничная торговля" -cost 0.5
+field list -sort cost
+`
+	res := runCLI(script, "-backend", "memory", "shell")
+	require.Equal(t, exitOK, res.code, res.stderr)
+
+	lines := strings.Split(strings.TrimSpace(res.stdout), "\n")
+	require.Len(t, lines, 7)
+	require.True(t, strings.HasPrefix(lines[4], "ID"))
+	require.Contains(t, lines[5], "Торговля")
+	require.Contains(t, lines[6], "информационные технологии")
+}
+
+func TestRun_Errors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		stdin  string
+		args   []string
+		code   int
+		stderr string
+	}{
+		{
+			name:   "неизвестная команда",
+			args:   []string{"-backend", "memory", "user", "drop"},
+			code:   exitUsage,
+			stderr: "bladmin: неизвестная команда user drop",
+		},
+		{
+			name:   "неизвестный формат",
+			args:   []string{"-backend", "memory", "-format", "xml", "field", "list"},
+			code:   exitUsage,
+			stderr: "bladmin: неизвестный формат вывода xml",
+		},
+		{
+			name:   "неизвестное хранилище",
+			args:   []string{"-backend", "redis", "field", "list"},
+			code:   exitError,
+			stderr: "bladmin: неизвестное хранилище redis",
+		},
+		{
+			name:   "валюта отчетности без курсов",
+			args:   []string{"-backend", "memory", "-report-currency", "USD", "field", "list"},
+			code:   exitError,
+			stderr: "bladmin: валюта USD не поддерживается",
+		},
+		{
+			name:   "отрицательный срок переноса убытков",
+			args:   []string{"-backend", "memory", "-loss-carry-years", "-1", "field", "list"},
+			code:   exitUsage,
+			stderr: "bladmin: некорректное значение флага -loss-carry-years",
+		},
+		{
+			name:   "не указан флаг",
+			args:   []string{"-backend", "memory", "-lang", "en", "field", "set-cost", "-cost", "2"},
+			code:   exitError,
+			stderr: "bladmin: flag -id is required",
+		},
+		{
+			name:   "некорректный период",
+			args:   []string{"-backend", "memory", "-lang", "en", "user", "report", "-user", "00000000-0000-0000-0000-000000000001", "-from", "2023", "-to", "2023Q4"},
+			code:   exitError,
+			stderr: "bladmin: invalid value of flag -from",
+		},
+		{
+			name:   "ошибка в строке CSV",
+			stdin:  "company_id,year,quarter,revenue,costs\n00000000-0000-0000-0000-000000000001,2023,1,abc,1\n",
+			args:   []string{"-backend", "memory", "-lang", "en", "report", "import", "-file", "-"},
+			code:   exitError,
+			stderr: "bladmin: importing financial reports: row 2: invalid value in column revenue",
+		},
+		{
+			name:   "некорректный месяц в CSV",
+			stdin:  "company_id,year,granularity,month,revenue,costs\n00000000-0000-0000-0000-000000000001,2023,month,jan,1,1\n",
+			args:   []string{"-backend", "memory", "-lang", "en", "report", "import", "-file", "-"},
+			code:   exitError,
+			stderr: "bladmin: importing financial reports: row 2: invalid value in column month",
+		},
+		{
+			name:   "неполный заголовок CSV",
+			stdin:  "company_id,year\n",
+			args:   []string{"-backend", "memory", "-lang", "en", "report", "import", "-file", "-"},
+			code:   exitError,
+			stderr: "bladmin: importing financial reports: CSV header must contain columns company_id, year, revenue, costs",
+		},
+		{
+			name:   "некорректные ступени шкалы",
+			args:   []string{"-backend", "memory", "-lang", "en", "tax", "create", "-year", "2024", "-brackets", "0-4"},
+			code:   exitError,
+			stderr: "bladmin: invalid value of flag -brackets",
+		},
+		{
+			name:   "shell останавливается на первой ошибке",
+			stdin:  "field create -name IT -description IT -cost 0\nfield list\n",
+			args:   []string{"-backend", "memory", "-lang", "en", "shell"},
+			code:   exitError,
+			stderr: "bladmin: activity field cost cannot be zero",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := runCLI(tc.stdin, tc.args...)
+			require.Equal(t, tc.code, res.code)
+			require.Equal(t, tc.stderr, strings.SplitN(res.stderr, "\n", 2)[0])
+			if tc.code == exitError {
+				require.Empty(t, res.stdout)
+			}
+		})
+	}
+}
+
+func TestSplitArgs(t *testing.T) {
+	testCases := []struct {
+		name     string
+		line     string
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name:     "аргументы через пробелы",
+			line:     "field  list\t-sort name",
+			expected: []string{"field", "list", "-sort", "name"},
+		},
+		{
+			name:     "значение в кавычках",
+			line:     `user create -full-name "Иванов Иван Иванович" -city Москва`,
+			expected: []string{"user", "create", "-full-name", "Иванов Иван Иванович", "-city", "Москва"},
+		},
+		{
+			name:     "пустое значение в кавычках",
+			line:     `user list -city ""`,
+			expected: []string{"user", "list", "-city", ""},
+		},
+		{
+			name:    "незакрытая кавычка",
+			line:    `field create -name "IT`,
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			args, err := splitArgs(tc.line)
+			if tc.wantErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			require.Equal(t, tc.expected, args)
+		})
+	}
+}
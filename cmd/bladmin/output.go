@@ -0,0 +1,324 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+
+	dateLayout = "2006-01-02"
+)
+
+// view описывает результат команды: value печатается в JSON, columns и rows — таблицей.
+type view struct {
+	value   any
+	columns []string
+	rows    [][]string
+}
+
+func (a *app) print(v *view) error {
+	if a.format == formatJSON {
+		enc := json.NewEncoder(a.out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v.value)
+	}
+
+	w := tabwriter.NewWriter(a.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(v.columns, "\t"))
+	for _, row := range v.rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+
+	return w.Flush()
+}
+
+func formatFloat(f float32) string {
+	return strconv.FormatFloat(float64(f), 'f', -1, 32)
+}
+
+type userView struct {
+	ID       uuid.UUID `json:"id"`
+	Username string    `json:"username"`
+	FullName string    `json:"full_name"`
+	Gender   string    `json:"gender"`
+	Birthday string    `json:"birthday"`
+	City     string    `json:"city"`
+	Role     string    `json:"role"`
+}
+
+func newUserView(user *domain.User) *userView {
+	return &userView{
+		ID:       user.ID,
+		Username: user.Username,
+		FullName: user.FullName,
+		Gender:   user.Gender,
+		Birthday: user.Birthday.Format(dateLayout),
+		City:     user.City,
+		Role:     user.Role,
+	}
+}
+
+var userColumns = []string{"ID", "USERNAME", "FULL NAME", "GENDER", "BIRTHDAY", "CITY", "ROLE"}
+
+func (v *userView) row() []string {
+	return []string{v.ID.String(), v.Username, v.FullName, v.Gender, v.Birthday, v.City, v.Role}
+}
+
+type accountView struct {
+	ID       uuid.UUID `json:"id"`
+	Username string    `json:"username"`
+	Role     string    `json:"role"`
+}
+
+var accountColumns = []string{"ID", "USERNAME", "ROLE"}
+
+func (v *accountView) row() []string {
+	return []string{v.ID.String(), v.Username, v.Role}
+}
+
+type activityFieldView struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Cost        float32   `json:"cost"`
+}
+
+func newActivityFieldView(field *domain.ActivityField) *activityFieldView {
+	return &activityFieldView{
+		ID:          field.ID,
+		Name:        field.Name,
+		Description: field.Description,
+		Cost:        field.Cost,
+	}
+}
+
+var activityFieldColumns = []string{"ID", "NAME", "DESCRIPTION", "COST"}
+
+func (v *activityFieldView) row() []string {
+	return []string{v.ID.String(), v.Name, v.Description, formatFloat(v.Cost)}
+}
+
+type companyView struct {
+	ID              uuid.UUID `json:"id"`
+	OwnerID         uuid.UUID `json:"owner_id"`
+	ActivityFieldID uuid.UUID `json:"activity_field_id"`
+	Name            string    `json:"name"`
+	City            string    `json:"city"`
+}
+
+func newCompanyView(company *domain.Company) *companyView {
+	return &companyView{
+		ID:              company.ID,
+		OwnerID:         company.OwnerID,
+		ActivityFieldID: company.ActivityFieldId,
+		Name:            company.Name,
+		City:            company.City,
+	}
+}
+
+var companyColumns = []string{"ID", "OWNER", "ACTIVITY FIELD", "NAME", "CITY"}
+
+func (v *companyView) row() []string {
+	return []string{v.ID.String(), v.OwnerID.String(), v.ActivityFieldID.String(), v.Name, v.City}
+}
+
+type finReportView struct {
+	ID          uuid.UUID   `json:"id"`
+	CompanyID   uuid.UUID   `json:"company_id"`
+	Granularity string      `json:"granularity"`
+	Year        int         `json:"year"`
+	Quarter     int         `json:"quarter"`
+	Month       int         `json:"month"`
+	Revenue     json.Number `json:"revenue"`
+	Costs       json.Number `json:"costs"`
+	Currency    string      `json:"currency"`
+}
+
+func newFinReportView(report *domain.FinancialReport) *finReportView {
+	return &finReportView{
+		ID:          report.ID,
+		CompanyID:   report.CompanyID,
+		Granularity: report.Granularity,
+		Year:        report.Year,
+		Quarter:     report.Quarter,
+		Month:       report.Month,
+		Revenue:     json.Number(report.Revenue.Decimal()),
+		Costs:       json.Number(report.Costs.Decimal()),
+		Currency:    report.Revenue.Currency,
+	}
+}
+
+var finReportColumns = []string{"ID", "COMPANY", "GRANULARITY", "YEAR", "QUARTER", "MONTH", "REVENUE", "COSTS", "CURRENCY"}
+
+func (v *finReportView) row() []string {
+	return []string{
+		v.ID.String(), v.CompanyID.String(), v.Granularity, strconv.Itoa(v.Year), strconv.Itoa(v.Quarter),
+		strconv.Itoa(v.Month), v.Revenue.String(), v.Costs.String(), v.Currency,
+	}
+}
+
+type pageView[T any] struct {
+	Items      []T    `json:"items"`
+	Total      int    `json:"total"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+type rower interface {
+	row() []string
+}
+
+// pageOf строит представление страницы, convert переводит элемент домена в его представление.
+func pageOf[T any, V rower](page *domain.Page[T], columns []string, convert func(T) V) *view {
+	items := make([]V, len(page.Items))
+	rows := make([][]string, len(page.Items))
+	for i, item := range page.Items {
+		items[i] = convert(item)
+		rows[i] = items[i].row()
+	}
+
+	return &view{
+		value:   &pageView[V]{Items: items, Total: page.Total, NextCursor: page.NextCursor},
+		columns: columns,
+		rows:    rows,
+	}
+}
+
+func single(value rower, columns []string) *view {
+	return &view{value: value, columns: columns, rows: [][]string{value.row()}}
+}
+
+type periodView struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+type userFinReportView struct {
+	UserID   uuid.UUID        `json:"user_id"`
+	Period   periodView       `json:"period"`
+	Revenue  json.Number      `json:"revenue"`
+	Costs    json.Number      `json:"costs"`
+	Profit   json.Number      `json:"profit"`
+	Taxes    json.Number      `json:"taxes"`
+	Currency string           `json:"currency"`
+	TaxLoad  *float32         `json:"tax_load,omitempty"`
+	Reports  []*finReportView `json:"reports"`
+}
+
+func newUserFinReportView(userId uuid.UUID, report *domain.FinancialReportByPeriod) *userFinReportView {
+	reports := make([]*finReportView, len(report.Reports))
+	for i := range report.Reports {
+		reports[i] = newFinReportView(&report.Reports[i])
+	}
+
+	return &userFinReportView{
+		UserID: userId,
+		Period: periodView{
+			Start: formatQuarter(report.Period.StartYear, report.Period.StartQuarter),
+			End:   formatQuarter(report.Period.EndYear, report.Period.EndQuarter),
+		},
+		Revenue:  json.Number(report.Revenue().Decimal()),
+		Costs:    json.Number(report.Costs().Decimal()),
+		Profit:   json.Number(report.Profit().Decimal()),
+		Taxes:    json.Number(report.Taxes.Decimal()),
+		Currency: report.Currency,
+		TaxLoad:  report.TaxLoad,
+		Reports:  reports,
+	}
+}
+
+var userFinReportColumns = []string{"USER", "PERIOD", "REVENUE", "COSTS", "PROFIT", "TAXES", "CURRENCY", "TAX LOAD"}
+
+func (v *userFinReportView) row() []string {
+	// без полного года с выручкой налоговая нагрузка не определена
+	taxLoad := "-"
+	if v.TaxLoad != nil {
+		taxLoad = formatFloat(*v.TaxLoad)
+	}
+
+	return []string{
+		v.UserID.String(), v.Period.Start + "-" + v.Period.End,
+		v.Revenue.String(), v.Costs.String(), v.Profit.String(),
+		v.Taxes.String(), v.Currency, taxLoad,
+	}
+}
+
+type ratingView struct {
+	UserID uuid.UUID `json:"user_id"`
+	Rating float32   `json:"rating"`
+}
+
+var ratingColumns = []string{"USER", "RATING"}
+
+func (v *ratingView) row() []string {
+	return []string{v.UserID.String(), formatFloat(v.Rating)}
+}
+
+type importView struct {
+	Imported int `json:"imported"`
+}
+
+var importColumns = []string{"IMPORTED"}
+
+func (v *importView) row() []string {
+	return []string{strconv.Itoa(v.Imported)}
+}
+
+type taxBracketView struct {
+	Threshold json.Number `json:"threshold"`
+	Rate      float32     `json:"rate"`
+}
+
+type taxScheduleView struct {
+	ID              uuid.UUID        `json:"id"`
+	Jurisdiction    string           `json:"jurisdiction"`
+	ActivityFieldID *uuid.UUID       `json:"activity_field_id,omitempty"`
+	Year            int              `json:"year"`
+	Method          string           `json:"method"`
+	Currency        string           `json:"currency"`
+	Brackets        []taxBracketView `json:"brackets"`
+}
+
+func newTaxScheduleView(schedule *domain.TaxSchedule) *taxScheduleView {
+	v := &taxScheduleView{
+		ID:           schedule.ID,
+		Jurisdiction: schedule.Jurisdiction,
+		Year:         schedule.Year,
+		Method:       schedule.Method,
+		Brackets:     make([]taxBracketView, len(schedule.Brackets)),
+	}
+	if schedule.ActivityFieldId != uuid.Nil {
+		v.ActivityFieldID = &schedule.ActivityFieldId
+	}
+	for i, bracket := range schedule.Brackets {
+		v.Currency = bracket.Threshold.Currency
+		v.Brackets[i] = taxBracketView{Threshold: json.Number(bracket.Threshold.Decimal()), Rate: bracket.Rate}
+	}
+
+	return v
+}
+
+var taxScheduleColumns = []string{"ID", "JURISDICTION", "ACTIVITY FIELD", "YEAR", "METHOD", "CURRENCY", "BRACKETS"}
+
+func (v *taxScheduleView) row() []string {
+	field := "*"
+	if v.ActivityFieldID != nil {
+		field = v.ActivityFieldID.String()
+	}
+
+	brackets := make([]string, len(v.Brackets))
+	for i, bracket := range v.Brackets {
+		brackets[i] = bracket.Threshold.String() + ":" + formatFloat(bracket.Rate)
+	}
+
+	return []string{
+		v.ID.String(), v.Jurisdiction, field, strconv.Itoa(v.Year), v.Method, v.Currency, strings.Join(brackets, ","),
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/dlankinl/bmstu-ppo-bl/services/exchange_rate"
+	"math/big"
+	"os"
+	"strconv"
+)
+
+// rateColumnNames — колонки CSV с курсами валют. Курс rate — стоимость единицы currency
+// в domain.DefaultCurrency в квартале quarter года year, например 92.37 или 1/3.
+var rateColumnNames = []string{"currency", "year", "quarter", "rate"}
+
+// loadRates загружает таблицу курсов из CSV-файла path. Без файла суммы переводятся
+// только в domain.DefaultCurrency и из нее.
+func loadRates(path string) (domain.IExchangeRateProvider, error) {
+	rates := make([]domain.ExchangeRate, 0)
+	if path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		err = readCSV(f, rateColumnNames, func(field func(name string) string) error {
+			rate, err := parseRate(field)
+			if err != nil {
+				return err
+			}
+			rates = append(rates, rate)
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return exchange_rate.NewTable(rates)
+}
+
+func parseRate(field func(name string) string) (rate domain.ExchangeRate, err error) {
+	invalid := func(name string) error {
+		return domain.NewValidationError(name, i18n.CLIColumnInvalid, name)
+	}
+
+	rate.Currency = field("currency")
+
+	rate.Year, err = strconv.Atoi(field("year"))
+	if err != nil {
+		return rate, invalid("year")
+	}
+
+	rate.Quarter, err = strconv.Atoi(field("quarter"))
+	if err != nil {
+		return rate, invalid("quarter")
+	}
+
+	var ok bool
+	rate.Rate, ok = new(big.Rat).SetString(field("rate"))
+	if !ok {
+		return rate, invalid("rate")
+	}
+
+	return rate, nil
+}
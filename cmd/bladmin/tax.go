@@ -0,0 +1,113 @@
+package main
+
+import (
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"strconv"
+	"strings"
+)
+
+// parseBrackets разбирает ступени шкалы в формате порог:ставка через запятую,
+// например 0:4,10000000:7. Пороги указываются в валюте currency.
+func parseBrackets(value, currency string) ([]domain.TaxBracket, error) {
+	err := required("brackets", value)
+	if err != nil {
+		return nil, err
+	}
+
+	invalid := domain.NewValidationError("brackets", i18n.CLIArgInvalid, "brackets")
+	brackets := make([]domain.TaxBracket, 0)
+	for _, item := range strings.Split(value, ",") {
+		threshold, rate, ok := strings.Cut(strings.TrimSpace(item), ":")
+		if !ok {
+			return nil, invalid
+		}
+
+		t, err := domain.ParseMoney(threshold, currency)
+		if err != nil {
+			return nil, invalid
+		}
+		r, err := strconv.ParseFloat(rate, 32)
+		if err != nil {
+			return nil, invalid
+		}
+		brackets = append(brackets, domain.TaxBracket{Threshold: t, Rate: float32(r)})
+	}
+
+	return brackets, nil
+}
+
+func (a *app) taxCreate(args []string) error {
+	fs := a.flags("tax create")
+	schedule := &domain.TaxSchedule{Jurisdiction: a.jurisdiction}
+	fs.IntVar(&schedule.Year, "year", 0, "год вступления шкалы в силу")
+	fieldId := fs.String("field", "", "id сферы деятельности, без флага шкала действует для всех сфер")
+	fs.StringVar(&schedule.Method, "method", domain.TaxMethodFlat, "способ расчета: flat или marginal")
+	brackets := fs.String("brackets", "", "ступени порог:ставка через запятую, например 0:4,10000000:7")
+	currency := fs.String("currency", domain.DefaultCurrency, "валюта порогов")
+	err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if *fieldId != "" {
+		schedule.ActivityFieldId, err = parseID("field", *fieldId)
+		if err != nil {
+			return err
+		}
+	}
+
+	schedule.Brackets, err = parseBrackets(*brackets, *currency)
+	if err != nil {
+		return err
+	}
+
+	err = a.taxes.Create(a.ctx, schedule)
+	if err != nil {
+		return err
+	}
+
+	return a.print(single(newTaxScheduleView(schedule), taxScheduleColumns))
+}
+
+func (a *app) taxList(args []string) error {
+	fs := a.flags("tax list")
+	err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	schedules, err := a.taxes.GetByJurisdiction(a.ctx, a.jurisdiction)
+	if err != nil {
+		return err
+	}
+	page := &domain.Page[*domain.TaxSchedule]{Items: schedules, Total: len(schedules)}
+
+	return a.print(pageOf(page, taxScheduleColumns, newTaxScheduleView))
+}
+
+func (a *app) taxDelete(args []string) error {
+	fs := a.flags("tax delete")
+	rawId := fs.String("id", "", "id шкалы налогообложения")
+	err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	id, err := parseID("id", *rawId)
+	if err != nil {
+		return err
+	}
+
+	schedule, err := a.taxes.GetById(a.ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = a.taxes.DeleteById(a.ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return a.print(single(newTaxScheduleView(schedule), taxScheduleColumns))
+}
@@ -8,6 +8,8 @@ import (
 
 //go:generate mockgen -source=activity_field.go -destination=../mocks/activity_field.go -package=mocks
 
+var ActivityFieldSortFields = []string{"name", "cost"}
+
 type ActivityField struct {
 	ID          uuid.UUID
 	Name        string
@@ -20,16 +22,19 @@ type IActivityFieldRepository interface {
 	DeleteById(context.Context, uuid.UUID) error
 	Update(context.Context, *ActivityField) error
 	GetById(context.Context, uuid.UUID) (*ActivityField, error)
+	// GetByIds возвращает найденные записи в произвольном порядке, отсутствующие id пропускаются.
+	GetByIds(context.Context, []uuid.UUID) ([]*ActivityField, error)
 	GetMaxCost(context.Context) (float32, error)
-	GetAll(context.Context, int) ([]*ActivityField, error)
+	GetAll(context.Context, PageRequest) (*Page[*ActivityField], error)
 }
 
 type IActivityFieldService interface {
-	Create(*ActivityField) error
-	DeleteById(uuid.UUID) error
-	Update(*ActivityField) error
-	GetById(uuid.UUID) (*ActivityField, error)
-	GetCostByCompanyId(uuid.UUID) (float32, error)
-	GetMaxCost() (float32, error)
-	GetAll(page int) ([]*ActivityField, error)
+	Create(ctx context.Context, data *ActivityField) error
+	DeleteById(ctx context.Context, id uuid.UUID) error
+	Update(ctx context.Context, data *ActivityField) error
+	GetById(ctx context.Context, id uuid.UUID) (*ActivityField, error)
+	GetByIds(ctx context.Context, ids []uuid.UUID) ([]*ActivityField, error)
+	GetCostByCompanyId(ctx context.Context, companyId uuid.UUID) (float32, error)
+	GetMaxCost(ctx context.Context) (float32, error)
+	GetAll(ctx context.Context, req PageRequest) (*Page[*ActivityField], error)
 }
@@ -3,10 +3,17 @@ package domain
 import (
 	"context"
 	"github.com/google/uuid"
+	"time"
 )
 
 //go:generate mockgen -source=auth.go -destination=../mocks/auth.go -package=mocks
 
+const (
+	RoleAdmin        = "admin"
+	RoleEntrepreneur = "entrepreneur"
+	RoleGuest        = "guest"
+)
+
 type UserAuth struct {
 	ID         uuid.UUID
 	Username   string
@@ -15,12 +22,39 @@ type UserAuth struct {
 	Role       string
 }
 
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+type RefreshToken struct {
+	ID        uuid.UUID
+	UserId    uuid.UUID
+	Username  string
+	ExpiresAt time.Time
+	Revoked   bool
+}
+
 type IAuthRepository interface {
 	Register(ctx context.Context, authInfo *UserAuth) (err error)
 	GetByUsername(ctx context.Context, username string) (*UserAuth, error)
 }
 
+type IRefreshTokenRepository interface {
+	Create(ctx context.Context, token *RefreshToken) error
+	GetById(ctx context.Context, id uuid.UUID) (*RefreshToken, error)
+	Revoke(ctx context.Context, id uuid.UUID) error
+	IsRevoked(ctx context.Context, id uuid.UUID) (bool, error)
+}
+
+// IRegistrationService регистрирует учетные записи без выдачи токенов.
+type IRegistrationService interface {
+	Register(ctx context.Context, authInfo *UserAuth) (err error)
+}
+
 type IAuthService interface {
-	Login(authInfo *UserAuth) (string, error)
-	Register(authInfo *UserAuth) (err error)
+	IRegistrationService
+	Login(ctx context.Context, authInfo *UserAuth) (*TokenPair, error)
+	Refresh(ctx context.Context, refreshToken string) (*TokenPair, error)
+	Logout(ctx context.Context, refreshToken string) error
 }
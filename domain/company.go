@@ -7,6 +7,8 @@ import (
 
 //go:generate mockgen -source=company.go -destination=../mocks/company.go -package=mocks
 
+var CompanySortFields = []string{"name", "city"}
+
 type Company struct {
 	ID              uuid.UUID
 	OwnerID         uuid.UUID
@@ -18,17 +20,17 @@ type Company struct {
 type ICompanyRepository interface {
 	Create(ctx context.Context, company *Company) error
 	GetById(ctx context.Context, id uuid.UUID) (*Company, error)
-	GetByOwnerId(ctx context.Context, id uuid.UUID, page int) ([]*Company, error)
-	GetAll(ctx context.Context, page int) ([]*Company, error)
+	GetByOwnerId(ctx context.Context, id uuid.UUID, req PageRequest) (*Page[*Company], error)
+	GetAll(ctx context.Context, filter CompanyFilter, req PageRequest) (*Page[*Company], error)
 	Update(ctx context.Context, company *Company) error
 	DeleteById(ctx context.Context, id uuid.UUID) error
 }
 
 type ICompanyService interface {
-	Create(company *Company) error
-	GetById(id uuid.UUID) (*Company, error)
-	GetByOwnerId(id uuid.UUID, page int) ([]*Company, error)
-	GetAll(page int) ([]*Company, error)
-	Update(company *Company) error
-	DeleteById(id uuid.UUID) error
+	Create(ctx context.Context, company *Company) error
+	GetById(ctx context.Context, id uuid.UUID) (*Company, error)
+	GetByOwnerId(ctx context.Context, id uuid.UUID, req PageRequest) (*Page[*Company], error)
+	GetAll(ctx context.Context, filter CompanyFilter, req PageRequest) (*Page[*Company], error)
+	Update(ctx context.Context, company *Company) error
+	DeleteById(ctx context.Context, id uuid.UUID) error
 }
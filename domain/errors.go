@@ -0,0 +1,72 @@
+package domain
+
+import "github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+
+var (
+	// ErrNotFound — запрошенная запись отсутствует в хранилище.
+	ErrNotFound = i18n.New(i18n.NotFound)
+	// ErrValidation — входные данные не прошли проверку, подробности в ValidationError.
+	ErrValidation = i18n.New(i18n.Validation)
+	// ErrConflict — запись противоречит уже сохраненным данным, например нарушает уникальность.
+	ErrConflict = i18n.New(i18n.Conflict)
+	// ErrForbidden — у пользователя нет прав на действие.
+	ErrForbidden = i18n.New(i18n.Forbidden)
+	// ErrUnauthenticated — пользователь не прошел аутентификацию или токен недействителен.
+	ErrUnauthenticated = i18n.New(i18n.Unauthenticated)
+	// ErrNotEnoughData — показатель нельзя вычислить по имеющимся данным, например рентабельность без выручки.
+	ErrNotEnoughData = i18n.New(i18n.NotEnoughData)
+)
+
+type ValidationError struct {
+	Field string
+	Code  i18n.Code
+	Args  []any
+}
+
+func NewValidationError(field string, code i18n.Code, args ...any) error {
+	return &ValidationError{
+		Field: field,
+		Code:  code,
+		Args:  args,
+	}
+}
+
+func (e *ValidationError) Error() string {
+	return e.Localize(i18n.DefaultLocale)
+}
+
+func (e *ValidationError) Localize(locale i18n.Locale) string {
+	return i18n.Text(locale, e.Code, e.Args...)
+}
+
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+type KindError struct {
+	Kind error
+	Err  error
+}
+
+func WithKind(kind, err error) error {
+	return &KindError{
+		Kind: kind,
+		Err:  err,
+	}
+}
+
+func NewError(kind error, code i18n.Code, args ...any) error {
+	return WithKind(kind, i18n.New(code, args...))
+}
+
+func (e *KindError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *KindError) Is(target error) bool {
+	return target == e.Kind
+}
+
+func (e *KindError) Unwrap() error {
+	return e.Err
+}
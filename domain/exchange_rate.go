@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"context"
+	"math/big"
+)
+
+//go:generate mockgen -source=exchange_rate.go -destination=../mocks/exchange_rate.go -package=mocks
+
+// ExchangeRate — курс валюты Currency в квартале Quarter года Year: стоимость единицы Currency
+// в DefaultCurrency. Курсы между другими валютами вычисляются через DefaultCurrency.
+type ExchangeRate struct {
+	Currency string
+	Year     int
+	Quarter  int
+	Rate     *big.Rat
+}
+
+type IExchangeRateProvider interface {
+	// Supports сообщает, может ли провайдер переводить суммы в валюте currency и обратно.
+	Supports(ctx context.Context, currency string) (bool, error)
+	// Convert переводит сумму amount в валюту currency по курсу квартала quarter года year.
+	// Сумма в той же валюте возвращается без изменений.
+	Convert(ctx context.Context, amount Money, currency string, year, quarter int) (Money, error)
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const MaxSearchLength = 100
+
+type UserFilter struct {
+	City   string
+	Gender string
+	MinAge int
+	MaxAge int
+	Search string
+}
+
+type CompanyFilter struct {
+	City            string
+	ActivityFieldId uuid.UUID
+	OwnerId         uuid.UUID
+	Search          string
+}
+
+func (f UserFilter) Validate() error {
+	if f.Gender != "" && f.Gender != "m" && f.Gender != "w" {
+		return NewValidationError("gender", i18n.UserGenderUnknown)
+	}
+
+	if f.MinAge < 0 || f.MaxAge < 0 {
+		return NewValidationError("age", i18n.FilterAgeNegative)
+	}
+
+	if f.MaxAge > 0 && f.MinAge > f.MaxAge {
+		return NewValidationError("age", i18n.FilterAgeRange)
+	}
+
+	return validateSearch(f.Search)
+}
+
+// BirthdayRange возвращает границы дат рождения, нулевая граница не ограничивает выборку.
+func (f UserFilter) BirthdayRange(now time.Time) (from, to time.Time) {
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	if f.MaxAge > 0 {
+		from = today.AddDate(-f.MaxAge-1, 0, 1)
+	}
+	if f.MinAge > 0 {
+		to = today.AddDate(-f.MinAge, 0, 0)
+	}
+
+	return from, to
+}
+
+func (f CompanyFilter) Validate() error {
+	return validateSearch(f.Search)
+}
+
+func validateSearch(search string) error {
+	if utf8.RuneCountInString(search) > MaxSearchLength {
+		return NewValidationError("search", i18n.FilterSearchTooLong, MaxSearchLength)
+	}
+
+	return nil
+}
+
+func FoldSearch(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
@@ -7,20 +7,68 @@ import (
 
 //go:generate mockgen -source=fin_report.go -destination=../mocks/fin_report.go -package=mocks
 
+const (
+	// GranularityMonth — отчет за месяц Month, Quarter — квартал этого месяца.
+	GranularityMonth = "month"
+	// GranularityQuarter — отчет за квартал Quarter, Month равен нулю.
+	GranularityQuarter = "quarter"
+	// GranularityYear — отчет за год, Quarter и Month равны нулю.
+	GranularityYear = "year"
+)
+
 type FinancialReport struct {
 	ID        uuid.UUID
 	CompanyID uuid.UUID
-	Revenue   float32
-	Costs     float32
-	Year      int
-	Quarter   int
+	// Revenue и Costs указываются в одной валюте, она же считается валютой отчета.
+	Revenue     Money
+	Costs       Money
+	Granularity string
+	Year        int
+	Quarter     int
+	Month       int
+}
+
+// Months возвращает первый и последний месяц года, за которые составлен отчет.
+func (r *FinancialReport) Months() (first, last int) {
+	switch r.Granularity {
+	case GranularityMonth:
+		return r.Month, r.Month
+	case GranularityYear:
+		return 1, 12
+	}
+
+	return r.Quarter*3 - 2, r.Quarter * 3
+}
+
+// LastQuarter возвращает последний квартал отчета, по курсу которого переводятся его суммы.
+func (r *FinancialReport) LastQuarter() int {
+	_, last := r.Months()
+
+	return (last + 2) / 3
+}
+
+// Overlaps сообщает, пересекаются ли периоды отчетов одной компании.
+func (r *FinancialReport) Overlaps(o *FinancialReport) bool {
+	if r.CompanyID != o.CompanyID || r.Year != o.Year {
+		return false
+	}
+
+	first, last := r.Months()
+	otherFirst, otherLast := o.Months()
+
+	return first <= otherLast && otherFirst <= last
 }
 
 type FinancialReportByPeriod struct {
 	Reports []FinancialReport
 	Period  *Period
-	Taxes   float32
-	TaxLoad float32
+	// Currency — валюта отчетности, к которой приведены суммы отчетов и налоги.
+	// Пустая, если отчеты не приводились к одной валюте, например при загрузке.
+	Currency string
+	Taxes    Money
+	// TaxLoad — налоговая нагрузка в процентах от выручки за полные годы периода.
+	// nil, если в периоде нет полного года с выручкой и нагрузку вычислить нельзя.
+	TaxLoad *float32
 }
 
 type Period struct {
@@ -30,25 +78,122 @@ type Period struct {
 	EndQuarter   int
 }
 
-func (r *FinancialReportByPeriod) Revenue() (sum float32) {
+// Valid сообщает, что начало периода не позже его конца.
+func (p *Period) Valid() bool {
+	return p.StartYear < p.EndYear || (p.StartYear == p.EndYear && p.StartQuarter <= p.EndQuarter)
+}
+
+// Contains сообщает, целиком ли отчет попадает в период. Годовой отчет попадает только
+// в период, который включает все кварталы года.
+func (p *Period) Contains(r *FinancialReport) bool {
+	first, _ := r.Months()
+
+	return r.Year*4+(first+2)/3 >= p.StartYear*4+p.StartQuarter &&
+		r.Year*4+r.LastQuarter() <= p.EndYear*4+p.EndQuarter
+}
+
+// RollUp сворачивает отчеты полных периодов: месячные отчеты полного квартала — в квартальный отчет,
+// а отчеты полного года — в годовой. Свернутый отчет не имеет ID, его выручка и расходы равны суммам
+// по свернутым отчетам. Отчеты неполных периодов остаются как есть. Отчеты должны быть в одной валюте,
+// упорядочены по времени и не пересекаться.
+func RollUp(reports []FinancialReport) []FinancialReport {
+	quarters := mergeFull(reports, GranularityQuarter, 3, func(r *FinancialReport) (periodKey, bool) {
+		return periodKey{companyId: r.CompanyID, year: r.Year, quarter: r.Quarter}, r.Granularity == GranularityMonth
+	})
+
+	return mergeFull(quarters, GranularityYear, 12, func(r *FinancialReport) (periodKey, bool) {
+		return periodKey{companyId: r.CompanyID, year: r.Year}, r.Granularity != GranularityYear
+	})
+}
+
+type periodKey struct {
+	companyId uuid.UUID
+	year      int
+	quarter   int
+}
+
+// mergeFull заменяет отчеты с одинаковым ключом key одним отчетом периодичности granularity,
+// если они вместе покрывают months месяцев. Отчет, для которого key возвращает false, не сворачивается.
+func mergeFull(reports []FinancialReport, granularity string, months int,
+	key func(r *FinancialReport) (periodKey, bool)) []FinancialReport {
+	covered := make(map[periodKey]int)
+	for i := range reports {
+		if k, ok := key(&reports[i]); ok {
+			first, last := reports[i].Months()
+			covered[k] += last - first + 1
+		}
+	}
+
+	merged := make([]FinancialReport, 0, len(reports))
+	positions := make(map[periodKey]int)
+	for _, rep := range reports {
+		k, ok := key(&rep)
+		if !ok || covered[k] != months {
+			merged = append(merged, rep)
+			continue
+		}
+
+		i, seen := positions[k]
+		if !seen {
+			positions[k] = len(merged)
+			merged = append(merged, FinancialReport{
+				CompanyID:   rep.CompanyID,
+				Revenue:     rep.Revenue,
+				Costs:       rep.Costs,
+				Granularity: granularity,
+				Year:        rep.Year,
+				Quarter:     k.quarter,
+			})
+			continue
+		}
+		merged[i].Revenue = merged[i].Revenue.Add(rep.Revenue)
+		merged[i].Costs = merged[i].Costs.Add(rep.Costs)
+	}
+
+	return merged
+}
+
+// CheckCurrency проверяет, что суммы всех отчетов указаны в одной валюте с отчетом за период.
+// Revenue, Costs и Profit складывают суммы без проверки, поэтому отчеты из хранилища проверяются
+// после перевода в валюту отчетности.
+func (r *FinancialReportByPeriod) CheckCurrency() error {
+	currency := Money{Currency: r.Currency}
 	for _, rep := range r.Reports {
-		sum += rep.Revenue
+		for _, amount := range []Money{rep.Revenue, rep.Costs} {
+			if err := currency.CheckCurrency(amount); err != nil {
+				return err
+			}
+			if currency.Currency == "" {
+				currency.Currency = amount.Currency
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *FinancialReportByPeriod) Revenue() (sum Money) {
+	sum.Currency = r.Currency
+	for _, rep := range r.Reports {
+		sum = sum.Add(rep.Revenue)
 	}
 
 	return sum
 }
 
-func (r *FinancialReportByPeriod) Costs() (sum float32) {
+func (r *FinancialReportByPeriod) Costs() (sum Money) {
+	sum.Currency = r.Currency
 	for _, rep := range r.Reports {
-		sum += rep.Costs
+		sum = sum.Add(rep.Costs)
 	}
 
 	return sum
 }
 
-func (r *FinancialReportByPeriod) Profit() (sum float32) {
+func (r *FinancialReportByPeriod) Profit() (sum Money) {
+	sum.Currency = r.Currency
 	for _, rep := range r.Reports {
-		sum += rep.Revenue - rep.Costs
+		sum = sum.Add(rep.Revenue.Sub(rep.Costs))
 	}
 
 	return sum
@@ -58,15 +203,27 @@ type IFinancialReportRepository interface {
 	Create(ctx context.Context, finRep *FinancialReport) error
 	GetById(ctx context.Context, id uuid.UUID) (*FinancialReport, error)
 	GetByCompany(ctx context.Context, companyId uuid.UUID, period *Period) (*FinancialReportByPeriod, error)
+	// GetByCompanies возвращает отчеты за период для каждой из компаний, в том числе пустые.
+	GetByCompanies(ctx context.Context, companyIds []uuid.UUID, period *Period) (map[uuid.UUID]*FinancialReportByPeriod, error)
 	Update(ctx context.Context, finRep *FinancialReport) error
 	DeleteById(ctx context.Context, id uuid.UUID) error
+	// LockCompanyYear до конца транзакции ctx не дает другим транзакциям записывать отчеты компании за год.
+	LockCompanyYear(ctx context.Context, companyId uuid.UUID, year int) error
 }
 
 type IFinancialReportService interface {
-	Create(finRep *FinancialReport) error
-	CreateByPeriod(finReportByPeriod *FinancialReportByPeriod) error
-	GetById(id uuid.UUID) (*FinancialReport, error)
-	GetByCompany(companyId uuid.UUID, period *Period) (*FinancialReportByPeriod, error)
-	Update(finRep *FinancialReport) error
-	DeleteById(id uuid.UUID) error
+	Create(ctx context.Context, finRep *FinancialReport) error
+	CreateByPeriod(ctx context.Context, finReportByPeriod *FinancialReportByPeriod) error
+	GetById(ctx context.Context, id uuid.UUID) (*FinancialReport, error)
+	// GetByCompany возвращает отчеты компании за период, отчеты полных кварталов и лет свернуты.
+	GetByCompany(ctx context.Context, companyId uuid.UUID, period *Period) (*FinancialReportByPeriod, error)
+	// GetDetailedByCompany возвращает отчеты компании за период без сворачивания, например для
+	// расчетов по курсу каждого квартала.
+	GetDetailedByCompany(ctx context.Context, companyId uuid.UUID, period *Period) (*FinancialReportByPeriod, error)
+	// GetUnconvertedByCompany возвращает отчеты компании за период без сворачивания в валютах, в которых
+	// они были загружены, чтобы переводить только нужные суммы.
+	GetUnconvertedByCompany(ctx context.Context, companyId uuid.UUID, period *Period) (*FinancialReportByPeriod, error)
+	GetByCompanies(ctx context.Context, companyIds []uuid.UUID, period *Period) (map[uuid.UUID]*FinancialReportByPeriod, error)
+	Update(ctx context.Context, finRep *FinancialReport) error
+	DeleteById(ctx context.Context, id uuid.UUID) error
 }
@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRollUp(t *testing.T) {
+	company := uuid.UUID{1}
+	rub := func(units int64) Money {
+		return NewMoney(units, DefaultCurrency)
+	}
+	month := func(year, month int) FinancialReport {
+		return FinancialReport{
+			CompanyID:   company,
+			Revenue:     rub(100),
+			Costs:       rub(40),
+			Granularity: GranularityMonth,
+			Year:        year,
+			Quarter:     (month-1)/3 + 1,
+			Month:       month,
+		}
+	}
+	quarter := func(year, quarter int) FinancialReport {
+		return FinancialReport{
+			CompanyID:   company,
+			Revenue:     rub(300),
+			Costs:       rub(120),
+			Granularity: GranularityQuarter,
+			Year:        year,
+			Quarter:     quarter,
+		}
+	}
+	year := func(year int, revenue, costs int64) FinancialReport {
+		return FinancialReport{
+			CompanyID:   company,
+			Revenue:     rub(revenue),
+			Costs:       rub(costs),
+			Granularity: GranularityYear,
+			Year:        year,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		reports  []FinancialReport
+		expected []FinancialReport
+	}{
+		{
+			name: "12 месяцев сворачиваются в год",
+			reports: func() (reports []FinancialReport) {
+				for m := 1; m <= 12; m++ {
+					reports = append(reports, month(2023, m))
+				}
+				return reports
+			}(),
+			expected: []FinancialReport{year(2023, 1200, 480)},
+		},
+		{
+			name: "4 квартала сворачиваются в год",
+			reports: []FinancialReport{
+				quarter(2023, 1),
+				quarter(2023, 2),
+				quarter(2023, 3),
+				quarter(2023, 4),
+				quarter(2024, 1),
+			},
+			expected: []FinancialReport{
+				year(2023, 1200, 480),
+				quarter(2024, 1),
+			},
+		},
+		{
+			name: "месяцы первого квартала и остальные кварталы сворачиваются в год",
+			reports: []FinancialReport{
+				month(2023, 1),
+				month(2023, 2),
+				month(2023, 3),
+				quarter(2023, 2),
+				quarter(2023, 3),
+				quarter(2023, 4),
+			},
+			expected: []FinancialReport{year(2023, 1200, 480)},
+		},
+		{
+			name: "неполный год не сворачивается",
+			reports: []FinancialReport{
+				month(2023, 1),
+				month(2023, 2),
+				month(2023, 3),
+				month(2023, 4),
+				quarter(2023, 3),
+				quarter(2023, 4),
+			},
+			expected: []FinancialReport{
+				{
+					CompanyID:   company,
+					Revenue:     rub(300),
+					Costs:       rub(120),
+					Granularity: GranularityQuarter,
+					Year:        2023,
+					Quarter:     1,
+				},
+				month(2023, 4),
+				quarter(2023, 3),
+				quarter(2023, 4),
+			},
+		},
+		{
+			name:     "годовой отчет не меняется",
+			reports:  []FinancialReport{year(2023, 500, 100)},
+			expected: []FinancialReport{year(2023, 500, 100)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, RollUp(tc.reports))
+		})
+	}
+}
+
+func TestPeriod_Valid(t *testing.T) {
+	testCases := []struct {
+		name     string
+		period   Period
+		expected bool
+	}{
+		{
+			name:     "один квартал",
+			period:   Period{StartYear: 2023, StartQuarter: 2, EndYear: 2023, EndQuarter: 2},
+			expected: true,
+		},
+		{
+			name:     "начало в предыдущем году",
+			period:   Period{StartYear: 2022, StartQuarter: 4, EndYear: 2023, EndQuarter: 1},
+			expected: true,
+		},
+		{
+			name:     "начальный квартал после конечного",
+			period:   Period{StartYear: 2023, StartQuarter: 3, EndYear: 2023, EndQuarter: 2},
+			expected: false,
+		},
+		{
+			name:     "начальный год после конечного",
+			period:   Period{StartYear: 2024, StartQuarter: 1, EndYear: 2023, EndQuarter: 4},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.period.Valid())
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// DefaultCurrency — валюта сумм, для которых валюта не указана явно.
+const DefaultCurrency = "RUB"
+
+// MinorUnits — число минимальных единиц (копеек, центов) в единице валюты.
+const MinorUnits = 100
+
+// Money — денежная сумма с фиксированной точкой. Amount хранится в минимальных единицах валюты,
+// поэтому сложение и вычитание точны. Пустая валюта означает сумму без валюты, например нулевой итог
+// по пустому списку отчетов, и при сложении принимает валюту второго слагаемого.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+// NewMoney возвращает сумму в целых единицах валюты.
+func NewMoney(units int64, currency string) Money {
+	return Money{Amount: units * MinorUnits, Currency: currency}
+}
+
+// ParseMoney разбирает десятичную запись суммы вида 1234.5 или -10.05. Дробная часть
+// длиннее двух знаков не округляется, а считается ошибкой.
+func ParseMoney(value, currency string) (Money, error) {
+	invalid := NewValidationError("amount", i18n.MoneyInvalid, value)
+
+	digits, negative := strings.CutPrefix(value, "-")
+	units, fraction, hasFraction := strings.Cut(digits, ".")
+	if units == "" || (hasFraction && fraction == "") || len(fraction) > 2 {
+		return Money{}, invalid
+	}
+
+	for _, part := range []string{units, fraction} {
+		if strings.TrimLeft(part, "0123456789") != "" {
+			return Money{}, invalid
+		}
+	}
+
+	amount, err := strconv.ParseInt(units+(fraction + "00")[:2], 10, 64)
+	if err != nil {
+		return Money{}, invalid
+	}
+	if negative {
+		amount = -amount
+	}
+
+	return Money{Amount: amount, Currency: currency}, nil
+}
+
+// CheckCurrency возвращает ErrValidation, если суммы в разных валютах и их нельзя складывать
+// и сравнивать.
+func (m Money) CheckCurrency(o Money) error {
+	if m.Currency != "" && o.Currency != "" && m.Currency != o.Currency {
+		return NewValidationError("currency", i18n.MoneyCurrencyMismatch, m.Currency, o.Currency)
+	}
+
+	return nil
+}
+
+func (m Money) currencyWith(o Money) string {
+	// Add, Sub и Cmp получают суммы уже проверенных валют: суммы из запросов и хранилища
+	// проверяются CheckCurrency или складываются через CheckedAdd и CheckedSub
+	if err := m.CheckCurrency(o); err != nil {
+		panic(err)
+	}
+
+	if m.Currency == "" {
+		return o.Currency
+	}
+
+	return m.Currency
+}
+
+func (m Money) Add(o Money) Money {
+	return Money{Amount: m.Amount + o.Amount, Currency: m.currencyWith(o)}
+}
+
+func (m Money) Sub(o Money) Money {
+	return Money{Amount: m.Amount - o.Amount, Currency: m.currencyWith(o)}
+}
+
+// CheckedAdd складывает суммы или возвращает ошибку CheckCurrency, если они в разных валютах.
+func (m Money) CheckedAdd(o Money) (Money, error) {
+	if err := m.CheckCurrency(o); err != nil {
+		return Money{}, err
+	}
+
+	return m.Add(o), nil
+}
+
+// CheckedSub вычитает суммы или возвращает ошибку CheckCurrency, если они в разных валютах.
+func (m Money) CheckedSub(o Money) (Money, error) {
+	if err := m.CheckCurrency(o); err != nil {
+		return Money{}, err
+	}
+
+	return m.Sub(o), nil
+}
+
+func (m Money) Neg() Money {
+	return Money{Amount: -m.Amount, Currency: m.Currency}
+}
+
+// Sign возвращает -1, 0 или 1 для отрицательной, нулевой и положительной суммы.
+func (m Money) Sign() int {
+	switch {
+	case m.Amount < 0:
+		return -1
+	case m.Amount > 0:
+		return 1
+	}
+
+	return 0
+}
+
+// Cmp сравнивает суммы одной валюты и возвращает -1, 0 или 1.
+func (m Money) Cmp(o Money) int {
+	m.currencyWith(o)
+
+	return cmp.Compare(m.Amount, o.Amount)
+}
+
+// Exchange переводит сумму в валюту currency по курсу rate — стоимости единицы валюты суммы
+// в currency. Результат округляется до минимальной единицы, половина округляется от нуля.
+func (m Money) Exchange(rate *big.Rat, currency string) Money {
+	exact := new(big.Rat).Mul(new(big.Rat).SetInt64(m.Amount), rate)
+
+	num := new(big.Int).Abs(exact.Num())
+	amount, rest := new(big.Int).QuoRem(num, exact.Denom(), new(big.Int))
+	if rest.Lsh(rest, 1).Cmp(exact.Denom()) >= 0 {
+		amount.Add(amount, big.NewInt(1))
+	}
+	if exact.Sign() < 0 {
+		amount.Neg(amount)
+	}
+
+	return Money{Amount: amount.Int64(), Currency: currency}
+}
+
+// Float64 возвращает сумму в единицах валюты для расчета относительных показателей.
+func (m Money) Float64() float64 {
+	return float64(m.Amount) / MinorUnits
+}
+
+// Decimal возвращает десятичную запись суммы с двумя знаками после точки.
+func (m Money) Decimal() string {
+	amount := m.Amount
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+	}
+
+	units := amount / MinorUnits
+	minor := amount % MinorUnits
+	if units < 0 {
+		units = -units
+	}
+	if minor < 0 {
+		minor = -minor
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, units, minor)
+}
+
+func (m Money) String() string {
+	if m.Currency == "" {
+		return m.Decimal()
+	}
+
+	return m.Decimal() + " " + m.Currency
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestMoney_Checked(t *testing.T) {
+	rub := NewMoney(100, DefaultCurrency)
+	usd := NewMoney(10, "USD")
+
+	sum, err := rub.CheckedAdd(Money{Amount: 50})
+	require.Nil(t, err)
+	require.Equal(t, Money{Amount: 10050, Currency: DefaultCurrency}, sum)
+
+	diff, err := Money{}.CheckedSub(usd)
+	require.Nil(t, err)
+	require.Equal(t, Money{Amount: -1000, Currency: "USD"}, diff)
+
+	_, err = rub.CheckedAdd(usd)
+	require.ErrorIs(t, err, ErrValidation)
+	require.Equal(t, "суммы в разных валютах RUB и USD нельзя складывать и сравнивать", err.Error())
+
+	_, err = usd.CheckedSub(rub)
+	require.ErrorIs(t, err, ErrValidation)
+	require.Equal(t, "суммы в разных валютах USD и RUB нельзя складывать и сравнивать", err.Error())
+}
+
+func TestFinancialReportByPeriod_CheckCurrency(t *testing.T) {
+	testCases := []struct {
+		name    string
+		report  *FinancialReportByPeriod
+		wantErr bool
+	}{
+		{
+			name:   "нет отчетов",
+			report: &FinancialReportByPeriod{Currency: DefaultCurrency},
+		},
+		{
+			name: "отчеты в валюте отчета",
+			report: &FinancialReportByPeriod{
+				Currency: "USD",
+				Reports:  []FinancialReport{{Revenue: NewMoney(10, "USD"), Costs: NewMoney(5, "USD")}},
+			},
+		},
+		{
+			name: "отчет в другой валюте",
+			report: &FinancialReportByPeriod{
+				Currency: DefaultCurrency,
+				Reports:  []FinancialReport{{Revenue: NewMoney(10, "USD"), Costs: NewMoney(5, "USD")}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "отчеты в разных валютах без валюты отчета",
+			report: &FinancialReportByPeriod{
+				Reports: []FinancialReport{
+					{Revenue: NewMoney(10, DefaultCurrency), Costs: NewMoney(5, DefaultCurrency)},
+					{Revenue: NewMoney(10, "USD"), Costs: NewMoney(5, "USD")},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.report.CheckCurrency()
+			if tc.wantErr {
+				require.ErrorIs(t, err, ErrValidation)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestTaxSchedule_Calculate_Currency(t *testing.T) {
+	_, err := DefaultTaxSchedule().Calculate(NewMoney(1000, "USD"))
+	require.ErrorIs(t, err, ErrValidation)
+	require.Equal(t, "суммы в разных валютах USD и RUB нельзя складывать и сравнивать", err.Error())
+}
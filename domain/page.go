@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"encoding/base64"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"strconv"
+	"strings"
+)
+
+const (
+	PageSize    = 10
+	MaxPageSize = 100
+)
+
+type PageRequest struct {
+	Limit  int
+	Offset int
+	Cursor string
+	Sort   string
+}
+
+type PageQuery struct {
+	Limit  int
+	Offset int
+	SortBy string
+	Desc   bool
+	sort   string
+}
+
+type Page[T any] struct {
+	Items      []T
+	Total      int
+	Offset     int
+	Limit      int
+	NextCursor string
+}
+
+func (r PageRequest) Resolve(sortFields ...string) (*PageQuery, error) {
+	query := &PageQuery{
+		Limit:  r.Limit,
+		Offset: r.Offset,
+		sort:   r.Sort,
+	}
+
+	if query.Limit == 0 {
+		query.Limit = PageSize
+	}
+	if query.Limit < 0 || query.Limit > MaxPageSize {
+		return nil, NewValidationError("limit", i18n.PageLimitRange, MaxPageSize)
+	}
+
+	if r.Cursor != "" {
+		offset, sort, err := decodeCursor(r.Cursor)
+		if err != nil {
+			return nil, NewValidationError("cursor", i18n.PageCursorInvalid)
+		}
+		if sort != r.Sort {
+			return nil, NewValidationError("cursor", i18n.PageCursorSortMismatch)
+		}
+		query.Offset = offset
+	}
+	if query.Offset < 0 {
+		return nil, NewValidationError("offset", i18n.PageOffsetNegative)
+	}
+
+	query.SortBy = strings.TrimPrefix(r.Sort, "-")
+	query.Desc = strings.HasPrefix(r.Sort, "-")
+	if query.SortBy == "" && len(sortFields) > 0 {
+		query.SortBy = sortFields[0]
+	}
+
+	known := false
+	for _, field := range sortFields {
+		known = known || field == query.SortBy
+	}
+	if !known {
+		return nil, NewValidationError("sort", i18n.PageSortUnknown, query.SortBy)
+	}
+
+	return query, nil
+}
+
+func NewPage[T any](items []T, total int, query *PageQuery) *Page[T] {
+	page := &Page[T]{
+		Items:  items,
+		Total:  total,
+		Offset: query.Offset,
+		Limit:  query.Limit,
+	}
+
+	if next := query.Offset + len(items); len(items) > 0 && next < total {
+		page.NextCursor = encodeCursor(next, query.sort)
+	}
+
+	return page
+}
+
+func encodeCursor(offset int, sort string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset) + ":" + sort))
+}
+
+func decodeCursor(cursor string) (int, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, "", err
+	}
+
+	offset, sort, _ := strings.Cut(string(raw), ":")
+	n, err := strconv.Atoi(offset)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return n, sort, nil
+}
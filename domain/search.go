@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"strings"
+	"unicode/utf8"
+)
+
+//go:generate mockgen -source=search.go -destination=../mocks/search.go -package=mocks
+
+type SearchKind string
+
+const (
+	SearchKindSkill         SearchKind = "skill"
+	SearchKindActivityField SearchKind = "activity_field"
+	SearchKindCompany       SearchKind = "company"
+)
+
+var (
+	SearchKinds      = []SearchKind{SearchKindSkill, SearchKindActivityField, SearchKindCompany}
+	SearchSortFields = []string{"relevance"}
+)
+
+// SearchDocument описывает сущность в поисковом индексе, совпадения в Title весят больше, чем в Text.
+type SearchDocument struct {
+	Kind  SearchKind
+	ID    uuid.UUID
+	Title string
+	Text  string
+}
+
+type SearchHit struct {
+	Kind  SearchKind
+	ID    uuid.UUID
+	Title string
+	Score float64
+}
+
+type ISearchIndex interface {
+	Put(ctx context.Context, doc *SearchDocument) error
+	Remove(ctx context.Context, kind SearchKind, id uuid.UUID) error
+	Search(ctx context.Context, query string, kinds []SearchKind, req PageRequest) (*Page[*SearchHit], error)
+}
+
+type ISearchService interface {
+	Search(ctx context.Context, query string, kinds []SearchKind, req PageRequest) (*Page[*SearchHit], error)
+	Rebuild(ctx context.Context) error
+}
+
+func ValidateSearchQuery(query string, kinds []SearchKind) error {
+	if strings.TrimSpace(query) == "" {
+		return NewValidationError("query", i18n.SearchQueryRequired)
+	}
+
+	if utf8.RuneCountInString(query) > MaxSearchLength {
+		return NewValidationError("query", i18n.FilterSearchTooLong, MaxSearchLength)
+	}
+
+	for _, kind := range kinds {
+		known := false
+		for _, k := range SearchKinds {
+			known = known || k == kind
+		}
+		if !known {
+			return NewValidationError("kinds", i18n.SearchKindUnknown, kind)
+		}
+	}
+
+	return nil
+}
+
+func SkillDocument(skill *Skill) *SearchDocument {
+	return &SearchDocument{
+		Kind:  SearchKindSkill,
+		ID:    skill.ID,
+		Title: skill.Name,
+		Text:  skill.Description,
+	}
+}
+
+func ActivityFieldDocument(field *ActivityField) *SearchDocument {
+	return &SearchDocument{
+		Kind:  SearchKindActivityField,
+		ID:    field.ID,
+		Title: field.Name,
+		Text:  field.Description,
+	}
+}
+
+func CompanyDocument(company *Company) *SearchDocument {
+	return &SearchDocument{
+		Kind:  SearchKindCompany,
+		ID:    company.ID,
+		Title: company.Name,
+		Text:  company.City,
+	}
+}
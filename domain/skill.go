@@ -7,6 +7,8 @@ import (
 
 //go:generate mockgen -source=skill.go -destination=../mocks/skill.go -package=mocks
 
+var SkillSortFields = []string{"name"}
+
 type Skill struct {
 	ID          uuid.UUID
 	Name        string
@@ -16,15 +18,18 @@ type Skill struct {
 type ISkillRepository interface {
 	Create(ctx context.Context, skill *Skill) error
 	GetById(ctx context.Context, id uuid.UUID) (*Skill, error)
-	GetAll(ctx context.Context, page int) ([]*Skill, error)
+	// GetByIds возвращает найденные записи в произвольном порядке, отсутствующие id пропускаются.
+	GetByIds(ctx context.Context, ids []uuid.UUID) ([]*Skill, error)
+	GetAll(ctx context.Context, req PageRequest) (*Page[*Skill], error)
 	Update(ctx context.Context, skill *Skill) error
 	DeleteById(ctx context.Context, id uuid.UUID) error
 }
 
 type ISkillService interface {
-	Create(skill *Skill) error
-	GetById(id uuid.UUID) (*Skill, error)
-	GetAll(page int) ([]*Skill, error)
-	Update(skill *Skill) error
-	DeleteById(id uuid.UUID) error
+	Create(ctx context.Context, skill *Skill) error
+	GetById(ctx context.Context, id uuid.UUID) (*Skill, error)
+	GetByIds(ctx context.Context, ids []uuid.UUID) ([]*Skill, error)
+	GetAll(ctx context.Context, req PageRequest) (*Page[*Skill], error)
+	Update(ctx context.Context, skill *Skill) error
+	DeleteById(ctx context.Context, id uuid.UUID) error
 }
@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"math"
+	"math/big"
+)
+
+//go:generate mockgen -source=tax_schedule.go -destination=../mocks/tax_schedule.go -package=mocks
+
+const (
+	// TaxMethodFlat — ставка найденной по прибыли ступени применяется ко всей прибыли.
+	TaxMethodFlat = "flat"
+	// TaxMethodMarginal — каждая ступень облагает только часть прибыли между своим и следующим порогом.
+	TaxMethodMarginal = "marginal"
+)
+
+const DefaultJurisdiction = "RU"
+
+var TaxMethods = []string{TaxMethodFlat, TaxMethodMarginal}
+
+// TaxBracket — ступень шкалы: ставка Rate в процентах для прибыли от Threshold.
+// Ставка учитывается с точностью до сотых долей процента.
+type TaxBracket struct {
+	Threshold Money
+	Rate      float32
+}
+
+// TaxSchedule действует в юрисдикции Jurisdiction начиная с года Year.
+// Нулевой ActivityFieldId означает шкалу для всех сфер деятельности.
+// Brackets упорядочены по возрастанию порога, порог первой ступени равен нулю,
+// пороги всех ступеней указаны в одной валюте.
+type TaxSchedule struct {
+	ID              uuid.UUID
+	Jurisdiction    string
+	ActivityFieldId uuid.UUID
+	Year            int
+	Method          string
+	Brackets        []TaxBracket
+}
+
+// DefaultTaxSchedule возвращает прежнюю фиксированную шкалу: ставка ступени применяется ко всей прибыли.
+func DefaultTaxSchedule() *TaxSchedule {
+	return &TaxSchedule{
+		Jurisdiction: DefaultJurisdiction,
+		Method:       TaxMethodFlat,
+		Brackets: []TaxBracket{
+			{Threshold: NewMoney(0, DefaultCurrency), Rate: 4},
+			{Threshold: NewMoney(10000000, DefaultCurrency), Rate: 7},
+			{Threshold: NewMoney(50000000, DefaultCurrency), Rate: 13},
+			{Threshold: NewMoney(150000000, DefaultCurrency), Rate: 20},
+			{Threshold: NewMoney(500000000, DefaultCurrency), Rate: 30},
+		},
+	}
+}
+
+// Calculate возвращает налог с прибыли profit. Налог считается без промежуточных округлений и
+// округляется до целых единиц валюты: доля меньше половины отбрасывается, половина и больше
+// округляется до целой единицы (п. 6 ст. 52 НК РФ). С неположительной прибыли налог не взимается.
+// Прибыль в валюте, отличной от валюты порогов, — ErrValidation.
+func (s *TaxSchedule) Calculate(profit Money) (Money, error) {
+	for _, bracket := range s.Brackets {
+		if err := profit.CheckCurrency(bracket.Threshold); err != nil {
+			return Money{}, err
+		}
+	}
+
+	taxes := Money{Currency: profit.Currency}
+	if len(s.Brackets) == 0 || profit.Sign() <= 0 {
+		return taxes, nil
+	}
+
+	// налог в минимальных единицах валюты, умноженный на 100 * 100 из-за ставки в сотых долях процента
+	exact := new(big.Int)
+	if s.Method != TaxMethodMarginal {
+		rate := s.Brackets[0].Rate
+		for _, bracket := range s.Brackets[1:] {
+			if profit.Cmp(bracket.Threshold) < 0 {
+				break
+			}
+			rate = bracket.Rate
+		}
+
+		exact.Mul(big.NewInt(profit.Amount), big.NewInt(basisPoints(rate)))
+	} else {
+		for i, bracket := range s.Brackets {
+			if profit.Cmp(bracket.Threshold) <= 0 {
+				break
+			}
+
+			upper := profit
+			if i+1 < len(s.Brackets) && s.Brackets[i+1].Threshold.Cmp(profit) < 0 {
+				upper = s.Brackets[i+1].Threshold
+			}
+			part := upper.Sub(bracket.Threshold)
+			exact.Add(exact, new(big.Int).Mul(big.NewInt(part.Amount), big.NewInt(basisPoints(bracket.Rate))))
+		}
+	}
+
+	unit := big.NewInt(100 * 100 * MinorUnits)
+	units, rest := new(big.Int).QuoRem(exact, unit, new(big.Int))
+	if rest.Lsh(rest, 1).Cmp(unit) >= 0 {
+		units.Add(units, big.NewInt(1))
+	}
+	taxes.Amount = units.Int64() * MinorUnits
+
+	return taxes, nil
+}
+
+// basisPoints переводит ставку в процентах в сотые доли процента.
+func basisPoints(rate float32) int64 {
+	return int64(math.Round(float64(rate) * 100))
+}
+
+type ITaxScheduleRepository interface {
+	Create(ctx context.Context, schedule *TaxSchedule) error
+	GetById(ctx context.Context, id uuid.UUID) (*TaxSchedule, error)
+	// GetByJurisdiction возвращает все шкалы юрисдикции, упорядоченные по году и id.
+	GetByJurisdiction(ctx context.Context, jurisdiction string) ([]*TaxSchedule, error)
+	DeleteById(ctx context.Context, id uuid.UUID) error
+}
+
+type ITaxScheduleService interface {
+	Create(ctx context.Context, schedule *TaxSchedule) error
+	GetById(ctx context.Context, id uuid.UUID) (*TaxSchedule, error)
+	GetByJurisdiction(ctx context.Context, jurisdiction string) ([]*TaxSchedule, error)
+	// GetInForce возвращает шкалу, действующую в году year для сферы деятельности activityFieldId.
+	GetInForce(ctx context.Context, year int, activityFieldId uuid.UUID) (*TaxSchedule, error)
+	DeleteById(ctx context.Context, id uuid.UUID) error
+}
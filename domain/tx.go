@@ -0,0 +1,9 @@
+package domain
+
+import "context"
+
+//go:generate mockgen -source=tx.go -destination=../mocks/tx.go -package=mocks
+
+type ITxManager interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -8,6 +8,8 @@ import (
 
 //go:generate mockgen -source=user.go -destination=../mocks/user.go -package=mocks
 
+var UserSortFields = []string{"username", "full_name", "city"}
+
 type User struct {
 	ID       uuid.UUID
 	Username string
@@ -22,16 +24,18 @@ type IUserRepository interface {
 	Create(ctx context.Context, user *User) error
 	GetByUsername(ctx context.Context, username string) (*User, error)
 	GetById(ctx context.Context, userId uuid.UUID) (*User, error)
-	GetAll(ctx context.Context, page int) ([]*User, error)
+	// GetByIds возвращает найденные записи в произвольном порядке, отсутствующие id пропускаются.
+	GetByIds(ctx context.Context, ids []uuid.UUID) ([]*User, error)
+	GetAll(ctx context.Context, filter UserFilter, req PageRequest) (*Page[*User], error)
 	Update(ctx context.Context, user *User) error
 	DeleteById(ctx context.Context, id uuid.UUID) error
 }
 
 type IUserService interface {
-	Create(user *User) error
-	GetByUsername(username string) (*User, error)
-	GetById(userId uuid.UUID) (*User, error)
-	GetAll(page int) ([]*User, error)
-	Update(user *User) error
-	DeleteById(id uuid.UUID) error
+	Create(ctx context.Context, user *User) error
+	GetByUsername(ctx context.Context, username string) (*User, error)
+	GetById(ctx context.Context, userId uuid.UUID) (*User, error)
+	GetAll(ctx context.Context, filter UserFilter, req PageRequest) (*Page[*User], error)
+	Update(ctx context.Context, user *User) error
+	DeleteById(ctx context.Context, id uuid.UUID) error
 }
@@ -1,9 +1,14 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=user_activity_field.go -destination=../mocks/user_activity_field.go -package=mocks
 
 type IInteractor interface {
-	GetMostProfitableCompany(period *Period, companies []*Company) (*Company, error)
-	CalculateUserRating(id uuid.UUID) (float32, error)
-	GetUserFinancialReport(id uuid.UUID, period *Period) (*FinancialReportByPeriod, error)
+	GetMostProfitableCompany(ctx context.Context, period *Period, companies []*Company) (*Company, error)
+	CalculateUserRating(ctx context.Context, id uuid.UUID) (float32, error)
+	GetUserFinancialReport(ctx context.Context, id uuid.UUID, period *Period) (*FinancialReportByPeriod, error)
 }
@@ -7,6 +7,11 @@ import (
 
 //go:generate mockgen -source=user_skill.go -destination=../mocks/user_skill.go -package=mocks
 
+var (
+	UserSkillByUserSortFields  = []string{"skill_id"}
+	UserSkillBySkillSortFields = []string{"user_id"}
+)
+
 type UserSkill struct {
 	UserId  uuid.UUID
 	SkillId uuid.UUID
@@ -15,14 +20,14 @@ type UserSkill struct {
 type IUserSkillRepository interface {
 	Create(ctx context.Context, pair *UserSkill) error
 	Delete(ctx context.Context, pair *UserSkill) error
-	GetUserSkillsByUserId(ctx context.Context, userId uuid.UUID, page int) ([]*UserSkill, error)
-	GetUserSkillsBySkillId(ctx context.Context, skillId uuid.UUID, page int) ([]*UserSkill, error)
+	GetUserSkillsByUserId(ctx context.Context, userId uuid.UUID, req PageRequest) (*Page[*UserSkill], error)
+	GetUserSkillsBySkillId(ctx context.Context, skillId uuid.UUID, req PageRequest) (*Page[*UserSkill], error)
 }
 
 type IUserSkillService interface {
-	Create(pair *UserSkill) error
-	Delete(pair *UserSkill) error
-	GetSkillsForUser(userId uuid.UUID, page int) ([]*Skill, error)
-	GetUsersForSkill(skillId uuid.UUID, page int) ([]*User, error)
-	DeleteSkillsForUser(userId uuid.UUID) error
+	Create(ctx context.Context, pair *UserSkill) error
+	Delete(ctx context.Context, pair *UserSkill) error
+	GetSkillsForUser(ctx context.Context, userId uuid.UUID, req PageRequest) (*Page[*Skill], error)
+	GetUsersForSkill(ctx context.Context, skillId uuid.UUID, req PageRequest) (*Page[*User], error)
+	DeleteSkillsForUser(ctx context.Context, userId uuid.UUID) error
 }
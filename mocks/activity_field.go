@@ -70,10 +70,10 @@ func (mr *MockIActivityFieldRepositoryMockRecorder) DeleteById(arg0, arg1 any) *
 }
 
 // GetAll mocks base method.
-func (m *MockIActivityFieldRepository) GetAll(arg0 context.Context, arg1 int) ([]*domain.ActivityField, error) {
+func (m *MockIActivityFieldRepository) GetAll(arg0 context.Context, arg1 domain.PageRequest) (*domain.Page[*domain.ActivityField], error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
-	ret0, _ := ret[0].([]*domain.ActivityField)
+	ret0, _ := ret[0].(*domain.Page[*domain.ActivityField])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -99,6 +99,21 @@ func (mr *MockIActivityFieldRepositoryMockRecorder) GetById(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIActivityFieldRepository)(nil).GetById), arg0, arg1)
 }
 
+// GetByIds mocks base method.
+func (m *MockIActivityFieldRepository) GetByIds(arg0 context.Context, arg1 []uuid.UUID) ([]*domain.ActivityField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIds", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.ActivityField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIds indicates an expected call of GetByIds.
+func (mr *MockIActivityFieldRepositoryMockRecorder) GetByIds(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIds", reflect.TypeOf((*MockIActivityFieldRepository)(nil).GetByIds), arg0, arg1)
+}
+
 // GetMaxCost mocks base method.
 func (m *MockIActivityFieldRepository) GetMaxCost(arg0 context.Context) (float32, error) {
 	m.ctrl.T.Helper()
@@ -152,103 +167,118 @@ func (m *MockIActivityFieldService) EXPECT() *MockIActivityFieldServiceMockRecor
 }
 
 // Create mocks base method.
-func (m *MockIActivityFieldService) Create(arg0 *domain.ActivityField) error {
+func (m *MockIActivityFieldService) Create(ctx context.Context, data *domain.ActivityField) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0)
+	ret := m.ctrl.Call(m, "Create", ctx, data)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockIActivityFieldServiceMockRecorder) Create(arg0 any) *gomock.Call {
+func (mr *MockIActivityFieldServiceMockRecorder) Create(ctx, data any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIActivityFieldService)(nil).Create), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIActivityFieldService)(nil).Create), ctx, data)
 }
 
 // DeleteById mocks base method.
-func (m *MockIActivityFieldService) DeleteById(arg0 uuid.UUID) error {
+func (m *MockIActivityFieldService) DeleteById(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteById", arg0)
+	ret := m.ctrl.Call(m, "DeleteById", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteById indicates an expected call of DeleteById.
-func (mr *MockIActivityFieldServiceMockRecorder) DeleteById(arg0 any) *gomock.Call {
+func (mr *MockIActivityFieldServiceMockRecorder) DeleteById(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockIActivityFieldService)(nil).DeleteById), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockIActivityFieldService)(nil).DeleteById), ctx, id)
 }
 
 // GetAll mocks base method.
-func (m *MockIActivityFieldService) GetAll(page int) ([]*domain.ActivityField, error) {
+func (m *MockIActivityFieldService) GetAll(ctx context.Context, req domain.PageRequest) (*domain.Page[*domain.ActivityField], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", page)
-	ret0, _ := ret[0].([]*domain.ActivityField)
+	ret := m.ctrl.Call(m, "GetAll", ctx, req)
+	ret0, _ := ret[0].(*domain.Page[*domain.ActivityField])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockIActivityFieldServiceMockRecorder) GetAll(page any) *gomock.Call {
+func (mr *MockIActivityFieldServiceMockRecorder) GetAll(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIActivityFieldService)(nil).GetAll), page)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIActivityFieldService)(nil).GetAll), ctx, req)
 }
 
 // GetById mocks base method.
-func (m *MockIActivityFieldService) GetById(arg0 uuid.UUID) (*domain.ActivityField, error) {
+func (m *MockIActivityFieldService) GetById(ctx context.Context, id uuid.UUID) (*domain.ActivityField, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetById", arg0)
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
 	ret0, _ := ret[0].(*domain.ActivityField)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetById indicates an expected call of GetById.
-func (mr *MockIActivityFieldServiceMockRecorder) GetById(arg0 any) *gomock.Call {
+func (mr *MockIActivityFieldServiceMockRecorder) GetById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIActivityFieldService)(nil).GetById), ctx, id)
+}
+
+// GetByIds mocks base method.
+func (m *MockIActivityFieldService) GetByIds(ctx context.Context, ids []uuid.UUID) ([]*domain.ActivityField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIds", ctx, ids)
+	ret0, _ := ret[0].([]*domain.ActivityField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIds indicates an expected call of GetByIds.
+func (mr *MockIActivityFieldServiceMockRecorder) GetByIds(ctx, ids any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIActivityFieldService)(nil).GetById), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIds", reflect.TypeOf((*MockIActivityFieldService)(nil).GetByIds), ctx, ids)
 }
 
 // GetCostByCompanyId mocks base method.
-func (m *MockIActivityFieldService) GetCostByCompanyId(arg0 uuid.UUID) (float32, error) {
+func (m *MockIActivityFieldService) GetCostByCompanyId(ctx context.Context, companyId uuid.UUID) (float32, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCostByCompanyId", arg0)
+	ret := m.ctrl.Call(m, "GetCostByCompanyId", ctx, companyId)
 	ret0, _ := ret[0].(float32)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCostByCompanyId indicates an expected call of GetCostByCompanyId.
-func (mr *MockIActivityFieldServiceMockRecorder) GetCostByCompanyId(arg0 any) *gomock.Call {
+func (mr *MockIActivityFieldServiceMockRecorder) GetCostByCompanyId(ctx, companyId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCostByCompanyId", reflect.TypeOf((*MockIActivityFieldService)(nil).GetCostByCompanyId), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCostByCompanyId", reflect.TypeOf((*MockIActivityFieldService)(nil).GetCostByCompanyId), ctx, companyId)
 }
 
 // GetMaxCost mocks base method.
-func (m *MockIActivityFieldService) GetMaxCost() (float32, error) {
+func (m *MockIActivityFieldService) GetMaxCost(ctx context.Context) (float32, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMaxCost")
+	ret := m.ctrl.Call(m, "GetMaxCost", ctx)
 	ret0, _ := ret[0].(float32)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetMaxCost indicates an expected call of GetMaxCost.
-func (mr *MockIActivityFieldServiceMockRecorder) GetMaxCost() *gomock.Call {
+func (mr *MockIActivityFieldServiceMockRecorder) GetMaxCost(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaxCost", reflect.TypeOf((*MockIActivityFieldService)(nil).GetMaxCost))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaxCost", reflect.TypeOf((*MockIActivityFieldService)(nil).GetMaxCost), ctx)
 }
 
 // Update mocks base method.
-func (m *MockIActivityFieldService) Update(arg0 *domain.ActivityField) error {
+func (m *MockIActivityFieldService) Update(ctx context.Context, data *domain.ActivityField) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0)
+	ret := m.ctrl.Call(m, "Update", ctx, data)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockIActivityFieldServiceMockRecorder) Update(arg0 any) *gomock.Call {
+func (mr *MockIActivityFieldServiceMockRecorder) Update(ctx, data any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIActivityFieldService)(nil).Update), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIActivityFieldService)(nil).Update), ctx, data)
 }
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	domain "github.com/dlankinl/bmstu-ppo-bl/domain"
+	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -69,6 +70,124 @@ func (mr *MockIAuthRepositoryMockRecorder) Register(ctx, authInfo any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockIAuthRepository)(nil).Register), ctx, authInfo)
 }
 
+// MockIRefreshTokenRepository is a mock of IRefreshTokenRepository interface.
+type MockIRefreshTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRefreshTokenRepositoryMockRecorder
+}
+
+// MockIRefreshTokenRepositoryMockRecorder is the mock recorder for MockIRefreshTokenRepository.
+type MockIRefreshTokenRepositoryMockRecorder struct {
+	mock *MockIRefreshTokenRepository
+}
+
+// NewMockIRefreshTokenRepository creates a new mock instance.
+func NewMockIRefreshTokenRepository(ctrl *gomock.Controller) *MockIRefreshTokenRepository {
+	mock := &MockIRefreshTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockIRefreshTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRefreshTokenRepository) EXPECT() *MockIRefreshTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIRefreshTokenRepository) Create(ctx context.Context, token *domain.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIRefreshTokenRepositoryMockRecorder) Create(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIRefreshTokenRepository)(nil).Create), ctx, token)
+}
+
+// GetById mocks base method.
+func (m *MockIRefreshTokenRepository) GetById(ctx context.Context, id uuid.UUID) (*domain.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
+	ret0, _ := ret[0].(*domain.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockIRefreshTokenRepositoryMockRecorder) GetById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIRefreshTokenRepository)(nil).GetById), ctx, id)
+}
+
+// IsRevoked mocks base method.
+func (m *MockIRefreshTokenRepository) IsRevoked(ctx context.Context, id uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRevoked", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRevoked indicates an expected call of IsRevoked.
+func (mr *MockIRefreshTokenRepositoryMockRecorder) IsRevoked(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRevoked", reflect.TypeOf((*MockIRefreshTokenRepository)(nil).IsRevoked), ctx, id)
+}
+
+// Revoke mocks base method.
+func (m *MockIRefreshTokenRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockIRefreshTokenRepositoryMockRecorder) Revoke(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockIRefreshTokenRepository)(nil).Revoke), ctx, id)
+}
+
+// MockIRegistrationService is a mock of IRegistrationService interface.
+type MockIRegistrationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRegistrationServiceMockRecorder
+}
+
+// MockIRegistrationServiceMockRecorder is the mock recorder for MockIRegistrationService.
+type MockIRegistrationServiceMockRecorder struct {
+	mock *MockIRegistrationService
+}
+
+// NewMockIRegistrationService creates a new mock instance.
+func NewMockIRegistrationService(ctrl *gomock.Controller) *MockIRegistrationService {
+	mock := &MockIRegistrationService{ctrl: ctrl}
+	mock.recorder = &MockIRegistrationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRegistrationService) EXPECT() *MockIRegistrationServiceMockRecorder {
+	return m.recorder
+}
+
+// Register mocks base method.
+func (m *MockIRegistrationService) Register(ctx context.Context, authInfo *domain.UserAuth) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, authInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockIRegistrationServiceMockRecorder) Register(ctx, authInfo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockIRegistrationService)(nil).Register), ctx, authInfo)
+}
+
 // MockIAuthService is a mock of IAuthService interface.
 type MockIAuthService struct {
 	ctrl     *gomock.Controller
@@ -93,30 +212,59 @@ func (m *MockIAuthService) EXPECT() *MockIAuthServiceMockRecorder {
 }
 
 // Login mocks base method.
-func (m *MockIAuthService) Login(authInfo *domain.UserAuth) (string, error) {
+func (m *MockIAuthService) Login(ctx context.Context, authInfo *domain.UserAuth) (*domain.TokenPair, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Login", authInfo)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Login", ctx, authInfo)
+	ret0, _ := ret[0].(*domain.TokenPair)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Login indicates an expected call of Login.
-func (mr *MockIAuthServiceMockRecorder) Login(authInfo any) *gomock.Call {
+func (mr *MockIAuthServiceMockRecorder) Login(ctx, authInfo any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockIAuthService)(nil).Login), authInfo)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockIAuthService)(nil).Login), ctx, authInfo)
+}
+
+// Logout mocks base method.
+func (m *MockIAuthService) Logout(ctx context.Context, refreshToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, refreshToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockIAuthServiceMockRecorder) Logout(ctx, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockIAuthService)(nil).Logout), ctx, refreshToken)
+}
+
+// Refresh mocks base method.
+func (m *MockIAuthService) Refresh(ctx context.Context, refreshToken string) (*domain.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, refreshToken)
+	ret0, _ := ret[0].(*domain.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockIAuthServiceMockRecorder) Refresh(ctx, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockIAuthService)(nil).Refresh), ctx, refreshToken)
 }
 
 // Register mocks base method.
-func (m *MockIAuthService) Register(authInfo *domain.UserAuth) error {
+func (m *MockIAuthService) Register(ctx context.Context, authInfo *domain.UserAuth) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Register", authInfo)
+	ret := m.ctrl.Call(m, "Register", ctx, authInfo)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Register indicates an expected call of Register.
-func (mr *MockIAuthServiceMockRecorder) Register(authInfo any) *gomock.Call {
+func (mr *MockIAuthServiceMockRecorder) Register(ctx, authInfo any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockIAuthService)(nil).Register), authInfo)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockIAuthService)(nil).Register), ctx, authInfo)
 }
@@ -70,18 +70,18 @@ func (mr *MockICompanyRepositoryMockRecorder) DeleteById(ctx, id any) *gomock.Ca
 }
 
 // GetAll mocks base method.
-func (m *MockICompanyRepository) GetAll(ctx context.Context, page int) ([]*domain.Company, error) {
+func (m *MockICompanyRepository) GetAll(ctx context.Context, filter domain.CompanyFilter, req domain.PageRequest) (*domain.Page[*domain.Company], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx, page)
-	ret0, _ := ret[0].([]*domain.Company)
+	ret := m.ctrl.Call(m, "GetAll", ctx, filter, req)
+	ret0, _ := ret[0].(*domain.Page[*domain.Company])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockICompanyRepositoryMockRecorder) GetAll(ctx, page any) *gomock.Call {
+func (mr *MockICompanyRepositoryMockRecorder) GetAll(ctx, filter, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockICompanyRepository)(nil).GetAll), ctx, page)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockICompanyRepository)(nil).GetAll), ctx, filter, req)
 }
 
 // GetById mocks base method.
@@ -100,18 +100,18 @@ func (mr *MockICompanyRepositoryMockRecorder) GetById(ctx, id any) *gomock.Call
 }
 
 // GetByOwnerId mocks base method.
-func (m *MockICompanyRepository) GetByOwnerId(ctx context.Context, id uuid.UUID, page int) ([]*domain.Company, error) {
+func (m *MockICompanyRepository) GetByOwnerId(ctx context.Context, id uuid.UUID, req domain.PageRequest) (*domain.Page[*domain.Company], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByOwnerId", ctx, id, page)
-	ret0, _ := ret[0].([]*domain.Company)
+	ret := m.ctrl.Call(m, "GetByOwnerId", ctx, id, req)
+	ret0, _ := ret[0].(*domain.Page[*domain.Company])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByOwnerId indicates an expected call of GetByOwnerId.
-func (mr *MockICompanyRepositoryMockRecorder) GetByOwnerId(ctx, id, page any) *gomock.Call {
+func (mr *MockICompanyRepositoryMockRecorder) GetByOwnerId(ctx, id, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOwnerId", reflect.TypeOf((*MockICompanyRepository)(nil).GetByOwnerId), ctx, id, page)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOwnerId", reflect.TypeOf((*MockICompanyRepository)(nil).GetByOwnerId), ctx, id, req)
 }
 
 // Update mocks base method.
@@ -152,88 +152,88 @@ func (m *MockICompanyService) EXPECT() *MockICompanyServiceMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockICompanyService) Create(company *domain.Company) error {
+func (m *MockICompanyService) Create(ctx context.Context, company *domain.Company) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", company)
+	ret := m.ctrl.Call(m, "Create", ctx, company)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockICompanyServiceMockRecorder) Create(company any) *gomock.Call {
+func (mr *MockICompanyServiceMockRecorder) Create(ctx, company any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockICompanyService)(nil).Create), company)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockICompanyService)(nil).Create), ctx, company)
 }
 
 // DeleteById mocks base method.
-func (m *MockICompanyService) DeleteById(id uuid.UUID) error {
+func (m *MockICompanyService) DeleteById(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteById", id)
+	ret := m.ctrl.Call(m, "DeleteById", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteById indicates an expected call of DeleteById.
-func (mr *MockICompanyServiceMockRecorder) DeleteById(id any) *gomock.Call {
+func (mr *MockICompanyServiceMockRecorder) DeleteById(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockICompanyService)(nil).DeleteById), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockICompanyService)(nil).DeleteById), ctx, id)
 }
 
 // GetAll mocks base method.
-func (m *MockICompanyService) GetAll(page int) ([]*domain.Company, error) {
+func (m *MockICompanyService) GetAll(ctx context.Context, filter domain.CompanyFilter, req domain.PageRequest) (*domain.Page[*domain.Company], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", page)
-	ret0, _ := ret[0].([]*domain.Company)
+	ret := m.ctrl.Call(m, "GetAll", ctx, filter, req)
+	ret0, _ := ret[0].(*domain.Page[*domain.Company])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockICompanyServiceMockRecorder) GetAll(page any) *gomock.Call {
+func (mr *MockICompanyServiceMockRecorder) GetAll(ctx, filter, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockICompanyService)(nil).GetAll), page)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockICompanyService)(nil).GetAll), ctx, filter, req)
 }
 
 // GetById mocks base method.
-func (m *MockICompanyService) GetById(id uuid.UUID) (*domain.Company, error) {
+func (m *MockICompanyService) GetById(ctx context.Context, id uuid.UUID) (*domain.Company, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetById", id)
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
 	ret0, _ := ret[0].(*domain.Company)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetById indicates an expected call of GetById.
-func (mr *MockICompanyServiceMockRecorder) GetById(id any) *gomock.Call {
+func (mr *MockICompanyServiceMockRecorder) GetById(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockICompanyService)(nil).GetById), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockICompanyService)(nil).GetById), ctx, id)
 }
 
 // GetByOwnerId mocks base method.
-func (m *MockICompanyService) GetByOwnerId(id uuid.UUID, page int) ([]*domain.Company, error) {
+func (m *MockICompanyService) GetByOwnerId(ctx context.Context, id uuid.UUID, req domain.PageRequest) (*domain.Page[*domain.Company], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByOwnerId", id, page)
-	ret0, _ := ret[0].([]*domain.Company)
+	ret := m.ctrl.Call(m, "GetByOwnerId", ctx, id, req)
+	ret0, _ := ret[0].(*domain.Page[*domain.Company])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByOwnerId indicates an expected call of GetByOwnerId.
-func (mr *MockICompanyServiceMockRecorder) GetByOwnerId(id, page any) *gomock.Call {
+func (mr *MockICompanyServiceMockRecorder) GetByOwnerId(ctx, id, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOwnerId", reflect.TypeOf((*MockICompanyService)(nil).GetByOwnerId), id, page)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOwnerId", reflect.TypeOf((*MockICompanyService)(nil).GetByOwnerId), ctx, id, req)
 }
 
 // Update mocks base method.
-func (m *MockICompanyService) Update(company *domain.Company) error {
+func (m *MockICompanyService) Update(ctx context.Context, company *domain.Company) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", company)
+	ret := m.ctrl.Call(m, "Update", ctx, company)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockICompanyServiceMockRecorder) Update(company any) *gomock.Call {
+func (mr *MockICompanyServiceMockRecorder) Update(ctx, company any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockICompanyService)(nil).Update), company)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockICompanyService)(nil).Update), ctx, company)
 }
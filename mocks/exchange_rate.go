@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: exchange_rate.go
+//
+// Generated by this command:
+//
+//	mockgen -source=exchange_rate.go -destination=../mocks/exchange_rate.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/dlankinl/bmstu-ppo-bl/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIExchangeRateProvider is a mock of IExchangeRateProvider interface.
+type MockIExchangeRateProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockIExchangeRateProviderMockRecorder
+}
+
+// MockIExchangeRateProviderMockRecorder is the mock recorder for MockIExchangeRateProvider.
+type MockIExchangeRateProviderMockRecorder struct {
+	mock *MockIExchangeRateProvider
+}
+
+// NewMockIExchangeRateProvider creates a new mock instance.
+func NewMockIExchangeRateProvider(ctrl *gomock.Controller) *MockIExchangeRateProvider {
+	mock := &MockIExchangeRateProvider{ctrl: ctrl}
+	mock.recorder = &MockIExchangeRateProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIExchangeRateProvider) EXPECT() *MockIExchangeRateProviderMockRecorder {
+	return m.recorder
+}
+
+// Convert mocks base method.
+func (m *MockIExchangeRateProvider) Convert(ctx context.Context, amount domain.Money, currency string, year, quarter int) (domain.Money, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Convert", ctx, amount, currency, year, quarter)
+	ret0, _ := ret[0].(domain.Money)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Convert indicates an expected call of Convert.
+func (mr *MockIExchangeRateProviderMockRecorder) Convert(ctx, amount, currency, year, quarter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Convert", reflect.TypeOf((*MockIExchangeRateProvider)(nil).Convert), ctx, amount, currency, year, quarter)
+}
+
+// Supports mocks base method.
+func (m *MockIExchangeRateProvider) Supports(ctx context.Context, currency string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Supports", ctx, currency)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Supports indicates an expected call of Supports.
+func (mr *MockIExchangeRateProviderMockRecorder) Supports(ctx, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Supports", reflect.TypeOf((*MockIExchangeRateProvider)(nil).Supports), ctx, currency)
+}
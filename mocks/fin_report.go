@@ -69,6 +69,21 @@ func (mr *MockIFinancialReportRepositoryMockRecorder) DeleteById(ctx, id any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockIFinancialReportRepository)(nil).DeleteById), ctx, id)
 }
 
+// GetByCompanies mocks base method.
+func (m *MockIFinancialReportRepository) GetByCompanies(ctx context.Context, companyIds []uuid.UUID, period *domain.Period) (map[uuid.UUID]*domain.FinancialReportByPeriod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCompanies", ctx, companyIds, period)
+	ret0, _ := ret[0].(map[uuid.UUID]*domain.FinancialReportByPeriod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCompanies indicates an expected call of GetByCompanies.
+func (mr *MockIFinancialReportRepositoryMockRecorder) GetByCompanies(ctx, companyIds, period any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCompanies", reflect.TypeOf((*MockIFinancialReportRepository)(nil).GetByCompanies), ctx, companyIds, period)
+}
+
 // GetByCompany mocks base method.
 func (m *MockIFinancialReportRepository) GetByCompany(ctx context.Context, companyId uuid.UUID, period *domain.Period) (*domain.FinancialReportByPeriod, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +114,20 @@ func (mr *MockIFinancialReportRepositoryMockRecorder) GetById(ctx, id any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIFinancialReportRepository)(nil).GetById), ctx, id)
 }
 
+// LockCompanyYear mocks base method.
+func (m *MockIFinancialReportRepository) LockCompanyYear(ctx context.Context, companyId uuid.UUID, year int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockCompanyYear", ctx, companyId, year)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockCompanyYear indicates an expected call of LockCompanyYear.
+func (mr *MockIFinancialReportRepositoryMockRecorder) LockCompanyYear(ctx, companyId, year any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockCompanyYear", reflect.TypeOf((*MockIFinancialReportRepository)(nil).LockCompanyYear), ctx, companyId, year)
+}
+
 // Update mocks base method.
 func (m *MockIFinancialReportRepository) Update(ctx context.Context, finRep *domain.FinancialReport) error {
 	m.ctrl.T.Helper()
@@ -137,87 +166,132 @@ func (m *MockIFinancialReportService) EXPECT() *MockIFinancialReportServiceMockR
 }
 
 // Create mocks base method.
-func (m *MockIFinancialReportService) Create(finRep *domain.FinancialReport) error {
+func (m *MockIFinancialReportService) Create(ctx context.Context, finRep *domain.FinancialReport) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", finRep)
+	ret := m.ctrl.Call(m, "Create", ctx, finRep)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockIFinancialReportServiceMockRecorder) Create(finRep any) *gomock.Call {
+func (mr *MockIFinancialReportServiceMockRecorder) Create(ctx, finRep any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIFinancialReportService)(nil).Create), finRep)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIFinancialReportService)(nil).Create), ctx, finRep)
 }
 
 // CreateByPeriod mocks base method.
-func (m *MockIFinancialReportService) CreateByPeriod(finReportByPeriod *domain.FinancialReportByPeriod) error {
+func (m *MockIFinancialReportService) CreateByPeriod(ctx context.Context, finReportByPeriod *domain.FinancialReportByPeriod) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateByPeriod", finReportByPeriod)
+	ret := m.ctrl.Call(m, "CreateByPeriod", ctx, finReportByPeriod)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateByPeriod indicates an expected call of CreateByPeriod.
-func (mr *MockIFinancialReportServiceMockRecorder) CreateByPeriod(finReportByPeriod any) *gomock.Call {
+func (mr *MockIFinancialReportServiceMockRecorder) CreateByPeriod(ctx, finReportByPeriod any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateByPeriod", reflect.TypeOf((*MockIFinancialReportService)(nil).CreateByPeriod), finReportByPeriod)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateByPeriod", reflect.TypeOf((*MockIFinancialReportService)(nil).CreateByPeriod), ctx, finReportByPeriod)
 }
 
 // DeleteById mocks base method.
-func (m *MockIFinancialReportService) DeleteById(id uuid.UUID) error {
+func (m *MockIFinancialReportService) DeleteById(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteById", id)
+	ret := m.ctrl.Call(m, "DeleteById", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteById indicates an expected call of DeleteById.
-func (mr *MockIFinancialReportServiceMockRecorder) DeleteById(id any) *gomock.Call {
+func (mr *MockIFinancialReportServiceMockRecorder) DeleteById(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockIFinancialReportService)(nil).DeleteById), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockIFinancialReportService)(nil).DeleteById), ctx, id)
+}
+
+// GetByCompanies mocks base method.
+func (m *MockIFinancialReportService) GetByCompanies(ctx context.Context, companyIds []uuid.UUID, period *domain.Period) (map[uuid.UUID]*domain.FinancialReportByPeriod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCompanies", ctx, companyIds, period)
+	ret0, _ := ret[0].(map[uuid.UUID]*domain.FinancialReportByPeriod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCompanies indicates an expected call of GetByCompanies.
+func (mr *MockIFinancialReportServiceMockRecorder) GetByCompanies(ctx, companyIds, period any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCompanies", reflect.TypeOf((*MockIFinancialReportService)(nil).GetByCompanies), ctx, companyIds, period)
 }
 
 // GetByCompany mocks base method.
-func (m *MockIFinancialReportService) GetByCompany(companyId uuid.UUID, period *domain.Period) (*domain.FinancialReportByPeriod, error) {
+func (m *MockIFinancialReportService) GetByCompany(ctx context.Context, companyId uuid.UUID, period *domain.Period) (*domain.FinancialReportByPeriod, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByCompany", companyId, period)
+	ret := m.ctrl.Call(m, "GetByCompany", ctx, companyId, period)
 	ret0, _ := ret[0].(*domain.FinancialReportByPeriod)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByCompany indicates an expected call of GetByCompany.
-func (mr *MockIFinancialReportServiceMockRecorder) GetByCompany(companyId, period any) *gomock.Call {
+func (mr *MockIFinancialReportServiceMockRecorder) GetByCompany(ctx, companyId, period any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCompany", reflect.TypeOf((*MockIFinancialReportService)(nil).GetByCompany), companyId, period)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCompany", reflect.TypeOf((*MockIFinancialReportService)(nil).GetByCompany), ctx, companyId, period)
 }
 
 // GetById mocks base method.
-func (m *MockIFinancialReportService) GetById(id uuid.UUID) (*domain.FinancialReport, error) {
+func (m *MockIFinancialReportService) GetById(ctx context.Context, id uuid.UUID) (*domain.FinancialReport, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetById", id)
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
 	ret0, _ := ret[0].(*domain.FinancialReport)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetById indicates an expected call of GetById.
-func (mr *MockIFinancialReportServiceMockRecorder) GetById(id any) *gomock.Call {
+func (mr *MockIFinancialReportServiceMockRecorder) GetById(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIFinancialReportService)(nil).GetById), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIFinancialReportService)(nil).GetById), ctx, id)
+}
+
+// GetDetailedByCompany mocks base method.
+func (m *MockIFinancialReportService) GetDetailedByCompany(ctx context.Context, companyId uuid.UUID, period *domain.Period) (*domain.FinancialReportByPeriod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDetailedByCompany", ctx, companyId, period)
+	ret0, _ := ret[0].(*domain.FinancialReportByPeriod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDetailedByCompany indicates an expected call of GetDetailedByCompany.
+func (mr *MockIFinancialReportServiceMockRecorder) GetDetailedByCompany(ctx, companyId, period any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDetailedByCompany", reflect.TypeOf((*MockIFinancialReportService)(nil).GetDetailedByCompany), ctx, companyId, period)
+}
+
+// GetUnconvertedByCompany mocks base method.
+func (m *MockIFinancialReportService) GetUnconvertedByCompany(ctx context.Context, companyId uuid.UUID, period *domain.Period) (*domain.FinancialReportByPeriod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnconvertedByCompany", ctx, companyId, period)
+	ret0, _ := ret[0].(*domain.FinancialReportByPeriod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnconvertedByCompany indicates an expected call of GetUnconvertedByCompany.
+func (mr *MockIFinancialReportServiceMockRecorder) GetUnconvertedByCompany(ctx, companyId, period any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnconvertedByCompany", reflect.TypeOf((*MockIFinancialReportService)(nil).GetUnconvertedByCompany), ctx, companyId, period)
 }
 
 // Update mocks base method.
-func (m *MockIFinancialReportService) Update(finRep *domain.FinancialReport) error {
+func (m *MockIFinancialReportService) Update(ctx context.Context, finRep *domain.FinancialReport) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", finRep)
+	ret := m.ctrl.Call(m, "Update", ctx, finRep)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockIFinancialReportServiceMockRecorder) Update(finRep any) *gomock.Call {
+func (mr *MockIFinancialReportServiceMockRecorder) Update(ctx, finRep any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIFinancialReportService)(nil).Update), finRep)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIFinancialReportService)(nil).Update), ctx, finRep)
 }
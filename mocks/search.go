@@ -0,0 +1,137 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: search.go
+//
+// Generated by this command:
+//
+//	mockgen -source=search.go -destination=../mocks/search.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/dlankinl/bmstu-ppo-bl/domain"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockISearchIndex is a mock of ISearchIndex interface.
+type MockISearchIndex struct {
+	ctrl     *gomock.Controller
+	recorder *MockISearchIndexMockRecorder
+}
+
+// MockISearchIndexMockRecorder is the mock recorder for MockISearchIndex.
+type MockISearchIndexMockRecorder struct {
+	mock *MockISearchIndex
+}
+
+// NewMockISearchIndex creates a new mock instance.
+func NewMockISearchIndex(ctrl *gomock.Controller) *MockISearchIndex {
+	mock := &MockISearchIndex{ctrl: ctrl}
+	mock.recorder = &MockISearchIndexMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISearchIndex) EXPECT() *MockISearchIndexMockRecorder {
+	return m.recorder
+}
+
+// Put mocks base method.
+func (m *MockISearchIndex) Put(ctx context.Context, doc *domain.SearchDocument) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, doc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockISearchIndexMockRecorder) Put(ctx, doc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockISearchIndex)(nil).Put), ctx, doc)
+}
+
+// Remove mocks base method.
+func (m *MockISearchIndex) Remove(ctx context.Context, kind domain.SearchKind, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, kind, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockISearchIndexMockRecorder) Remove(ctx, kind, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockISearchIndex)(nil).Remove), ctx, kind, id)
+}
+
+// Search mocks base method.
+func (m *MockISearchIndex) Search(ctx context.Context, query string, kinds []domain.SearchKind, req domain.PageRequest) (*domain.Page[*domain.SearchHit], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, query, kinds, req)
+	ret0, _ := ret[0].(*domain.Page[*domain.SearchHit])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockISearchIndexMockRecorder) Search(ctx, query, kinds, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockISearchIndex)(nil).Search), ctx, query, kinds, req)
+}
+
+// MockISearchService is a mock of ISearchService interface.
+type MockISearchService struct {
+	ctrl     *gomock.Controller
+	recorder *MockISearchServiceMockRecorder
+}
+
+// MockISearchServiceMockRecorder is the mock recorder for MockISearchService.
+type MockISearchServiceMockRecorder struct {
+	mock *MockISearchService
+}
+
+// NewMockISearchService creates a new mock instance.
+func NewMockISearchService(ctrl *gomock.Controller) *MockISearchService {
+	mock := &MockISearchService{ctrl: ctrl}
+	mock.recorder = &MockISearchServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISearchService) EXPECT() *MockISearchServiceMockRecorder {
+	return m.recorder
+}
+
+// Rebuild mocks base method.
+func (m *MockISearchService) Rebuild(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rebuild", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rebuild indicates an expected call of Rebuild.
+func (mr *MockISearchServiceMockRecorder) Rebuild(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rebuild", reflect.TypeOf((*MockISearchService)(nil).Rebuild), ctx)
+}
+
+// Search mocks base method.
+func (m *MockISearchService) Search(ctx context.Context, query string, kinds []domain.SearchKind, req domain.PageRequest) (*domain.Page[*domain.SearchHit], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, query, kinds, req)
+	ret0, _ := ret[0].(*domain.Page[*domain.SearchHit])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockISearchServiceMockRecorder) Search(ctx, query, kinds, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockISearchService)(nil).Search), ctx, query, kinds, req)
+}
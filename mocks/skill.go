@@ -70,18 +70,18 @@ func (mr *MockISkillRepositoryMockRecorder) DeleteById(ctx, id any) *gomock.Call
 }
 
 // GetAll mocks base method.
-func (m *MockISkillRepository) GetAll(ctx context.Context, page int) ([]*domain.Skill, error) {
+func (m *MockISkillRepository) GetAll(ctx context.Context, req domain.PageRequest) (*domain.Page[*domain.Skill], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx, page)
-	ret0, _ := ret[0].([]*domain.Skill)
+	ret := m.ctrl.Call(m, "GetAll", ctx, req)
+	ret0, _ := ret[0].(*domain.Page[*domain.Skill])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockISkillRepositoryMockRecorder) GetAll(ctx, page any) *gomock.Call {
+func (mr *MockISkillRepositoryMockRecorder) GetAll(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockISkillRepository)(nil).GetAll), ctx, page)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockISkillRepository)(nil).GetAll), ctx, req)
 }
 
 // GetById mocks base method.
@@ -99,6 +99,21 @@ func (mr *MockISkillRepositoryMockRecorder) GetById(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockISkillRepository)(nil).GetById), ctx, id)
 }
 
+// GetByIds mocks base method.
+func (m *MockISkillRepository) GetByIds(ctx context.Context, ids []uuid.UUID) ([]*domain.Skill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIds", ctx, ids)
+	ret0, _ := ret[0].([]*domain.Skill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIds indicates an expected call of GetByIds.
+func (mr *MockISkillRepositoryMockRecorder) GetByIds(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIds", reflect.TypeOf((*MockISkillRepository)(nil).GetByIds), ctx, ids)
+}
+
 // Update mocks base method.
 func (m *MockISkillRepository) Update(ctx context.Context, skill *domain.Skill) error {
 	m.ctrl.T.Helper()
@@ -137,73 +152,88 @@ func (m *MockISkillService) EXPECT() *MockISkillServiceMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockISkillService) Create(skill *domain.Skill) error {
+func (m *MockISkillService) Create(ctx context.Context, skill *domain.Skill) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", skill)
+	ret := m.ctrl.Call(m, "Create", ctx, skill)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockISkillServiceMockRecorder) Create(skill any) *gomock.Call {
+func (mr *MockISkillServiceMockRecorder) Create(ctx, skill any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockISkillService)(nil).Create), skill)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockISkillService)(nil).Create), ctx, skill)
 }
 
 // DeleteById mocks base method.
-func (m *MockISkillService) DeleteById(id uuid.UUID) error {
+func (m *MockISkillService) DeleteById(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteById", id)
+	ret := m.ctrl.Call(m, "DeleteById", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteById indicates an expected call of DeleteById.
-func (mr *MockISkillServiceMockRecorder) DeleteById(id any) *gomock.Call {
+func (mr *MockISkillServiceMockRecorder) DeleteById(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockISkillService)(nil).DeleteById), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockISkillService)(nil).DeleteById), ctx, id)
 }
 
 // GetAll mocks base method.
-func (m *MockISkillService) GetAll(page int) ([]*domain.Skill, error) {
+func (m *MockISkillService) GetAll(ctx context.Context, req domain.PageRequest) (*domain.Page[*domain.Skill], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", page)
-	ret0, _ := ret[0].([]*domain.Skill)
+	ret := m.ctrl.Call(m, "GetAll", ctx, req)
+	ret0, _ := ret[0].(*domain.Page[*domain.Skill])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockISkillServiceMockRecorder) GetAll(page any) *gomock.Call {
+func (mr *MockISkillServiceMockRecorder) GetAll(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockISkillService)(nil).GetAll), page)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockISkillService)(nil).GetAll), ctx, req)
 }
 
 // GetById mocks base method.
-func (m *MockISkillService) GetById(id uuid.UUID) (*domain.Skill, error) {
+func (m *MockISkillService) GetById(ctx context.Context, id uuid.UUID) (*domain.Skill, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetById", id)
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
 	ret0, _ := ret[0].(*domain.Skill)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetById indicates an expected call of GetById.
-func (mr *MockISkillServiceMockRecorder) GetById(id any) *gomock.Call {
+func (mr *MockISkillServiceMockRecorder) GetById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockISkillService)(nil).GetById), ctx, id)
+}
+
+// GetByIds mocks base method.
+func (m *MockISkillService) GetByIds(ctx context.Context, ids []uuid.UUID) ([]*domain.Skill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIds", ctx, ids)
+	ret0, _ := ret[0].([]*domain.Skill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIds indicates an expected call of GetByIds.
+func (mr *MockISkillServiceMockRecorder) GetByIds(ctx, ids any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockISkillService)(nil).GetById), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIds", reflect.TypeOf((*MockISkillService)(nil).GetByIds), ctx, ids)
 }
 
 // Update mocks base method.
-func (m *MockISkillService) Update(skill *domain.Skill) error {
+func (m *MockISkillService) Update(ctx context.Context, skill *domain.Skill) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", skill)
+	ret := m.ctrl.Call(m, "Update", ctx, skill)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockISkillServiceMockRecorder) Update(skill any) *gomock.Call {
+func (mr *MockISkillServiceMockRecorder) Update(ctx, skill any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockISkillService)(nil).Update), skill)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockISkillService)(nil).Update), ctx, skill)
 }
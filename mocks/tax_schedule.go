@@ -0,0 +1,196 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tax_schedule.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tax_schedule.go -destination=../mocks/tax_schedule.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/dlankinl/bmstu-ppo-bl/domain"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITaxScheduleRepository is a mock of ITaxScheduleRepository interface.
+type MockITaxScheduleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockITaxScheduleRepositoryMockRecorder
+}
+
+// MockITaxScheduleRepositoryMockRecorder is the mock recorder for MockITaxScheduleRepository.
+type MockITaxScheduleRepositoryMockRecorder struct {
+	mock *MockITaxScheduleRepository
+}
+
+// NewMockITaxScheduleRepository creates a new mock instance.
+func NewMockITaxScheduleRepository(ctrl *gomock.Controller) *MockITaxScheduleRepository {
+	mock := &MockITaxScheduleRepository{ctrl: ctrl}
+	mock.recorder = &MockITaxScheduleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITaxScheduleRepository) EXPECT() *MockITaxScheduleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockITaxScheduleRepository) Create(ctx context.Context, schedule *domain.TaxSchedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, schedule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockITaxScheduleRepositoryMockRecorder) Create(ctx, schedule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockITaxScheduleRepository)(nil).Create), ctx, schedule)
+}
+
+// DeleteById mocks base method.
+func (m *MockITaxScheduleRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteById", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteById indicates an expected call of DeleteById.
+func (mr *MockITaxScheduleRepositoryMockRecorder) DeleteById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockITaxScheduleRepository)(nil).DeleteById), ctx, id)
+}
+
+// GetById mocks base method.
+func (m *MockITaxScheduleRepository) GetById(ctx context.Context, id uuid.UUID) (*domain.TaxSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
+	ret0, _ := ret[0].(*domain.TaxSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockITaxScheduleRepositoryMockRecorder) GetById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockITaxScheduleRepository)(nil).GetById), ctx, id)
+}
+
+// GetByJurisdiction mocks base method.
+func (m *MockITaxScheduleRepository) GetByJurisdiction(ctx context.Context, jurisdiction string) ([]*domain.TaxSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByJurisdiction", ctx, jurisdiction)
+	ret0, _ := ret[0].([]*domain.TaxSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByJurisdiction indicates an expected call of GetByJurisdiction.
+func (mr *MockITaxScheduleRepositoryMockRecorder) GetByJurisdiction(ctx, jurisdiction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByJurisdiction", reflect.TypeOf((*MockITaxScheduleRepository)(nil).GetByJurisdiction), ctx, jurisdiction)
+}
+
+// MockITaxScheduleService is a mock of ITaxScheduleService interface.
+type MockITaxScheduleService struct {
+	ctrl     *gomock.Controller
+	recorder *MockITaxScheduleServiceMockRecorder
+}
+
+// MockITaxScheduleServiceMockRecorder is the mock recorder for MockITaxScheduleService.
+type MockITaxScheduleServiceMockRecorder struct {
+	mock *MockITaxScheduleService
+}
+
+// NewMockITaxScheduleService creates a new mock instance.
+func NewMockITaxScheduleService(ctrl *gomock.Controller) *MockITaxScheduleService {
+	mock := &MockITaxScheduleService{ctrl: ctrl}
+	mock.recorder = &MockITaxScheduleServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITaxScheduleService) EXPECT() *MockITaxScheduleServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockITaxScheduleService) Create(ctx context.Context, schedule *domain.TaxSchedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, schedule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockITaxScheduleServiceMockRecorder) Create(ctx, schedule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockITaxScheduleService)(nil).Create), ctx, schedule)
+}
+
+// DeleteById mocks base method.
+func (m *MockITaxScheduleService) DeleteById(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteById", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteById indicates an expected call of DeleteById.
+func (mr *MockITaxScheduleServiceMockRecorder) DeleteById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockITaxScheduleService)(nil).DeleteById), ctx, id)
+}
+
+// GetById mocks base method.
+func (m *MockITaxScheduleService) GetById(ctx context.Context, id uuid.UUID) (*domain.TaxSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
+	ret0, _ := ret[0].(*domain.TaxSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockITaxScheduleServiceMockRecorder) GetById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockITaxScheduleService)(nil).GetById), ctx, id)
+}
+
+// GetByJurisdiction mocks base method.
+func (m *MockITaxScheduleService) GetByJurisdiction(ctx context.Context, jurisdiction string) ([]*domain.TaxSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByJurisdiction", ctx, jurisdiction)
+	ret0, _ := ret[0].([]*domain.TaxSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByJurisdiction indicates an expected call of GetByJurisdiction.
+func (mr *MockITaxScheduleServiceMockRecorder) GetByJurisdiction(ctx, jurisdiction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByJurisdiction", reflect.TypeOf((*MockITaxScheduleService)(nil).GetByJurisdiction), ctx, jurisdiction)
+}
+
+// GetInForce mocks base method.
+func (m *MockITaxScheduleService) GetInForce(ctx context.Context, year int, activityFieldId uuid.UUID) (*domain.TaxSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInForce", ctx, year, activityFieldId)
+	ret0, _ := ret[0].(*domain.TaxSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInForce indicates an expected call of GetInForce.
+func (mr *MockITaxScheduleServiceMockRecorder) GetInForce(ctx, year, activityFieldId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInForce", reflect.TypeOf((*MockITaxScheduleService)(nil).GetInForce), ctx, year, activityFieldId)
+}
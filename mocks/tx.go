@@ -0,0 +1,54 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tx.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tx.go -destination=../mocks/tx.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITxManager is a mock of ITxManager interface.
+type MockITxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockITxManagerMockRecorder
+}
+
+// MockITxManagerMockRecorder is the mock recorder for MockITxManager.
+type MockITxManagerMockRecorder struct {
+	mock *MockITxManager
+}
+
+// NewMockITxManager creates a new mock instance.
+func NewMockITxManager(ctrl *gomock.Controller) *MockITxManager {
+	mock := &MockITxManager{ctrl: ctrl}
+	mock.recorder = &MockITxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITxManager) EXPECT() *MockITxManagerMockRecorder {
+	return m.recorder
+}
+
+// WithinTx mocks base method.
+func (m *MockITxManager) WithinTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithinTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithinTx indicates an expected call of WithinTx.
+func (mr *MockITxManagerMockRecorder) WithinTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithinTx", reflect.TypeOf((*MockITxManager)(nil).WithinTx), ctx, fn)
+}
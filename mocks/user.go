@@ -70,18 +70,18 @@ func (mr *MockIUserRepositoryMockRecorder) DeleteById(ctx, id any) *gomock.Call
 }
 
 // GetAll mocks base method.
-func (m *MockIUserRepository) GetAll(ctx context.Context, page int) ([]*domain.User, error) {
+func (m *MockIUserRepository) GetAll(ctx context.Context, filter domain.UserFilter, req domain.PageRequest) (*domain.Page[*domain.User], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx, page)
-	ret0, _ := ret[0].([]*domain.User)
+	ret := m.ctrl.Call(m, "GetAll", ctx, filter, req)
+	ret0, _ := ret[0].(*domain.Page[*domain.User])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockIUserRepositoryMockRecorder) GetAll(ctx, page any) *gomock.Call {
+func (mr *MockIUserRepositoryMockRecorder) GetAll(ctx, filter, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIUserRepository)(nil).GetAll), ctx, page)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIUserRepository)(nil).GetAll), ctx, filter, req)
 }
 
 // GetById mocks base method.
@@ -99,6 +99,21 @@ func (mr *MockIUserRepositoryMockRecorder) GetById(ctx, userId any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIUserRepository)(nil).GetById), ctx, userId)
 }
 
+// GetByIds mocks base method.
+func (m *MockIUserRepository) GetByIds(ctx context.Context, ids []uuid.UUID) ([]*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIds", ctx, ids)
+	ret0, _ := ret[0].([]*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIds indicates an expected call of GetByIds.
+func (mr *MockIUserRepositoryMockRecorder) GetByIds(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIds", reflect.TypeOf((*MockIUserRepository)(nil).GetByIds), ctx, ids)
+}
+
 // GetByUsername mocks base method.
 func (m *MockIUserRepository) GetByUsername(ctx context.Context, username string) (*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -152,88 +167,88 @@ func (m *MockIUserService) EXPECT() *MockIUserServiceMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockIUserService) Create(user *domain.User) error {
+func (m *MockIUserService) Create(ctx context.Context, user *domain.User) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", user)
+	ret := m.ctrl.Call(m, "Create", ctx, user)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockIUserServiceMockRecorder) Create(user any) *gomock.Call {
+func (mr *MockIUserServiceMockRecorder) Create(ctx, user any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIUserService)(nil).Create), user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIUserService)(nil).Create), ctx, user)
 }
 
 // DeleteById mocks base method.
-func (m *MockIUserService) DeleteById(id uuid.UUID) error {
+func (m *MockIUserService) DeleteById(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteById", id)
+	ret := m.ctrl.Call(m, "DeleteById", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteById indicates an expected call of DeleteById.
-func (mr *MockIUserServiceMockRecorder) DeleteById(id any) *gomock.Call {
+func (mr *MockIUserServiceMockRecorder) DeleteById(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockIUserService)(nil).DeleteById), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockIUserService)(nil).DeleteById), ctx, id)
 }
 
 // GetAll mocks base method.
-func (m *MockIUserService) GetAll(page int) ([]*domain.User, error) {
+func (m *MockIUserService) GetAll(ctx context.Context, filter domain.UserFilter, req domain.PageRequest) (*domain.Page[*domain.User], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", page)
-	ret0, _ := ret[0].([]*domain.User)
+	ret := m.ctrl.Call(m, "GetAll", ctx, filter, req)
+	ret0, _ := ret[0].(*domain.Page[*domain.User])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockIUserServiceMockRecorder) GetAll(page any) *gomock.Call {
+func (mr *MockIUserServiceMockRecorder) GetAll(ctx, filter, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIUserService)(nil).GetAll), page)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIUserService)(nil).GetAll), ctx, filter, req)
 }
 
 // GetById mocks base method.
-func (m *MockIUserService) GetById(userId uuid.UUID) (*domain.User, error) {
+func (m *MockIUserService) GetById(ctx context.Context, userId uuid.UUID) (*domain.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetById", userId)
+	ret := m.ctrl.Call(m, "GetById", ctx, userId)
 	ret0, _ := ret[0].(*domain.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetById indicates an expected call of GetById.
-func (mr *MockIUserServiceMockRecorder) GetById(userId any) *gomock.Call {
+func (mr *MockIUserServiceMockRecorder) GetById(ctx, userId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIUserService)(nil).GetById), userId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIUserService)(nil).GetById), ctx, userId)
 }
 
 // GetByUsername mocks base method.
-func (m *MockIUserService) GetByUsername(username string) (*domain.User, error) {
+func (m *MockIUserService) GetByUsername(ctx context.Context, username string) (*domain.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByUsername", username)
+	ret := m.ctrl.Call(m, "GetByUsername", ctx, username)
 	ret0, _ := ret[0].(*domain.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByUsername indicates an expected call of GetByUsername.
-func (mr *MockIUserServiceMockRecorder) GetByUsername(username any) *gomock.Call {
+func (mr *MockIUserServiceMockRecorder) GetByUsername(ctx, username any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUsername", reflect.TypeOf((*MockIUserService)(nil).GetByUsername), username)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUsername", reflect.TypeOf((*MockIUserService)(nil).GetByUsername), ctx, username)
 }
 
 // Update mocks base method.
-func (m *MockIUserService) Update(user *domain.User) error {
+func (m *MockIUserService) Update(ctx context.Context, user *domain.User) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", user)
+	ret := m.ctrl.Call(m, "Update", ctx, user)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockIUserServiceMockRecorder) Update(user any) *gomock.Call {
+func (mr *MockIUserServiceMockRecorder) Update(ctx, user any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIUserService)(nil).Update), user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIUserService)(nil).Update), ctx, user)
 }
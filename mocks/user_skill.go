@@ -70,33 +70,33 @@ func (mr *MockIUserSkillRepositoryMockRecorder) Delete(ctx, pair any) *gomock.Ca
 }
 
 // GetUserSkillsBySkillId mocks base method.
-func (m *MockIUserSkillRepository) GetUserSkillsBySkillId(ctx context.Context, skillId uuid.UUID, page int) ([]*domain.UserSkill, error) {
+func (m *MockIUserSkillRepository) GetUserSkillsBySkillId(ctx context.Context, skillId uuid.UUID, req domain.PageRequest) (*domain.Page[*domain.UserSkill], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserSkillsBySkillId", ctx, skillId, page)
-	ret0, _ := ret[0].([]*domain.UserSkill)
+	ret := m.ctrl.Call(m, "GetUserSkillsBySkillId", ctx, skillId, req)
+	ret0, _ := ret[0].(*domain.Page[*domain.UserSkill])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserSkillsBySkillId indicates an expected call of GetUserSkillsBySkillId.
-func (mr *MockIUserSkillRepositoryMockRecorder) GetUserSkillsBySkillId(ctx, skillId, page any) *gomock.Call {
+func (mr *MockIUserSkillRepositoryMockRecorder) GetUserSkillsBySkillId(ctx, skillId, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSkillsBySkillId", reflect.TypeOf((*MockIUserSkillRepository)(nil).GetUserSkillsBySkillId), ctx, skillId, page)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSkillsBySkillId", reflect.TypeOf((*MockIUserSkillRepository)(nil).GetUserSkillsBySkillId), ctx, skillId, req)
 }
 
 // GetUserSkillsByUserId mocks base method.
-func (m *MockIUserSkillRepository) GetUserSkillsByUserId(ctx context.Context, userId uuid.UUID, page int) ([]*domain.UserSkill, error) {
+func (m *MockIUserSkillRepository) GetUserSkillsByUserId(ctx context.Context, userId uuid.UUID, req domain.PageRequest) (*domain.Page[*domain.UserSkill], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserSkillsByUserId", ctx, userId, page)
-	ret0, _ := ret[0].([]*domain.UserSkill)
+	ret := m.ctrl.Call(m, "GetUserSkillsByUserId", ctx, userId, req)
+	ret0, _ := ret[0].(*domain.Page[*domain.UserSkill])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserSkillsByUserId indicates an expected call of GetUserSkillsByUserId.
-func (mr *MockIUserSkillRepositoryMockRecorder) GetUserSkillsByUserId(ctx, userId, page any) *gomock.Call {
+func (mr *MockIUserSkillRepositoryMockRecorder) GetUserSkillsByUserId(ctx, userId, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSkillsByUserId", reflect.TypeOf((*MockIUserSkillRepository)(nil).GetUserSkillsByUserId), ctx, userId, page)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSkillsByUserId", reflect.TypeOf((*MockIUserSkillRepository)(nil).GetUserSkillsByUserId), ctx, userId, req)
 }
 
 // MockIUserSkillService is a mock of IUserSkillService interface.
@@ -123,73 +123,73 @@ func (m *MockIUserSkillService) EXPECT() *MockIUserSkillServiceMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockIUserSkillService) Create(pair *domain.UserSkill) error {
+func (m *MockIUserSkillService) Create(ctx context.Context, pair *domain.UserSkill) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", pair)
+	ret := m.ctrl.Call(m, "Create", ctx, pair)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockIUserSkillServiceMockRecorder) Create(pair any) *gomock.Call {
+func (mr *MockIUserSkillServiceMockRecorder) Create(ctx, pair any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIUserSkillService)(nil).Create), pair)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIUserSkillService)(nil).Create), ctx, pair)
 }
 
 // Delete mocks base method.
-func (m *MockIUserSkillService) Delete(pair *domain.UserSkill) error {
+func (m *MockIUserSkillService) Delete(ctx context.Context, pair *domain.UserSkill) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", pair)
+	ret := m.ctrl.Call(m, "Delete", ctx, pair)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockIUserSkillServiceMockRecorder) Delete(pair any) *gomock.Call {
+func (mr *MockIUserSkillServiceMockRecorder) Delete(ctx, pair any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIUserSkillService)(nil).Delete), pair)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIUserSkillService)(nil).Delete), ctx, pair)
 }
 
 // DeleteSkillsForUser mocks base method.
-func (m *MockIUserSkillService) DeleteSkillsForUser(userId uuid.UUID) error {
+func (m *MockIUserSkillService) DeleteSkillsForUser(ctx context.Context, userId uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSkillsForUser", userId)
+	ret := m.ctrl.Call(m, "DeleteSkillsForUser", ctx, userId)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteSkillsForUser indicates an expected call of DeleteSkillsForUser.
-func (mr *MockIUserSkillServiceMockRecorder) DeleteSkillsForUser(userId any) *gomock.Call {
+func (mr *MockIUserSkillServiceMockRecorder) DeleteSkillsForUser(ctx, userId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSkillsForUser", reflect.TypeOf((*MockIUserSkillService)(nil).DeleteSkillsForUser), userId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSkillsForUser", reflect.TypeOf((*MockIUserSkillService)(nil).DeleteSkillsForUser), ctx, userId)
 }
 
 // GetSkillsForUser mocks base method.
-func (m *MockIUserSkillService) GetSkillsForUser(userId uuid.UUID, page int) ([]*domain.Skill, error) {
+func (m *MockIUserSkillService) GetSkillsForUser(ctx context.Context, userId uuid.UUID, req domain.PageRequest) (*domain.Page[*domain.Skill], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSkillsForUser", userId, page)
-	ret0, _ := ret[0].([]*domain.Skill)
+	ret := m.ctrl.Call(m, "GetSkillsForUser", ctx, userId, req)
+	ret0, _ := ret[0].(*domain.Page[*domain.Skill])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSkillsForUser indicates an expected call of GetSkillsForUser.
-func (mr *MockIUserSkillServiceMockRecorder) GetSkillsForUser(userId, page any) *gomock.Call {
+func (mr *MockIUserSkillServiceMockRecorder) GetSkillsForUser(ctx, userId, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSkillsForUser", reflect.TypeOf((*MockIUserSkillService)(nil).GetSkillsForUser), userId, page)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSkillsForUser", reflect.TypeOf((*MockIUserSkillService)(nil).GetSkillsForUser), ctx, userId, req)
 }
 
 // GetUsersForSkill mocks base method.
-func (m *MockIUserSkillService) GetUsersForSkill(skillId uuid.UUID, page int) ([]*domain.User, error) {
+func (m *MockIUserSkillService) GetUsersForSkill(ctx context.Context, skillId uuid.UUID, req domain.PageRequest) (*domain.Page[*domain.User], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUsersForSkill", skillId, page)
-	ret0, _ := ret[0].([]*domain.User)
+	ret := m.ctrl.Call(m, "GetUsersForSkill", ctx, skillId, req)
+	ret0, _ := ret[0].(*domain.Page[*domain.User])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUsersForSkill indicates an expected call of GetUsersForSkill.
-func (mr *MockIUserSkillServiceMockRecorder) GetUsersForSkill(skillId, page any) *gomock.Call {
+func (mr *MockIUserSkillServiceMockRecorder) GetUsersForSkill(ctx, skillId, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersForSkill", reflect.TypeOf((*MockIUserSkillService)(nil).GetUsersForSkill), skillId, page)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersForSkill", reflect.TypeOf((*MockIUserSkillService)(nil).GetUsersForSkill), ctx, skillId, req)
 }
@@ -1,7 +1,7 @@
 package base
 
 import (
-	"fmt"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -21,7 +21,7 @@ func NewHashCrypto() IHashCrypto {
 func (c HashCrypto) GenerateHashPass(password string) (string, error) {
 	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
-		return "", fmt.Errorf("генерация хэша пароля: %w", err)
+		return "", i18n.Wrap(err, i18n.AuthPasswordHashGenerate)
 	}
 
 	return string(hash), nil
@@ -1,51 +1,279 @@
 package base
 
 import (
-	"fmt"
+	"context"
+	"errors"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"time"
 )
 
+const (
+	AccessTokenTTL  = 15 * time.Minute
+	RefreshTokenTTL = 30 * 24 * time.Hour
+
+	accessTokenType  = "access"
+	refreshTokenType = "refresh"
+)
+
+var (
+	ErrTokenMalformed   = i18n.New(i18n.TokenMalformed)
+	ErrTokenSignature   = i18n.New(i18n.TokenSignature)
+	ErrTokenExpired     = i18n.New(i18n.TokenExpired)
+	ErrTokenNotValidYet = i18n.New(i18n.TokenNotValidYet)
+	ErrTokenIssuer      = i18n.New(i18n.TokenIssuer)
+	ErrTokenAudience    = i18n.New(i18n.TokenAudience)
+	ErrTokenType        = i18n.New(i18n.TokenType)
+	ErrTokenRevoked     = i18n.New(i18n.TokenRevoked)
+	ErrTokenInvalid     = i18n.New(i18n.TokenInvalid)
+)
+
+type TokenOptions struct {
+	Issuer   string
+	Audience string
+}
+
 type JwtPayload struct {
-	Username string
-	Role     string
+	UserId    uuid.UUID
+	Username  string
+	Role      string
+	SessionId uuid.UUID
+	TokenId   uuid.UUID
+	Issuer    string
+	Audience  []string
+	IssuedAt  time.Time
+	NotBefore time.Time
+	ExpiresAt time.Time
+}
+
+type IRevocationChecker interface {
+	IsRevoked(ctx context.Context, id uuid.UUID) (bool, error)
+}
+
+type accessClaims struct {
+	jwt.RegisteredClaims
+	Username  string `json:"username"`
+	Role      string `json:"role"`
+	SessionId string `json:"sid"`
+	Type      string `json:"typ"`
+}
+
+func (c *accessClaims) validate() error {
+	if c.Type != accessTokenType {
+		return ErrTokenType
+	}
+
+	if _, err := uuid.Parse(c.Subject); err != nil {
+		return i18n.Wrap(errors.New("sub"), i18n.TokenMalformed)
+	}
+
+	if c.Username == "" {
+		return i18n.Wrap(errors.New("username"), i18n.TokenMalformed)
+	}
+
+	if _, err := uuid.Parse(c.SessionId); err != nil {
+		return i18n.Wrap(errors.New("sid"), i18n.TokenMalformed)
+	}
+
+	if _, err := uuid.Parse(c.ID); err != nil {
+		return i18n.Wrap(errors.New("jti"), i18n.TokenMalformed)
+	}
+
+	return nil
+}
+
+type refreshClaims struct {
+	jwt.RegisteredClaims
+	Type string `json:"typ"`
+}
+
+func (c *refreshClaims) validate() error {
+	if c.Type != refreshTokenType {
+		return ErrTokenType
+	}
+
+	if _, err := uuid.Parse(c.ID); err != nil {
+		return i18n.Wrap(errors.New("jti"), i18n.TokenMalformed)
+	}
+
+	return nil
 }
 
-func GenerateAuthToken(username, jwtKey, role string) (tokenString string, err error) {
-	token := jwt.NewWithClaims(
-		jwt.SigningMethodHS256,
-		jwt.MapClaims{
-			"sub":  username,
-			"exp":  time.Now().Add(time.Hour * 24).Unix(),
-			"role": role,
-		})
+func registeredClaims(subject string, id uuid.UUID, opts TokenOptions, expiresAt time.Time) jwt.RegisteredClaims {
+	now := time.Now()
+
+	claims := jwt.RegisteredClaims{
+		Issuer:    opts.Issuer,
+		Subject:   subject,
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+		NotBefore: jwt.NewNumericDate(now),
+		IssuedAt:  jwt.NewNumericDate(now),
+		ID:        id.String(),
+	}
+	if opts.Audience != "" {
+		claims.Audience = jwt.ClaimStrings{opts.Audience}
+	}
+
+	return claims
+}
 
-	tokenString, err = token.SignedString([]byte(jwtKey))
+func signToken(claims jwt.Claims, keys IKeyProvider) (tokenString string, err error) {
+	key, err := keys.SigningKey()
 	if err != nil {
-		return "", fmt.Errorf("формирование JWT-ключа: %w", err)
+		return "", i18n.Wrap(err, i18n.TokenSigningKeyGet)
+	}
+
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.ID
+
+	return token.SignedString(key.Private)
+}
+
+func GenerateAuthToken(userId uuid.UUID, username, role string, sessionId uuid.UUID, keys IKeyProvider, opts TokenOptions) (tokenString string, err error) {
+	tokenString, err = signToken(
+		&accessClaims{
+			RegisteredClaims: registeredClaims(userId.String(), uuid.New(), opts, time.Now().Add(AccessTokenTTL)),
+			Username:         username,
+			Role:             role,
+			SessionId:        sessionId.String(),
+			Type:             accessTokenType,
+		}, keys)
+	if err != nil {
+		return "", i18n.Wrap(err, i18n.TokenAccessSign)
+	}
+
+	return tokenString, nil
+}
+
+func GenerateRefreshToken(userId, sessionId uuid.UUID, keys IKeyProvider, opts TokenOptions, expiresAt time.Time) (tokenString string, err error) {
+	tokenString, err = signToken(
+		&refreshClaims{
+			RegisteredClaims: registeredClaims(userId.String(), sessionId, opts, expiresAt),
+			Type:             refreshTokenType,
+		}, keys)
+	if err != nil {
+		return "", i18n.Wrap(err, i18n.TokenRefreshSign)
 	}
 
 	return tokenString, nil
 }
 
-func VerifyAuthToken(tokenString, jwtKey string) (payload *JwtPayload, err error) {
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		return []byte(jwtKey), nil
-	})
+func classifyTokenError(err error) error {
+	switch {
+	case errors.Is(err, jwt.ErrTokenMalformed):
+		return i18n.Wrap(err, i18n.TokenMalformed)
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return i18n.Wrap(err, i18n.TokenExpired)
+	case errors.Is(err, jwt.ErrTokenNotValidYet), errors.Is(err, jwt.ErrTokenUsedBeforeIssued):
+		return i18n.Wrap(err, i18n.TokenNotValidYet)
+	case errors.Is(err, jwt.ErrTokenInvalidIssuer):
+		return i18n.Wrap(err, i18n.TokenIssuer)
+	case errors.Is(err, jwt.ErrTokenInvalidAudience):
+		return i18n.Wrap(err, i18n.TokenAudience)
+	case errors.Is(err, jwt.ErrTokenSignatureInvalid), errors.Is(err, jwt.ErrTokenUnverifiable):
+		return i18n.Wrap(err, i18n.TokenSignature)
+	default:
+		return i18n.Wrap(err, i18n.TokenInvalid)
+	}
+}
+
+func parseToken(tokenString string, claims jwt.Claims, keys IKeyProvider, opts TokenOptions) (err error) {
+	parserOpts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	}
+	if opts.Issuer != "" {
+		parserOpts = append(parserOpts, jwt.WithIssuer(opts.Issuer))
+	}
+	if opts.Audience != "" {
+		parserOpts = append(parserOpts, jwt.WithAudience(opts.Audience))
+	}
+
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, ok := token.Header["kid"].(string)
+		if !ok {
+			return nil, i18n.New(i18n.TokenKidMissing)
+		}
+
+		key, err := keys.VerificationKey(kid)
+		if err != nil {
+			return nil, err
+		}
+
+		if token.Method.Alg() != key.Method.Alg() {
+			return nil, i18n.New(i18n.TokenAlgMismatch, token.Method.Alg(), kid)
+		}
+
+		return key.Public, nil
+	}, parserOpts...)
 
 	if err != nil {
-		return nil, fmt.Errorf("парсинг токена: %w", err)
+		return classifyTokenError(err)
 	}
 
 	if !token.Valid {
-		return nil, fmt.Errorf("токен невалидный")
+		return ErrTokenInvalid
 	}
 
-	payload = new(JwtPayload)
-	if claims, ok := token.Claims.(jwt.MapClaims); ok {
-		payload.Username = fmt.Sprint(claims["sub"])
-		payload.Role = fmt.Sprint(claims["role"])
+	return nil
+}
+
+func VerifyAuthToken(ctx context.Context, tokenString string, keys IKeyProvider, opts TokenOptions, revocation IRevocationChecker) (payload *JwtPayload, err error) {
+	claims := new(accessClaims)
+	err = parseToken(tokenString, claims, keys, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = claims.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	payload = &JwtPayload{
+		UserId:    uuid.MustParse(claims.Subject),
+		Username:  claims.Username,
+		Role:      claims.Role,
+		SessionId: uuid.MustParse(claims.SessionId),
+		TokenId:   uuid.MustParse(claims.ID),
+		Issuer:    claims.Issuer,
+		Audience:  claims.Audience,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}
+	if claims.IssuedAt != nil {
+		payload.IssuedAt = claims.IssuedAt.Time
+	}
+	if claims.NotBefore != nil {
+		payload.NotBefore = claims.NotBefore.Time
+	}
+
+	if revocation != nil {
+		revoked, err := revocation.IsRevoked(ctx, payload.SessionId)
+		if err != nil {
+			return nil, i18n.Wrap(err, i18n.TokenRevocationCheck)
+		}
+
+		if revoked {
+			return nil, ErrTokenRevoked
+		}
 	}
 
 	return payload, nil
 }
+
+func VerifyRefreshToken(tokenString string, keys IKeyProvider, opts TokenOptions) (sessionId uuid.UUID, err error) {
+	claims := new(refreshClaims)
+	err = parseToken(tokenString, claims, keys, opts)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	err = claims.validate()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return uuid.MustParse(claims.ID), nil
+}
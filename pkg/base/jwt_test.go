@@ -0,0 +1,233 @@
+package base
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func newEd25519Key(t *testing.T, kid string) *SigningKey {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	require.Nil(t, err)
+
+	return NewEd25519Key(kid, private)
+}
+
+func newRSAKey(t *testing.T, kid string) *SigningKey {
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+
+	return NewRSAKey(kid, private)
+}
+
+func newAccessClaims(opts TokenOptions) *accessClaims {
+	return &accessClaims{
+		RegisteredClaims: registeredClaims(uuid.New().String(), uuid.New(), opts, time.Now().Add(time.Hour)),
+		Username:         "test123",
+		Role:             "admin",
+		SessionId:        uuid.New().String(),
+		Type:             accessTokenType,
+	}
+}
+
+func TestVerifyAuthToken_KeyRotation(t *testing.T) {
+	oldKey := newRSAKey(t, "old")
+	newKey := newEd25519Key(t, "new")
+
+	keys, err := NewKeySet(oldKey)
+	require.Nil(t, err)
+
+	oldToken, err := GenerateAuthToken(uuid.New(), "test123", "admin", uuid.New(), keys, TokenOptions{})
+	require.Nil(t, err)
+
+	require.Nil(t, keys.Rotate(newKey))
+
+	newToken, err := GenerateAuthToken(uuid.New(), "test123", "admin", uuid.New(), keys, TokenOptions{})
+	require.Nil(t, err)
+
+	parsed, _, err := jwt.NewParser().ParseUnverified(newToken, jwt.MapClaims{})
+	require.Nil(t, err)
+	require.Equal(t, "new", parsed.Header["kid"])
+	require.Equal(t, "EdDSA", parsed.Header["alg"])
+
+	_, err = VerifyAuthToken(context.Background(), oldToken, keys, TokenOptions{}, nil)
+	require.Nil(t, err)
+	_, err = VerifyAuthToken(context.Background(), newToken, keys, TokenOptions{}, nil)
+	require.Nil(t, err)
+
+	require.NotNil(t, keys.Remove("new"))
+	require.Nil(t, keys.Remove("old"))
+
+	_, err = VerifyAuthToken(context.Background(), oldToken, keys, TokenOptions{}, nil)
+	require.NotNil(t, err)
+	_, err = VerifyAuthToken(context.Background(), newToken, keys, TokenOptions{}, nil)
+	require.Nil(t, err)
+}
+
+func TestVerifyAuthToken_Algorithm(t *testing.T) {
+	rsaKey := newRSAKey(t, "rsa")
+	keys, err := NewKeySet(rsaKey)
+	require.Nil(t, err)
+
+	claims := newAccessClaims(TokenOptions{})
+
+	testCases := []struct {
+		name  string
+		token func(t *testing.T) string
+	}{
+		{
+			name: "HS256 с открытым ключом в качестве секрета",
+			token: func(t *testing.T) string {
+				token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+				token.Header["kid"] = "rsa"
+				str, err := token.SignedString([]byte("secret"))
+				require.Nil(t, err)
+				return str
+			},
+		},
+		{
+			name: "алгоритм none",
+			token: func(t *testing.T) string {
+				token := jwt.NewWithClaims(jwt.SigningMethodNone, claims)
+				token.Header["kid"] = "rsa"
+				str, err := token.SignedString(jwt.UnsafeAllowNoneSignatureType)
+				require.Nil(t, err)
+				return str
+			},
+		},
+		{
+			name: "EdDSA-подпись для RSA-ключа",
+			token: func(t *testing.T) string {
+				edKey := newEd25519Key(t, "rsa")
+				token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+				token.Header["kid"] = "rsa"
+				str, err := token.SignedString(edKey.Private)
+				require.Nil(t, err)
+				return str
+			},
+		},
+		{
+			name: "токен без kid",
+			token: func(t *testing.T) string {
+				token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+				str, err := token.SignedString(rsaKey.Private)
+				require.Nil(t, err)
+				return str
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := VerifyAuthToken(context.Background(), tc.token(t), keys, TokenOptions{}, nil)
+			require.NotNil(t, err)
+		})
+	}
+}
+
+func TestVerifyAuthToken_Claims(t *testing.T) {
+	keys, err := NewKeySet(newEd25519Key(t, "key"))
+	require.Nil(t, err)
+
+	opts := TokenOptions{
+		Issuer:   "bmstu-ppo-bl",
+		Audience: "api",
+	}
+
+	sign := func(t *testing.T, claims jwt.Claims) string {
+		token, err := signToken(claims, keys)
+		require.Nil(t, err)
+		return token
+	}
+
+	testCases := []struct {
+		name    string
+		token   func(t *testing.T) string
+		wantErr error
+	}{
+		{
+			name: "корректный токен",
+			token: func(t *testing.T) string {
+				return sign(t, newAccessClaims(opts))
+			},
+		},
+		{
+			name: "истекший токен",
+			token: func(t *testing.T) string {
+				claims := newAccessClaims(opts)
+				claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+				return sign(t, claims)
+			},
+			wantErr: ErrTokenExpired,
+		},
+		{
+			name: "токен еще не действителен",
+			token: func(t *testing.T) string {
+				claims := newAccessClaims(opts)
+				claims.NotBefore = jwt.NewNumericDate(time.Now().Add(time.Hour))
+				return sign(t, claims)
+			},
+			wantErr: ErrTokenNotValidYet,
+		},
+		{
+			name: "неверная аудитория",
+			token: func(t *testing.T) string {
+				return sign(t, newAccessClaims(TokenOptions{Issuer: opts.Issuer, Audience: "other"}))
+			},
+			wantErr: ErrTokenAudience,
+		},
+		{
+			name: "неверный издатель",
+			token: func(t *testing.T) string {
+				return sign(t, newAccessClaims(TokenOptions{Issuer: "other", Audience: opts.Audience}))
+			},
+			wantErr: ErrTokenIssuer,
+		},
+		{
+			name: "некорректный формат",
+			token: func(t *testing.T) string {
+				return "not.a.token"
+			},
+			wantErr: ErrTokenMalformed,
+		},
+		{
+			name: "отсутствует идентификатор пользователя",
+			token: func(t *testing.T) string {
+				claims := newAccessClaims(opts)
+				claims.Subject = ""
+				return sign(t, claims)
+			},
+			wantErr: ErrTokenMalformed,
+		},
+		{
+			name: "токен обновления вместо токена доступа",
+			token: func(t *testing.T) string {
+				token, err := GenerateRefreshToken(uuid.New(), uuid.New(), keys, opts, time.Now().Add(time.Hour))
+				require.Nil(t, err)
+				return token
+			},
+			wantErr: ErrTokenType,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			payload, err := VerifyAuthToken(context.Background(), tc.token(t), keys, opts, nil)
+
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, "test123", payload.Username)
+				require.Equal(t, opts.Issuer, payload.Issuer)
+				require.Equal(t, []string{opts.Audience}, payload.Audience)
+				require.NotEqual(t, uuid.Nil, payload.UserId)
+				require.NotEqual(t, uuid.Nil, payload.TokenId)
+			}
+		})
+	}
+}
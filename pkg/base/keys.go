@@ -0,0 +1,189 @@
+package base
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/golang-jwt/jwt/v5"
+	"sync"
+)
+
+type SigningKey struct {
+	ID      string
+	Method  jwt.SigningMethod
+	Private crypto.Signer
+	Public  crypto.PublicKey
+}
+
+type IKeyProvider interface {
+	SigningKey() (*SigningKey, error)
+	VerificationKey(kid string) (*SigningKey, error)
+}
+
+func NewRSAKey(kid string, private *rsa.PrivateKey) *SigningKey {
+	return &SigningKey{
+		ID:      kid,
+		Method:  jwt.SigningMethodRS256,
+		Private: private,
+		Public:  &private.PublicKey,
+	}
+}
+
+func NewEd25519Key(kid string, private ed25519.PrivateKey) *SigningKey {
+	return &SigningKey{
+		ID:      kid,
+		Method:  jwt.SigningMethodEdDSA,
+		Private: private,
+		Public:  private.Public(),
+	}
+}
+
+func NewVerificationKey(kid string, public crypto.PublicKey) (*SigningKey, error) {
+	switch public.(type) {
+	case *rsa.PublicKey:
+		return &SigningKey{ID: kid, Method: jwt.SigningMethodRS256, Public: public}, nil
+	case ed25519.PublicKey:
+		return &SigningKey{ID: kid, Method: jwt.SigningMethodEdDSA, Public: public}, nil
+	default:
+		return nil, i18n.New(i18n.KeyUnsupportedType, public)
+	}
+}
+
+func ParsePrivateKeyPEM(kid string, data []byte) (*SigningKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, i18n.New(i18n.KeyNotPEM)
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return NewRSAKey(kid, key), nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, i18n.Wrap(err, i18n.KeyParsePrivate)
+	}
+
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		return NewRSAKey(kid, key), nil
+	case ed25519.PrivateKey:
+		return NewEd25519Key(kid, key), nil
+	default:
+		return nil, i18n.New(i18n.KeyUnsupportedType, key)
+	}
+}
+
+func ParsePublicKeyPEM(kid string, data []byte) (*SigningKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, i18n.New(i18n.KeyNotPEM)
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, i18n.Wrap(err, i18n.KeyParsePublic)
+	}
+
+	return NewVerificationKey(kid, key)
+}
+
+type KeySet struct {
+	mu       sync.RWMutex
+	activeId string
+	keys     map[string]*SigningKey
+}
+
+func NewKeySet(active *SigningKey, verification ...*SigningKey) (*KeySet, error) {
+	set := &KeySet{
+		keys: make(map[string]*SigningKey),
+	}
+
+	for _, key := range verification {
+		err := set.Add(key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := set.Rotate(active)
+	if err != nil {
+		return nil, err
+	}
+
+	return set, nil
+}
+
+func (s *KeySet) Add(key *SigningKey) error {
+	if key == nil || key.ID == "" {
+		return i18n.New(i18n.KeyIdRequired)
+	}
+
+	if key.Public == nil {
+		return i18n.New(i18n.KeyPublicRequired)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys[key.ID] = key
+
+	return nil
+}
+
+func (s *KeySet) Rotate(key *SigningKey) error {
+	if key != nil && key.Private == nil {
+		return i18n.New(i18n.KeyPrivateRequired)
+	}
+
+	err := s.Add(key)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.activeId = key.ID
+
+	return nil
+}
+
+func (s *KeySet) Remove(kid string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if kid == s.activeId {
+		return i18n.New(i18n.KeyRemoveActive)
+	}
+	delete(s.keys, kid)
+
+	return nil
+}
+
+func (s *KeySet) SigningKey() (*SigningKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, ok := s.keys[s.activeId]
+	if !ok {
+		return nil, i18n.New(i18n.KeyNoActive)
+	}
+
+	return key, nil
+}
+
+func (s *KeySet) VerificationKey(kid string) (*SigningKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, i18n.New(i18n.KeyUnknownId, kid)
+	}
+
+	return key, nil
+}
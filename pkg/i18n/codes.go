@@ -0,0 +1,249 @@
+package i18n
+
+const (
+	NotFound        Code = "common.not_found"
+	Validation      Code = "common.validation"
+	Conflict        Code = "common.conflict"
+	Forbidden       Code = "common.forbidden"
+	Unauthenticated Code = "common.unauthenticated"
+	NotEnoughData   Code = "common.not_enough_data"
+
+	AuthUsernameRequired           Code = "auth.username_required"
+	AuthPasswordRequired           Code = "auth.password_required"
+	AuthWrongPassword              Code = "auth.wrong_password"
+	AuthHashGenerate               Code = "auth.hash_generate"
+	AuthPasswordHashGenerate       Code = "auth.password_hash_generate"
+	AuthRegister                   Code = "auth.register"
+	AuthTokensGenerate             Code = "auth.tokens_generate"
+	AuthAccessTokenGenerate        Code = "auth.access_token_generate"
+	AuthRefreshTokenGenerate       Code = "auth.refresh_token_generate"
+	AuthRefreshTokenVerify         Code = "auth.refresh_token_verify"
+	AuthRefreshTokenGet            Code = "auth.refresh_token_get"
+	AuthRefreshTokenRevoked        Code = "auth.refresh_token_revoked"
+	AuthRefreshTokenExpired        Code = "auth.refresh_token_expired"
+	AuthRefreshTokenRevoke         Code = "auth.refresh_token_revoke"
+	AuthRefreshTokenSave           Code = "auth.refresh_token_save"
+	AuthRefreshTokenExists         Code = "auth.refresh_token_exists"
+	AuthRefreshTokenNotFound       Code = "auth.refresh_token_not_found"
+	AuthRefreshTokenAlreadyRevoked Code = "auth.refresh_token_already_revoked"
+
+	TokenMalformed       Code = "token.malformed"
+	TokenSignature       Code = "token.signature"
+	TokenExpired         Code = "token.expired"
+	TokenNotValidYet     Code = "token.not_valid_yet"
+	TokenIssuer          Code = "token.issuer"
+	TokenAudience        Code = "token.audience"
+	TokenType            Code = "token.type"
+	TokenRevoked         Code = "token.revoked"
+	TokenInvalid         Code = "token.invalid"
+	TokenSigningKeyGet   Code = "token.signing_key_get"
+	TokenAccessSign      Code = "token.access_sign"
+	TokenRefreshSign     Code = "token.refresh_sign"
+	TokenKidMissing      Code = "token.kid_missing"
+	TokenAlgMismatch     Code = "token.alg_mismatch"
+	TokenRevocationCheck Code = "token.revocation_check"
+
+	KeyUnsupportedType Code = "key.unsupported_type"
+	KeyNotPEM          Code = "key.not_pem"
+	KeyParsePrivate    Code = "key.parse_private"
+	KeyParsePublic     Code = "key.parse_public"
+	KeyIdRequired      Code = "key.id_required"
+	KeyPublicRequired  Code = "key.public_required"
+	KeyPrivateRequired Code = "key.private_required"
+	KeyRemoveActive    Code = "key.remove_active"
+	KeyNoActive        Code = "key.no_active"
+	KeyUnknownId       Code = "key.unknown_id"
+
+	ActivityFieldNameRequired        Code = "activity_field.name_required"
+	ActivityFieldDescriptionRequired Code = "activity_field.description_required"
+	ActivityFieldCostZero            Code = "activity_field.cost_zero"
+	ActivityFieldCreate              Code = "activity_field.create"
+	ActivityFieldDelete              Code = "activity_field.delete"
+	ActivityFieldUpdate              Code = "activity_field.update"
+	ActivityFieldGet                 Code = "activity_field.get"
+	ActivityFieldGetMaxCost          Code = "activity_field.get_max_cost"
+	ActivityFieldGetAll              Code = "activity_field.get_all"
+
+	CompanyNameRequired Code = "company.name_required"
+
+	CityRequired Code = "common.city_required"
+
+	CompanyCreate       Code = "company.create"
+	CompanyGet          Code = "company.get"
+	CompanyGetByOwner   Code = "company.get_by_owner"
+	CompanyGetAll       Code = "company.get_all"
+	CompanyUpdate       Code = "company.update"
+	CompanyDelete       Code = "company.delete"
+	CompanyDeleteAction Code = "company.delete_action"
+
+	ContactNameRequired  Code = "contact.name_required"
+	ContactValueRequired Code = "contact.value_required"
+	ContactCreate        Code = "contact.create"
+	ContactGet           Code = "contact.get"
+	ContactGetByOwner    Code = "contact.get_by_owner"
+	ContactUpdate        Code = "contact.update"
+	ContactUpdateAction  Code = "contact.update_action"
+	ContactDelete        Code = "contact.delete"
+	ContactDeleteAction  Code = "contact.delete_action"
+
+	FinReportRevenueNegative    Code = "fin_report.revenue_negative"
+	FinReportCostsNegative      Code = "fin_report.costs_negative"
+	FinReportQuarterRange       Code = "fin_report.quarter_range"
+	FinReportYearInFuture       Code = "fin_report.year_in_future"
+	FinReportQuarterNotFinished Code = "fin_report.quarter_not_finished"
+	FinReportPeriodOrder        Code = "fin_report.period_order"
+	FinReportCreate             Code = "fin_report.create"
+	FinReportCreateByPeriod     Code = "fin_report.create_by_period"
+	FinReportGet                Code = "fin_report.get"
+	FinReportGetByCompany       Code = "fin_report.get_by_company"
+	FinReportUpdate             Code = "fin_report.update"
+	FinReportDelete             Code = "fin_report.delete"
+
+	SkillNameRequired        Code = "skill.name_required"
+	SkillDescriptionRequired Code = "skill.description_required"
+	SkillCreate              Code = "skill.create"
+	SkillGet                 Code = "skill.get"
+	SkillGetAll              Code = "skill.get_all"
+	SkillUpdate              Code = "skill.update"
+	SkillDelete              Code = "skill.delete"
+
+	UserGenderUnknown    Code = "user.gender_unknown"
+	UserBirthdayRequired Code = "user.birthday_required"
+	UserFullNameRequired Code = "user.full_name_required"
+	UserFullNameWords    Code = "user.full_name_words"
+	UserCreate           Code = "user.create"
+	UserGetByUsername    Code = "user.get_by_username"
+	UserGet              Code = "user.get"
+	UserGetAll           Code = "user.get_all"
+	UserUpdate           Code = "user.update"
+	UserDelete           Code = "user.delete"
+	UserRoleChange       Code = "user.role_change"
+
+	UserSkillCreate        Code = "user_skill.create"
+	UserSkillDelete        Code = "user_skill.delete"
+	UserSkillGetByUser     Code = "user_skill.get_by_user"
+	UserSkillGetBySkill    Code = "user_skill.get_by_skill"
+	UserSkillGetSkill      Code = "user_skill.get_skill"
+	UserSkillGetUser       Code = "user_skill.get_user"
+	UserSkillGetSkills     Code = "user_skill.get_skills"
+	UserSkillGetUsers      Code = "user_skill.get_users"
+	UserSkillDeletePair    Code = "user_skill.delete_pair"
+	UserSkillDeleteForUser Code = "user_skill.delete_for_user"
+
+	InteractorCompanyReport   Code = "interactor.company_report"
+	InteractorCompanies       Code = "interactor.companies"
+	InteractorUserReport      Code = "interactor.user_report"
+	InteractorUserReportBuild Code = "interactor.user_report_build"
+	InteractorMostProfitable  Code = "interactor.most_profitable"
+	InteractorNoCompanies     Code = "interactor.no_companies"
+	InteractorMaxCost         Code = "interactor.max_cost"
+	InteractorCompanyCost     Code = "interactor.company_cost"
+
+	StorageIdExists              Code = "storage.id_exists"
+	StorageReferenceNotFound     Code = "storage.reference_not_found"
+	StorageInUse                 Code = "storage.in_use"
+	StorageUserNotFound          Code = "storage.user_not_found"
+	StorageUsernameTaken         Code = "storage.username_taken"
+	StorageCompanyNotFound       Code = "storage.company_not_found"
+	StorageActivityFieldNotFound Code = "storage.activity_field_not_found"
+	StorageActivityFieldsEmpty   Code = "storage.activity_fields_empty"
+	StorageFinReportNotFound     Code = "storage.fin_report_not_found"
+	StorageFinReportExists       Code = "storage.fin_report_exists"
+	StorageFinReportOverlap      Code = "storage.fin_report_overlap"
+	StorageSkillNotFound         Code = "storage.skill_not_found"
+	StorageContactNotFound       Code = "storage.contact_not_found"
+	StorageUserSkillNotFound     Code = "storage.user_skill_not_found"
+	StorageUserSkillExists       Code = "storage.user_skill_exists"
+	StorageMigrate               Code = "storage.migrate"
+
+	PageLimitRange         Code = "page.limit_range"
+	PageOffsetNegative     Code = "page.offset_negative"
+	PageCursorInvalid      Code = "page.cursor_invalid"
+	PageCursorSortMismatch Code = "page.cursor_sort_mismatch"
+	PageSortUnknown        Code = "page.sort_unknown"
+
+	FilterAgeNegative   Code = "filter.age_negative"
+	FilterAgeRange      Code = "filter.age_range"
+	FilterSearchTooLong Code = "filter.search_too_long"
+
+	SearchQueryRequired Code = "search.query_required"
+	SearchKindUnknown   Code = "search.kind_unknown"
+	Search              Code = "search.search"
+	SearchIndex         Code = "search.index"
+	SearchRebuild       Code = "search.rebuild"
+
+	HTTPBodyInvalid   Code = "http.body_invalid"
+	HTTPParamInvalid  Code = "http.param_invalid"
+	HTTPParamRequired Code = "http.param_required"
+	HTTPAuthHeader    Code = "http.auth_header"
+	HTTPAuthenticate  Code = "http.authenticate"
+	HTTPInternal      Code = "http.internal"
+
+	GRPCFieldInvalid  Code = "grpc.field_invalid"
+	GRPCFieldRequired Code = "grpc.field_required"
+	GRPCAuthMetadata  Code = "grpc.auth_metadata"
+	GRPCAuthenticate  Code = "grpc.authenticate"
+	GRPCInternal      Code = "grpc.internal"
+
+	SkillGetByIds           Code = "skill.get_by_ids"
+	ActivityFieldGetByIds   Code = "activity_field.get_by_ids"
+	FinReportGetByCompanies Code = "fin_report.get_by_companies"
+
+	GraphQLBodyInvalid  Code = "graphql.body_invalid"
+	GraphQLArgInvalid   Code = "graphql.arg_invalid"
+	GraphQLAuthHeader   Code = "graphql.auth_header"
+	GraphQLAuthenticate Code = "graphql.authenticate"
+	GraphQLInternal     Code = "graphql.internal"
+
+	CLIUnknownCommand Code = "cli.unknown_command"
+	CLIArgRequired    Code = "cli.arg_required"
+	CLIArgInvalid     Code = "cli.arg_invalid"
+	CLIFormatUnknown  Code = "cli.format_unknown"
+	CLIBackendUnknown Code = "cli.backend_unknown"
+	CLIOpenBackend    Code = "cli.open_backend"
+	CLIImportHeader   Code = "cli.import_header"
+	CLIColumnInvalid  Code = "cli.column_invalid"
+	CLIImportRow      Code = "cli.import_row"
+	CLIImport         Code = "cli.import"
+	CLIQuoteUnclosed  Code = "cli.quote_unclosed"
+
+	TaxScheduleJurisdictionRequired Code = "tax_schedule.jurisdiction_required"
+	TaxScheduleYearInvalid          Code = "tax_schedule.year_invalid"
+	TaxScheduleMethodUnknown        Code = "tax_schedule.method_unknown"
+	TaxScheduleBracketsEmpty        Code = "tax_schedule.brackets_empty"
+	TaxScheduleFirstThreshold       Code = "tax_schedule.first_threshold"
+	TaxScheduleThresholdOrder       Code = "tax_schedule.threshold_order"
+	TaxScheduleRateRange            Code = "tax_schedule.rate_range"
+	TaxScheduleNotInForce           Code = "tax_schedule.not_in_force"
+	TaxScheduleCreate               Code = "tax_schedule.create"
+	TaxScheduleGet                  Code = "tax_schedule.get"
+	TaxScheduleGetByJurisdiction    Code = "tax_schedule.get_by_jurisdiction"
+	TaxScheduleGetInForce           Code = "tax_schedule.get_in_force"
+	TaxScheduleDelete               Code = "tax_schedule.delete"
+	StorageTaxScheduleNotFound      Code = "storage.tax_schedule_not_found"
+	StorageTaxScheduleExists        Code = "storage.tax_schedule_exists"
+	InteractorTaxSchedule           Code = "interactor.tax_schedule"
+
+	InteractorNoRevenue           Code = "interactor.no_revenue"
+	InteractorNoActivityFieldCost Code = "interactor.no_activity_field_cost"
+
+	MoneyInvalid              Code = "money.invalid"
+	MoneyCurrencyMismatch     Code = "money.currency_mismatch"
+	CurrencyUnsupported       Code = "money.currency_unsupported"
+	FinReportCurrencyMismatch Code = "fin_report.currency_mismatch"
+
+	ExchangeRateCurrencyInvalid Code = "exchange_rate.currency_invalid"
+	ExchangeRateQuarterRange    Code = "exchange_rate.quarter_range"
+	ExchangeRateNotPositive     Code = "exchange_rate.not_positive"
+	ExchangeRateDuplicate       Code = "exchange_rate.duplicate"
+	ExchangeRateNotFound        Code = "exchange_rate.not_found"
+	FinReportConvert            Code = "fin_report.convert"
+	InteractorTaxes             Code = "interactor.taxes"
+	InteractorTaxConvert        Code = "interactor.tax_convert"
+	CLIRates                    Code = "cli.rates"
+
+	FinReportGranularityUnknown Code = "fin_report.granularity_unknown"
+	FinReportMonthRange         Code = "fin_report.month_range"
+	FinReportPeriodNotFinished  Code = "fin_report.period_not_finished"
+	FinReportOverlap            Code = "fin_report.overlap"
+)
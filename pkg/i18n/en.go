@@ -0,0 +1,249 @@
+package i18n
+
+var en = map[Code]string{
+	NotFound:        "object not found",
+	Validation:      "invalid data",
+	Conflict:        "data conflict",
+	Forbidden:       "insufficient permissions for the operation",
+	Unauthenticated: "user is not authenticated",
+	NotEnoughData:   "not enough data",
+
+	AuthUsernameRequired:           "username is required",
+	AuthPasswordRequired:           "password is required",
+	AuthWrongPassword:              "wrong password",
+	AuthHashGenerate:               "generating hash",
+	AuthPasswordHashGenerate:       "generating password hash",
+	AuthRegister:                   "registering user",
+	AuthTokensGenerate:             "generating tokens",
+	AuthAccessTokenGenerate:        "generating access token",
+	AuthRefreshTokenGenerate:       "generating refresh token",
+	AuthRefreshTokenVerify:         "verifying refresh token",
+	AuthRefreshTokenGet:            "getting refresh token by id",
+	AuthRefreshTokenRevoked:        "refresh token has been revoked",
+	AuthRefreshTokenExpired:        "refresh token has expired",
+	AuthRefreshTokenRevoke:         "revoking refresh token",
+	AuthRefreshTokenSave:           "saving refresh token",
+	AuthRefreshTokenExists:         "refresh token with this id already exists",
+	AuthRefreshTokenNotFound:       "refresh token not found",
+	AuthRefreshTokenAlreadyRevoked: "refresh token has already been revoked",
+
+	TokenMalformed:       "malformed token",
+	TokenSignature:       "invalid token signature",
+	TokenExpired:         "token has expired",
+	TokenNotValidYet:     "token is not valid yet",
+	TokenIssuer:          "invalid token issuer",
+	TokenAudience:        "invalid token audience",
+	TokenType:            "invalid token type",
+	TokenRevoked:         "token has been revoked",
+	TokenInvalid:         "invalid token",
+	TokenSigningKeyGet:   "getting signing key",
+	TokenAccessSign:      "signing access token",
+	TokenRefreshSign:     "signing refresh token",
+	TokenKidMissing:      "token header has no kid",
+	TokenAlgMismatch:     "signing algorithm %s does not match key %s",
+	TokenRevocationCheck: "checking token revocation",
+
+	KeyUnsupportedType: "unsupported key type: %T",
+	KeyNotPEM:          "key is not PEM encoded",
+	KeyParsePrivate:    "parsing private key",
+	KeyParsePublic:     "parsing public key",
+	KeyIdRequired:      "key id is required",
+	KeyPublicRequired:  "public key is required",
+	KeyPrivateRequired: "a private key is required for signing",
+	KeyRemoveActive:    "cannot remove the active signing key",
+	KeyNoActive:        "no active signing key",
+	KeyUnknownId:       "unknown key id: %s",
+
+	ActivityFieldNameRequired:        "activity field name is required",
+	ActivityFieldDescriptionRequired: "activity field description is required",
+	ActivityFieldCostZero:            "activity field cost cannot be zero",
+	ActivityFieldCreate:              "creating activity field",
+	ActivityFieldDelete:              "deleting activity field by id",
+	ActivityFieldUpdate:              "updating activity field",
+	ActivityFieldGet:                 "getting activity field by id",
+	ActivityFieldGetMaxCost:          "getting maximum activity field cost",
+	ActivityFieldGetAll:              "listing all activity fields",
+
+	CompanyNameRequired: "company name is required",
+
+	CityRequired: "city is required",
+
+	CompanyCreate:       "creating company",
+	CompanyGet:          "getting company by id",
+	CompanyGetByOwner:   "listing companies by owner id",
+	CompanyGetAll:       "listing all companies",
+	CompanyUpdate:       "updating company",
+	CompanyDelete:       "deleting company by id",
+	CompanyDeleteAction: "deleting company",
+
+	ContactNameRequired:  "contact name is required",
+	ContactValueRequired: "contact value is required",
+	ContactCreate:        "creating contact",
+	ContactGet:           "getting contact by id",
+	ContactGetByOwner:    "listing contacts by owner id",
+	ContactUpdate:        "updating contact",
+	ContactUpdateAction:  "updating contact",
+	ContactDelete:        "deleting contact by id",
+	ContactDeleteAction:  "deleting contact",
+
+	FinReportRevenueNegative:    "revenue cannot be negative",
+	FinReportCostsNegative:      "costs cannot be negative",
+	FinReportQuarterRange:       "quarter must be between 1 and 4",
+	FinReportYearInFuture:       "year cannot be later than the current year",
+	FinReportQuarterNotFinished: "cannot add a report for a quarter that has not ended yet",
+	FinReportPeriodOrder:        "period end must be later than period start",
+	FinReportCreate:             "creating financial report",
+	FinReportCreateByPeriod:     "creating reports for a period",
+	FinReportGet:                "getting financial report by id",
+	FinReportGetByCompany:       "getting financial report by company id",
+	FinReportUpdate:             "updating report",
+	FinReportDelete:             "deleting report by id",
+
+	SkillNameRequired:        "skill name is required",
+	SkillDescriptionRequired: "skill description is required",
+	SkillCreate:              "creating skill",
+	SkillGet:                 "getting skill by id",
+	SkillGetAll:              "listing all skills",
+	SkillUpdate:              "updating skill",
+	SkillDelete:              "deleting skill by id",
+
+	UserGenderUnknown:    "unknown gender",
+	UserBirthdayRequired: "birthday is required",
+	UserFullNameRequired: "full name is required",
+	UserFullNameWords:    "wrong number of words (surname, first name and patronymic are required)",
+	UserCreate:           "creating user",
+	UserGetByUsername:    "getting user by username",
+	UserGet:              "getting user by id",
+	UserGetAll:           "listing all users",
+	UserUpdate:           "updating user",
+	UserDelete:           "deleting user by id",
+	UserRoleChange:       "changing user role",
+
+	UserSkillCreate:        "linking user and skill",
+	UserSkillDelete:        "deleting user-skill link",
+	UserSkillGetByUser:     "getting user-skill links by user id",
+	UserSkillGetBySkill:    "getting user-skill links by skill id",
+	UserSkillGetSkill:      "getting skill by skill id",
+	UserSkillGetUser:       "getting user by user id",
+	UserSkillGetSkills:     "getting user skills",
+	UserSkillGetUsers:      "getting users with skill",
+	UserSkillDeletePair:    "deleting user-skill pair",
+	UserSkillDeleteForUser: "deleting user skills",
+
+	InteractorCompanyReport:   "getting company report",
+	InteractorCompanies:       "listing companies",
+	InteractorUserReport:      "getting user financial report",
+	InteractorUserReportBuild: "building user financial report",
+	InteractorMostProfitable:  "finding the most profitable company",
+	InteractorNoCompanies:     "the entrepreneur has no companies",
+	InteractorMaxCost:         "finding maximum cost",
+	InteractorCompanyCost:     "getting company activity field cost",
+
+	StorageIdExists:              "record with this id already exists",
+	StorageReferenceNotFound:     "referenced record not found",
+	StorageInUse:                 "record is referenced by other records",
+	StorageUserNotFound:          "user not found",
+	StorageUsernameTaken:         "username is already taken",
+	StorageCompanyNotFound:       "company not found",
+	StorageActivityFieldNotFound: "activity field not found",
+	StorageActivityFieldsEmpty:   "no activity fields found",
+	StorageFinReportNotFound:     "financial report not found",
+	StorageFinReportExists:       "company report for this period already exists",
+	StorageFinReportOverlap:      "report period overlaps another report of the company",
+	StorageSkillNotFound:         "skill not found",
+	StorageContactNotFound:       "contact not found",
+	StorageUserSkillNotFound:     "user-skill pair not found",
+	StorageUserSkillExists:       "user-skill pair already exists",
+	StorageMigrate:               "applying migration %s",
+
+	PageLimitRange:         "page size must be between 1 and %d",
+	PageOffsetNegative:     "offset must not be negative",
+	PageCursorInvalid:      "invalid page cursor",
+	PageCursorSortMismatch: "cursor was issued for a different sort order",
+	PageSortUnknown:        "unknown sort field: %s",
+
+	FilterAgeNegative:   "age must not be negative",
+	FilterAgeRange:      "minimum age is greater than maximum age",
+	FilterSearchTooLong: "search string is longer than %d characters",
+
+	SearchQueryRequired: "search query is required",
+	SearchKindUnknown:   "unknown search kind: %s",
+	Search:              "searching",
+	SearchIndex:         "updating search index",
+	SearchRebuild:       "rebuilding search index",
+
+	HTTPBodyInvalid:   "invalid request body",
+	HTTPParamInvalid:  "invalid value of parameter %s",
+	HTTPParamRequired: "parameter %s is required",
+	HTTPAuthHeader:    "Authorization header must contain a Bearer token",
+	HTTPAuthenticate:  "verifying access token",
+	HTTPInternal:      "internal server error",
+
+	GRPCFieldInvalid:  "invalid value of field %s",
+	GRPCFieldRequired: "field %s is required",
+	GRPCAuthMetadata:  "authorization metadata must contain a Bearer token",
+	GRPCAuthenticate:  "verifying access token",
+	GRPCInternal:      "internal server error",
+
+	SkillGetByIds:           "getting skills by ids",
+	ActivityFieldGetByIds:   "getting activity fields by ids",
+	FinReportGetByCompanies: "getting reports by companies",
+
+	GraphQLBodyInvalid:  "invalid request body",
+	GraphQLArgInvalid:   "invalid value of argument %s",
+	GraphQLAuthHeader:   "Authorization header must contain a Bearer token",
+	GraphQLAuthenticate: "verifying access token",
+	GraphQLInternal:     "internal server error",
+
+	CLIUnknownCommand: "unknown command %s",
+	CLIArgRequired:    "flag -%s is required",
+	CLIArgInvalid:     "invalid value of flag -%s",
+	CLIFormatUnknown:  "unknown output format %s",
+	CLIBackendUnknown: "unknown backend %s",
+	CLIOpenBackend:    "opening backend",
+	CLIImportHeader:   "CSV header must contain columns %s",
+	CLIColumnInvalid:  "invalid value in column %s",
+	CLIImportRow:      "row %d",
+	CLIImport:         "importing financial reports",
+	CLIQuoteUnclosed:  "unclosed quote in line %q",
+
+	TaxScheduleJurisdictionRequired: "jurisdiction is required",
+	TaxScheduleYearInvalid:          "effective year must be positive",
+	TaxScheduleMethodUnknown:        "unknown tax calculation method %s",
+	TaxScheduleBracketsEmpty:        "tax schedule must contain at least one bracket",
+	TaxScheduleFirstThreshold:       "first bracket threshold must be zero",
+	TaxScheduleThresholdOrder:       "bracket thresholds must be increasing",
+	TaxScheduleRateRange:            "rate must be between 0 and 100 percent",
+	TaxScheduleNotInForce:           "no tax schedule is in force in %d",
+	TaxScheduleCreate:               "creating tax schedule",
+	TaxScheduleGet:                  "getting tax schedule",
+	TaxScheduleGetByJurisdiction:    "listing jurisdiction tax schedules",
+	TaxScheduleGetInForce:           "finding tax schedule in force",
+	TaxScheduleDelete:               "deleting tax schedule",
+	StorageTaxScheduleNotFound:      "tax schedule not found",
+	StorageTaxScheduleExists:        "tax schedule for this year already exists",
+	InteractorTaxSchedule:           "getting tax schedule",
+
+	InteractorNoRevenue:           "no revenue for the period, profitability is undefined",
+	InteractorNoActivityFieldCost: "no activity fields with positive cost",
+
+	MoneyInvalid:              "invalid amount of money %s",
+	MoneyCurrencyMismatch:     "amounts in different currencies %s and %s cannot be added or compared",
+	CurrencyUnsupported:       "currency %s is not supported",
+	FinReportCurrencyMismatch: "revenue and costs must be in the same currency",
+
+	ExchangeRateCurrencyInvalid: "exchange rate cannot be set for currency %q",
+	ExchangeRateQuarterRange:    "exchange rate quarter must be between 1 and 4",
+	ExchangeRateNotPositive:     "exchange rate must be positive",
+	ExchangeRateDuplicate:       "exchange rate of %s for quarter %d of %d is set twice",
+	ExchangeRateNotFound:        "no exchange rate of %s for quarter %d of %d",
+	FinReportConvert:            "converting reports to the reporting currency",
+	InteractorTaxes:             "calculating taxes",
+	InteractorTaxConvert:        "converting amount for tax calculation",
+	CLIRates:                    "loading exchange rates",
+
+	FinReportGranularityUnknown: "unknown report granularity %s",
+	FinReportMonthRange:         "month must be between 1 and 12",
+	FinReportPeriodNotFinished:  "cannot add a report for a period that has not ended yet",
+	FinReportOverlap:            "report period overlaps an existing report of the company",
+}
@@ -0,0 +1,144 @@
+package i18n
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type Locale string
+
+const (
+	RU Locale = "ru"
+	EN Locale = "en"
+
+	DefaultLocale = RU
+)
+
+type Code string
+
+var bundles = map[Locale]map[Code]string{
+	RU: ru,
+	EN: en,
+}
+
+func Locales() []Locale {
+	return []Locale{RU, EN}
+}
+
+func ParseLocale(tag string) Locale {
+	for _, part := range strings.Split(tag, ",") {
+		lang, _, _ := strings.Cut(strings.TrimSpace(part), ";")
+		lang, _, _ = strings.Cut(strings.ToLower(lang), "-")
+		if _, ok := bundles[Locale(lang)]; ok {
+			return Locale(lang)
+		}
+	}
+
+	return DefaultLocale
+}
+
+func Text(locale Locale, code Code, args ...any) string {
+	msg, ok := bundles[locale][code]
+	if !ok {
+		msg, ok = bundles[DefaultLocale][code]
+	}
+	if !ok {
+		msg = string(code)
+	}
+
+	if len(args) > 0 {
+		return fmt.Sprintf(msg, args...)
+	}
+
+	return msg
+}
+
+func (c Code) String() string {
+	return Text(DefaultLocale, c)
+}
+
+type localeKey struct{}
+
+func WithLocale(ctx context.Context, locale Locale) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+func FromContext(ctx context.Context) Locale {
+	locale, ok := ctx.Value(localeKey{}).(Locale)
+	if !ok {
+		return DefaultLocale
+	}
+
+	return locale
+}
+
+type Localizer interface {
+	Localize(locale Locale) string
+}
+
+type Error struct {
+	Code Code
+	Args []any
+	Err  error
+}
+
+func New(code Code, args ...any) error {
+	return &Error{
+		Code: code,
+		Args: args,
+	}
+}
+
+func Wrap(err error, code Code, args ...any) error {
+	return &Error{
+		Code: code,
+		Args: args,
+		Err:  err,
+	}
+}
+
+func (e *Error) Error() string {
+	return e.Localize(DefaultLocale)
+}
+
+func (e *Error) Localize(locale Locale) string {
+	msg := Text(locale, e.Code, e.Args...)
+	if e.Err != nil {
+		msg += ": " + Localize(e.Err, locale)
+	}
+
+	return msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Err == nil && t.Code == e.Code
+}
+
+func Localize(err error, locale Locale) string {
+	if err == nil {
+		return ""
+	}
+
+	if l, ok := err.(Localizer); ok {
+		return l.Localize(locale)
+	}
+
+	inner := errors.Unwrap(err)
+	if inner == nil {
+		return err.Error()
+	}
+
+	prefix, ok := strings.CutSuffix(err.Error(), inner.Error())
+	if !ok {
+		return err.Error()
+	}
+
+	return prefix + Localize(inner, locale)
+}
@@ -0,0 +1,101 @@
+package i18n
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+func TestBundles_Complete(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "codes.go", nil, 0)
+	require.Nil(t, err)
+
+	var codes []Code
+	ast.Inspect(file, func(n ast.Node) bool {
+		if spec, ok := n.(*ast.ValueSpec); ok {
+			lit := spec.Values[0].(*ast.BasicLit)
+			codes = append(codes, Code(lit.Value[1:len(lit.Value)-1]))
+		}
+		return true
+	})
+	require.NotEmpty(t, codes)
+
+	for _, locale := range Locales() {
+		require.Len(t, bundles[locale], len(codes), "локаль %s", locale)
+		for _, code := range codes {
+			require.NotEmpty(t, bundles[locale][code], "нет перевода %s для локали %s", code, locale)
+		}
+	}
+}
+
+func TestLocalize(t *testing.T) {
+	sqlErr := errors.New("sql error")
+
+	testCases := []struct {
+		name     string
+		err      error
+		locale   Locale
+		expected string
+	}{
+		{
+			name:     "цепочка кодов на русском",
+			err:      Wrap(Wrap(sqlErr, CompanyGet), InteractorCompanies),
+			locale:   RU,
+			expected: "получение списка компаний: получение компании по id: sql error",
+		},
+		{
+			name:     "цепочка кодов на английском",
+			err:      Wrap(Wrap(sqlErr, CompanyGet), InteractorCompanies),
+			locale:   EN,
+			expected: "listing companies: getting company by id: sql error",
+		},
+		{
+			name:     "обертка fmt.Errorf вокруг кода",
+			err:      fmt.Errorf("request 42: %w", New(CompanyNameRequired)),
+			locale:   EN,
+			expected: "request 42: company name is required",
+		},
+		{
+			name:     "сообщение с аргументами",
+			err:      New(KeyUnknownId, "k1"),
+			locale:   EN,
+			expected: "unknown key id: k1",
+		},
+		{
+			name:     "неизвестная локаль",
+			err:      New(CompanyNameRequired),
+			locale:   "de",
+			expected: "должно быть указано название компании",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, Localize(tc.err, tc.locale))
+		})
+	}
+}
+
+func TestError_Is(t *testing.T) {
+	sentinel := New(TokenExpired)
+
+	require.ErrorIs(t, Wrap(errors.New("jwt"), TokenExpired), sentinel)
+	require.ErrorIs(t, Wrap(sentinel, AuthRefreshTokenVerify), sentinel)
+	require.NotErrorIs(t, New(TokenInvalid), sentinel)
+	require.Equal(t, "срок действия токена истек", sentinel.Error())
+}
+
+func TestParseLocale(t *testing.T) {
+	require.Equal(t, EN, ParseLocale("en-US,en;q=0.9,ru;q=0.8"))
+	require.Equal(t, RU, ParseLocale("ru"))
+	require.Equal(t, EN, ParseLocale("de-DE, en;q=0.5"))
+	require.Equal(t, DefaultLocale, ParseLocale(""))
+
+	ctx := WithLocale(context.Background(), EN)
+	require.Equal(t, EN, FromContext(ctx))
+	require.Equal(t, DefaultLocale, FromContext(context.Background()))
+}
@@ -0,0 +1,249 @@
+package i18n
+
+var ru = map[Code]string{
+	NotFound:        "объект не найден",
+	Validation:      "некорректные данные",
+	Conflict:        "конфликт данных",
+	Forbidden:       "недостаточно прав для выполнения операции",
+	Unauthenticated: "пользователь не аутентифицирован",
+	NotEnoughData:   "недостаточно данных",
+
+	AuthUsernameRequired:           "должно быть указано имя пользователя",
+	AuthPasswordRequired:           "должен быть указан пароль",
+	AuthWrongPassword:              "неверный пароль",
+	AuthHashGenerate:               "генерация хэша",
+	AuthPasswordHashGenerate:       "генерация хэша пароля",
+	AuthRegister:                   "регистрация пользователя",
+	AuthTokensGenerate:             "генерация токенов",
+	AuthAccessTokenGenerate:        "генерация токена доступа",
+	AuthRefreshTokenGenerate:       "генерация токена обновления",
+	AuthRefreshTokenVerify:         "проверка токена обновления",
+	AuthRefreshTokenGet:            "получение токена обновления по id",
+	AuthRefreshTokenRevoked:        "токен обновления отозван",
+	AuthRefreshTokenExpired:        "срок действия токена обновления истек",
+	AuthRefreshTokenRevoke:         "отзыв токена обновления",
+	AuthRefreshTokenSave:           "сохранение токена обновления",
+	AuthRefreshTokenExists:         "токен обновления с таким id уже существует",
+	AuthRefreshTokenNotFound:       "токен обновления не найден",
+	AuthRefreshTokenAlreadyRevoked: "токен обновления уже отозван",
+
+	TokenMalformed:       "некорректный формат токена",
+	TokenSignature:       "неверная подпись токена",
+	TokenExpired:         "срок действия токена истек",
+	TokenNotValidYet:     "токен еще не действителен",
+	TokenIssuer:          "неверный издатель токена",
+	TokenAudience:        "неверная аудитория токена",
+	TokenType:            "неверный тип токена",
+	TokenRevoked:         "токен отозван",
+	TokenInvalid:         "токен невалидный",
+	TokenSigningKeyGet:   "получение ключа подписи",
+	TokenAccessSign:      "формирование JWT-ключа",
+	TokenRefreshSign:     "формирование токена обновления",
+	TokenKidMissing:      "в заголовке токена не указан kid",
+	TokenAlgMismatch:     "алгоритм подписи %s не соответствует ключу %s",
+	TokenRevocationCheck: "проверка отзыва токена",
+
+	KeyUnsupportedType: "неподдерживаемый тип ключа: %T",
+	KeyNotPEM:          "ключ не в формате PEM",
+	KeyParsePrivate:    "парсинг закрытого ключа",
+	KeyParsePublic:     "парсинг открытого ключа",
+	KeyIdRequired:      "у ключа должен быть указан идентификатор",
+	KeyPublicRequired:  "у ключа должен быть указан открытый ключ",
+	KeyPrivateRequired: "для подписи нужен закрытый ключ",
+	KeyRemoveActive:    "нельзя удалить активный ключ подписи",
+	KeyNoActive:        "не задан активный ключ подписи",
+	KeyUnknownId:       "неизвестный идентификатор ключа: %s",
+
+	ActivityFieldNameRequired:        "должно быть указано название сферы деятельности",
+	ActivityFieldDescriptionRequired: "должно быть указано описание сферы деятельности",
+	ActivityFieldCostZero:            "вес сферы деятельности не может быть равен 0",
+	ActivityFieldCreate:              "создание сферы деятельности",
+	ActivityFieldDelete:              "удаление сферы деятельности по id",
+	ActivityFieldUpdate:              "обновление информации о cфере деятельности",
+	ActivityFieldGet:                 "получение сферы деятельности по id",
+	ActivityFieldGetMaxCost:          "получение максимального веса сферы деятельности",
+	ActivityFieldGetAll:              "получение списка всех сфер деятельности",
+
+	CompanyNameRequired: "должно быть указано название компании",
+
+	CityRequired: "должно быть указано название города",
+
+	CompanyCreate:       "добавление компании",
+	CompanyGet:          "получение компании по id",
+	CompanyGetByOwner:   "получение списка компаний по id владельца",
+	CompanyGetAll:       "получение списка всех компаний",
+	CompanyUpdate:       "обновление информации о компании",
+	CompanyDelete:       "удаление компании по id",
+	CompanyDeleteAction: "удаление компании",
+
+	ContactNameRequired:  "должно быть указано название средства связи",
+	ContactValueRequired: "должно быть указано значение средства связи",
+	ContactCreate:        "добавление средства связи",
+	ContactGet:           "получение средства связи по id",
+	ContactGetByOwner:    "получение всех средств связи по id владельца",
+	ContactUpdate:        "обновление информации о средстве связи",
+	ContactUpdateAction:  "обновление средства связи",
+	ContactDelete:        "удаление средства связи по id",
+	ContactDeleteAction:  "удаление средства связи",
+
+	FinReportRevenueNegative:    "выручка не может быть отрицательной",
+	FinReportCostsNegative:      "расходы не могут быть отрицательными",
+	FinReportQuarterRange:       "значение квартала должно находиться в отрезке от 1 до 4",
+	FinReportYearInFuture:       "значение года не может быть больше текущего года",
+	FinReportQuarterNotFinished: "нельзя добавить отчет за квартал, который еще не закончился",
+	FinReportPeriodOrder:        "дата конца периода должна быть позже даты начала",
+	FinReportCreate:             "добавление финансового отчета",
+	FinReportCreateByPeriod:     "добавление отчетов за период",
+	FinReportGet:                "получение финансового отчета по id",
+	FinReportGetByCompany:       "получение финансового отчета по id компании",
+	FinReportUpdate:             "обновление отчета",
+	FinReportDelete:             "удаление отчета по id",
+
+	SkillNameRequired:        "должно быть указано название навыка",
+	SkillDescriptionRequired: "должно быть указано описание навыка",
+	SkillCreate:              "добавление навыка",
+	SkillGet:                 "получение навыка по id",
+	SkillGetAll:              "получение списка всех навыков",
+	SkillUpdate:              "обновление информации о навыке",
+	SkillDelete:              "удаление навыка по id",
+
+	UserGenderUnknown:    "неизвестный пол",
+	UserBirthdayRequired: "должна быть указана дата рождения",
+	UserFullNameRequired: "должны быть указаны ФИО",
+	UserFullNameWords:    "некорректное количество слов (должны быть фамилия, имя и отчество)",
+	UserCreate:           "создание пользователя",
+	UserGetByUsername:    "получение пользователя по username",
+	UserGet:              "получение пользователя по id",
+	UserGetAll:           "получение списка всех пользователей",
+	UserUpdate:           "обновление информации о пользователе",
+	UserDelete:           "удаление пользователя по id",
+	UserRoleChange:       "изменение роли пользователя",
+
+	UserSkillCreate:        "связывание пользователя и навыка",
+	UserSkillDelete:        "удаление связи пользователь-навык",
+	UserSkillGetByUser:     "получение связок пользователь-навык по userId",
+	UserSkillGetBySkill:    "получение связок пользователь-навык по skillId",
+	UserSkillGetSkill:      "получение скилла по skillId",
+	UserSkillGetUser:       "получение пользователя по userId",
+	UserSkillGetSkills:     "получение навыков пользователя",
+	UserSkillGetUsers:      "получение пользователей с навыком",
+	UserSkillDeletePair:    "удаление пары пользователь-навык",
+	UserSkillDeleteForUser: "удаление навыков пользователя",
+
+	InteractorCompanyReport:   "получение отчета компании",
+	InteractorCompanies:       "получение списка компаний",
+	InteractorUserReport:      "получение финансового отчета пользователя",
+	InteractorUserReportBuild: "формирование финансового отчета пользователя",
+	InteractorMostProfitable:  "поиск наиболее прибыльной компании",
+	InteractorNoCompanies:     "у предпринимателя не найдены компании",
+	InteractorMaxCost:         "поиск максимального веса",
+	InteractorCompanyCost:     "получение веса сферы деятельности компании",
+
+	StorageIdExists:              "запись с таким id уже существует",
+	StorageReferenceNotFound:     "связанная запись не найдена",
+	StorageInUse:                 "запись используется другими записями",
+	StorageUserNotFound:          "пользователь не найден",
+	StorageUsernameTaken:         "пользователь с таким именем уже существует",
+	StorageCompanyNotFound:       "компания не найдена",
+	StorageActivityFieldNotFound: "сфера деятельности не найдена",
+	StorageActivityFieldsEmpty:   "не найдено ни одной сферы деятельности",
+	StorageFinReportNotFound:     "финансовый отчет не найден",
+	StorageFinReportExists:       "отчет компании за этот период уже существует",
+	StorageFinReportOverlap:      "период отчета пересекается с другим отчетом компании",
+	StorageSkillNotFound:         "навык не найден",
+	StorageContactNotFound:       "контакт не найден",
+	StorageUserSkillNotFound:     "пара пользователь-навык не найдена",
+	StorageUserSkillExists:       "пара пользователь-навык уже существует",
+	StorageMigrate:               "применение миграции %s",
+
+	PageLimitRange:         "размер страницы должен быть от 1 до %d",
+	PageOffsetNegative:     "смещение не может быть отрицательным",
+	PageCursorInvalid:      "некорректный курсор страницы",
+	PageCursorSortMismatch: "курсор получен для другой сортировки",
+	PageSortUnknown:        "неизвестное поле сортировки: %s",
+
+	FilterAgeNegative:   "возраст не может быть отрицательным",
+	FilterAgeRange:      "минимальный возраст больше максимального",
+	FilterSearchTooLong: "строка поиска длиннее %d символов",
+
+	SearchQueryRequired: "поисковый запрос не может быть пустым",
+	SearchKindUnknown:   "неизвестный тип поиска: %s",
+	Search:              "поиск",
+	SearchIndex:         "обновление поискового индекса",
+	SearchRebuild:       "перестроение поискового индекса",
+
+	HTTPBodyInvalid:   "некорректное тело запроса",
+	HTTPParamInvalid:  "некорректное значение параметра %s",
+	HTTPParamRequired: "не указан параметр %s",
+	HTTPAuthHeader:    "заголовок Authorization должен содержать Bearer-токен",
+	HTTPAuthenticate:  "проверка токена доступа",
+	HTTPInternal:      "внутренняя ошибка сервера",
+
+	GRPCFieldInvalid:  "некорректное значение поля %s",
+	GRPCFieldRequired: "не заполнено поле %s",
+	GRPCAuthMetadata:  "метаданные authorization должны содержать Bearer-токен",
+	GRPCAuthenticate:  "проверка токена доступа",
+	GRPCInternal:      "внутренняя ошибка сервера",
+
+	SkillGetByIds:           "получение навыков по списку id",
+	ActivityFieldGetByIds:   "получение сфер деятельности по списку id",
+	FinReportGetByCompanies: "получение отчетов по списку компаний",
+
+	GraphQLBodyInvalid:  "некорректное тело запроса",
+	GraphQLArgInvalid:   "некорректное значение аргумента %s",
+	GraphQLAuthHeader:   "заголовок Authorization должен содержать Bearer-токен",
+	GraphQLAuthenticate: "проверка токена доступа",
+	GraphQLInternal:     "внутренняя ошибка сервера",
+
+	CLIUnknownCommand: "неизвестная команда %s",
+	CLIArgRequired:    "не указан флаг -%s",
+	CLIArgInvalid:     "некорректное значение флага -%s",
+	CLIFormatUnknown:  "неизвестный формат вывода %s",
+	CLIBackendUnknown: "неизвестное хранилище %s",
+	CLIOpenBackend:    "подключение к хранилищу",
+	CLIImportHeader:   "заголовок CSV должен содержать колонки %s",
+	CLIColumnInvalid:  "некорректное значение в колонке %s",
+	CLIImportRow:      "строка %d",
+	CLIImport:         "импорт финансовых отчетов",
+	CLIQuoteUnclosed:  "незакрытая кавычка в строке %q",
+
+	TaxScheduleJurisdictionRequired: "не указана юрисдикция",
+	TaxScheduleYearInvalid:          "год вступления в силу должен быть положительным",
+	TaxScheduleMethodUnknown:        "неизвестный способ расчета налога %s",
+	TaxScheduleBracketsEmpty:        "шкала должна содержать хотя бы одну ступень",
+	TaxScheduleFirstThreshold:       "порог первой ступени должен быть равен нулю",
+	TaxScheduleThresholdOrder:       "пороги ступеней должны возрастать",
+	TaxScheduleRateRange:            "ставка должна быть от 0 до 100 процентов",
+	TaxScheduleNotInForce:           "нет шкалы налогообложения, действующей в %d году",
+	TaxScheduleCreate:               "создание шкалы налогообложения",
+	TaxScheduleGet:                  "получение шкалы налогообложения",
+	TaxScheduleGetByJurisdiction:    "получение шкал налогообложения юрисдикции",
+	TaxScheduleGetInForce:           "поиск действующей шкалы налогообложения",
+	TaxScheduleDelete:               "удаление шкалы налогообложения",
+	StorageTaxScheduleNotFound:      "шкала налогообложения не найдена",
+	StorageTaxScheduleExists:        "шкала налогообложения на этот год уже существует",
+	InteractorTaxSchedule:           "получение шкалы налогообложения",
+
+	InteractorNoRevenue:           "за период нет выручки, рентабельность не определена",
+	InteractorNoActivityFieldCost: "нет сфер деятельности с положительным весом",
+
+	MoneyInvalid:              "некорректная денежная сумма %s",
+	MoneyCurrencyMismatch:     "суммы в разных валютах %s и %s нельзя складывать и сравнивать",
+	CurrencyUnsupported:       "валюта %s не поддерживается",
+	FinReportCurrencyMismatch: "выручка и расходы должны быть указаны в одной валюте",
+
+	ExchangeRateCurrencyInvalid: "курс не задается для валюты %q",
+	ExchangeRateQuarterRange:    "квартал курса должен быть от 1 до 4",
+	ExchangeRateNotPositive:     "курс должен быть положительным",
+	ExchangeRateDuplicate:       "курс %s за %d квартал %d года указан дважды",
+	ExchangeRateNotFound:        "нет курса %s за %d квартал %d года",
+	FinReportConvert:            "перевод отчетов в валюту отчетности",
+	InteractorTaxes:             "расчет налогов",
+	InteractorTaxConvert:        "перевод суммы для расчета налогов",
+	CLIRates:                    "загрузка курсов валют",
+
+	FinReportGranularityUnknown: "неизвестная периодичность отчета %s",
+	FinReportMonthRange:         "значение месяца должно находиться в отрезке от 1 до 12",
+	FinReportPeriodNotFinished:  "нельзя добавить отчет за период, который еще не закончился",
+	FinReportOverlap:            "период отчета пересекается с уже добавленным отчетом компании",
+}
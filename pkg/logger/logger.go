@@ -1,6 +1,7 @@
 package logger
 
 import (
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
 	"github.com/rs/zerolog"
 	"io"
 	"os"
@@ -22,9 +23,10 @@ type ILogger interface {
 
 type Logger struct {
 	logger *zerolog.Logger
+	locale i18n.Locale
 }
 
-func NewLogger(logLevel string, w io.Writer) ILogger {
+func NewLogger(logLevel string, locale i18n.Locale, w io.Writer) ILogger {
 	var l zerolog.Level
 	switch logLevel {
 	case ErrorLevel:
@@ -42,22 +44,39 @@ func NewLogger(logLevel string, w io.Writer) ILogger {
 	logger := zerolog.New(w).With().Timestamp().CallerWithSkipFrameCount(zerolog.CallerSkipFrameCount + skipFrameCount).Logger()
 	return &Logger{
 		logger: &logger,
+		locale: locale,
 	}
 }
 
+func (l *Logger) localize(args []interface{}) []interface{} {
+	localized := make([]interface{}, len(args))
+	for i, arg := range args {
+		switch v := arg.(type) {
+		case i18n.Code:
+			localized[i] = i18n.Text(l.locale, v)
+		case error:
+			localized[i] = i18n.Localize(v, l.locale)
+		default:
+			localized[i] = arg
+		}
+	}
+
+	return localized
+}
+
 func (l *Logger) Infof(message string, args ...interface{}) {
-	l.logger.Info().Msgf(message, args...)
+	l.logger.Info().Msgf(message, l.localize(args)...)
 }
 
 func (l *Logger) Warnf(message string, args ...interface{}) {
-	l.logger.Warn().Msgf(message, args...)
+	l.logger.Warn().Msgf(message, l.localize(args)...)
 }
 
 func (l *Logger) Errorf(message string, args ...interface{}) {
-	l.logger.Error().Msgf(message, args...)
+	l.logger.Error().Msgf(message, l.localize(args)...)
 }
 
 func (l *Logger) Fatalf(message string, args ...interface{}) {
-	l.logger.Fatal().Msgf(message, args...)
+	l.logger.Fatal().Msgf(message, l.localize(args)...)
 	os.Exit(1)
 }
@@ -0,0 +1,55 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestLogger_Localize(t *testing.T) {
+	testCases := []struct {
+		name     string
+		locale   i18n.Locale
+		message  string
+		args     []interface{}
+		expected string
+	}{
+		{
+			name:     "код без аргументов",
+			locale:   i18n.EN,
+			message:  "%v",
+			args:     []interface{}{i18n.FinReportPeriodOrder},
+			expected: i18n.Text(i18n.EN, i18n.FinReportPeriodOrder),
+		},
+		{
+			name:     "аргументы ошибки подставляются в текст",
+			locale:   i18n.EN,
+			message:  "%v",
+			args:     []interface{}{domain.NewError(domain.ErrNotFound, i18n.TaxScheduleNotInForce, 2017)},
+			expected: "no tax schedule is in force in 2017",
+		},
+		{
+			name:     "обернутая ошибка с аргументами",
+			locale:   i18n.RU,
+			message:  "%v: %v",
+			args:     []interface{}{i18n.InteractorTaxes, i18n.Wrap(domain.NewError(domain.ErrNotFound, i18n.TaxScheduleNotInForce, 2017), i18n.InteractorTaxSchedule)},
+			expected: fmt.Sprintf("%v: %v: нет шкалы налогообложения, действующей в 2017 году", i18n.InteractorTaxes, i18n.InteractorTaxSchedule),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			NewLogger(InfoLevel, tc.locale, &buf).Infof(tc.message, tc.args...)
+
+			var entry struct {
+				Message string `json:"message"`
+			}
+			require.Nil(t, json.Unmarshal(buf.Bytes(), &entry))
+			require.Equal(t, tc.expected, entry.Message)
+		})
+	}
+}
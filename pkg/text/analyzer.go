@@ -0,0 +1,66 @@
+package text
+
+import (
+	"strings"
+	"unicode"
+)
+
+var stopWords = map[string]bool{
+	"и": true, "в": true, "во": true, "не": true, "на": true, "с": true, "со": true, "по": true,
+	"к": true, "о": true, "об": true, "от": true, "до": true, "для": true, "из": true, "за": true,
+	"а": true, "но": true, "или": true, "что": true, "как": true, "это": true, "у": true,
+	"the": true, "a": true, "an": true, "and": true, "or": true, "of": true, "in": true, "on": true,
+	"to": true, "for": true, "with": true, "is": true, "are": true, "by": true, "at": true,
+}
+
+// Fold приводит текст к нижнему регистру и заменяет ё на е.
+func Fold(s string) string {
+	return strings.ReplaceAll(strings.ToLower(s), "ё", "е")
+}
+
+// Tokenize разбивает текст на слова из букв и цифр после Fold.
+func Tokenize(s string) []string {
+	return strings.FieldsFunc(Fold(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// Analyze возвращает основы слов текста без стоп-слов. Кириллические слова
+// обрабатываются русским стеммером, латинские — английским.
+func Analyze(s string) []string {
+	tokens := Tokenize(s)
+
+	terms := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if stopWords[token] {
+			continue
+		}
+		terms = append(terms, Stem(token))
+	}
+
+	return terms
+}
+
+// Stem выбирает стеммер по алфавиту слова, слова со смешанным алфавитом не изменяются.
+func Stem(word string) string {
+	cyrillic, latin := false, false
+	for _, r := range word {
+		switch {
+		case unicode.Is(unicode.Cyrillic, r):
+			cyrillic = true
+		case r < unicode.MaxASCII && unicode.IsLetter(r):
+			latin = true
+		case unicode.IsLetter(r):
+			return word
+		}
+	}
+
+	switch {
+	case cyrillic && !latin:
+		return StemRussian(word)
+	case latin && !cyrillic:
+		return StemEnglish(word)
+	}
+
+	return word
+}
@@ -0,0 +1,334 @@
+package text
+
+import "strings"
+
+// Стеммер английского языка по алгоритму Snowball (Porter2).
+// Ожидает слово в нижнем регистре из латинских букв.
+
+var enExceptions = map[string]string{
+	"skis":   "ski",
+	"skies":  "sky",
+	"dying":  "die",
+	"lying":  "lie",
+	"tying":  "tie",
+	"idly":   "idl",
+	"gently": "gentl",
+	"ugly":   "ugli",
+	"early":  "earli",
+	"only":   "onli",
+	"singly": "singl",
+	"sky":    "sky",
+	"news":   "news",
+	"howe":   "howe",
+	"atlas":  "atlas",
+	"cosmos": "cosmos",
+	"bias":   "bias",
+	"andes":  "andes",
+}
+
+var enStep1aInvariant = map[string]bool{
+	"inning":  true,
+	"outing":  true,
+	"canning": true,
+	"herring": true,
+	"earring": true,
+	"proceed": true,
+	"exceed":  true,
+	"succeed": true,
+}
+
+var enStep2 = []struct{ suffix, replacement string }{
+	{"ization", "ize"},
+	{"ational", "ate"},
+	{"fulness", "ful"},
+	{"ousness", "ous"},
+	{"iveness", "ive"},
+	{"tional", "tion"},
+	{"biliti", "ble"},
+	{"lessli", "less"},
+	{"entli", "ent"},
+	{"ation", "ate"},
+	{"alism", "al"},
+	{"aliti", "al"},
+	{"ousli", "ous"},
+	{"iviti", "ive"},
+	{"fulli", "ful"},
+	{"enci", "ence"},
+	{"anci", "ance"},
+	{"abli", "able"},
+	{"izer", "ize"},
+	{"ator", "ate"},
+	{"alli", "al"},
+	{"bli", "ble"},
+	{"ogi", "og"},
+	{"li", ""},
+}
+
+var enStep3 = []struct{ suffix, replacement string }{
+	{"ational", "ate"},
+	{"tional", "tion"},
+	{"alize", "al"},
+	{"icate", "ic"},
+	{"iciti", "ic"},
+	{"ative", ""},
+	{"ical", "ic"},
+	{"ness", ""},
+	{"ful", ""},
+}
+
+var enStep4 = []string{
+	"ement", "ance", "ence", "able", "ible", "ment",
+	"ant", "ent", "ism", "ate", "iti", "ous", "ive", "ize", "ion",
+	"al", "er", "ic",
+}
+
+func isEnVowel(c byte) bool {
+	switch c {
+	case 'a', 'e', 'i', 'o', 'u', 'y':
+		return true
+	}
+
+	return false
+}
+
+func StemEnglish(word string) string {
+	if len(word) <= 2 {
+		return word
+	}
+	if stem, ok := enExceptions[word]; ok {
+		return stem
+	}
+
+	w := []byte(strings.TrimPrefix(word, "'"))
+	if len(w) == 0 {
+		return word
+	}
+	for i := range w {
+		if w[i] == 'y' && (i == 0 || isEnVowel(w[i-1])) {
+			w[i] = 'Y'
+		}
+	}
+
+	r1 := enR1(w)
+	r2 := enRegion(w, r1)
+
+	w = enStep0(w)
+	w = enStep1a(w)
+	if enStep1aInvariant[string(w)] {
+		return string(w)
+	}
+	w = enStep1b(w, r1)
+	w = enStep1c(w)
+	w = enReplace(w, r1, enStep2)
+	w = enStep3Apply(w, r1, r2)
+	w = enStep4Apply(w, r2)
+	w = enStep5(w, r1, r2)
+
+	return strings.ReplaceAll(string(w), "Y", "y")
+}
+
+func enR1(w []byte) int {
+	for _, prefix := range []string{"gener", "commun", "arsen"} {
+		if strings.HasPrefix(string(w), prefix) {
+			return len(prefix)
+		}
+	}
+
+	return enRegion(w, 0)
+}
+
+func enRegion(w []byte, from int) int {
+	for i := from + 1; i < len(w); i++ {
+		if !isEnVowel(w[i]) && isEnVowel(w[i-1]) {
+			return i + 1
+		}
+	}
+
+	return len(w)
+}
+
+func hasVowel(w []byte) bool {
+	for _, c := range w {
+		if isEnVowel(c) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func endsWith(w []byte, suffix string) bool {
+	return strings.HasSuffix(string(w), suffix)
+}
+
+func enStep0(w []byte) []byte {
+	for _, suffix := range []string{"'s'", "'s", "'"} {
+		if endsWith(w, suffix) {
+			return w[:len(w)-len(suffix)]
+		}
+	}
+
+	return w
+}
+
+func enStep1a(w []byte) []byte {
+	switch {
+	case endsWith(w, "sses"):
+		return w[:len(w)-2]
+	case endsWith(w, "ied"), endsWith(w, "ies"):
+		if len(w) > 4 {
+			return w[:len(w)-2]
+		}
+		return w[:len(w)-1]
+	case endsWith(w, "us"), endsWith(w, "ss"):
+		return w
+	case endsWith(w, "s"):
+		if hasVowel(w[:len(w)-2]) {
+			return w[:len(w)-1]
+		}
+	}
+
+	return w
+}
+
+func enStep1b(w []byte, r1 int) []byte {
+	for _, suffix := range []string{"eedly", "eed"} {
+		if endsWith(w, suffix) {
+			if len(w)-len(suffix) >= r1 {
+				return append(w[:len(w)-len(suffix)], "ee"...)
+			}
+			return w
+		}
+	}
+
+	for _, suffix := range []string{"ingly", "edly", "ing", "ed"} {
+		if !endsWith(w, suffix) {
+			continue
+		}
+
+		stem := w[:len(w)-len(suffix)]
+		if !hasVowel(stem) {
+			return w
+		}
+
+		switch {
+		case endsWith(stem, "at"), endsWith(stem, "bl"), endsWith(stem, "iz"):
+			return append(stem, 'e')
+		case enDouble(stem):
+			return stem[:len(stem)-1]
+		case enShortWord(stem, r1):
+			return append(stem, 'e')
+		}
+		return stem
+	}
+
+	return w
+}
+
+func enStep1c(w []byte) []byte {
+	n := len(w)
+	if n > 2 && (w[n-1] == 'y' || w[n-1] == 'Y') && !isEnVowel(w[n-2]) {
+		w[n-1] = 'i'
+	}
+
+	return w
+}
+
+func enReplace(w []byte, r1 int, rules []struct{ suffix, replacement string }) []byte {
+	for _, rule := range rules {
+		if !endsWith(w, rule.suffix) {
+			continue
+		}
+
+		stem := w[:len(w)-len(rule.suffix)]
+		if len(stem) < r1 {
+			return w
+		}
+
+		switch rule.suffix {
+		case "ogi":
+			if !endsWith(stem, "l") {
+				return w
+			}
+		case "li":
+			if len(stem) == 0 || !strings.ContainsRune("cdeghkmnrt", rune(stem[len(stem)-1])) {
+				return w
+			}
+		}
+
+		return append(stem, rule.replacement...)
+	}
+
+	return w
+}
+
+func enStep3Apply(w []byte, r1, r2 int) []byte {
+	// ative удаляется только в R2
+	if endsWith(w, "ative") && len(w)-len("ative") < r2 {
+		return w
+	}
+
+	return enReplace(w, r1, enStep3)
+}
+
+func enStep4Apply(w []byte, r2 int) []byte {
+	for _, suffix := range enStep4 {
+		if !endsWith(w, suffix) {
+			continue
+		}
+
+		stem := w[:len(w)-len(suffix)]
+		if len(stem) < r2 {
+			return w
+		}
+		if suffix == "ion" && !endsWith(stem, "s") && !endsWith(stem, "t") {
+			return w
+		}
+
+		return stem
+	}
+
+	return w
+}
+
+func enStep5(w []byte, r1, r2 int) []byte {
+	n := len(w)
+	switch {
+	case n > 0 && w[n-1] == 'e':
+		stem := w[:n-1]
+		if len(stem) >= r2 || (len(stem) >= r1 && !enShortSyllable(stem)) {
+			return stem
+		}
+	case n > 1 && w[n-1] == 'l' && w[n-2] == 'l' && n-1 >= r2:
+		return w[:n-1]
+	}
+
+	return w
+}
+
+func enDouble(w []byte) bool {
+	n := len(w)
+	if n < 2 || w[n-1] != w[n-2] {
+		return false
+	}
+
+	return strings.IndexByte("bdfgmnprt", w[n-1]) >= 0
+}
+
+// enShortSyllable проверяет, заканчивается ли слово коротким слогом.
+func enShortSyllable(w []byte) bool {
+	n := len(w)
+	if n == 2 {
+		return isEnVowel(w[0]) && !isEnVowel(w[1])
+	}
+	if n < 3 {
+		return false
+	}
+
+	return !isEnVowel(w[n-3]) && isEnVowel(w[n-2]) && !isEnVowel(w[n-1]) &&
+		w[n-1] != 'w' && w[n-1] != 'x' && w[n-1] != 'Y'
+}
+
+func enShortWord(w []byte, r1 int) bool {
+	return r1 >= len(w) && enShortSyllable(w)
+}
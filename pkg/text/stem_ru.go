@@ -0,0 +1,158 @@
+package text
+
+// Стеммер русского языка по алгоритму Snowball.
+// Ожидает слово в нижнем регистре с уже замененной ё на е.
+
+var (
+	ruPerfectiveGerund1 = []string{"вшись", "вши", "в"}
+	ruPerfectiveGerund2 = []string{"ившись", "ывшись", "ивши", "ывши", "ив", "ыв"}
+	ruAdjective         = []string{
+		"ими", "ыми", "его", "ого", "ему", "ому",
+		"ее", "ие", "ые", "ое", "ей", "ий", "ый", "ой", "ем", "им", "ым", "ом",
+		"их", "ых", "ую", "юю", "ая", "яя", "ою", "ею",
+	}
+	ruParticiple1 = []string{"ем", "нн", "вш", "ющ", "щ"}
+	ruParticiple2 = []string{"ивш", "ывш", "ующ"}
+	ruReflexive   = []string{"ся", "сь"}
+	ruVerb1       = []string{
+		"ете", "йте", "ешь", "нно",
+		"ла", "на", "ли", "ем", "ло", "но", "ет", "ют", "ны", "ть",
+		"й", "л", "н",
+	}
+	ruVerb2 = []string{
+		"ейте", "уйте",
+		"ила", "ыла", "ена", "ите", "или", "ыли", "ило", "ыло", "ено", "ует", "уют", "ены", "ить", "ыть", "ишь",
+		"ей", "уй", "ил", "ыл", "им", "ым", "ен", "ят", "ит", "ыт", "ую",
+		"ю",
+	}
+	ruNoun = []string{
+		"иями", "ями", "ами", "ией", "иям", "ием", "иях",
+		"ев", "ов", "ие", "ье", "еи", "ии", "ей", "ой", "ий", "ям", "ем", "ам", "ом", "ах", "ях", "ию", "ью", "ия", "ья",
+		"а", "е", "и", "й", "о", "у", "ы", "ь", "ю", "я",
+	}
+	ruSuperlative  = []string{"ейше", "ейш"}
+	ruDerivational = []string{"ость", "ост"}
+	ruGroup1Before = []rune{'а', 'я'}
+)
+
+func isRuVowel(r rune) bool {
+	switch r {
+	case 'а', 'е', 'и', 'о', 'у', 'ы', 'э', 'ю', 'я':
+		return true
+	}
+
+	return false
+}
+
+func StemRussian(word string) string {
+	w := []rune(word)
+
+	rv := len(w)
+	for i, r := range w {
+		if isRuVowel(r) {
+			rv = i + 1
+			break
+		}
+	}
+	r2 := ruRegion(w, ruRegion(w, 0))
+
+	if n, ok := ruEnding(w, rv, ruPerfectiveGerund1, true); ok {
+		w = w[:n]
+	} else if n, ok := ruEnding(w, rv, ruPerfectiveGerund2, false); ok {
+		w = w[:n]
+	} else {
+		if n, ok := ruEnding(w, rv, ruReflexive, false); ok {
+			w = w[:n]
+		}
+
+		if n, ok := ruEnding(w, rv, ruAdjective, false); ok {
+			w = w[:n]
+			if n, ok := ruEnding(w, rv, ruParticiple1, true); ok {
+				w = w[:n]
+			} else if n, ok := ruEnding(w, rv, ruParticiple2, false); ok {
+				w = w[:n]
+			}
+		} else if n, ok := ruEnding(w, rv, ruVerb1, true); ok {
+			w = w[:n]
+		} else if n, ok := ruEnding(w, rv, ruVerb2, false); ok {
+			w = w[:n]
+		} else if n, ok := ruEnding(w, rv, ruNoun, false); ok {
+			w = w[:n]
+		}
+	}
+
+	if n, ok := ruEnding(w, rv, []string{"и"}, false); ok {
+		w = w[:n]
+	}
+
+	if n, ok := ruEnding(w, r2, ruDerivational, false); ok {
+		w = w[:n]
+	}
+
+	if n, ok := ruEnding(w, rv, []string{"нн"}, false); ok {
+		w = w[:n+1]
+	} else if n, ok := ruEnding(w, rv, ruSuperlative, false); ok {
+		w = w[:n]
+		if n, ok := ruEnding(w, rv, []string{"нн"}, false); ok {
+			w = w[:n+1]
+		}
+	} else if n, ok := ruEnding(w, rv, []string{"ь"}, false); ok {
+		w = w[:n]
+	}
+
+	return string(w)
+}
+
+// ruRegion возвращает начало области после первой согласной, следующей за гласной.
+func ruRegion(w []rune, from int) int {
+	for i := from + 1; i < len(w); i++ {
+		if !isRuVowel(w[i]) && isRuVowel(w[i-1]) {
+			return i + 1
+		}
+	}
+
+	return len(w)
+}
+
+// ruEnding ищет самое длинное из окончаний, целиком лежащее в области start,
+// и возвращает длину основы без него. Окончания первой группы должны
+// следовать за а или я, которые сами тоже лежат в области.
+func ruEnding(w []rune, start int, endings []string, group1 bool) (int, bool) {
+	best := -1
+	for _, ending := range endings {
+		e := []rune(ending)
+		n := len(w) - len(e)
+		if n < start || (best >= 0 && n >= best) || !hasSuffix(w, e) {
+			continue
+		}
+		if group1 && (n-1 < start || !containsRune(ruGroup1Before, w[n-1])) {
+			continue
+		}
+		best = n
+	}
+
+	return best, best >= 0
+}
+
+func hasSuffix(w, suffix []rune) bool {
+	if len(suffix) > len(w) {
+		return false
+	}
+	for i := range suffix {
+		if w[len(w)-len(suffix)+i] != suffix[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsRune(runes []rune, r rune) bool {
+	for _, c := range runes {
+		if c == r {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,91 @@
+package text
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestStemRussian(t *testing.T) {
+	testCases := map[string]string{
+		"книги":         "книг",
+		"книгами":       "книг",
+		"красивая":      "красив",
+		"красивейший":   "красив",
+		"программист":   "программист",
+		"программисты":  "программист",
+		"программистов": "программист",
+		"разработка":    "разработк",
+		"разработкой":   "разработк",
+		"управление":    "управлен",
+		"управления":    "управлен",
+		"обучаться":     "обуча",
+		"сделавшись":    "сдела",
+		"бухгалтерия":   "бухгалтер",
+		"жизнь":         "жизн",
+		"я":             "я",
+	}
+
+	for word, stem := range testCases {
+		require.Equal(t, stem, StemRussian(word), word)
+	}
+}
+
+func TestStemEnglish(t *testing.T) {
+	testCases := map[string]string{
+		"running":     "run",
+		"runs":        "run",
+		"generously":  "generous",
+		"happiness":   "happi",
+		"relational":  "relat",
+		"ponies":      "poni",
+		"caresses":    "caress",
+		"cats":        "cat",
+		"hopping":     "hop",
+		"hoped":       "hope",
+		"agreed":      "agre",
+		"development": "develop",
+		"management":  "manag",
+		"skies":       "sky",
+		"news":        "news",
+		"go":          "go",
+	}
+
+	for word, stem := range testCases {
+		require.Equal(t, stem, StemEnglish(word), word)
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{
+			name:     "ё и е совпадают",
+			text:     "Ёлка и елка",
+			expected: []string{"елк", "елк"},
+		},
+		{
+			name:     "смешанный текст",
+			text:     "Разработка веб-приложений на Go, developing services",
+			expected: []string{"разработк", "веб", "приложен", "go", "develop", "servic"},
+		},
+		{
+			name:     "только стоп-слова",
+			text:     "the and и в",
+			expected: []string{},
+		},
+		{
+			name:     "цифры",
+			text:     "1С: Бухгалтерия 8.3",
+			expected: []string{"1с", "бухгалтер", "8", "3"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, Analyze(tc.text))
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package conformance
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testActivityFields(t *testing.T, repos *Repositories) {
+	ctx := context.Background()
+	repo := repos.ActivityFields
+
+	t.Run("максимальный вес пустого списка", func(t *testing.T) {
+		_, err := repo.GetMaxCost(ctx)
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("максимальный вес", func(t *testing.T) {
+		fields := make([]*domain.ActivityField, 0)
+		for i, cost := range []float32{1.5, 13.75, 0.25, 13.5, 7} {
+			fields = append(fields, newActivityField(t, repos, label("max", i), cost))
+		}
+
+		maxCost, err := repo.GetMaxCost(ctx)
+		require.Nil(t, err)
+		require.Equal(t, float32(13.75), maxCost)
+
+		fields[4].Cost = 21.125
+		require.Nil(t, repo.Update(ctx, fields[4]))
+		maxCost, err = repo.GetMaxCost(ctx)
+		require.Nil(t, err)
+		require.Equal(t, float32(21.125), maxCost)
+
+		require.Nil(t, repo.DeleteById(ctx, fields[4].ID))
+		maxCost, err = repo.GetMaxCost(ctx)
+		require.Nil(t, err)
+		require.Equal(t, float32(13.75), maxCost)
+
+		for _, field := range fields[:4] {
+			require.Nil(t, repo.DeleteById(ctx, field.ID))
+		}
+	})
+
+	t.Run("создание и чтение", func(t *testing.T) {
+		field := newActivityField(t, repos, "IT", 2.5)
+
+		got, err := repo.GetById(ctx, field.ID)
+		require.Nil(t, err)
+		require.Equal(t, field, got)
+
+		field.Description = "информационные технологии"
+		require.Nil(t, repo.Update(ctx, field))
+		got, err = repo.GetById(ctx, field.ID)
+		require.Nil(t, err)
+		require.Equal(t, field, got)
+
+		require.Nil(t, repo.DeleteById(ctx, field.ID))
+		_, err = repo.GetById(ctx, field.ID)
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("отсутствующая сфера деятельности", func(t *testing.T) {
+		_, err := repo.GetById(ctx, uuid.New())
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		require.ErrorIs(t, repo.Update(ctx, &domain.ActivityField{ID: uuid.New()}), domain.ErrNotFound)
+		require.ErrorIs(t, repo.DeleteById(ctx, uuid.New()), domain.ErrNotFound)
+	})
+
+	t.Run("повтор id", func(t *testing.T) {
+		field := newActivityField(t, repos, "dup", 1)
+		require.ErrorIs(t, repo.Create(ctx, &domain.ActivityField{ID: field.ID, Name: "dup2"}), domain.ErrConflict)
+		require.Nil(t, repo.DeleteById(ctx, field.ID))
+	})
+
+	t.Run("постраничный вывод", func(t *testing.T) {
+		expected := make([]*domain.ActivityField, 0)
+		for _, i := range shuffled(pageCount()) {
+			expected = append(expected, newActivityField(t, repos, label("field", i), float32(i)))
+		}
+		sortByName(expected,
+			func(f *domain.ActivityField) string { return f.Name },
+			func(f *domain.ActivityField) uuid.UUID { return f.ID },
+		)
+
+		fetch := func(req domain.PageRequest) (*domain.Page[*domain.ActivityField], error) {
+			return repo.GetAll(ctx, req)
+		}
+		key := func(f *domain.ActivityField) string { return f.ID.String() }
+		checkPages(t, fetch, "name", expected, key)
+		checkPages(t, fetch, "cost", expected, key)
+	})
+
+	t.Run("чтение по списку id", func(t *testing.T) {
+		first := newActivityField(t, repos, "ids-1", 1.25)
+		second := newActivityField(t, repos, "ids-2", 2.5)
+
+		got, err := repo.GetByIds(ctx, []uuid.UUID{second.ID, uuid.New(), first.ID, second.ID})
+		require.Nil(t, err)
+		require.ElementsMatch(t, []*domain.ActivityField{first, second}, got)
+
+		got, err = repo.GetByIds(ctx, nil)
+		require.Nil(t, err)
+		require.Empty(t, got)
+	})
+}
@@ -0,0 +1,45 @@
+package conformance
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testAuth(t *testing.T, repos *Repositories) {
+	ctx := context.Background()
+	repo := repos.Auth
+
+	t.Run("регистрация и чтение", func(t *testing.T) {
+		authInfo := &domain.UserAuth{Username: "ivan", HashedPass: "hash", Role: domain.RoleEntrepreneur}
+		require.Nil(t, repo.Register(ctx, authInfo))
+		require.NotEqual(t, uuid.Nil, authInfo.ID)
+
+		got, err := repo.GetByUsername(ctx, "ivan")
+		require.Nil(t, err)
+		require.Equal(t, authInfo.ID, got.ID)
+		require.Equal(t, "ivan", got.Username)
+		require.Equal(t, "hash", got.HashedPass)
+		require.Equal(t, domain.RoleEntrepreneur, got.Role)
+	})
+
+	t.Run("роль по умолчанию", func(t *testing.T) {
+		require.Nil(t, repo.Register(ctx, &domain.UserAuth{Username: "guest", HashedPass: "hash"}))
+
+		got, err := repo.GetByUsername(ctx, "guest")
+		require.Nil(t, err)
+		require.Equal(t, domain.RoleGuest, got.Role)
+	})
+
+	t.Run("уникальность имени", func(t *testing.T) {
+		require.Nil(t, repo.Register(ctx, &domain.UserAuth{Username: "petr", HashedPass: "hash"}))
+		require.ErrorIs(t, repo.Register(ctx, &domain.UserAuth{Username: "petr", HashedPass: "hash"}), domain.ErrConflict)
+	})
+
+	t.Run("отсутствующий пользователь", func(t *testing.T) {
+		_, err := repo.GetByUsername(ctx, "nobody")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
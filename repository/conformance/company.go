@@ -0,0 +1,132 @@
+package conformance
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testCompanies(t *testing.T, repos *Repositories) {
+	ctx := context.Background()
+	repo := repos.Companies
+
+	owner := newUser(t, repos, "owner")
+	other := newUser(t, repos, "other")
+	field := newActivityField(t, repos, "IT", 1)
+
+	t.Run("создание и чтение", func(t *testing.T) {
+		company := newCompany(t, repos, owner.ID, field.ID, "Альфа")
+
+		got, err := repo.GetById(ctx, company.ID)
+		require.Nil(t, err)
+		require.Equal(t, company, got)
+
+		company.City = "Казань"
+		require.Nil(t, repo.Update(ctx, company))
+		got, err = repo.GetById(ctx, company.ID)
+		require.Nil(t, err)
+		require.Equal(t, company, got)
+
+		require.Nil(t, repo.DeleteById(ctx, company.ID))
+		_, err = repo.GetById(ctx, company.ID)
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("отсутствующая компания", func(t *testing.T) {
+		_, err := repo.GetById(ctx, uuid.New())
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		require.ErrorIs(t, repo.Update(ctx, &domain.Company{
+			ID:              uuid.New(),
+			OwnerID:         owner.ID,
+			ActivityFieldId: field.ID,
+		}), domain.ErrNotFound)
+		require.ErrorIs(t, repo.DeleteById(ctx, uuid.New()), domain.ErrNotFound)
+	})
+
+	t.Run("постраничный вывод", func(t *testing.T) {
+		expected := make([]*domain.Company, 0)
+		all := make([]*domain.Company, 0)
+		for _, i := range shuffled(pageCount()) {
+			company := newCompany(t, repos, owner.ID, field.ID, label("company", i))
+			expected = append(expected, company)
+			all = append(all, company)
+			all = append(all, newCompany(t, repos, other.ID, field.ID, label("other", i)))
+		}
+		byName := func(c *domain.Company) string { return c.Name }
+		byId := func(c *domain.Company) uuid.UUID { return c.ID }
+		sortByName(expected, byName, byId)
+		sortByName(all, byName, byId)
+
+		checkPages(t, func(req domain.PageRequest) (*domain.Page[*domain.Company], error) {
+			return repo.GetByOwnerId(ctx, owner.ID, req)
+		}, "name", expected, func(c *domain.Company) string { return c.ID.String() })
+
+		checkPages(t, func(req domain.PageRequest) (*domain.Page[*domain.Company], error) {
+			return repo.GetAll(ctx, domain.CompanyFilter{}, req)
+		}, "name", all, func(c *domain.Company) string { return c.ID.String() })
+
+		companies, err := repo.GetByOwnerId(ctx, uuid.New(), domain.PageRequest{})
+		require.Nil(t, err)
+		require.NotNil(t, companies.Items)
+		require.Empty(t, companies.Items)
+		require.Zero(t, companies.Total)
+		require.Empty(t, companies.NextCursor)
+	})
+	t.Run("фильтрация", func(t *testing.T) {
+		owner := newUser(t, repos, "filter owner")
+		trade := newActivityField(t, repos, "Торговля", 2)
+
+		create := func(name, city string, fieldId uuid.UUID) *domain.Company {
+			company := &domain.Company{OwnerID: owner.ID, ActivityFieldId: fieldId, Name: name, City: city}
+			require.Nil(t, repo.Create(ctx, company))
+			return company
+		}
+		chamomile := create("Ромашка", "Москва", field.ID)
+		chamomilePlus := create("ромашка плюс", "Казань", trade.ID)
+		buttercup := create("Лютик", "Казань", field.ID)
+
+		testCases := []struct {
+			name     string
+			filter   domain.CompanyFilter
+			expected []*domain.Company
+		}{
+			{
+				name:     "по владельцу",
+				filter:   domain.CompanyFilter{OwnerId: owner.ID},
+				expected: []*domain.Company{buttercup, chamomile, chamomilePlus},
+			},
+			{
+				name:     "по владельцу и городу",
+				filter:   domain.CompanyFilter{OwnerId: owner.ID, City: "Казань"},
+				expected: []*domain.Company{buttercup, chamomilePlus},
+			},
+			{
+				name:     "по сфере деятельности",
+				filter:   domain.CompanyFilter{ActivityFieldId: trade.ID},
+				expected: []*domain.Company{chamomilePlus},
+			},
+			{
+				name:     "поиск по названию без учета регистра",
+				filter:   domain.CompanyFilter{Search: "РОМАШ"},
+				expected: []*domain.Company{chamomile, chamomilePlus},
+			},
+			{
+				name:   "ничего не найдено",
+				filter: domain.CompanyFilter{OwnerId: uuid.New()},
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				page, err := repo.GetAll(ctx, tc.filter, domain.PageRequest{})
+				require.Nil(t, err)
+				require.Equal(t, len(tc.expected), page.Total)
+				require.Equal(t, len(tc.expected), len(page.Items))
+				for i := range tc.expected {
+					require.Equal(t, tc.expected[i].ID, page.Items[i].ID)
+				}
+			})
+		}
+	})
+}
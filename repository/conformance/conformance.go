@@ -0,0 +1,280 @@
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"sort"
+	"testing"
+	"time"
+)
+
+type Repositories struct {
+	Users            domain.IUserRepository
+	Auth             domain.IAuthRepository
+	ActivityFields   domain.IActivityFieldRepository
+	Companies        domain.ICompanyRepository
+	FinancialReports domain.IFinancialReportRepository
+	Skills           domain.ISkillRepository
+	UserSkills       domain.IUserSkillRepository
+	Contacts         domain.IContactsRepository
+	RefreshTokens    domain.IRefreshTokenRepository
+	TaxSchedules     domain.ITaxScheduleRepository
+	TxManager        domain.ITxManager
+}
+
+type Factory func(t *testing.T) *Repositories
+
+func Run(t *testing.T, factory Factory) {
+	suites := []struct {
+		name     string
+		run      func(t *testing.T, repos *Repositories)
+		required func(repos *Repositories) bool
+	}{
+		{
+			name: "Users",
+			run:  testUsers,
+			required: func(repos *Repositories) bool {
+				return repos.Users != nil
+			},
+		},
+		{
+			name: "Auth",
+			run:  testAuth,
+			required: func(repos *Repositories) bool {
+				return repos.Auth != nil
+			},
+		},
+		{
+			name: "ActivityFields",
+			run:  testActivityFields,
+			required: func(repos *Repositories) bool {
+				return repos.ActivityFields != nil
+			},
+		},
+		{
+			name: "Companies",
+			run:  testCompanies,
+			required: func(repos *Repositories) bool {
+				return repos.Companies != nil && repos.Users != nil && repos.ActivityFields != nil
+			},
+		},
+		{
+			name: "FinancialReports",
+			run:  testFinancialReports,
+			required: func(repos *Repositories) bool {
+				return repos.FinancialReports != nil && repos.Companies != nil &&
+					repos.Users != nil && repos.ActivityFields != nil
+			},
+		},
+		{
+			name: "Skills",
+			run:  testSkills,
+			required: func(repos *Repositories) bool {
+				return repos.Skills != nil
+			},
+		},
+		{
+			name: "UserSkills",
+			run:  testUserSkills,
+			required: func(repos *Repositories) bool {
+				return repos.UserSkills != nil && repos.Users != nil && repos.Skills != nil
+			},
+		},
+		{
+			name: "Contacts",
+			run:  testContacts,
+			required: func(repos *Repositories) bool {
+				return repos.Contacts != nil && repos.Users != nil
+			},
+		},
+		{
+			name: "RefreshTokens",
+			run:  testRefreshTokens,
+			required: func(repos *Repositories) bool {
+				return repos.RefreshTokens != nil && repos.Users != nil
+			},
+		},
+		{
+			name: "TaxSchedules",
+			run:  testTaxSchedules,
+			required: func(repos *Repositories) bool {
+				return repos.TaxSchedules != nil && repos.ActivityFields != nil
+			},
+		},
+		{
+			name: "Transactions",
+			run:  testTransactions,
+			required: func(repos *Repositories) bool {
+				return repos.TxManager != nil && repos.Users != nil && repos.Skills != nil && repos.UserSkills != nil
+			},
+		},
+	}
+
+	for _, suite := range suites {
+		t.Run(suite.name, func(t *testing.T) {
+			repos := factory(t)
+			if !suite.required(repos) {
+				t.Skip("репозиторий не предоставлен")
+			}
+			suite.run(t, repos)
+		})
+	}
+}
+
+func shuffled(n int) []int {
+	order := make([]int, n)
+	for i := range order {
+		order[i] = (i*7 + 3) % n
+	}
+
+	return order
+}
+
+func pageCount() int {
+	return 2*domain.PageSize + 3
+}
+
+type pageFetcher[T any] func(req domain.PageRequest) (*domain.Page[T], error)
+
+func checkPages[T any](t *testing.T, fetch pageFetcher[T], sort string, expected []T, key func(T) string) {
+	t.Helper()
+
+	want := make([]string, 0, len(expected))
+	for _, item := range expected {
+		want = append(want, key(item))
+	}
+	reversed := make([]string, 0, len(want))
+	for i := len(want) - 1; i >= 0; i-- {
+		reversed = append(reversed, want[i])
+	}
+
+	require.Equal(t, want, collectPages(t, fetch, sort, len(want), key), "сортировка %q", sort)
+	require.Equal(t, reversed, collectPages(t, fetch, "-"+sort, len(want), key), "сортировка %q", "-"+sort)
+
+	page, err := fetch(domain.PageRequest{Offset: domain.PageSize, Limit: 5, Sort: sort})
+	require.Nil(t, err)
+	require.Equal(t, len(want), page.Total)
+	require.Equal(t, domain.PageSize, page.Offset)
+	require.Equal(t, 5, page.Limit)
+	got := make([]string, 0, len(page.Items))
+	for _, item := range page.Items {
+		got = append(got, key(item))
+	}
+	require.Equal(t, want[domain.PageSize:domain.PageSize+5], got, "выборка по смещению")
+
+	page, err = fetch(domain.PageRequest{Offset: len(want) + 1, Sort: sort})
+	require.Nil(t, err)
+	require.NotNil(t, page.Items)
+	require.Empty(t, page.Items)
+	require.Empty(t, page.NextCursor)
+	require.Equal(t, len(want), page.Total)
+
+	first, err := fetch(domain.PageRequest{Sort: sort})
+	require.Nil(t, err)
+	require.NotEmpty(t, first.NextCursor)
+
+	invalid := []domain.PageRequest{
+		{Limit: -1},
+		{Limit: domain.MaxPageSize + 1},
+		{Offset: -1},
+		{Cursor: "не курсор"},
+		{Cursor: first.NextCursor, Sort: "-" + sort},
+		{Sort: "unknown"},
+	}
+	for _, req := range invalid {
+		_, err = fetch(req)
+		require.ErrorIs(t, err, domain.ErrValidation, "%+v", req)
+	}
+}
+
+func collectPages[T any](t *testing.T, fetch pageFetcher[T], sort string, total int, key func(T) string) []string {
+	t.Helper()
+
+	got := make([]string, 0, total)
+	req := domain.PageRequest{Sort: sort}
+	for {
+		page, err := fetch(req)
+		require.Nil(t, err)
+		require.NotNil(t, page.Items)
+		require.Equal(t, total, page.Total)
+		require.Equal(t, domain.PageSize, page.Limit)
+		require.Equal(t, len(got), page.Offset)
+		require.LessOrEqual(t, len(page.Items), domain.PageSize)
+
+		for _, item := range page.Items {
+			got = append(got, key(item))
+		}
+		require.LessOrEqual(t, len(got), total, "страницы пересекаются")
+
+		if page.NextCursor == "" {
+			break
+		}
+		require.Len(t, page.Items, domain.PageSize, "неполная страница в середине выборки")
+		req.Cursor = page.NextCursor
+	}
+
+	return got
+}
+
+func sortByName[T any](items []T, name func(T) string, id func(T) uuid.UUID) {
+	sort.SliceStable(items, func(i, j int) bool {
+		if name(items[i]) != name(items[j]) {
+			return name(items[i]) < name(items[j])
+		}
+		return id(items[i]).String() < id(items[j]).String()
+	})
+}
+
+func newUser(t *testing.T, repos *Repositories, username string) *domain.User {
+	t.Helper()
+
+	user := &domain.User{
+		Username: username,
+		FullName: "Иванов Иван Иванович",
+		Gender:   "m",
+		Birthday: time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC),
+		City:     "Москва",
+		Role:     domain.RoleEntrepreneur,
+	}
+	require.Nil(t, repos.Users.Create(context.Background(), user))
+	require.NotEqual(t, uuid.Nil, user.ID)
+
+	return user
+}
+
+func newActivityField(t *testing.T, repos *Repositories, name string, cost float32) *domain.ActivityField {
+	t.Helper()
+
+	field := &domain.ActivityField{Name: name, Description: "описание " + name, Cost: cost}
+	require.Nil(t, repos.ActivityFields.Create(context.Background(), field))
+	require.NotEqual(t, uuid.Nil, field.ID)
+
+	return field
+}
+
+func newCompany(t *testing.T, repos *Repositories, ownerId, fieldId uuid.UUID, name string) *domain.Company {
+	t.Helper()
+
+	company := &domain.Company{OwnerID: ownerId, ActivityFieldId: fieldId, Name: name, City: "Москва"}
+	require.Nil(t, repos.Companies.Create(context.Background(), company))
+	require.NotEqual(t, uuid.Nil, company.ID)
+
+	return company
+}
+
+func newSkill(t *testing.T, repos *Repositories, name string) *domain.Skill {
+	t.Helper()
+
+	skill := &domain.Skill{Name: name, Description: "описание " + name}
+	require.Nil(t, repos.Skills.Create(context.Background(), skill))
+	require.NotEqual(t, uuid.Nil, skill.ID)
+
+	return skill
+}
+
+func label(prefix string, i int) string {
+	return fmt.Sprintf("%s %02d", prefix, i)
+}
@@ -0,0 +1,60 @@
+package conformance
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testContacts(t *testing.T, repos *Repositories) {
+	ctx := context.Background()
+	repo := repos.Contacts
+
+	owner := newUser(t, repos, "ivan")
+	other := newUser(t, repos, "petr")
+
+	t.Run("создание и чтение", func(t *testing.T) {
+		contact := &domain.Contact{OwnerID: owner.ID, Name: "email", Value: "ivan@example.com"}
+		require.Nil(t, repo.Create(ctx, contact))
+		require.NotEqual(t, uuid.Nil, contact.ID)
+
+		got, err := repo.GetById(ctx, contact.ID)
+		require.Nil(t, err)
+		require.Equal(t, contact, got)
+
+		contact.Value = "ivan@example.org"
+		require.Nil(t, repo.Update(ctx, contact))
+		got, err = repo.GetById(ctx, contact.ID)
+		require.Nil(t, err)
+		require.Equal(t, contact, got)
+
+		require.Nil(t, repo.DeleteById(ctx, contact.ID))
+		_, err = repo.GetById(ctx, contact.ID)
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("отсутствующий контакт", func(t *testing.T) {
+		_, err := repo.GetById(ctx, uuid.New())
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		require.ErrorIs(t, repo.Update(ctx, &domain.Contact{ID: uuid.New(), OwnerID: owner.ID}), domain.ErrNotFound)
+		require.ErrorIs(t, repo.DeleteById(ctx, uuid.New()), domain.ErrNotFound)
+	})
+
+	t.Run("постраничный вывод", func(t *testing.T) {
+		expected := make([]*domain.Contact, 0)
+		for _, i := range shuffled(pageCount()) {
+			contact := &domain.Contact{OwnerID: owner.ID, Name: label("contact", i), Value: "value"}
+			require.Nil(t, repo.Create(ctx, contact))
+			expected = append(expected, contact)
+
+			require.Nil(t, repo.Create(ctx, &domain.Contact{OwnerID: other.ID, Name: label("other", i)}))
+		}
+		sortByName(expected, func(c *domain.Contact) string { return c.Name }, func(c *domain.Contact) uuid.UUID { return c.ID })
+
+		checkPages(t, func(req domain.PageRequest) (*domain.Page[*domain.Contact], error) {
+			return repo.GetByOwnerId(ctx, owner.ID, req)
+		}, "name", expected, func(c *domain.Contact) string { return c.ID.String() })
+	})
+}
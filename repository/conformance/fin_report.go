@@ -0,0 +1,238 @@
+package conformance
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func testFinancialReports(t *testing.T, repos *Repositories) {
+	ctx := context.Background()
+	repo := repos.FinancialReports
+
+	owner := newUser(t, repos, "owner")
+	field := newActivityField(t, repos, "IT", 1)
+	company := newCompany(t, repos, owner.ID, field.ID, "Альфа")
+	other := newCompany(t, repos, owner.ID, field.ID, "Бета")
+
+	reports := make(map[[2]int]*domain.FinancialReport)
+	for _, yq := range [][2]int{{2022, 4}, {2021, 1}, {2020, 4}, {2021, 3}, {2022, 1}, {2021, 2}, {2020, 1}} {
+		report := &domain.FinancialReport{
+			CompanyID:   company.ID,
+			Revenue:     domain.NewMoney(int64(yq[0]*10+yq[1]), domain.DefaultCurrency),
+			Costs:       domain.NewMoney(int64(yq[1]), domain.DefaultCurrency),
+			Granularity: domain.GranularityQuarter,
+			Year:        yq[0],
+			Quarter:     yq[1],
+		}
+		require.Nil(t, repo.Create(ctx, report))
+		require.NotEqual(t, uuid.Nil, report.ID)
+		reports[yq] = report
+
+		require.Nil(t, repo.Create(ctx, &domain.FinancialReport{
+			CompanyID:   other.ID,
+			Granularity: domain.GranularityQuarter,
+			Year:        yq[0],
+			Quarter:     yq[1],
+		}))
+	}
+
+	t.Run("чтение", func(t *testing.T) {
+		report := reports[[2]int{2021, 3}]
+
+		got, err := repo.GetById(ctx, report.ID)
+		require.Nil(t, err)
+		require.Equal(t, report, got)
+
+		_, err = repo.GetById(ctx, uuid.New())
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("отчеты за период", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			period   *domain.Period
+			expected [][2]int
+		}{
+			{
+				name:     "несколько лет",
+				period:   &domain.Period{StartYear: 2020, StartQuarter: 4, EndYear: 2022, EndQuarter: 1},
+				expected: [][2]int{{2020, 4}, {2021, 1}, {2021, 2}, {2021, 3}, {2022, 1}},
+			},
+			{
+				name:     "границы периода внутри года",
+				period:   &domain.Period{StartYear: 2021, StartQuarter: 2, EndYear: 2021, EndQuarter: 3},
+				expected: [][2]int{{2021, 2}, {2021, 3}},
+			},
+			{
+				name:     "один квартал",
+				period:   &domain.Period{StartYear: 2022, StartQuarter: 4, EndYear: 2022, EndQuarter: 4},
+				expected: [][2]int{{2022, 4}},
+			},
+			{
+				name:     "период без отчетов",
+				period:   &domain.Period{StartYear: 2023, StartQuarter: 1, EndYear: 2023, EndQuarter: 4},
+				expected: [][2]int{},
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				byPeriod, err := repo.GetByCompany(ctx, company.ID, tc.period)
+				require.Nil(t, err)
+				require.Equal(t, tc.period, byPeriod.Period)
+				require.NotNil(t, byPeriod.Reports)
+
+				got := make([][2]int, 0)
+				for _, report := range byPeriod.Reports {
+					require.Equal(t, *reports[[2]int{report.Year, report.Quarter}], report)
+					got = append(got, [2]int{report.Year, report.Quarter})
+				}
+				require.Equal(t, tc.expected, got)
+			})
+		}
+
+		byPeriod, err := repo.GetByCompany(ctx, uuid.New(), &domain.Period{StartYear: 2020, StartQuarter: 1, EndYear: 2022, EndQuarter: 4})
+		require.Nil(t, err)
+		require.NotNil(t, byPeriod.Reports)
+		require.Empty(t, byPeriod.Reports)
+	})
+
+	t.Run("отчеты нескольких компаний", func(t *testing.T) {
+		period := &domain.Period{StartYear: 2021, StartQuarter: 2, EndYear: 2022, EndQuarter: 1}
+		missing := uuid.New()
+
+		got, err := repo.GetByCompanies(ctx, []uuid.UUID{company.ID, other.ID, missing}, period)
+		require.Nil(t, err)
+		require.Len(t, got, 3)
+
+		for _, id := range []uuid.UUID{company.ID, other.ID, missing} {
+			single, err := repo.GetByCompany(ctx, id, period)
+			require.Nil(t, err)
+			require.Equal(t, single, got[id])
+		}
+		require.Len(t, got[company.ID].Reports, 3)
+		require.Empty(t, got[missing].Reports)
+
+		got, err = repo.GetByCompanies(ctx, nil, period)
+		require.Nil(t, err)
+		require.Empty(t, got)
+	})
+
+	t.Run("один отчет за квартал", func(t *testing.T) {
+		err := repo.Create(ctx, &domain.FinancialReport{
+			CompanyID:   company.ID,
+			Granularity: domain.GranularityQuarter,
+			Year:        2021,
+			Quarter:     1,
+		})
+		require.ErrorIs(t, err, domain.ErrConflict)
+
+		moved := *reports[[2]int{2022, 4}]
+		moved.Year, moved.Quarter = 2021, 1
+		require.ErrorIs(t, repo.Update(ctx, &moved), domain.ErrConflict)
+	})
+
+	t.Run("отчеты разной периодичности", func(t *testing.T) {
+		third := newCompany(t, repos, owner.ID, field.ID, "Гамма")
+		report := func(granularity string, year, quarter, month int) *domain.FinancialReport {
+			return &domain.FinancialReport{
+				CompanyID:   third.ID,
+				Revenue:     domain.NewMoney(100, domain.DefaultCurrency),
+				Costs:       domain.NewMoney(10, domain.DefaultCurrency),
+				Granularity: granularity,
+				Year:        year,
+				Quarter:     quarter,
+				Month:       month,
+			}
+		}
+		annual := report(domain.GranularityYear, 2019, 0, 0)
+		january := report(domain.GranularityMonth, 2020, 1, 1)
+		february := report(domain.GranularityMonth, 2020, 1, 2)
+		second := report(domain.GranularityQuarter, 2020, 2, 0)
+		// отчеты создаются не по порядку, чтобы проверить сортировку
+		for _, rep := range []*domain.FinancialReport{second, february, annual, january} {
+			require.Nil(t, repo.Create(ctx, rep))
+		}
+		err := repo.Create(ctx, report(domain.GranularityMonth, 2020, 1, 1))
+		require.ErrorIs(t, err, domain.ErrConflict)
+		require.Equal(t, "отчет компании за этот период уже существует", err.Error())
+
+		byPeriod, err := repo.GetByCompany(ctx, third.ID, &domain.Period{StartYear: 2019, StartQuarter: 1, EndYear: 2020, EndQuarter: 2})
+		require.Nil(t, err)
+		require.Equal(t, []domain.FinancialReport{*annual, *january, *february, *second}, byPeriod.Reports)
+
+		// годовой отчет входит только в период, покрывающий весь год
+		byPeriod, err = repo.GetByCompany(ctx, third.ID, &domain.Period{StartYear: 2019, StartQuarter: 2, EndYear: 2020, EndQuarter: 1})
+		require.Nil(t, err)
+		require.Equal(t, []domain.FinancialReport{*january, *february}, byPeriod.Reports)
+
+		got, err := repo.GetByCompanies(ctx, []uuid.UUID{third.ID}, &domain.Period{StartYear: 2019, StartQuarter: 1, EndYear: 2019, EndQuarter: 4})
+		require.Nil(t, err)
+		require.Equal(t, []domain.FinancialReport{*annual}, got[third.ID].Reports)
+	})
+
+	t.Run("обновление и удаление", func(t *testing.T) {
+		report := reports[[2]int{2020, 1}]
+		// суммы за пределами точности float32 и валюта сохраняются без искажений
+		report.Revenue = domain.Money{Amount: 50000000012, Currency: "USD"}
+		report.Costs = domain.Money{Amount: 2501, Currency: "USD"}
+		require.Nil(t, repo.Update(ctx, report))
+
+		got, err := repo.GetById(ctx, report.ID)
+		require.Nil(t, err)
+		require.Equal(t, report, got)
+
+		require.Nil(t, repo.DeleteById(ctx, report.ID))
+		_, err = repo.GetById(ctx, report.ID)
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		require.ErrorIs(t, repo.DeleteById(ctx, report.ID), domain.ErrNotFound)
+		require.ErrorIs(t, repo.Update(ctx, report), domain.ErrNotFound)
+	})
+
+	t.Run("блокировка года", func(t *testing.T) {
+		if repos.TxManager == nil {
+			t.Skip("менеджер транзакций не предоставлен")
+		}
+
+		locked := make(chan struct{})
+		release := make(chan struct{})
+		first := make(chan error, 1)
+		go func() {
+			first <- repos.TxManager.WithinTx(ctx, func(ctx context.Context) error {
+				err := repo.LockCompanyYear(ctx, company.ID, 2023)
+				if err != nil {
+					return err
+				}
+				close(locked)
+				<-release
+
+				return nil
+			})
+		}()
+		select {
+		case <-locked:
+		case err := <-first:
+			t.Fatalf("блокировка не получена: %v", err)
+		}
+
+		second := make(chan error, 1)
+		go func() {
+			second <- repos.TxManager.WithinTx(ctx, func(ctx context.Context) error {
+				return repo.LockCompanyYear(ctx, company.ID, 2023)
+			})
+		}()
+		select {
+		case err := <-second:
+			close(release)
+			t.Fatalf("вторая транзакция не дождалась первой: %v", err)
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		close(release)
+		require.Nil(t, <-first)
+		require.Nil(t, <-second)
+	})
+}
@@ -0,0 +1,58 @@
+package conformance
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func testRefreshTokens(t *testing.T, repos *Repositories) {
+	ctx := context.Background()
+	repo := repos.RefreshTokens
+
+	user := newUser(t, repos, "ivan")
+	token := &domain.RefreshToken{
+		ID:        uuid.New(),
+		UserId:    user.ID,
+		Username:  user.Username,
+		ExpiresAt: time.Now().Add(time.Hour).Truncate(time.Microsecond),
+	}
+
+	t.Run("создание и чтение", func(t *testing.T) {
+		require.Nil(t, repo.Create(ctx, token))
+		err := repo.Create(ctx, token)
+		require.ErrorIs(t, err, domain.ErrConflict)
+		require.Equal(t, "токен обновления с таким id уже существует", err.Error())
+
+		got, err := repo.GetById(ctx, token.ID)
+		require.Nil(t, err)
+		require.Equal(t, token.UserId, got.UserId)
+		require.Equal(t, token.Username, got.Username)
+		require.True(t, token.ExpiresAt.Equal(got.ExpiresAt))
+		require.False(t, got.Revoked)
+
+		_, err = repo.GetById(ctx, uuid.New())
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		require.Equal(t, "токен обновления не найден", err.Error())
+	})
+
+	t.Run("отзыв", func(t *testing.T) {
+		revoked, err := repo.IsRevoked(ctx, token.ID)
+		require.Nil(t, err)
+		require.False(t, revoked)
+
+		require.Nil(t, repo.Revoke(ctx, token.ID))
+		require.ErrorIs(t, repo.Revoke(ctx, token.ID), domain.ErrConflict)
+		require.ErrorIs(t, repo.Revoke(ctx, uuid.New()), domain.ErrNotFound)
+
+		revoked, err = repo.IsRevoked(ctx, token.ID)
+		require.Nil(t, err)
+		require.True(t, revoked)
+
+		_, err = repo.IsRevoked(ctx, uuid.New())
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
@@ -0,0 +1,64 @@
+package conformance
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testSkills(t *testing.T, repos *Repositories) {
+	ctx := context.Background()
+	repo := repos.Skills
+
+	t.Run("создание и чтение", func(t *testing.T) {
+		skill := newSkill(t, repos, "Go")
+
+		got, err := repo.GetById(ctx, skill.ID)
+		require.Nil(t, err)
+		require.Equal(t, skill, got)
+
+		skill.Description = "язык программирования"
+		require.Nil(t, repo.Update(ctx, skill))
+		got, err = repo.GetById(ctx, skill.ID)
+		require.Nil(t, err)
+		require.Equal(t, skill, got)
+
+		require.Nil(t, repo.DeleteById(ctx, skill.ID))
+		_, err = repo.GetById(ctx, skill.ID)
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("отсутствующий навык", func(t *testing.T) {
+		_, err := repo.GetById(ctx, uuid.New())
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		require.ErrorIs(t, repo.Update(ctx, &domain.Skill{ID: uuid.New()}), domain.ErrNotFound)
+		require.ErrorIs(t, repo.DeleteById(ctx, uuid.New()), domain.ErrNotFound)
+	})
+
+	t.Run("постраничный вывод", func(t *testing.T) {
+		expected := make([]*domain.Skill, 0)
+		for _, i := range shuffled(pageCount()) {
+			expected = append(expected, newSkill(t, repos, label("skill", i)))
+		}
+		sortByName(expected, func(s *domain.Skill) string { return s.Name }, func(s *domain.Skill) uuid.UUID { return s.ID })
+
+		checkPages(t, func(req domain.PageRequest) (*domain.Page[*domain.Skill], error) {
+			return repo.GetAll(ctx, req)
+		}, "name", expected, func(s *domain.Skill) string { return s.ID.String() })
+	})
+
+	t.Run("чтение по списку id", func(t *testing.T) {
+		first := newSkill(t, repos, "ids-1")
+		second := newSkill(t, repos, "ids-2")
+
+		got, err := repo.GetByIds(ctx, []uuid.UUID{second.ID, uuid.New(), first.ID, second.ID})
+		require.Nil(t, err)
+		require.ElementsMatch(t, []*domain.Skill{first, second}, got)
+
+		got, err = repo.GetByIds(ctx, nil)
+		require.Nil(t, err)
+		require.Empty(t, got)
+	})
+}
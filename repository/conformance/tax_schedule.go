@@ -0,0 +1,78 @@
+package conformance
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testTaxSchedules(t *testing.T, repos *Repositories) {
+	ctx := context.Background()
+	repo := repos.TaxSchedules
+
+	field := newActivityField(t, repos, "IT", 1)
+
+	newSchedule := func(jurisdiction string, fieldId uuid.UUID, year int) *domain.TaxSchedule {
+		return &domain.TaxSchedule{
+			Jurisdiction:    jurisdiction,
+			ActivityFieldId: fieldId,
+			Year:            year,
+			Method:          domain.TaxMethodMarginal,
+			Brackets: []domain.TaxBracket{
+				{Threshold: domain.NewMoney(0, "KZT"), Rate: 4},
+				{Threshold: domain.Money{Amount: 100000050, Currency: "KZT"}, Rate: 6.5},
+			},
+		}
+	}
+
+	t.Run("создание и чтение", func(t *testing.T) {
+		for _, schedule := range []*domain.TaxSchedule{newSchedule("KZ", uuid.Nil, 2020), newSchedule("KZ", field.ID, 2020)} {
+			require.Nil(t, repo.Create(ctx, schedule))
+			require.NotEqual(t, uuid.Nil, schedule.ID)
+
+			got, err := repo.GetById(ctx, schedule.ID)
+			require.Nil(t, err)
+			require.Equal(t, schedule, got)
+
+			require.Nil(t, repo.DeleteById(ctx, schedule.ID))
+			_, err = repo.GetById(ctx, schedule.ID)
+			require.ErrorIs(t, err, domain.ErrNotFound)
+		}
+	})
+
+	t.Run("отсутствующая шкала", func(t *testing.T) {
+		_, err := repo.GetById(ctx, uuid.New())
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		require.ErrorIs(t, repo.DeleteById(ctx, uuid.New()), domain.ErrNotFound)
+	})
+
+	t.Run("одна шкала на год", func(t *testing.T) {
+		require.Nil(t, repo.Create(ctx, newSchedule("BY", uuid.Nil, 2021)))
+		require.Nil(t, repo.Create(ctx, newSchedule("BY", field.ID, 2021)))
+		require.Nil(t, repo.Create(ctx, newSchedule("AM", uuid.Nil, 2021)))
+
+		require.ErrorIs(t, repo.Create(ctx, newSchedule("BY", uuid.Nil, 2021)), domain.ErrConflict)
+		require.ErrorIs(t, repo.Create(ctx, newSchedule("BY", field.ID, 2021)), domain.ErrConflict)
+	})
+
+	t.Run("шкалы юрисдикции", func(t *testing.T) {
+		expected := make([]*domain.TaxSchedule, 0)
+		for _, year := range []int{2024, 2019, 2022} {
+			schedule := newSchedule("RS", uuid.Nil, year)
+			require.Nil(t, repo.Create(ctx, schedule))
+			expected = append(expected, schedule)
+		}
+		require.Nil(t, repo.Create(ctx, newSchedule("ME", uuid.Nil, 2019)))
+
+		got, err := repo.GetByJurisdiction(ctx, "RS")
+		require.Nil(t, err)
+		require.Equal(t, []*domain.TaxSchedule{expected[1], expected[2], expected[0]}, got)
+
+		got, err = repo.GetByJurisdiction(ctx, "XX")
+		require.Nil(t, err)
+		require.NotNil(t, got)
+		require.Empty(t, got)
+	})
+}
@@ -0,0 +1,101 @@
+package conformance
+
+import (
+	"context"
+	"errors"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testTransactions(t *testing.T, repos *Repositories) {
+	ctx := context.Background()
+	errAbort := errors.New("abort")
+
+	user := newUser(t, repos, "ivan")
+	skill := newSkill(t, repos, "Go")
+	pair := &domain.UserSkill{UserId: user.ID, SkillId: skill.ID}
+	require.Nil(t, repos.UserSkills.Create(ctx, pair))
+
+	t.Run("фиксация", func(t *testing.T) {
+		var created *domain.User
+		err := repos.TxManager.WithinTx(ctx, func(ctx context.Context) error {
+			created = &domain.User{Username: "petr", Role: domain.RoleGuest}
+			err := repos.Users.Create(ctx, created)
+			if err != nil {
+				return err
+			}
+
+			got, err := repos.Users.GetById(ctx, created.ID)
+			if err != nil {
+				return err
+			}
+			require.Equal(t, "petr", got.Username)
+
+			return repos.UserSkills.Create(ctx, &domain.UserSkill{UserId: created.ID, SkillId: skill.ID})
+		})
+		require.Nil(t, err)
+
+		_, err = repos.Users.GetById(ctx, created.ID)
+		require.Nil(t, err)
+		pairs, err := repos.UserSkills.GetUserSkillsByUserId(ctx, created.ID, domain.PageRequest{})
+		require.Nil(t, err)
+		require.Len(t, pairs.Items, 1)
+	})
+
+	t.Run("откат", func(t *testing.T) {
+		var created *domain.User
+		err := repos.TxManager.WithinTx(ctx, func(ctx context.Context) error {
+			created = &domain.User{Username: "sidor"}
+			err := repos.Users.Create(ctx, created)
+			if err != nil {
+				return err
+			}
+
+			renamed := *skill
+			renamed.Name = "Golang"
+			err = repos.Skills.Update(ctx, &renamed)
+			if err != nil {
+				return err
+			}
+
+			err = repos.UserSkills.Delete(ctx, pair)
+			if err != nil {
+				return err
+			}
+
+			return errAbort
+		})
+		require.ErrorIs(t, err, errAbort)
+
+		_, err = repos.Users.GetById(ctx, created.ID)
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		_, err = repos.Users.GetByUsername(ctx, "sidor")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+
+		got, err := repos.Skills.GetById(ctx, skill.ID)
+		require.Nil(t, err)
+		require.Equal(t, "Go", got.Name)
+
+		pairs, err := repos.UserSkills.GetUserSkillsByUserId(ctx, user.ID, domain.PageRequest{})
+		require.Nil(t, err)
+		require.Equal(t, []*domain.UserSkill{pair}, pairs.Items)
+	})
+
+	t.Run("вложенная транзакция", func(t *testing.T) {
+		err := repos.TxManager.WithinTx(ctx, func(ctx context.Context) error {
+			err := repos.TxManager.WithinTx(ctx, func(ctx context.Context) error {
+				return repos.Users.Create(ctx, &domain.User{Username: "nested"})
+			})
+			if err != nil {
+				return err
+			}
+
+			return errAbort
+		})
+		require.ErrorIs(t, err, errAbort)
+
+		_, err = repos.Users.GetByUsername(ctx, "nested")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
@@ -0,0 +1,210 @@
+package conformance
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func testUsers(t *testing.T, repos *Repositories) {
+	ctx := context.Background()
+	repo := repos.Users
+
+	t.Run("создание и чтение", func(t *testing.T) {
+		user := newUser(t, repos, "ivan")
+
+		got, err := repo.GetById(ctx, user.ID)
+		require.Nil(t, err)
+		require.Equal(t, user.ID, got.ID)
+		require.Equal(t, user.Username, got.Username)
+		require.Equal(t, user.FullName, got.FullName)
+		require.Equal(t, user.Gender, got.Gender)
+		require.True(t, user.Birthday.Equal(got.Birthday))
+		require.Equal(t, user.City, got.City)
+		require.Equal(t, user.Role, got.Role)
+
+		got, err = repo.GetByUsername(ctx, "ivan")
+		require.Nil(t, err)
+		require.Equal(t, user.ID, got.ID)
+
+		got.City = "Казань"
+		stored, err := repo.GetById(ctx, user.ID)
+		require.Nil(t, err)
+		require.Equal(t, user.City, stored.City, "возвращенный объект должен быть копией")
+	})
+
+	t.Run("чтение по списку id", func(t *testing.T) {
+		first := newUser(t, repos, "ids-1")
+		second := newUser(t, repos, "ids-2")
+
+		got, err := repo.GetByIds(ctx, []uuid.UUID{second.ID, uuid.New(), first.ID, second.ID})
+		require.Nil(t, err)
+		ids := make([]uuid.UUID, len(got))
+		for i, user := range got {
+			ids[i] = user.ID
+		}
+		require.ElementsMatch(t, []uuid.UUID{first.ID, second.ID}, ids)
+
+		got, err = repo.GetByIds(ctx, nil)
+		require.Nil(t, err)
+		require.Empty(t, got)
+	})
+
+	t.Run("отсутствующий пользователь", func(t *testing.T) {
+		_, err := repo.GetById(ctx, uuid.New())
+		require.ErrorIs(t, err, domain.ErrNotFound)
+
+		_, err = repo.GetByUsername(ctx, "nobody")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+
+		require.ErrorIs(t, repo.Update(ctx, &domain.User{ID: uuid.New(), Username: "nobody"}), domain.ErrNotFound)
+		require.ErrorIs(t, repo.DeleteById(ctx, uuid.New()), domain.ErrNotFound)
+	})
+
+	t.Run("уникальность", func(t *testing.T) {
+		user := newUser(t, repos, "petr")
+
+		require.ErrorIs(t, repo.Create(ctx, &domain.User{Username: "petr"}), domain.ErrConflict)
+		require.ErrorIs(t, repo.Create(ctx, &domain.User{ID: user.ID, Username: "petr2"}), domain.ErrConflict)
+
+		other := newUser(t, repos, "sidor")
+		other.Username = "petr"
+		require.ErrorIs(t, repo.Update(ctx, other), domain.ErrConflict)
+	})
+
+	t.Run("обновление и удаление", func(t *testing.T) {
+		user := newUser(t, repos, "oleg")
+
+		user.Username = "oleg2"
+		user.City = "Казань"
+		require.Nil(t, repo.Update(ctx, user))
+
+		got, err := repo.GetByUsername(ctx, "oleg2")
+		require.Nil(t, err)
+		require.Equal(t, "Казань", got.City)
+		_, err = repo.GetByUsername(ctx, "oleg")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+
+		require.Nil(t, repo.DeleteById(ctx, user.ID))
+		_, err = repo.GetById(ctx, user.ID)
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		require.ErrorIs(t, repo.DeleteById(ctx, user.ID), domain.ErrNotFound)
+	})
+
+	t.Run("постраничный вывод", func(t *testing.T) {
+		all, err := repo.GetAll(ctx, domain.UserFilter{}, domain.PageRequest{Limit: domain.MaxPageSize})
+		require.Nil(t, err)
+		for _, user := range all.Items {
+			require.Nil(t, repo.DeleteById(ctx, user.ID))
+		}
+		all, err = repo.GetAll(ctx, domain.UserFilter{}, domain.PageRequest{})
+		require.Nil(t, err)
+		require.Empty(t, all.Items)
+		require.Zero(t, all.Total)
+
+		expected := make([]*domain.User, 0)
+		for _, i := range shuffled(pageCount()) {
+			expected = append(expected, newUser(t, repos, label("user", i)))
+		}
+		sortByName(expected, func(u *domain.User) string { return u.Username }, func(u *domain.User) uuid.UUID { return u.ID })
+
+		checkPages(t, func(req domain.PageRequest) (*domain.Page[*domain.User], error) {
+			return repo.GetAll(ctx, domain.UserFilter{}, req)
+		}, "username", expected, func(u *domain.User) string { return u.Username })
+	})
+	t.Run("фильтрация", func(t *testing.T) {
+		all, err := repo.GetAll(ctx, domain.UserFilter{}, domain.PageRequest{Limit: domain.MaxPageSize})
+		require.Nil(t, err)
+		for _, user := range all.Items {
+			require.Nil(t, repo.DeleteById(ctx, user.ID))
+		}
+
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+		create := func(username, fullName, gender, city string, age int) *domain.User {
+			user := &domain.User{
+				Username: username,
+				FullName: fullName,
+				Gender:   gender,
+				Birthday: today.AddDate(-age, 0, 0),
+				City:     city,
+			}
+			require.Nil(t, repo.Create(ctx, user))
+			return user
+		}
+		ivan := create("ivan", "Иванов Иван Иванович", "m", "Москва", 30)
+		maria := create("maria", "Иванова Мария Петровна", "w", "Казань", 25)
+		petr := create("petr", "Петров Петр Петрович", "m", "Казань", 41)
+		require.Nil(t, repo.Create(ctx, &domain.User{Username: "guest"}))
+
+		testCases := []struct {
+			name     string
+			filter   domain.UserFilter
+			expected []*domain.User
+		}{
+			{
+				name:     "по городу",
+				filter:   domain.UserFilter{City: "Казань"},
+				expected: []*domain.User{maria, petr},
+			},
+			{
+				name:     "по полу",
+				filter:   domain.UserFilter{Gender: "m"},
+				expected: []*domain.User{ivan, petr},
+			},
+			{
+				name:     "минимальный возраст включает день рождения",
+				filter:   domain.UserFilter{MinAge: 30},
+				expected: []*domain.User{ivan, petr},
+			},
+			{
+				name:     "максимальный возраст",
+				filter:   domain.UserFilter{MaxAge: 29},
+				expected: []*domain.User{maria},
+			},
+			{
+				name:     "диапазон возраста",
+				filter:   domain.UserFilter{MinAge: 25, MaxAge: 30},
+				expected: []*domain.User{ivan, maria},
+			},
+			{
+				name:     "поиск по ФИО без учета регистра",
+				filter:   domain.UserFilter{Search: "ИВАНОВ"},
+				expected: []*domain.User{ivan, maria},
+			},
+			{
+				name:     "поиск по логину",
+				filter:   domain.UserFilter{Search: "PET"},
+				expected: []*domain.User{petr},
+			},
+			{
+				name:     "несколько условий",
+				filter:   domain.UserFilter{City: "Казань", Gender: "w", Search: "мария"},
+				expected: []*domain.User{maria},
+			},
+			{
+				name:   "ничего не найдено",
+				filter: domain.UserFilter{City: "Тверь"},
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				page, err := repo.GetAll(ctx, tc.filter, domain.PageRequest{})
+				require.Nil(t, err)
+				require.Equal(t, len(tc.expected), page.Total)
+
+				got := make([]string, 0, len(page.Items))
+				for _, user := range page.Items {
+					got = append(got, user.Username)
+				}
+				want := make([]string, 0, len(tc.expected))
+				for _, user := range tc.expected {
+					want = append(want, user.Username)
+				}
+				require.Equal(t, want, got)
+			})
+		}
+	})
+}
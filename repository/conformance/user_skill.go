@@ -0,0 +1,56 @@
+package conformance
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testUserSkills(t *testing.T, repos *Repositories) {
+	ctx := context.Background()
+	repo := repos.UserSkills
+
+	user := newUser(t, repos, "ivan")
+	skill := newSkill(t, repos, "Go")
+
+	t.Run("уникальность пары", func(t *testing.T) {
+		pair := &domain.UserSkill{UserId: user.ID, SkillId: skill.ID}
+		require.Nil(t, repo.Create(ctx, pair))
+		require.ErrorIs(t, repo.Create(ctx, pair), domain.ErrConflict)
+
+		require.Nil(t, repo.Delete(ctx, pair))
+		require.ErrorIs(t, repo.Delete(ctx, pair), domain.ErrNotFound)
+	})
+
+	t.Run("постраничный вывод", func(t *testing.T) {
+		bySkill := make([]*domain.UserSkill, 0)
+		byUser := make([]*domain.UserSkill, 0)
+		for _, i := range shuffled(pageCount()) {
+			pair := &domain.UserSkill{UserId: newUser(t, repos, label("user", i)).ID, SkillId: skill.ID}
+			require.Nil(t, repo.Create(ctx, pair))
+			bySkill = append(bySkill, pair)
+
+			pair = &domain.UserSkill{UserId: user.ID, SkillId: newSkill(t, repos, label("skill", i)).ID}
+			require.Nil(t, repo.Create(ctx, pair))
+			byUser = append(byUser, pair)
+		}
+		sortByName(bySkill,
+			func(p *domain.UserSkill) string { return "" },
+			func(p *domain.UserSkill) uuid.UUID { return p.UserId },
+		)
+		sortByName(byUser,
+			func(p *domain.UserSkill) string { return "" },
+			func(p *domain.UserSkill) uuid.UUID { return p.SkillId },
+		)
+
+		checkPages(t, func(req domain.PageRequest) (*domain.Page[*domain.UserSkill], error) {
+			return repo.GetUserSkillsBySkillId(ctx, skill.ID, req)
+		}, "user_id", bySkill, func(p *domain.UserSkill) string { return p.UserId.String() + p.SkillId.String() })
+
+		checkPages(t, func(req domain.PageRequest) (*domain.Page[*domain.UserSkill], error) {
+			return repo.GetUserSkillsByUserId(ctx, user.ID, req)
+		}, "skill_id", byUser, func(p *domain.UserSkill) string { return p.UserId.String() + p.SkillId.String() })
+	})
+}
@@ -0,0 +1,122 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"strings"
+	"sync"
+)
+
+type ActivityFieldRepository struct {
+	mu     sync.RWMutex
+	fields map[uuid.UUID]domain.ActivityField
+}
+
+func NewActivityFieldRepository() domain.IActivityFieldRepository {
+	return &ActivityFieldRepository{
+		fields: make(map[uuid.UUID]domain.ActivityField),
+	}
+}
+
+func (r *ActivityFieldRepository) Create(ctx context.Context, data *domain.ActivityField) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if data.ID == uuid.Nil {
+		data.ID = uuid.New()
+	}
+
+	if _, ok := r.fields[data.ID]; ok {
+		return domain.NewError(domain.ErrConflict, i18n.StorageIdExists)
+	}
+	remember(ctx, &r.mu, r.fields, data.ID)
+	r.fields[data.ID] = *data
+
+	return nil
+}
+
+func (r *ActivityFieldRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.fields[id]; !ok {
+		return domain.NewError(domain.ErrNotFound, i18n.StorageActivityFieldNotFound)
+	}
+	remember(ctx, &r.mu, r.fields, id)
+	delete(r.fields, id)
+
+	return nil
+}
+
+func (r *ActivityFieldRepository) Update(ctx context.Context, data *domain.ActivityField) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.fields[data.ID]; !ok {
+		return domain.NewError(domain.ErrNotFound, i18n.StorageActivityFieldNotFound)
+	}
+	remember(ctx, &r.mu, r.fields, data.ID)
+	r.fields[data.ID] = *data
+
+	return nil
+}
+
+func (r *ActivityFieldRepository) GetById(_ context.Context, id uuid.UUID) (*domain.ActivityField, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	field, ok := r.fields[id]
+	if !ok {
+		return nil, domain.NewError(domain.ErrNotFound, i18n.StorageActivityFieldNotFound)
+	}
+
+	return &field, nil
+}
+
+func (r *ActivityFieldRepository) GetByIds(_ context.Context, ids []uuid.UUID) ([]*domain.ActivityField, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return pick(r.fields, ids), nil
+}
+
+func (r *ActivityFieldRepository) GetMaxCost(_ context.Context) (float32, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if len(r.fields) == 0 {
+		return 0, domain.NewError(domain.ErrNotFound, i18n.StorageActivityFieldsEmpty)
+	}
+
+	first := true
+	var maxCost float32
+	for _, field := range r.fields {
+		if first || field.Cost > maxCost {
+			maxCost = field.Cost
+			first = false
+		}
+	}
+
+	return maxCost, nil
+}
+
+var activityFieldComparators = comparators[*domain.ActivityField]{
+	"name": func(a, b *domain.ActivityField) int { return strings.Compare(a.Name, b.Name) },
+	"cost": func(a, b *domain.ActivityField) int { return cmp.Compare(a.Cost, b.Cost) },
+}
+
+func (r *ActivityFieldRepository) GetAll(_ context.Context, req domain.PageRequest) (*domain.Page[*domain.ActivityField], error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	fields := make([]*domain.ActivityField, 0, len(r.fields))
+	for _, field := range r.fields {
+		field := field
+		fields = append(fields, &field)
+	}
+
+	return paginate(fields, req, domain.ActivityFieldSortFields, activityFieldComparators, func(field *domain.ActivityField) uuid.UUID { return field.ID })
+}
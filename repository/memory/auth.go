@@ -0,0 +1,60 @@
+package memory
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"sync"
+)
+
+type AuthRepository struct {
+	mu    sync.RWMutex
+	users map[string]domain.UserAuth
+}
+
+func NewAuthRepository() domain.IAuthRepository {
+	return &AuthRepository{
+		users: make(map[string]domain.UserAuth),
+	}
+}
+
+func (r *AuthRepository) Register(ctx context.Context, authInfo *domain.UserAuth) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if authInfo.ID == uuid.Nil {
+		authInfo.ID = uuid.New()
+	}
+	if authInfo.Role == "" {
+		authInfo.Role = domain.RoleGuest
+	}
+
+	if _, ok := r.users[authInfo.Username]; ok {
+		return domain.NewError(domain.ErrConflict, i18n.StorageUsernameTaken)
+	}
+	for _, user := range r.users {
+		if user.ID == authInfo.ID {
+			return domain.NewError(domain.ErrConflict, i18n.StorageIdExists)
+		}
+	}
+
+	stored := *authInfo
+	stored.Password = ""
+	remember(ctx, &r.mu, r.users, authInfo.Username)
+	r.users[authInfo.Username] = stored
+
+	return nil
+}
+
+func (r *AuthRepository) GetByUsername(_ context.Context, username string) (*domain.UserAuth, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	authInfo, ok := r.users[username]
+	if !ok {
+		return nil, domain.NewError(domain.ErrNotFound, i18n.StorageUserNotFound)
+	}
+
+	return &authInfo, nil
+}
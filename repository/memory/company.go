@@ -0,0 +1,106 @@
+package memory
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"strings"
+	"sync"
+)
+
+type CompanyRepository struct {
+	mu        sync.RWMutex
+	companies map[uuid.UUID]domain.Company
+}
+
+func NewCompanyRepository() domain.ICompanyRepository {
+	return &CompanyRepository{
+		companies: make(map[uuid.UUID]domain.Company),
+	}
+}
+
+func (r *CompanyRepository) Create(ctx context.Context, company *domain.Company) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if company.ID == uuid.Nil {
+		company.ID = uuid.New()
+	}
+
+	if _, ok := r.companies[company.ID]; ok {
+		return domain.NewError(domain.ErrConflict, i18n.StorageIdExists)
+	}
+	remember(ctx, &r.mu, r.companies, company.ID)
+	r.companies[company.ID] = *company
+
+	return nil
+}
+
+func (r *CompanyRepository) GetById(_ context.Context, id uuid.UUID) (*domain.Company, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	company, ok := r.companies[id]
+	if !ok {
+		return nil, domain.NewError(domain.ErrNotFound, i18n.StorageCompanyNotFound)
+	}
+
+	return &company, nil
+}
+
+var companyComparators = comparators[*domain.Company]{
+	"name": func(a, b *domain.Company) int { return strings.Compare(a.Name, b.Name) },
+	"city": func(a, b *domain.Company) int { return strings.Compare(a.City, b.City) },
+}
+
+func (r *CompanyRepository) GetByOwnerId(_ context.Context, id uuid.UUID, req domain.PageRequest) (*domain.Page[*domain.Company], error) {
+	return r.list(req, func(company *domain.Company) bool {
+		return company.OwnerID == id
+	})
+}
+
+func (r *CompanyRepository) GetAll(_ context.Context, filter domain.CompanyFilter, req domain.PageRequest) (*domain.Page[*domain.Company], error) {
+	return r.list(req, companyMatcher(filter))
+}
+
+func (r *CompanyRepository) list(req domain.PageRequest, match func(*domain.Company) bool) (*domain.Page[*domain.Company], error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	companies := make([]*domain.Company, 0)
+	for _, company := range r.companies {
+		company := company
+		if match(&company) {
+			companies = append(companies, &company)
+		}
+	}
+
+	return paginate(companies, req, domain.CompanySortFields, companyComparators, func(company *domain.Company) uuid.UUID { return company.ID })
+}
+
+func (r *CompanyRepository) Update(ctx context.Context, company *domain.Company) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.companies[company.ID]; !ok {
+		return domain.NewError(domain.ErrNotFound, i18n.StorageCompanyNotFound)
+	}
+	remember(ctx, &r.mu, r.companies, company.ID)
+	r.companies[company.ID] = *company
+
+	return nil
+}
+
+func (r *CompanyRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.companies[id]; !ok {
+		return domain.NewError(domain.ErrNotFound, i18n.StorageCompanyNotFound)
+	}
+	remember(ctx, &r.mu, r.companies, id)
+	delete(r.companies, id)
+
+	return nil
+}
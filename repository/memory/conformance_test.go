@@ -0,0 +1,24 @@
+package memory
+
+import (
+	"github.com/dlankinl/bmstu-ppo-bl/repository/conformance"
+	"testing"
+)
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, func(t *testing.T) *conformance.Repositories {
+		return &conformance.Repositories{
+			Users:            NewUserRepository(),
+			Auth:             NewAuthRepository(),
+			ActivityFields:   NewActivityFieldRepository(),
+			Companies:        NewCompanyRepository(),
+			FinancialReports: NewFinancialReportRepository(),
+			Skills:           NewSkillRepository(),
+			UserSkills:       NewUserSkillRepository(),
+			Contacts:         NewContactRepository(),
+			RefreshTokens:    NewRefreshTokenRepository(),
+			TaxSchedules:     NewTaxScheduleRepository(),
+			TxManager:        NewTxManager(),
+		}
+	})
+}
@@ -0,0 +1,95 @@
+package memory
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"strings"
+	"sync"
+)
+
+type ContactRepository struct {
+	mu       sync.RWMutex
+	contacts map[uuid.UUID]domain.Contact
+}
+
+func NewContactRepository() domain.IContactsRepository {
+	return &ContactRepository{
+		contacts: make(map[uuid.UUID]domain.Contact),
+	}
+}
+
+func (r *ContactRepository) Create(ctx context.Context, contact *domain.Contact) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if contact.ID == uuid.Nil {
+		contact.ID = uuid.New()
+	}
+
+	if _, ok := r.contacts[contact.ID]; ok {
+		return domain.NewError(domain.ErrConflict, i18n.StorageIdExists)
+	}
+	remember(ctx, &r.mu, r.contacts, contact.ID)
+	r.contacts[contact.ID] = *contact
+
+	return nil
+}
+
+func (r *ContactRepository) GetById(_ context.Context, id uuid.UUID) (*domain.Contact, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	contact, ok := r.contacts[id]
+	if !ok {
+		return nil, domain.NewError(domain.ErrNotFound, i18n.StorageContactNotFound)
+	}
+
+	return &contact, nil
+}
+
+var contactComparators = comparators[*domain.Contact]{
+	"name": func(a, b *domain.Contact) int { return strings.Compare(a.Name, b.Name) },
+}
+
+func (r *ContactRepository) GetByOwnerId(_ context.Context, id uuid.UUID, req domain.PageRequest) (*domain.Page[*domain.Contact], error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	contacts := make([]*domain.Contact, 0)
+	for _, contact := range r.contacts {
+		contact := contact
+		if contact.OwnerID == id {
+			contacts = append(contacts, &contact)
+		}
+	}
+
+	return paginate(contacts, req, domain.ContactSortFields, contactComparators, func(contact *domain.Contact) uuid.UUID { return contact.ID })
+}
+
+func (r *ContactRepository) Update(ctx context.Context, contact *domain.Contact) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.contacts[contact.ID]; !ok {
+		return domain.NewError(domain.ErrNotFound, i18n.StorageContactNotFound)
+	}
+	remember(ctx, &r.mu, r.contacts, contact.ID)
+	r.contacts[contact.ID] = *contact
+
+	return nil
+}
+
+func (r *ContactRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.contacts[id]; !ok {
+		return domain.NewError(domain.ErrNotFound, i18n.StorageContactNotFound)
+	}
+	remember(ctx, &r.mu, r.contacts, id)
+	delete(r.contacts, id)
+
+	return nil
+}
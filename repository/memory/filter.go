@@ -0,0 +1,81 @@
+package memory
+
+import (
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+func userMatcher(filter domain.UserFilter, now time.Time) func(*domain.User) bool {
+	from, to := filter.BirthdayRange(now)
+	search := domain.FoldSearch(filter.Search)
+
+	return func(user *domain.User) bool {
+		if filter.City != "" && user.City != filter.City {
+			return false
+		}
+		if filter.Gender != "" && user.Gender != filter.Gender {
+			return false
+		}
+		if (!from.IsZero() || !to.IsZero()) && user.Birthday.IsZero() {
+			return false
+		}
+		if !from.IsZero() && user.Birthday.Before(from) {
+			return false
+		}
+		if !to.IsZero() && user.Birthday.After(to) {
+			return false
+		}
+
+		return containsFold(search, user.Username, user.FullName)
+	}
+}
+
+func companyMatcher(filter domain.CompanyFilter) func(*domain.Company) bool {
+	search := domain.FoldSearch(filter.Search)
+
+	return func(company *domain.Company) bool {
+		if filter.City != "" && company.City != filter.City {
+			return false
+		}
+		if filter.ActivityFieldId != uuid.Nil && company.ActivityFieldId != filter.ActivityFieldId {
+			return false
+		}
+		if filter.OwnerId != uuid.Nil && company.OwnerID != filter.OwnerId {
+			return false
+		}
+
+		return containsFold(search, company.Name)
+	}
+}
+
+func containsFold(search string, values ...string) bool {
+	if search == "" {
+		return true
+	}
+
+	for _, value := range values {
+		if strings.Contains(domain.FoldSearch(value), search) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pick возвращает копии записей с указанными id, повторы и отсутствующие id пропускаются.
+func pick[T any](items map[uuid.UUID]T, ids []uuid.UUID) []*T {
+	picked := make([]*T, 0, len(ids))
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		item, ok := items[id]
+		if !ok || seen[id] {
+			continue
+		}
+		seen[id] = true
+		picked = append(picked, &item)
+	}
+
+	return picked
+}
@@ -0,0 +1,145 @@
+package memory
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"sort"
+	"sync"
+)
+
+type FinancialReportRepository struct {
+	mu      sync.RWMutex
+	reports map[uuid.UUID]domain.FinancialReport
+}
+
+func NewFinancialReportRepository() domain.IFinancialReportRepository {
+	return &FinancialReportRepository{
+		reports: make(map[uuid.UUID]domain.FinancialReport),
+	}
+}
+
+// hasPeriod проверяет, есть ли у компании другой отчет за тот же период. Пересечение периодов разной
+// периодичности проверяет сервис, хранилище запрещает только повтор.
+func (r *FinancialReportRepository) hasPeriod(report *domain.FinancialReport) bool {
+	for _, stored := range r.reports {
+		if stored.ID != report.ID && stored.CompanyID == report.CompanyID && stored.Year == report.Year &&
+			stored.Quarter == report.Quarter && stored.Month == report.Month {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *FinancialReportRepository) Create(ctx context.Context, finRep *domain.FinancialReport) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if finRep.ID == uuid.Nil {
+		finRep.ID = uuid.New()
+	}
+
+	if _, ok := r.reports[finRep.ID]; ok {
+		return domain.NewError(domain.ErrConflict, i18n.StorageIdExists)
+	}
+	if r.hasPeriod(finRep) {
+		return domain.NewError(domain.ErrConflict, i18n.StorageFinReportExists)
+	}
+	remember(ctx, &r.mu, r.reports, finRep.ID)
+	r.reports[finRep.ID] = *finRep
+
+	return nil
+}
+
+func (r *FinancialReportRepository) GetById(_ context.Context, id uuid.UUID) (*domain.FinancialReport, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	report, ok := r.reports[id]
+	if !ok {
+		return nil, domain.NewError(domain.ErrNotFound, i18n.StorageFinReportNotFound)
+	}
+
+	return &report, nil
+}
+
+func (r *FinancialReportRepository) GetByCompany(_ context.Context, companyId uuid.UUID, period *domain.Period) (
+	*domain.FinancialReportByPeriod, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.byPeriod(companyId, period), nil
+}
+
+func (r *FinancialReportRepository) GetByCompanies(_ context.Context, companyIds []uuid.UUID, period *domain.Period) (
+	map[uuid.UUID]*domain.FinancialReportByPeriod, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	reports := make(map[uuid.UUID]*domain.FinancialReportByPeriod, len(companyIds))
+	for _, companyId := range companyIds {
+		reports[companyId] = r.byPeriod(companyId, period)
+	}
+
+	return reports, nil
+}
+
+func (r *FinancialReportRepository) byPeriod(companyId uuid.UUID, period *domain.Period) *domain.FinancialReportByPeriod {
+	byPeriod := &domain.FinancialReportByPeriod{
+		Reports: make([]domain.FinancialReport, 0),
+		Period:  period,
+	}
+	for _, report := range r.reports {
+		if report.CompanyID == companyId && period.Contains(&report) {
+			byPeriod.Reports = append(byPeriod.Reports, report)
+		}
+	}
+	sort.Slice(byPeriod.Reports, func(i, j int) bool {
+		a, b := byPeriod.Reports[i], byPeriod.Reports[j]
+		if a.Year != b.Year {
+			return a.Year < b.Year
+		}
+		if a.Quarter != b.Quarter {
+			return a.Quarter < b.Quarter
+		}
+		return a.Month < b.Month
+	})
+
+	return byPeriod
+}
+
+func (r *FinancialReportRepository) Update(ctx context.Context, finRep *domain.FinancialReport) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.reports[finRep.ID]; !ok {
+		return domain.NewError(domain.ErrNotFound, i18n.StorageFinReportNotFound)
+	}
+	if r.hasPeriod(finRep) {
+		return domain.NewError(domain.ErrConflict, i18n.StorageFinReportExists)
+	}
+	remember(ctx, &r.mu, r.reports, finRep.ID)
+	r.reports[finRep.ID] = *finRep
+
+	return nil
+}
+
+// LockCompanyYear ничего не делает: транзакции хранилища в памяти и так выполняются по одной.
+func (r *FinancialReportRepository) LockCompanyYear(context.Context, uuid.UUID, int) error {
+	return nil
+}
+
+func (r *FinancialReportRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.reports[id]; !ok {
+		return domain.NewError(domain.ErrNotFound, i18n.StorageFinReportNotFound)
+	}
+	remember(ctx, &r.mu, r.reports, id)
+	delete(r.reports, id)
+
+	return nil
+}
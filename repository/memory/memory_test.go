@@ -0,0 +1,301 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+)
+
+func TestUserRepository_Unique(t *testing.T) {
+	ctx := context.Background()
+	repo := NewUserRepository()
+
+	ivan := &domain.User{Username: "ivan"}
+	require.Nil(t, repo.Create(ctx, ivan))
+	require.NotEqual(t, uuid.Nil, ivan.ID)
+	require.Equal(t, domain.RoleGuest, ivan.Role)
+
+	err := repo.Create(ctx, &domain.User{Username: "ivan"})
+	require.ErrorIs(t, err, domain.ErrConflict)
+	require.Equal(t, "пользователь с таким именем уже существует", err.Error())
+
+	petr := &domain.User{Username: "petr"}
+	require.Nil(t, repo.Create(ctx, petr))
+	require.ErrorIs(t, repo.Create(ctx, &domain.User{ID: petr.ID, Username: "sidor"}), domain.ErrConflict)
+
+	petr.Username = "ivan"
+	require.ErrorIs(t, repo.Update(ctx, petr), domain.ErrConflict)
+
+	ivan.Username = "ivan2"
+	require.Nil(t, repo.Update(ctx, ivan))
+	_, err = repo.GetByUsername(ctx, "ivan")
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	got, err := repo.GetByUsername(ctx, "ivan2")
+	require.Nil(t, err)
+	require.Equal(t, ivan, got)
+
+	got.City = "Москва"
+	stored, err := repo.GetById(ctx, ivan.ID)
+	require.Nil(t, err)
+	require.Empty(t, stored.City)
+}
+
+func TestAuthRepository_Unique(t *testing.T) {
+	ctx := context.Background()
+	repo := NewAuthRepository()
+
+	authInfo := &domain.UserAuth{Username: "ivan", Password: "pass", HashedPass: "hash"}
+	require.Nil(t, repo.Register(ctx, authInfo))
+	require.ErrorIs(t, repo.Register(ctx, &domain.UserAuth{Username: "ivan"}), domain.ErrConflict)
+
+	got, err := repo.GetByUsername(ctx, "ivan")
+	require.Nil(t, err)
+	require.Equal(t, authInfo.ID, got.ID)
+	require.Equal(t, "hash", got.HashedPass)
+	require.Empty(t, got.Password)
+}
+
+func TestUserSkillRepository_Unique(t *testing.T) {
+	ctx := context.Background()
+	repo := NewUserSkillRepository()
+
+	pair := &domain.UserSkill{UserId: uuid.UUID{1}, SkillId: uuid.UUID{2}}
+	require.Nil(t, repo.Create(ctx, pair))
+	require.ErrorIs(t, repo.Create(ctx, pair), domain.ErrConflict)
+	require.Nil(t, repo.Create(ctx, &domain.UserSkill{UserId: uuid.UUID{1}, SkillId: uuid.UUID{1}}))
+
+	pairs, err := repo.GetUserSkillsByUserId(ctx, uuid.UUID{1}, domain.PageRequest{})
+	require.Nil(t, err)
+	require.Equal(t, []*domain.UserSkill{
+		{UserId: uuid.UUID{1}, SkillId: uuid.UUID{1}},
+		{UserId: uuid.UUID{1}, SkillId: uuid.UUID{2}},
+	}, pairs.Items)
+
+	require.Nil(t, repo.Delete(ctx, pair))
+	require.ErrorIs(t, repo.Delete(ctx, pair), domain.ErrNotFound)
+}
+
+func TestFinancialReportRepository_GetByCompany(t *testing.T) {
+	ctx := context.Background()
+	repo := NewFinancialReportRepository()
+
+	for _, yq := range [][2]int{{2022, 4}, {2021, 1}, {2021, 3}, {2020, 4}, {2022, 1}} {
+		report := &domain.FinancialReport{CompanyID: uuid.UUID{1}, Year: yq[0], Quarter: yq[1]}
+		require.Nil(t, repo.Create(ctx, report))
+	}
+	require.Nil(t, repo.Create(ctx, &domain.FinancialReport{CompanyID: uuid.UUID{2}, Year: 2021, Quarter: 2}))
+
+	err := repo.Create(ctx, &domain.FinancialReport{CompanyID: uuid.UUID{1}, Year: 2021, Quarter: 1})
+	require.ErrorIs(t, err, domain.ErrConflict)
+
+	period := &domain.Period{StartYear: 2021, StartQuarter: 1, EndYear: 2022, EndQuarter: 1}
+	byPeriod, err := repo.GetByCompany(ctx, uuid.UUID{1}, period)
+	require.Nil(t, err)
+	require.Equal(t, period, byPeriod.Period)
+
+	quarters := make([][2]int, 0)
+	for _, report := range byPeriod.Reports {
+		quarters = append(quarters, [2]int{report.Year, report.Quarter})
+	}
+	require.Equal(t, [][2]int{{2021, 1}, {2021, 3}, {2022, 1}}, quarters)
+}
+
+func TestCompanyRepository_Pagination(t *testing.T) {
+	ctx := context.Background()
+	repo := NewCompanyRepository()
+
+	for i := 0; i < domain.PageSize+3; i++ {
+		company := &domain.Company{OwnerID: uuid.UUID{1}, Name: fmt.Sprintf("компания %02d", i)}
+		require.Nil(t, repo.Create(ctx, company))
+	}
+	require.Nil(t, repo.Create(ctx, &domain.Company{OwnerID: uuid.UUID{2}, Name: "чужая"}))
+
+	testCases := []struct {
+		name     string
+		req      domain.PageRequest
+		expected []string
+		next     bool
+	}{
+		{
+			name:     "первая страница",
+			expected: []string{"компания 00", "компания 09"},
+			next:     true,
+		},
+		{
+			name:     "последняя неполная страница",
+			req:      domain.PageRequest{Offset: domain.PageSize},
+			expected: []string{"компания 10", "компания 12"},
+		},
+		{
+			name:     "обратная сортировка",
+			req:      domain.PageRequest{Sort: "-name", Limit: 5},
+			expected: []string{"компания 12", "компания 08"},
+			next:     true,
+		},
+		{
+			name: "смещение за пределами списка",
+			req:  domain.PageRequest{Offset: 3 * domain.PageSize},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			companies, err := repo.GetByOwnerId(ctx, uuid.UUID{1}, tc.req)
+			require.Nil(t, err)
+			require.NotNil(t, companies.Items)
+			require.Equal(t, domain.PageSize+3, companies.Total)
+			require.Equal(t, tc.next, companies.NextCursor != "")
+
+			if tc.expected == nil {
+				require.Empty(t, companies.Items)
+				return
+			}
+			require.Equal(t, tc.expected[0], companies.Items[0].Name)
+			require.Equal(t, tc.expected[1], companies.Items[len(companies.Items)-1].Name)
+		})
+	}
+}
+
+func TestActivityFieldRepository_GetMaxCost(t *testing.T) {
+	ctx := context.Background()
+	repo := NewActivityFieldRepository()
+
+	_, err := repo.GetMaxCost(ctx)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+
+	for _, cost := range []float32{1.5, 13.5, 5} {
+		require.Nil(t, repo.Create(ctx, &domain.ActivityField{Cost: cost}))
+	}
+
+	maxCost, err := repo.GetMaxCost(ctx)
+	require.Nil(t, err)
+	require.Equal(t, float32(13.5), maxCost)
+}
+
+func TestSkillRepository_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	repo := NewSkillRepository()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			skill := &domain.Skill{Name: fmt.Sprintf("навык %02d", i)}
+			require.Nil(t, repo.Create(ctx, skill))
+			skill.Description = "описание"
+			require.Nil(t, repo.Update(ctx, skill))
+			_, err := repo.GetAll(ctx, domain.PageRequest{})
+			require.Nil(t, err)
+		}(i)
+	}
+	wg.Wait()
+
+	skills, err := repo.GetAll(ctx, domain.PageRequest{Offset: 40})
+	require.Nil(t, err)
+	require.Len(t, skills.Items, domain.PageSize)
+	require.Equal(t, 50, skills.Total)
+	require.Equal(t, "навык 40", skills.Items[0].Name)
+}
+
+func TestSearchIndex_Search(t *testing.T) {
+	ctx := context.Background()
+	index := NewSearchIndex()
+
+	golang := &domain.SearchDocument{Kind: domain.SearchKindSkill, ID: uuid.UUID{1}, Title: "Go", Text: "разработка серверных приложений"}
+	design := &domain.SearchDocument{Kind: domain.SearchKindSkill, ID: uuid.UUID{2}, Title: "Дизайн", Text: "разработка интерфейсов и макетов"}
+	it := &domain.SearchDocument{Kind: domain.SearchKindActivityField, ID: uuid.UUID{3}, Title: "Разработка ПО", Text: "software development"}
+	tree := &domain.SearchDocument{Kind: domain.SearchKindCompany, ID: uuid.UUID{4}, Title: "Ёлки-палки", Text: "Москва"}
+	for _, doc := range []*domain.SearchDocument{golang, design, it, tree} {
+		require.Nil(t, index.Put(ctx, doc))
+	}
+
+	testCases := []struct {
+		name     string
+		query    string
+		kinds    []domain.SearchKind
+		req      domain.PageRequest
+		expected []uuid.UUID
+	}{
+		{
+			name:     "совпадение в названии выше совпадения в описании",
+			query:    "РАЗРАБОТКА",
+			expected: []uuid.UUID{it.ID, golang.ID, design.ID},
+		},
+		{
+			name:     "фильтр по типу",
+			query:    "разработки",
+			kinds:    []domain.SearchKind{domain.SearchKindSkill},
+			expected: []uuid.UUID{golang.ID, design.ID},
+		},
+		{
+			name:     "английский стемминг",
+			query:    "developers",
+			expected: []uuid.UUID{it.ID},
+		},
+		{
+			name:     "ё и е совпадают",
+			query:    "елки",
+			expected: []uuid.UUID{tree.ID},
+		},
+		{
+			name:     "несколько слов",
+			query:    "разработка интерфейсов",
+			req:      domain.PageRequest{Limit: 1},
+			expected: []uuid.UUID{design.ID},
+		},
+		{
+			name:  "нет совпадений",
+			query: "бухгалтерия",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hits, err := index.Search(ctx, tc.query, tc.kinds, tc.req)
+			require.Nil(t, err)
+
+			ids := make([]uuid.UUID, 0)
+			for _, hit := range hits.Items {
+				ids = append(ids, hit.ID)
+			}
+			if tc.expected == nil {
+				tc.expected = []uuid.UUID{}
+			}
+			require.Equal(t, tc.expected, ids)
+		})
+	}
+
+	_, err := index.Search(ctx, "go", nil, domain.PageRequest{Sort: "name"})
+	require.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestSearchIndex_Update(t *testing.T) {
+	ctx := context.Background()
+	index := NewSearchIndex()
+
+	doc := &domain.SearchDocument{Kind: domain.SearchKindCompany, ID: uuid.UUID{1}, Title: "Пекарня", Text: "Казань"}
+	require.Nil(t, index.Put(ctx, doc))
+
+	doc.Title = "Кондитерская"
+	require.Nil(t, index.Put(ctx, doc))
+
+	hits, err := index.Search(ctx, "пекарня", nil, domain.PageRequest{})
+	require.Nil(t, err)
+	require.Empty(t, hits.Items)
+
+	hits, err = index.Search(ctx, "кондитерской", nil, domain.PageRequest{})
+	require.Nil(t, err)
+	require.Len(t, hits.Items, 1)
+	require.Equal(t, "Кондитерская", hits.Items[0].Title)
+
+	require.Nil(t, index.Remove(ctx, domain.SearchKindCompany, doc.ID))
+	require.Nil(t, index.Remove(ctx, domain.SearchKindCompany, doc.ID))
+
+	hits, err = index.Search(ctx, "казань", nil, domain.PageRequest{})
+	require.Nil(t, err)
+	require.Equal(t, 0, hits.Total)
+}
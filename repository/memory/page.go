@@ -0,0 +1,36 @@
+package memory
+
+import (
+	"bytes"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+	"sort"
+)
+
+type comparators[T any] map[string]func(a, b T) int
+
+func paginate[T any](items []T, req domain.PageRequest, fields []string, compare comparators[T], id func(T) uuid.UUID) (*domain.Page[T], error) {
+	query, err := req.Resolve(fields...)
+	if err != nil {
+		return nil, err
+	}
+
+	byField := compare[query.SortBy]
+	sort.Slice(items, func(i, j int) bool {
+		c := byField(items[i], items[j])
+		if c == 0 {
+			a, b := id(items[i]), id(items[j])
+			c = bytes.Compare(a[:], b[:])
+		}
+		if query.Desc {
+			c = -c
+		}
+		return c < 0
+	})
+
+	if query.Offset >= len(items) {
+		return domain.NewPage(make([]T, 0), len(items), query), nil
+	}
+
+	return domain.NewPage(items[query.Offset:min(query.Offset+query.Limit, len(items))], len(items), query), nil
+}
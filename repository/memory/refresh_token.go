@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"sync"
+)
+
+type RefreshTokenRepository struct {
+	mu     sync.RWMutex
+	tokens map[uuid.UUID]domain.RefreshToken
+}
+
+func NewRefreshTokenRepository() domain.IRefreshTokenRepository {
+	return &RefreshTokenRepository{
+		tokens: make(map[uuid.UUID]domain.RefreshToken),
+	}
+}
+
+func (r *RefreshTokenRepository) Create(ctx context.Context, token *domain.RefreshToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.tokens[token.ID]; ok {
+		return domain.NewError(domain.ErrConflict, i18n.AuthRefreshTokenExists)
+	}
+	remember(ctx, &r.mu, r.tokens, token.ID)
+	r.tokens[token.ID] = *token
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) GetById(_ context.Context, id uuid.UUID) (*domain.RefreshToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	token, ok := r.tokens[id]
+	if !ok {
+		return nil, domain.NewError(domain.ErrNotFound, i18n.AuthRefreshTokenNotFound)
+	}
+
+	return &token, nil
+}
+
+func (r *RefreshTokenRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	token, ok := r.tokens[id]
+	if !ok {
+		return domain.NewError(domain.ErrNotFound, i18n.AuthRefreshTokenNotFound)
+	}
+
+	if token.Revoked {
+		return domain.NewError(domain.ErrConflict, i18n.AuthRefreshTokenAlreadyRevoked)
+	}
+	token.Revoked = true
+	remember(ctx, &r.mu, r.tokens, id)
+	r.tokens[id] = token
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) IsRevoked(_ context.Context, id uuid.UUID) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	token, ok := r.tokens[id]
+	if !ok {
+		return false, domain.NewError(domain.ErrNotFound, i18n.AuthRefreshTokenNotFound)
+	}
+
+	return token.Revoked, nil
+}
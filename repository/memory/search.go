@@ -0,0 +1,198 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/text"
+	"github.com/google/uuid"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// параметры BM25F
+const (
+	searchK1          = 1.2
+	searchB           = 0.75
+	searchTitleWeight = 2.0
+	searchTextWeight  = 1.0
+)
+
+type searchKey struct {
+	kind domain.SearchKind
+	id   uuid.UUID
+}
+
+type searchEntry struct {
+	doc      domain.SearchDocument
+	title    map[string]int
+	text     map[string]int
+	titleLen int
+	textLen  int
+}
+
+type SearchIndex struct {
+	mu         sync.RWMutex
+	docs       map[searchKey]*searchEntry
+	postings   map[string]map[searchKey]struct{}
+	titleTotal int
+	textTotal  int
+}
+
+func NewSearchIndex() domain.ISearchIndex {
+	return &SearchIndex{
+		docs:     make(map[searchKey]*searchEntry),
+		postings: make(map[string]map[searchKey]struct{}),
+	}
+}
+
+func termFrequencies(s string) (map[string]int, int) {
+	terms := text.Analyze(s)
+
+	freq := make(map[string]int, len(terms))
+	for _, term := range terms {
+		freq[term]++
+	}
+
+	return freq, len(terms)
+}
+
+func (idx *SearchIndex) Put(_ context.Context, doc *domain.SearchDocument) error {
+	entry := &searchEntry{doc: *doc}
+	entry.title, entry.titleLen = termFrequencies(doc.Title)
+	entry.text, entry.textLen = termFrequencies(doc.Text)
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	key := searchKey{kind: doc.Kind, id: doc.ID}
+	idx.remove(key)
+
+	idx.docs[key] = entry
+	idx.titleTotal += entry.titleLen
+	idx.textTotal += entry.textLen
+	for _, freq := range []map[string]int{entry.title, entry.text} {
+		for term := range freq {
+			if idx.postings[term] == nil {
+				idx.postings[term] = make(map[searchKey]struct{})
+			}
+			idx.postings[term][key] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func (idx *SearchIndex) Remove(_ context.Context, kind domain.SearchKind, id uuid.UUID) error {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.remove(searchKey{kind: kind, id: id})
+
+	return nil
+}
+
+func (idx *SearchIndex) remove(key searchKey) {
+	entry, ok := idx.docs[key]
+	if !ok {
+		return
+	}
+
+	delete(idx.docs, key)
+	idx.titleTotal -= entry.titleLen
+	idx.textTotal -= entry.textLen
+	for _, freq := range []map[string]int{entry.title, entry.text} {
+		for term := range freq {
+			delete(idx.postings[term], key)
+			if len(idx.postings[term]) == 0 {
+				delete(idx.postings, term)
+			}
+		}
+	}
+}
+
+func (idx *SearchIndex) Search(_ context.Context, query string, kinds []domain.SearchKind, req domain.PageRequest) (*domain.Page[*domain.SearchHit], error) {
+	pageQuery, err := req.Resolve(domain.SearchSortFields...)
+	if err != nil {
+		return nil, err
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	terms, _ := termFrequencies(query)
+	scores := make(map[searchKey]float64)
+	for term := range terms {
+		keys := idx.postings[term]
+		if len(keys) == 0 {
+			continue
+		}
+
+		idf := math.Log(1 + (float64(len(idx.docs))-float64(len(keys))+0.5)/(float64(len(keys))+0.5))
+		for key := range keys {
+			if !kindSelected(kinds, key.kind) {
+				continue
+			}
+
+			entry := idx.docs[key]
+			tf := searchTitleWeight*float64(entry.title[term])/idx.norm(entry.titleLen, idx.titleTotal) +
+				searchTextWeight*float64(entry.text[term])/idx.norm(entry.textLen, idx.textTotal)
+			scores[key] += idf * tf * (searchK1 + 1) / (tf + searchK1)
+		}
+	}
+
+	hits := make([]*domain.SearchHit, 0, len(scores))
+	for key, score := range scores {
+		hits = append(hits, &domain.SearchHit{
+			Kind:  key.kind,
+			ID:    key.id,
+			Title: idx.docs[key].doc.Title,
+			Score: score,
+		})
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return (hits[i].Score > hits[j].Score) != pageQuery.Desc
+		}
+		if c := strings.Compare(hits[i].Title, hits[j].Title); c != 0 {
+			return c < 0
+		}
+		if hits[i].Kind != hits[j].Kind {
+			return hits[i].Kind < hits[j].Kind
+		}
+		return bytes.Compare(hits[i].ID[:], hits[j].ID[:]) < 0
+	})
+
+	if pageQuery.Offset >= len(hits) {
+		return domain.NewPage(make([]*domain.SearchHit, 0), len(hits), pageQuery), nil
+	}
+
+	return domain.NewPage(hits[pageQuery.Offset:min(pageQuery.Offset+pageQuery.Limit, len(hits))], len(hits), pageQuery), nil
+}
+
+// norm нормирует частоту термина на длину поля относительно средней длины.
+func (idx *SearchIndex) norm(length, total int) float64 {
+	if total == 0 {
+		return 1
+	}
+
+	avg := float64(total) / float64(len(idx.docs))
+	return 1 - searchB + searchB*float64(length)/avg
+}
+
+func kindSelected(kinds []domain.SearchKind, kind domain.SearchKind) bool {
+	if len(kinds) == 0 {
+		return true
+	}
+
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+
+	return false
+}
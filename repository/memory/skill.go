@@ -0,0 +1,100 @@
+package memory
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"strings"
+	"sync"
+)
+
+type SkillRepository struct {
+	mu     sync.RWMutex
+	skills map[uuid.UUID]domain.Skill
+}
+
+func NewSkillRepository() domain.ISkillRepository {
+	return &SkillRepository{
+		skills: make(map[uuid.UUID]domain.Skill),
+	}
+}
+
+func (r *SkillRepository) Create(ctx context.Context, skill *domain.Skill) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if skill.ID == uuid.Nil {
+		skill.ID = uuid.New()
+	}
+
+	if _, ok := r.skills[skill.ID]; ok {
+		return domain.NewError(domain.ErrConflict, i18n.StorageIdExists)
+	}
+	remember(ctx, &r.mu, r.skills, skill.ID)
+	r.skills[skill.ID] = *skill
+
+	return nil
+}
+
+func (r *SkillRepository) GetById(_ context.Context, id uuid.UUID) (*domain.Skill, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	skill, ok := r.skills[id]
+	if !ok {
+		return nil, domain.NewError(domain.ErrNotFound, i18n.StorageSkillNotFound)
+	}
+
+	return &skill, nil
+}
+
+func (r *SkillRepository) GetByIds(_ context.Context, ids []uuid.UUID) ([]*domain.Skill, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return pick(r.skills, ids), nil
+}
+
+var skillComparators = comparators[*domain.Skill]{
+	"name": func(a, b *domain.Skill) int { return strings.Compare(a.Name, b.Name) },
+}
+
+func (r *SkillRepository) GetAll(_ context.Context, req domain.PageRequest) (*domain.Page[*domain.Skill], error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	skills := make([]*domain.Skill, 0, len(r.skills))
+	for _, skill := range r.skills {
+		skill := skill
+		skills = append(skills, &skill)
+	}
+
+	return paginate(skills, req, domain.SkillSortFields, skillComparators, func(skill *domain.Skill) uuid.UUID { return skill.ID })
+}
+
+func (r *SkillRepository) Update(ctx context.Context, skill *domain.Skill) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.skills[skill.ID]; !ok {
+		return domain.NewError(domain.ErrNotFound, i18n.StorageSkillNotFound)
+	}
+	remember(ctx, &r.mu, r.skills, skill.ID)
+	r.skills[skill.ID] = *skill
+
+	return nil
+}
+
+func (r *SkillRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.skills[id]; !ok {
+		return domain.NewError(domain.ErrNotFound, i18n.StorageSkillNotFound)
+	}
+	remember(ctx, &r.mu, r.skills, id)
+	delete(r.skills, id)
+
+	return nil
+}
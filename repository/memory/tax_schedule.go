@@ -0,0 +1,103 @@
+package memory
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"slices"
+	"sort"
+	"sync"
+)
+
+type TaxScheduleRepository struct {
+	mu        sync.RWMutex
+	schedules map[uuid.UUID]domain.TaxSchedule
+}
+
+func NewTaxScheduleRepository() domain.ITaxScheduleRepository {
+	return &TaxScheduleRepository{
+		schedules: make(map[uuid.UUID]domain.TaxSchedule),
+	}
+}
+
+func cloneSchedule(schedule domain.TaxSchedule) *domain.TaxSchedule {
+	schedule.Brackets = slices.Clone(schedule.Brackets)
+	return &schedule
+}
+
+func (r *TaxScheduleRepository) hasYear(schedule *domain.TaxSchedule) bool {
+	for _, stored := range r.schedules {
+		if stored.Jurisdiction == schedule.Jurisdiction && stored.ActivityFieldId == schedule.ActivityFieldId &&
+			stored.Year == schedule.Year {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *TaxScheduleRepository) Create(ctx context.Context, schedule *domain.TaxSchedule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if schedule.ID == uuid.Nil {
+		schedule.ID = uuid.New()
+	}
+
+	if _, ok := r.schedules[schedule.ID]; ok {
+		return domain.NewError(domain.ErrConflict, i18n.StorageIdExists)
+	}
+	if r.hasYear(schedule) {
+		return domain.NewError(domain.ErrConflict, i18n.StorageTaxScheduleExists)
+	}
+	remember(ctx, &r.mu, r.schedules, schedule.ID)
+	r.schedules[schedule.ID] = *cloneSchedule(*schedule)
+
+	return nil
+}
+
+func (r *TaxScheduleRepository) GetById(_ context.Context, id uuid.UUID) (*domain.TaxSchedule, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	schedule, ok := r.schedules[id]
+	if !ok {
+		return nil, domain.NewError(domain.ErrNotFound, i18n.StorageTaxScheduleNotFound)
+	}
+
+	return cloneSchedule(schedule), nil
+}
+
+func (r *TaxScheduleRepository) GetByJurisdiction(_ context.Context, jurisdiction string) ([]*domain.TaxSchedule, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	schedules := make([]*domain.TaxSchedule, 0)
+	for _, schedule := range r.schedules {
+		if schedule.Jurisdiction == jurisdiction {
+			schedules = append(schedules, cloneSchedule(schedule))
+		}
+	}
+	sort.Slice(schedules, func(i, j int) bool {
+		if schedules[i].Year != schedules[j].Year {
+			return schedules[i].Year < schedules[j].Year
+		}
+		return schedules[i].ID.String() < schedules[j].ID.String()
+	})
+
+	return schedules, nil
+}
+
+func (r *TaxScheduleRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.schedules[id]; !ok {
+		return domain.NewError(domain.ErrNotFound, i18n.StorageTaxScheduleNotFound)
+	}
+	remember(ctx, &r.mu, r.schedules, id)
+	delete(r.schedules, id)
+
+	return nil
+}
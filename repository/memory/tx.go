@@ -0,0 +1,82 @@
+package memory
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"sync"
+)
+
+type txKey struct{}
+
+type tx struct {
+	mu   sync.Mutex
+	undo []func()
+}
+
+func (t *tx) rollback() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for i := len(t.undo) - 1; i >= 0; i-- {
+		t.undo[i]()
+	}
+	t.undo = nil
+}
+
+func onRollback(ctx context.Context, undo func()) {
+	t, ok := ctx.Value(txKey{}).(*tx)
+	if !ok {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.undo = append(t.undo, undo)
+}
+
+type TxManager struct {
+	mu sync.Mutex
+}
+
+func NewTxManager() domain.ITxManager {
+	return &TxManager{}
+}
+
+func (m *TxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*tx); ok {
+		return fn(ctx)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t := new(tx)
+	defer func() {
+		if p := recover(); p != nil {
+			t.rollback()
+			panic(p)
+		}
+	}()
+
+	err := fn(context.WithValue(ctx, txKey{}, t))
+	if err != nil {
+		t.rollback()
+		return err
+	}
+
+	return nil
+}
+
+func remember[K comparable, V any](ctx context.Context, mu *sync.RWMutex, items map[K]V, key K) {
+	old, existed := items[key]
+	onRollback(ctx, func() {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if existed {
+			items[key] = old
+		} else {
+			delete(items, key)
+		}
+	})
+}
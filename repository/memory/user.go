@@ -0,0 +1,146 @@
+package memory
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"strings"
+	"sync"
+	"time"
+)
+
+type UserRepository struct {
+	mu        sync.RWMutex
+	users     map[uuid.UUID]domain.User
+	usernames map[string]uuid.UUID
+}
+
+func NewUserRepository() domain.IUserRepository {
+	return &UserRepository{
+		users:     make(map[uuid.UUID]domain.User),
+		usernames: make(map[string]uuid.UUID),
+	}
+}
+
+func (r *UserRepository) Create(ctx context.Context, user *domain.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if user.ID == uuid.Nil {
+		user.ID = uuid.New()
+	}
+	if user.Role == "" {
+		user.Role = domain.RoleGuest
+	}
+
+	if _, ok := r.users[user.ID]; ok {
+		return domain.NewError(domain.ErrConflict, i18n.StorageIdExists)
+	}
+	if _, ok := r.usernames[user.Username]; ok {
+		return domain.NewError(domain.ErrConflict, i18n.StorageUsernameTaken)
+	}
+
+	remember(ctx, &r.mu, r.users, user.ID)
+	remember(ctx, &r.mu, r.usernames, user.Username)
+	r.users[user.ID] = *user
+	r.usernames[user.Username] = user.ID
+
+	return nil
+}
+
+func (r *UserRepository) GetByUsername(_ context.Context, username string) (*domain.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	id, ok := r.usernames[username]
+	if !ok {
+		return nil, domain.NewError(domain.ErrNotFound, i18n.StorageUserNotFound)
+	}
+	user := r.users[id]
+
+	return &user, nil
+}
+
+func (r *UserRepository) GetById(_ context.Context, userId uuid.UUID) (*domain.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	user, ok := r.users[userId]
+	if !ok {
+		return nil, domain.NewError(domain.ErrNotFound, i18n.StorageUserNotFound)
+	}
+
+	return &user, nil
+}
+
+func (r *UserRepository) GetByIds(_ context.Context, ids []uuid.UUID) ([]*domain.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return pick(r.users, ids), nil
+}
+
+var userComparators = comparators[*domain.User]{
+	"username":  func(a, b *domain.User) int { return strings.Compare(a.Username, b.Username) },
+	"full_name": func(a, b *domain.User) int { return strings.Compare(a.FullName, b.FullName) },
+	"city":      func(a, b *domain.User) int { return strings.Compare(a.City, b.City) },
+}
+
+func (r *UserRepository) GetAll(_ context.Context, filter domain.UserFilter, req domain.PageRequest) (*domain.Page[*domain.User], error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	match := userMatcher(filter, time.Now())
+	users := make([]*domain.User, 0)
+	for _, user := range r.users {
+		user := user
+		if match(&user) {
+			users = append(users, &user)
+		}
+	}
+
+	return paginate(users, req, domain.UserSortFields, userComparators, func(user *domain.User) uuid.UUID { return user.ID })
+}
+
+func (r *UserRepository) Update(ctx context.Context, user *domain.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	old, ok := r.users[user.ID]
+	if !ok {
+		return domain.NewError(domain.ErrNotFound, i18n.StorageUserNotFound)
+	}
+	if id, ok := r.usernames[user.Username]; ok && id != user.ID {
+		return domain.NewError(domain.ErrConflict, i18n.StorageUsernameTaken)
+	}
+
+	updated := *user
+	if updated.Role == "" {
+		updated.Role = old.Role
+	}
+	remember(ctx, &r.mu, r.users, user.ID)
+	remember(ctx, &r.mu, r.usernames, old.Username)
+	remember(ctx, &r.mu, r.usernames, user.Username)
+	delete(r.usernames, old.Username)
+	r.users[user.ID] = updated
+	r.usernames[user.Username] = user.ID
+
+	return nil
+}
+
+func (r *UserRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.users[id]
+	if !ok {
+		return domain.NewError(domain.ErrNotFound, i18n.StorageUserNotFound)
+	}
+	remember(ctx, &r.mu, r.users, id)
+	remember(ctx, &r.mu, r.usernames, user.Username)
+	delete(r.users, id)
+	delete(r.usernames, user.Username)
+
+	return nil
+}
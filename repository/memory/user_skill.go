@@ -0,0 +1,81 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"sync"
+)
+
+type UserSkillRepository struct {
+	mu    sync.RWMutex
+	pairs map[domain.UserSkill]struct{}
+}
+
+func NewUserSkillRepository() domain.IUserSkillRepository {
+	return &UserSkillRepository{
+		pairs: make(map[domain.UserSkill]struct{}),
+	}
+}
+
+func (r *UserSkillRepository) Create(ctx context.Context, pair *domain.UserSkill) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.pairs[*pair]; ok {
+		return domain.NewError(domain.ErrConflict, i18n.StorageUserSkillExists)
+	}
+	remember(ctx, &r.mu, r.pairs, *pair)
+	r.pairs[*pair] = struct{}{}
+
+	return nil
+}
+
+func (r *UserSkillRepository) Delete(ctx context.Context, pair *domain.UserSkill) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.pairs[*pair]; !ok {
+		return domain.NewError(domain.ErrNotFound, i18n.StorageUserSkillNotFound)
+	}
+	remember(ctx, &r.mu, r.pairs, *pair)
+	delete(r.pairs, *pair)
+
+	return nil
+}
+
+var userSkillComparators = comparators[*domain.UserSkill]{
+	"skill_id": func(a, b *domain.UserSkill) int { return bytes.Compare(a.SkillId[:], b.SkillId[:]) },
+	"user_id":  func(a, b *domain.UserSkill) int { return bytes.Compare(a.UserId[:], b.UserId[:]) },
+}
+
+func (r *UserSkillRepository) GetUserSkillsByUserId(_ context.Context, userId uuid.UUID, req domain.PageRequest) (*domain.Page[*domain.UserSkill], error) {
+	return r.list(req, domain.UserSkillByUserSortFields,
+		func(pair domain.UserSkill) bool { return pair.UserId == userId },
+		func(pair *domain.UserSkill) uuid.UUID { return pair.SkillId },
+	)
+}
+
+func (r *UserSkillRepository) GetUserSkillsBySkillId(_ context.Context, skillId uuid.UUID, req domain.PageRequest) (*domain.Page[*domain.UserSkill], error) {
+	return r.list(req, domain.UserSkillBySkillSortFields,
+		func(pair domain.UserSkill) bool { return pair.SkillId == skillId },
+		func(pair *domain.UserSkill) uuid.UUID { return pair.UserId },
+	)
+}
+
+func (r *UserSkillRepository) list(req domain.PageRequest, fields []string, match func(domain.UserSkill) bool, key func(*domain.UserSkill) uuid.UUID) (*domain.Page[*domain.UserSkill], error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	pairs := make([]*domain.UserSkill, 0)
+	for pair := range r.pairs {
+		pair := pair
+		if match(pair) {
+			pairs = append(pairs, &pair)
+		}
+	}
+
+	return paginate(pairs, req, fields, userSkillComparators, key)
+}
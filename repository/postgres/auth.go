@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+)
+
+type AuthRepository struct {
+	db DB
+}
+
+func NewAuthRepository(db DB) domain.IAuthRepository {
+	return &AuthRepository{
+		db: db,
+	}
+}
+
+func (r *AuthRepository) Register(ctx context.Context, authInfo *domain.UserAuth) error {
+	if authInfo.ID == uuid.Nil {
+		authInfo.ID = uuid.New()
+	}
+
+	_, err := conn(ctx, r.db).Exec(ctx,
+		"insert into users (id, username, password, role) values ($1, $2, $3, coalesce(nullif($4, ''), 'guest'))",
+		authInfo.ID, authInfo.Username, authInfo.HashedPass, authInfo.Role,
+	)
+	if err != nil {
+		return mapError(err, i18n.StorageUserNotFound)
+	}
+
+	return nil
+}
+
+func (r *AuthRepository) GetByUsername(ctx context.Context, username string) (*domain.UserAuth, error) {
+	authInfo := new(domain.UserAuth)
+
+	err := conn(ctx, r.db).QueryRow(ctx,
+		"select id, username, coalesce(password, ''), role from users where username = $1", username,
+	).Scan(&authInfo.ID, &authInfo.Username, &authInfo.HashedPass, &authInfo.Role)
+	if err != nil {
+		return nil, mapError(err, i18n.StorageUserNotFound)
+	}
+
+	return authInfo, nil
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const companyColumns = "id, owner_id, activity_field_id, name, city"
+
+var companyPages = pageSpec{
+	fields: domain.CompanySortFields,
+	orders: map[string]string{
+		"name": `name collate "C"`,
+		"city": `city collate "C"`,
+	},
+	key: "id",
+}
+
+type CompanyRepository struct {
+	db DB
+}
+
+func NewCompanyRepository(db DB) domain.ICompanyRepository {
+	return &CompanyRepository{
+		db: db,
+	}
+}
+
+func scanCompany(row pgx.Row) (*domain.Company, error) {
+	company := new(domain.Company)
+
+	err := row.Scan(&company.ID, &company.OwnerID, &company.ActivityFieldId, &company.Name, &company.City)
+	if err != nil {
+		return nil, err
+	}
+
+	return company, nil
+}
+
+func (r *CompanyRepository) Create(ctx context.Context, company *domain.Company) error {
+	if company.ID == uuid.Nil {
+		company.ID = uuid.New()
+	}
+
+	_, err := conn(ctx, r.db).Exec(ctx,
+		"insert into companies (id, owner_id, activity_field_id, name, city) values ($1, $2, $3, $4, $5)",
+		company.ID, company.OwnerID, company.ActivityFieldId, company.Name, company.City,
+	)
+	if err != nil {
+		return mapError(err, i18n.StorageCompanyNotFound)
+	}
+
+	return nil
+}
+
+func (r *CompanyRepository) GetById(ctx context.Context, id uuid.UUID) (*domain.Company, error) {
+	company, err := scanCompany(conn(ctx, r.db).QueryRow(ctx,
+		"select "+companyColumns+" from companies where id = $1", id))
+	if err != nil {
+		return nil, mapError(err, i18n.StorageCompanyNotFound)
+	}
+
+	return company, nil
+}
+
+func (r *CompanyRepository) GetByOwnerId(ctx context.Context, id uuid.UUID, req domain.PageRequest) (*domain.Page[*domain.Company], error) {
+	return queryPage(ctx, conn(ctx, r.db), scanCompany, companyPages, req,
+		"select "+companyColumns, "from companies where owner_id = $1", id,
+	)
+}
+
+func (r *CompanyRepository) GetAll(ctx context.Context, filter domain.CompanyFilter, req domain.PageRequest) (*domain.Page[*domain.Company], error) {
+	w := companyWhere(filter)
+
+	return queryPage(ctx, conn(ctx, r.db), scanCompany, companyPages, req,
+		"select "+companyColumns, "from companies"+w.String(), w.args...,
+	)
+}
+
+func (r *CompanyRepository) Update(ctx context.Context, company *domain.Company) error {
+	return execOne(ctx, conn(ctx, r.db), i18n.StorageCompanyNotFound,
+		"update companies set owner_id = $2, activity_field_id = $3, name = $4, city = $5 where id = $1",
+		company.ID, company.OwnerID, company.ActivityFieldId, company.Name, company.City,
+	)
+}
+
+func (r *CompanyRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	return deleteOne(ctx, conn(ctx, r.db), i18n.StorageCompanyNotFound, "delete from companies where id = $1", id)
+}
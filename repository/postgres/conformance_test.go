@@ -0,0 +1,26 @@
+package postgres
+
+import (
+	"github.com/dlankinl/bmstu-ppo-bl/repository/conformance"
+	"testing"
+)
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, func(t *testing.T) *conformance.Repositories {
+		pool := newTestPool(t)
+
+		return &conformance.Repositories{
+			Users:            NewUserRepository(pool),
+			Auth:             NewAuthRepository(pool),
+			ActivityFields:   NewActivityFieldRepository(pool),
+			Companies:        NewCompanyRepository(pool),
+			FinancialReports: NewFinancialReportRepository(pool),
+			Skills:           NewSkillRepository(pool),
+			UserSkills:       NewUserSkillRepository(pool),
+			Contacts:         NewContactRepository(pool),
+			RefreshTokens:    NewRefreshTokenRepository(pool),
+			TaxSchedules:     NewTaxScheduleRepository(pool),
+			TxManager:        NewTxManager(pool),
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const contactColumns = "id, owner_id, name, value"
+
+var contactPages = pageSpec{
+	fields: domain.ContactSortFields,
+	orders: map[string]string{
+		"name": `name collate "C"`,
+	},
+	key: "id",
+}
+
+type ContactRepository struct {
+	db DB
+}
+
+func NewContactRepository(db DB) domain.IContactsRepository {
+	return &ContactRepository{
+		db: db,
+	}
+}
+
+func scanContact(row pgx.Row) (*domain.Contact, error) {
+	contact := new(domain.Contact)
+
+	err := row.Scan(&contact.ID, &contact.OwnerID, &contact.Name, &contact.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return contact, nil
+}
+
+func (r *ContactRepository) Create(ctx context.Context, contact *domain.Contact) error {
+	if contact.ID == uuid.Nil {
+		contact.ID = uuid.New()
+	}
+
+	_, err := conn(ctx, r.db).Exec(ctx,
+		"insert into contacts (id, owner_id, name, value) values ($1, $2, $3, $4)",
+		contact.ID, contact.OwnerID, contact.Name, contact.Value,
+	)
+	if err != nil {
+		return mapError(err, i18n.StorageContactNotFound)
+	}
+
+	return nil
+}
+
+func (r *ContactRepository) GetById(ctx context.Context, id uuid.UUID) (*domain.Contact, error) {
+	contact, err := scanContact(conn(ctx, r.db).QueryRow(ctx,
+		"select "+contactColumns+" from contacts where id = $1", id))
+	if err != nil {
+		return nil, mapError(err, i18n.StorageContactNotFound)
+	}
+
+	return contact, nil
+}
+
+func (r *ContactRepository) GetByOwnerId(ctx context.Context, id uuid.UUID, req domain.PageRequest) (*domain.Page[*domain.Contact], error) {
+	return queryPage(ctx, conn(ctx, r.db), scanContact, contactPages, req,
+		"select "+contactColumns, "from contacts where owner_id = $1", id,
+	)
+}
+
+func (r *ContactRepository) Update(ctx context.Context, contact *domain.Contact) error {
+	return execOne(ctx, conn(ctx, r.db), i18n.StorageContactNotFound,
+		"update contacts set owner_id = $2, name = $3, value = $4 where id = $1",
+		contact.ID, contact.OwnerID, contact.Name, contact.Value,
+	)
+}
+
+func (r *ContactRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	return deleteOne(ctx, conn(ctx, r.db), i18n.StorageContactNotFound, "delete from contacts where id = $1", id)
+}
@@ -0,0 +1,183 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"strconv"
+	"strings"
+)
+
+const (
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
+	exclusionViolation  = "23P01"
+)
+
+type DB interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+var conflictConstraints = map[string]i18n.Code{
+	"users_username_key":                            i18n.StorageUsernameTaken,
+	"user_skills_pkey":                              i18n.StorageUserSkillExists,
+	"fin_reports_company_id_year_quarter_month_key": i18n.StorageFinReportExists,
+	"tax_schedules_scope_key":                       i18n.StorageTaxScheduleExists,
+	"fin_reports_period_excl":                       i18n.StorageFinReportOverlap,
+	"refresh_tokens_pkey":                           i18n.AuthRefreshTokenExists,
+}
+
+func mapError(err error, notFound i18n.Code) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.NewError(domain.ErrNotFound, notFound)
+	}
+
+	return mapConstraintError(err)
+}
+
+// mapConstraintError переводит нарушения ограничений в ошибки конфликта и ненайденной ссылки,
+// остальные ошибки возвращает как есть.
+func mapConstraintError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case uniqueViolation, exclusionViolation:
+		if code, ok := conflictConstraints[pgErr.ConstraintName]; ok {
+			return domain.NewError(domain.ErrConflict, code)
+		}
+		return domain.NewError(domain.ErrConflict, i18n.StorageIdExists)
+	case foreignKeyViolation:
+		return domain.NewError(domain.ErrNotFound, i18n.StorageReferenceNotFound)
+	}
+
+	return err
+}
+
+func mapDeleteError(err error, notFound i18n.Code) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+		return domain.NewError(domain.ErrConflict, i18n.StorageInUse)
+	}
+
+	return mapError(err, notFound)
+}
+
+func execOne(ctx context.Context, db DB, notFound i18n.Code, sql string, args ...any) error {
+	tag, err := db.Exec(ctx, sql, args...)
+	if err != nil {
+		return mapError(err, notFound)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.NewError(domain.ErrNotFound, notFound)
+	}
+
+	return nil
+}
+
+func deleteOne(ctx context.Context, db DB, notFound i18n.Code, sql string, args ...any) error {
+	tag, err := db.Exec(ctx, sql, args...)
+	if err != nil {
+		return mapDeleteError(err, notFound)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.NewError(domain.ErrNotFound, notFound)
+	}
+
+	return nil
+}
+
+func queryAll[T any](ctx context.Context, db DB, scan func(pgx.Row) (T, error), sql string, args ...any) ([]T, error) {
+	rows, err := db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make([]T, 0)
+	for rows.Next() {
+		item, err := scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, rows.Err()
+}
+
+type pageSpec struct {
+	fields []string
+	orders map[string]string
+	key    string
+}
+
+func queryPage[T any](ctx context.Context, db DB, scan func(pgx.Row) (T, error), spec pageSpec, req domain.PageRequest, sel, from string, args ...any) (*domain.Page[T], error) {
+	query, err := req.Resolve(spec.fields...)
+	if err != nil {
+		return nil, err
+	}
+
+	var total int
+	err = db.QueryRow(ctx, "select count(*) "+from, args...).Scan(&total)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := "asc"
+	if query.Desc {
+		dir = "desc"
+	}
+	items, err := queryAll(ctx, db, scan,
+		fmt.Sprintf("%s %s order by %s %s, %s %s limit $%d offset $%d",
+			sel, from, spec.orders[query.SortBy], dir, spec.key, dir, len(args)+1, len(args)+2),
+		append(args, query.Limit, query.Offset)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewPage(items, total, query), nil
+}
+
+type where struct {
+	conds []string
+	args  []any
+}
+
+func (w *where) add(cond string, args ...any) {
+	for _, arg := range args {
+		w.args = append(w.args, arg)
+		cond = strings.Replace(cond, "?", "$"+strconv.Itoa(len(w.args)), 1)
+	}
+	w.conds = append(w.conds, cond)
+}
+
+func (w *where) String() string {
+	if len(w.conds) == 0 {
+		return ""
+	}
+
+	return " where " + strings.Join(w.conds, " and ")
+}
+
+// uuidArray передает список id параметром вида $1::uuid[].
+func uuidArray(ids []uuid.UUID) []string {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, id.String())
+	}
+
+	return values
+}
@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+	"time"
+)
+
+func userWhere(filter domain.UserFilter, now time.Time) *where {
+	w := new(where)
+
+	if filter.City != "" {
+		w.add("city = ?", filter.City)
+	}
+	if filter.Gender != "" {
+		w.add("gender = ?", filter.Gender)
+	}
+
+	from, to := filter.BirthdayRange(now)
+	if !from.IsZero() {
+		w.add("birthday >= ?::date", from)
+	}
+	if !to.IsZero() {
+		w.add("birthday <= ?::date", to)
+	}
+
+	if search := domain.FoldSearch(filter.Search); search != "" {
+		w.add("(strpos(lower(username), ?) > 0 or strpos(lower(full_name), ?) > 0)", search, search)
+	}
+
+	return w
+}
+
+func companyWhere(filter domain.CompanyFilter) *where {
+	w := new(where)
+
+	if filter.City != "" {
+		w.add("city = ?", filter.City)
+	}
+	if filter.ActivityFieldId != uuid.Nil {
+		w.add("activity_field_id = ?", filter.ActivityFieldId)
+	}
+	if filter.OwnerId != uuid.Nil {
+		w.add("owner_id = ?", filter.OwnerId)
+	}
+
+	if search := domain.FoldSearch(filter.Search); search != "" {
+		w.add("strpos(lower(name), ?) > 0", search)
+	}
+
+	return w
+}
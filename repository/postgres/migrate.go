@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"embed"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"io/fs"
+	"path"
+	"sort"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+func Migrate(ctx context.Context, pool *pgxpool.Pool) error {
+	_, err := pool.Exec(ctx, `create table if not exists schema_migrations (
+		version text primary key,
+		applied_at timestamptz not null default now()
+	)`)
+	if err != nil {
+		return i18n.Wrap(err, i18n.StorageMigrate, "schema_migrations")
+	}
+
+	names, err := fs.Glob(migrations, "migrations/*.sql")
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		err = applyMigration(ctx, pool, name)
+		if err != nil {
+			return i18n.Wrap(err, i18n.StorageMigrate, path.Base(name))
+		}
+	}
+
+	return nil
+}
+
+func applyMigration(ctx context.Context, pool *pgxpool.Pool, name string) error {
+	script, err := migrations.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	version := path.Base(name)
+
+	return pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, "select pg_advisory_xact_lock(hashtext('schema_migrations'))")
+		if err != nil {
+			return err
+		}
+
+		var applied bool
+		err = tx.QueryRow(ctx, "select exists(select 1 from schema_migrations where version = $1)", version).
+			Scan(&applied)
+		if err != nil || applied {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, string(script))
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, "insert into schema_migrations (version) values ($1)", version)
+		return err
+	})
+}
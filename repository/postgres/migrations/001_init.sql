@@ -0,0 +1,76 @@
+create table users
+(
+    id        uuid primary key,
+    username  text not null unique,
+    password  text,
+    full_name text not null default '',
+    gender    text not null default '',
+    birthday  date,
+    city      text not null default '',
+    role      text not null default 'guest'
+);
+
+create table activity_fields
+(
+    id          uuid primary key,
+    name        text not null,
+    description text not null,
+    cost        real not null
+);
+
+create table companies
+(
+    id                uuid primary key,
+    owner_id          uuid not null references users (id) on delete cascade,
+    activity_field_id uuid not null references activity_fields (id) on delete restrict,
+    name              text not null,
+    city              text not null
+);
+
+create index companies_owner_id_idx on companies (owner_id);
+
+create table fin_reports
+(
+    id         uuid primary key,
+    company_id uuid    not null references companies (id) on delete cascade,
+    revenue    real    not null,
+    costs      real    not null,
+    year       integer not null,
+    quarter    integer not null check (quarter between 1 and 4),
+    unique (company_id, year, quarter)
+);
+
+create table skills
+(
+    id          uuid primary key,
+    name        text not null,
+    description text not null
+);
+
+create table user_skills
+(
+    user_id  uuid not null references users (id) on delete cascade,
+    skill_id uuid not null references skills (id) on delete cascade,
+    primary key (user_id, skill_id)
+);
+
+create index user_skills_skill_id_idx on user_skills (skill_id);
+
+create table contacts
+(
+    id       uuid primary key,
+    owner_id uuid not null references users (id) on delete cascade,
+    name     text not null,
+    value    text not null
+);
+
+create index contacts_owner_id_idx on contacts (owner_id);
+
+create table refresh_tokens
+(
+    id         uuid primary key,
+    user_id    uuid        not null references users (id) on delete cascade,
+    username   text        not null,
+    expires_at timestamptz not null,
+    revoked    boolean     not null default false
+);
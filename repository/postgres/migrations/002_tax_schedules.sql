@@ -0,0 +1,12 @@
+create table tax_schedules
+(
+    id                uuid primary key,
+    jurisdiction      text    not null,
+    activity_field_id uuid references activity_fields (id) on delete cascade,
+    year              integer not null,
+    method            text    not null,
+    brackets          jsonb   not null
+);
+
+create unique index tax_schedules_scope_key
+    on tax_schedules (jurisdiction, coalesce(activity_field_id, '00000000-0000-0000-0000-000000000000'), year);
@@ -0,0 +1,7 @@
+-- суммы отчетов переводятся из real в целые копейки, отчеты получают валюту
+alter table fin_reports
+    alter column revenue type bigint using round(revenue::numeric * 100)::bigint,
+    alter column costs type bigint using round(costs::numeric * 100)::bigint,
+    add column currency text not null default 'RUB';
+
+alter table tax_schedules add column currency text not null default 'RUB';
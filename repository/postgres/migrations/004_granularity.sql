@@ -0,0 +1,11 @@
+-- отчеты получают периодичность: месячные хранят квартал своего месяца, годовые — нулевой квартал
+alter table fin_reports
+    add column granularity text not null default 'quarter',
+    add column month integer not null default 0,
+    drop constraint fin_reports_quarter_check,
+    drop constraint fin_reports_company_id_year_quarter_key,
+    add constraint fin_reports_period_check check (
+        (granularity = 'month' and month between 1 and 12 and quarter = (month + 2) / 3) or
+        (granularity = 'quarter' and quarter between 1 and 4 and month = 0) or
+        (granularity = 'year' and quarter = 0 and month = 0)),
+    add constraint fin_reports_company_id_year_quarter_month_key unique (company_id, year, quarter, month);
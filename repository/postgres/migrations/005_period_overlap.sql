@@ -0,0 +1,9 @@
+-- периоды отчетов компании не пересекаются: отчет занимает диапазон месяцев от первого до последнего
+create extension if not exists btree_gist;
+
+alter table fin_reports add constraint fin_reports_period_excl exclude using gist (
+    company_id with =,
+    int4range(
+        year * 12 + case granularity when 'month' then month when 'quarter' then quarter * 3 - 2 else 1 end,
+        year * 12 + case granularity when 'month' then month when 'quarter' then quarter * 3 else 12 end,
+        '[]') with &&);
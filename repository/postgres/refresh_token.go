@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+)
+
+type RefreshTokenRepository struct {
+	db DB
+}
+
+func NewRefreshTokenRepository(db DB) domain.IRefreshTokenRepository {
+	return &RefreshTokenRepository{
+		db: db,
+	}
+}
+
+func (r *RefreshTokenRepository) Create(ctx context.Context, token *domain.RefreshToken) error {
+	_, err := conn(ctx, r.db).Exec(ctx,
+		"insert into refresh_tokens (id, user_id, username, expires_at, revoked) values ($1, $2, $3, $4, $5)",
+		token.ID, token.UserId, token.Username, token.ExpiresAt, token.Revoked,
+	)
+	if err != nil {
+		return mapConstraintError(err)
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) GetById(ctx context.Context, id uuid.UUID) (*domain.RefreshToken, error) {
+	token := new(domain.RefreshToken)
+
+	err := conn(ctx, r.db).QueryRow(ctx,
+		"select id, user_id, username, expires_at, revoked from refresh_tokens where id = $1", id,
+	).Scan(&token.ID, &token.UserId, &token.Username, &token.ExpiresAt, &token.Revoked)
+	if err != nil {
+		return nil, mapError(err, i18n.AuthRefreshTokenNotFound)
+	}
+
+	return token, nil
+}
+
+func (r *RefreshTokenRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	tag, err := conn(ctx, r.db).Exec(ctx, "update refresh_tokens set revoked = true where id = $1 and not revoked", id)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		_, err = r.IsRevoked(ctx, id)
+		if err != nil {
+			return err
+		}
+		return domain.NewError(domain.ErrConflict, i18n.AuthRefreshTokenAlreadyRevoked)
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) IsRevoked(ctx context.Context, id uuid.UUID) (bool, error) {
+	var revoked bool
+
+	err := conn(ctx, r.db).QueryRow(ctx, "select revoked from refresh_tokens where id = $1", id).Scan(&revoked)
+	if err != nil {
+		return false, mapError(err, i18n.AuthRefreshTokenNotFound)
+	}
+
+	return revoked, nil
+}
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const skillColumns = "id, name, description"
+
+var skillPages = pageSpec{
+	fields: domain.SkillSortFields,
+	orders: map[string]string{
+		"name": `name collate "C"`,
+	},
+	key: "id",
+}
+
+type SkillRepository struct {
+	db DB
+}
+
+func NewSkillRepository(db DB) domain.ISkillRepository {
+	return &SkillRepository{
+		db: db,
+	}
+}
+
+func scanSkill(row pgx.Row) (*domain.Skill, error) {
+	skill := new(domain.Skill)
+
+	err := row.Scan(&skill.ID, &skill.Name, &skill.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	return skill, nil
+}
+
+func (r *SkillRepository) Create(ctx context.Context, skill *domain.Skill) error {
+	if skill.ID == uuid.Nil {
+		skill.ID = uuid.New()
+	}
+
+	_, err := conn(ctx, r.db).Exec(ctx,
+		"insert into skills (id, name, description) values ($1, $2, $3)",
+		skill.ID, skill.Name, skill.Description,
+	)
+	if err != nil {
+		return mapError(err, i18n.StorageSkillNotFound)
+	}
+
+	return nil
+}
+
+func (r *SkillRepository) GetById(ctx context.Context, id uuid.UUID) (*domain.Skill, error) {
+	skill, err := scanSkill(conn(ctx, r.db).QueryRow(ctx,
+		"select "+skillColumns+" from skills where id = $1", id))
+	if err != nil {
+		return nil, mapError(err, i18n.StorageSkillNotFound)
+	}
+
+	return skill, nil
+}
+
+func (r *SkillRepository) GetByIds(ctx context.Context, ids []uuid.UUID) ([]*domain.Skill, error) {
+	return queryAll(ctx, conn(ctx, r.db), scanSkill,
+		"select "+skillColumns+" from skills where id = any($1::uuid[])", uuidArray(ids))
+}
+
+func (r *SkillRepository) GetAll(ctx context.Context, req domain.PageRequest) (*domain.Page[*domain.Skill], error) {
+	return queryPage(ctx, conn(ctx, r.db), scanSkill, skillPages, req,
+		"select "+skillColumns, "from skills",
+	)
+}
+
+func (r *SkillRepository) Update(ctx context.Context, skill *domain.Skill) error {
+	return execOne(ctx, conn(ctx, r.db), i18n.StorageSkillNotFound,
+		"update skills set name = $2, description = $3 where id = $1",
+		skill.ID, skill.Name, skill.Description,
+	)
+}
+
+func (r *SkillRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	return deleteOne(ctx, conn(ctx, r.db), i18n.StorageSkillNotFound, "delete from skills where id = $1", id)
+}
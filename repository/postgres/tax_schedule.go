@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const taxScheduleColumns = "id, jurisdiction, activity_field_id, year, method, currency, brackets"
+
+// taxBracket — представление ступени шкалы в колонке brackets. Порог хранится десятичным числом
+// в единицах валюты из колонки currency.
+type taxBracket struct {
+	Threshold json.Number `json:"threshold"`
+	Rate      float32     `json:"rate"`
+}
+
+type TaxScheduleRepository struct {
+	db DB
+}
+
+func NewTaxScheduleRepository(db DB) domain.ITaxScheduleRepository {
+	return &TaxScheduleRepository{
+		db: db,
+	}
+}
+
+func scanTaxSchedule(row pgx.Row) (*domain.TaxSchedule, error) {
+	schedule := new(domain.TaxSchedule)
+
+	var (
+		fieldId  uuid.NullUUID
+		currency string
+		brackets []byte
+	)
+	err := row.Scan(&schedule.ID, &schedule.Jurisdiction, &fieldId, &schedule.Year, &schedule.Method, &currency, &brackets)
+	if err != nil {
+		return nil, err
+	}
+	schedule.ActivityFieldId = fieldId.UUID
+
+	var stored []taxBracket
+	err = json.Unmarshal(brackets, &stored)
+	if err != nil {
+		return nil, err
+	}
+	schedule.Brackets = make([]domain.TaxBracket, len(stored))
+	for i, bracket := range stored {
+		threshold, err := domain.ParseMoney(bracket.Threshold.String(), currency)
+		if err != nil {
+			return nil, err
+		}
+		schedule.Brackets[i] = domain.TaxBracket{Threshold: threshold, Rate: bracket.Rate}
+	}
+
+	return schedule, nil
+}
+
+func (r *TaxScheduleRepository) Create(ctx context.Context, schedule *domain.TaxSchedule) error {
+	if schedule.ID == uuid.Nil {
+		schedule.ID = uuid.New()
+	}
+
+	stored := make([]taxBracket, len(schedule.Brackets))
+	for i, bracket := range schedule.Brackets {
+		stored[i] = taxBracket{Threshold: json.Number(bracket.Threshold.Decimal()), Rate: bracket.Rate}
+	}
+	brackets, err := json.Marshal(stored)
+	if err != nil {
+		return err
+	}
+
+	var currency string
+	if len(schedule.Brackets) > 0 {
+		currency = schedule.Brackets[0].Threshold.Currency
+	}
+
+	_, err = conn(ctx, r.db).Exec(ctx,
+		`insert into tax_schedules (id, jurisdiction, activity_field_id, year, method, currency, brackets)
+		values ($1, $2, $3, $4, $5, $6, $7)`,
+		schedule.ID, schedule.Jurisdiction,
+		uuid.NullUUID{UUID: schedule.ActivityFieldId, Valid: schedule.ActivityFieldId != uuid.Nil},
+		schedule.Year, schedule.Method, currency, brackets,
+	)
+	if err != nil {
+		return mapError(err, i18n.StorageTaxScheduleNotFound)
+	}
+
+	return nil
+}
+
+func (r *TaxScheduleRepository) GetById(ctx context.Context, id uuid.UUID) (*domain.TaxSchedule, error) {
+	schedule, err := scanTaxSchedule(conn(ctx, r.db).QueryRow(ctx,
+		"select "+taxScheduleColumns+" from tax_schedules where id = $1", id))
+	if err != nil {
+		return nil, mapError(err, i18n.StorageTaxScheduleNotFound)
+	}
+
+	return schedule, nil
+}
+
+func (r *TaxScheduleRepository) GetByJurisdiction(ctx context.Context, jurisdiction string) ([]*domain.TaxSchedule, error) {
+	return queryAll(ctx, conn(ctx, r.db), scanTaxSchedule,
+		"select "+taxScheduleColumns+" from tax_schedules where jurisdiction = $1 order by year, id", jurisdiction)
+}
+
+func (r *TaxScheduleRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	return deleteOne(ctx, conn(ctx, r.db), i18n.StorageTaxScheduleNotFound, "delete from tax_schedules where id = $1", id)
+}
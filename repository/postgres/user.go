@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+const userColumns = "id, username, full_name, gender, birthday, city, role"
+
+var userPages = pageSpec{
+	fields: domain.UserSortFields,
+	orders: map[string]string{
+		"username":  `username collate "C"`,
+		"full_name": `full_name collate "C"`,
+		"city":      `city collate "C"`,
+	},
+	key: "id",
+}
+
+type UserRepository struct {
+	db DB
+}
+
+func NewUserRepository(db DB) domain.IUserRepository {
+	return &UserRepository{
+		db: db,
+	}
+}
+
+func scanUser(row pgx.Row) (*domain.User, error) {
+	user := new(domain.User)
+	var birthday *time.Time
+
+	err := row.Scan(&user.ID, &user.Username, &user.FullName, &user.Gender, &birthday, &user.City, &user.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	if birthday != nil {
+		user.Birthday = *birthday
+	}
+
+	return user, nil
+}
+
+func (r *UserRepository) Create(ctx context.Context, user *domain.User) error {
+	if user.ID == uuid.Nil {
+		user.ID = uuid.New()
+	}
+
+	var birthday *time.Time
+	if !user.Birthday.IsZero() {
+		birthday = &user.Birthday
+	}
+
+	_, err := conn(ctx, r.db).Exec(ctx,
+		`insert into users (id, username, full_name, gender, birthday, city, role)
+		values ($1, $2, $3, $4, $5, $6, coalesce(nullif($7, ''), 'guest'))`,
+		user.ID, user.Username, user.FullName, user.Gender, birthday, user.City, user.Role,
+	)
+	if err != nil {
+		return mapError(err, i18n.StorageUserNotFound)
+	}
+
+	return nil
+}
+
+func (r *UserRepository) GetByUsername(ctx context.Context, username string) (*domain.User, error) {
+	user, err := scanUser(conn(ctx, r.db).QueryRow(ctx,
+		"select "+userColumns+" from users where username = $1", username))
+	if err != nil {
+		return nil, mapError(err, i18n.StorageUserNotFound)
+	}
+
+	return user, nil
+}
+
+func (r *UserRepository) GetById(ctx context.Context, userId uuid.UUID) (*domain.User, error) {
+	user, err := scanUser(conn(ctx, r.db).QueryRow(ctx,
+		"select "+userColumns+" from users where id = $1", userId))
+	if err != nil {
+		return nil, mapError(err, i18n.StorageUserNotFound)
+	}
+
+	return user, nil
+}
+
+func (r *UserRepository) GetByIds(ctx context.Context, ids []uuid.UUID) ([]*domain.User, error) {
+	return queryAll(ctx, conn(ctx, r.db), scanUser,
+		"select "+userColumns+" from users where id = any($1::uuid[])", uuidArray(ids))
+}
+
+func (r *UserRepository) GetAll(ctx context.Context, filter domain.UserFilter, req domain.PageRequest) (*domain.Page[*domain.User], error) {
+	w := userWhere(filter, time.Now())
+
+	return queryPage(ctx, conn(ctx, r.db), scanUser, userPages, req,
+		"select "+userColumns, "from users"+w.String(), w.args...,
+	)
+}
+
+func (r *UserRepository) Update(ctx context.Context, user *domain.User) error {
+	var birthday *time.Time
+	if !user.Birthday.IsZero() {
+		birthday = &user.Birthday
+	}
+
+	return execOne(ctx, conn(ctx, r.db), i18n.StorageUserNotFound,
+		`update users
+		set username = $2, full_name = $3, gender = $4, birthday = $5, city = $6, role = coalesce(nullif($7, ''), role)
+		where id = $1`,
+		user.ID, user.Username, user.FullName, user.Gender, birthday, user.City, user.Role,
+	)
+}
+
+func (r *UserRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	return deleteOne(ctx, conn(ctx, r.db), i18n.StorageUserNotFound, "delete from users where id = $1", id)
+}
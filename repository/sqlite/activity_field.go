@@ -0,0 +1,104 @@
+package sqlite
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+)
+
+const activityFieldColumns = "id, name, description, cost"
+
+var activityFieldPages = pageSpec{
+	fields: domain.ActivityFieldSortFields,
+	orders: map[string]string{
+		"name": "name",
+		"cost": "cost",
+	},
+	key: "id",
+}
+
+type ActivityFieldRepository struct {
+	db DB
+}
+
+func NewActivityFieldRepository(db DB) domain.IActivityFieldRepository {
+	return &ActivityFieldRepository{
+		db: db,
+	}
+}
+
+func scanActivityField(row Row) (*domain.ActivityField, error) {
+	field := new(domain.ActivityField)
+
+	err := row.Scan(&field.ID, &field.Name, &field.Description, &field.Cost)
+	if err != nil {
+		return nil, err
+	}
+
+	return field, nil
+}
+
+func (r *ActivityFieldRepository) Create(ctx context.Context, data *domain.ActivityField) error {
+	if data.ID == uuid.Nil {
+		data.ID = uuid.New()
+	}
+
+	_, err := conn(ctx, r.db).ExecContext(ctx,
+		"insert into activity_fields (id, name, description, cost) values (?, ?, ?, ?)",
+		data.ID, data.Name, data.Description, data.Cost,
+	)
+	if err != nil {
+		return mapError(err, i18n.StorageActivityFieldNotFound)
+	}
+
+	return nil
+}
+
+func (r *ActivityFieldRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	return deleteOne(ctx, conn(ctx, r.db), i18n.StorageActivityFieldNotFound, "delete from activity_fields where id = ?", id)
+}
+
+func (r *ActivityFieldRepository) Update(ctx context.Context, data *domain.ActivityField) error {
+	return execOne(ctx, conn(ctx, r.db), i18n.StorageActivityFieldNotFound,
+		"update activity_fields set name = ?, description = ?, cost = ? where id = ?",
+		data.Name, data.Description, data.Cost, data.ID,
+	)
+}
+
+func (r *ActivityFieldRepository) GetById(ctx context.Context, id uuid.UUID) (*domain.ActivityField, error) {
+	field, err := scanActivityField(conn(ctx, r.db).QueryRowContext(ctx,
+		"select "+activityFieldColumns+" from activity_fields where id = ?", id))
+	if err != nil {
+		return nil, mapError(err, i18n.StorageActivityFieldNotFound)
+	}
+
+	return field, nil
+}
+
+func (r *ActivityFieldRepository) GetByIds(ctx context.Context, ids []uuid.UUID) ([]*domain.ActivityField, error) {
+	in, args := inList(ids)
+	return queryAll(ctx, conn(ctx, r.db), scanActivityField,
+		"select "+activityFieldColumns+" from activity_fields where id "+in, args...)
+}
+
+func (r *ActivityFieldRepository) GetMaxCost(ctx context.Context) (float32, error) {
+	var maxCost *float32
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, "select max(cost) from activity_fields").Scan(&maxCost)
+	if err != nil {
+		return 0, err
+	}
+
+	if maxCost == nil {
+		return 0, domain.NewError(domain.ErrNotFound, i18n.StorageActivityFieldsEmpty)
+	}
+
+	return *maxCost, nil
+}
+
+func (r *ActivityFieldRepository) GetAll(ctx context.Context, req domain.PageRequest) (*domain.Page[*domain.ActivityField], error) {
+	return queryPage(ctx, conn(ctx, r.db), scanActivityField, activityFieldPages, req,
+		"select "+activityFieldColumns, "from activity_fields",
+	)
+}
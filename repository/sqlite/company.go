@@ -0,0 +1,91 @@
+package sqlite
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+)
+
+const companyColumns = "id, owner_id, activity_field_id, name, city"
+
+var companyPages = pageSpec{
+	fields: domain.CompanySortFields,
+	orders: map[string]string{
+		"name": "name",
+		"city": "city",
+	},
+	key: "id",
+}
+
+type CompanyRepository struct {
+	db DB
+}
+
+func NewCompanyRepository(db DB) domain.ICompanyRepository {
+	return &CompanyRepository{
+		db: db,
+	}
+}
+
+func scanCompany(row Row) (*domain.Company, error) {
+	company := new(domain.Company)
+
+	err := row.Scan(&company.ID, &company.OwnerID, &company.ActivityFieldId, &company.Name, &company.City)
+	if err != nil {
+		return nil, err
+	}
+
+	return company, nil
+}
+
+func (r *CompanyRepository) Create(ctx context.Context, company *domain.Company) error {
+	if company.ID == uuid.Nil {
+		company.ID = uuid.New()
+	}
+
+	_, err := conn(ctx, r.db).ExecContext(ctx,
+		"insert into companies (id, owner_id, activity_field_id, name, city) values (?, ?, ?, ?, ?)",
+		company.ID, company.OwnerID, company.ActivityFieldId, company.Name, company.City,
+	)
+	if err != nil {
+		return mapError(err, i18n.StorageCompanyNotFound)
+	}
+
+	return nil
+}
+
+func (r *CompanyRepository) GetById(ctx context.Context, id uuid.UUID) (*domain.Company, error) {
+	company, err := scanCompany(conn(ctx, r.db).QueryRowContext(ctx,
+		"select "+companyColumns+" from companies where id = ?", id))
+	if err != nil {
+		return nil, mapError(err, i18n.StorageCompanyNotFound)
+	}
+
+	return company, nil
+}
+
+func (r *CompanyRepository) GetByOwnerId(ctx context.Context, id uuid.UUID, req domain.PageRequest) (*domain.Page[*domain.Company], error) {
+	return queryPage(ctx, conn(ctx, r.db), scanCompany, companyPages, req,
+		"select "+companyColumns, "from companies where owner_id = ?", id,
+	)
+}
+
+func (r *CompanyRepository) GetAll(ctx context.Context, filter domain.CompanyFilter, req domain.PageRequest) (*domain.Page[*domain.Company], error) {
+	w := companyWhere(filter)
+
+	return queryPage(ctx, conn(ctx, r.db), scanCompany, companyPages, req,
+		"select "+companyColumns, "from companies"+w.String(), w.args...,
+	)
+}
+
+func (r *CompanyRepository) Update(ctx context.Context, company *domain.Company) error {
+	return execOne(ctx, conn(ctx, r.db), i18n.StorageCompanyNotFound,
+		"update companies set owner_id = ?, activity_field_id = ?, name = ?, city = ? where id = ?",
+		company.OwnerID, company.ActivityFieldId, company.Name, company.City, company.ID,
+	)
+}
+
+func (r *CompanyRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	return deleteOne(ctx, conn(ctx, r.db), i18n.StorageCompanyNotFound, "delete from companies where id = ?", id)
+}
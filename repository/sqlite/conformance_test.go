@@ -0,0 +1,26 @@
+package sqlite
+
+import (
+	"github.com/dlankinl/bmstu-ppo-bl/repository/conformance"
+	"testing"
+)
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, func(t *testing.T) *conformance.Repositories {
+		db := newTestDB(t)
+
+		return &conformance.Repositories{
+			Users:            NewUserRepository(db),
+			Auth:             NewAuthRepository(db),
+			ActivityFields:   NewActivityFieldRepository(db),
+			Companies:        NewCompanyRepository(db),
+			FinancialReports: NewFinancialReportRepository(db),
+			Skills:           NewSkillRepository(db),
+			UserSkills:       NewUserSkillRepository(db),
+			Contacts:         NewContactRepository(db),
+			RefreshTokens:    NewRefreshTokenRepository(db),
+			TaxSchedules:     NewTaxScheduleRepository(db),
+			TxManager:        NewTxManager(db),
+		}
+	})
+}
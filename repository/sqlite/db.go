@@ -0,0 +1,219 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+	"net/url"
+	"strings"
+)
+
+const dateLayout = "2006-01-02"
+
+type DB interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type Row interface {
+	Scan(dest ...any) error
+}
+
+func Open(ctx context.Context, path string) (*sql.DB, error) {
+	query := url.Values{}
+	query.Add("_pragma", "foreign_keys(1)")
+	query.Add("_pragma", "busy_timeout(5000)")
+	query.Add("_pragma", "journal_mode(wal)")
+
+	db, err := sql.Open("sqlite", "file:"+path+"?"+query.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	err = Migrate(ctx, db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
+
+var uniqueConstraints = map[string]i18n.Code{
+	"users.username": i18n.StorageUsernameTaken,
+	"user_skills.user_id, user_skills.skill_id":                                        i18n.StorageUserSkillExists,
+	"fin_reports.company_id, fin_reports.year, fin_reports.quarter, fin_reports.month": i18n.StorageFinReportExists,
+	"index 'tax_schedules_scope_key'":                                                  i18n.StorageTaxScheduleExists,
+	"refresh_tokens.id":                                                                i18n.AuthRefreshTokenExists,
+}
+
+func mapError(err error, notFound i18n.Code) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.NewError(domain.ErrNotFound, notFound)
+	}
+
+	return mapConstraintError(err)
+}
+
+// mapConstraintError переводит нарушения ограничений в ошибки конфликта и ненайденной ссылки,
+// остальные ошибки возвращает как есть.
+func mapConstraintError(err error) error {
+	var sqliteErr *sqlite.Error
+	if !errors.As(err, &sqliteErr) {
+		return err
+	}
+
+	switch sqliteErr.Code() {
+	case sqlite3.SQLITE_CONSTRAINT_UNIQUE, sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+		for columns, code := range uniqueConstraints {
+			if strings.Contains(sqliteErr.Error(), "failed: "+columns+" ") {
+				return domain.NewError(domain.ErrConflict, code)
+			}
+		}
+		return domain.NewError(domain.ErrConflict, i18n.StorageIdExists)
+	}
+
+	if isForeignKeyViolation(sqliteErr) {
+		return domain.NewError(domain.ErrNotFound, i18n.StorageReferenceNotFound)
+	}
+
+	return err
+}
+
+func isForeignKeyViolation(err *sqlite.Error) bool {
+	switch err.Code() {
+	case sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY:
+		return true
+	case sqlite3.SQLITE_CONSTRAINT_TRIGGER:
+		return strings.Contains(err.Error(), "FOREIGN KEY")
+	}
+
+	return false
+}
+
+func mapDeleteError(err error, notFound i18n.Code) error {
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) && isForeignKeyViolation(sqliteErr) {
+		return domain.NewError(domain.ErrConflict, i18n.StorageInUse)
+	}
+
+	return mapError(err, notFound)
+}
+
+func execOne(ctx context.Context, db DB, notFound i18n.Code, query string, args ...any) error {
+	res, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return mapError(err, notFound)
+	}
+
+	return checkAffected(res, notFound)
+}
+
+func deleteOne(ctx context.Context, db DB, notFound i18n.Code, query string, args ...any) error {
+	res, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return mapDeleteError(err, notFound)
+	}
+
+	return checkAffected(res, notFound)
+}
+
+func checkAffected(res sql.Result, notFound i18n.Code) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return domain.NewError(domain.ErrNotFound, notFound)
+	}
+
+	return nil
+}
+
+func queryAll[T any](ctx context.Context, db DB, scan func(Row) (T, error), query string, args ...any) ([]T, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make([]T, 0)
+	for rows.Next() {
+		item, err := scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, rows.Err()
+}
+
+type pageSpec struct {
+	fields []string
+	orders map[string]string
+	key    string
+}
+
+func queryPage[T any](ctx context.Context, db DB, scan func(Row) (T, error), spec pageSpec, req domain.PageRequest, sel, from string, args ...any) (*domain.Page[T], error) {
+	query, err := req.Resolve(spec.fields...)
+	if err != nil {
+		return nil, err
+	}
+
+	var total int
+	err = db.QueryRowContext(ctx, "select count(*) "+from, args...).Scan(&total)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := "asc"
+	if query.Desc {
+		dir = "desc"
+	}
+	items, err := queryAll(ctx, db, scan,
+		fmt.Sprintf("%s %s order by %s %s, %s %s limit ? offset ?", sel, from, spec.orders[query.SortBy], dir, spec.key, dir),
+		append(args, query.Limit, query.Offset)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewPage(items, total, query), nil
+}
+
+type where struct {
+	conds []string
+	args  []any
+}
+
+func (w *where) add(cond string, args ...any) {
+	w.conds = append(w.conds, cond)
+	w.args = append(w.args, args...)
+}
+
+func (w *where) String() string {
+	if len(w.conds) == 0 {
+		return ""
+	}
+
+	return " where " + strings.Join(w.conds, " and ")
+}
+
+// inList строит условие "in (?, ...)" и его параметры по списку id.
+func inList(ids []uuid.UUID) (string, []any) {
+	args := make([]any, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, id)
+	}
+
+	return "in (" + strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", ") + ")", args
+}
@@ -0,0 +1,67 @@
+package sqlite
+
+import (
+	"database/sql/driver"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+	"modernc.org/sqlite"
+	"time"
+)
+
+// встроенный lower в SQLite работает только с ASCII, поэтому поиск по имени
+// использует свою функцию с той же нормализацией, что и остальные хранилища
+func init() {
+	sqlite.MustRegisterDeterministicScalarFunction("fold", 1, func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		s, ok := args[0].(string)
+		if !ok {
+			return args[0], nil
+		}
+
+		return domain.FoldSearch(s), nil
+	})
+}
+
+func userWhere(filter domain.UserFilter, now time.Time) *where {
+	w := new(where)
+
+	if filter.City != "" {
+		w.add("city = ?", filter.City)
+	}
+	if filter.Gender != "" {
+		w.add("gender = ?", filter.Gender)
+	}
+
+	from, to := filter.BirthdayRange(now)
+	if !from.IsZero() {
+		w.add("birthday >= ?", from.Format(dateLayout))
+	}
+	if !to.IsZero() {
+		w.add("birthday <= ?", to.Format(dateLayout))
+	}
+
+	if search := domain.FoldSearch(filter.Search); search != "" {
+		w.add("(instr(fold(username), ?) > 0 or instr(fold(full_name), ?) > 0)", search, search)
+	}
+
+	return w
+}
+
+func companyWhere(filter domain.CompanyFilter) *where {
+	w := new(where)
+
+	if filter.City != "" {
+		w.add("city = ?", filter.City)
+	}
+	if filter.ActivityFieldId != uuid.Nil {
+		w.add("activity_field_id = ?", filter.ActivityFieldId)
+	}
+	if filter.OwnerId != uuid.Nil {
+		w.add("owner_id = ?", filter.OwnerId)
+	}
+
+	if search := domain.FoldSearch(filter.Search); search != "" {
+		w.add("instr(fold(name), ?) > 0", search)
+	}
+
+	return w
+}
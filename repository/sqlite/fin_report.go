@@ -0,0 +1,144 @@
+package sqlite
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+)
+
+const finReportColumns = "id, company_id, revenue, costs, currency, granularity, year, quarter, month"
+
+type FinancialReportRepository struct {
+	db DB
+}
+
+func NewFinancialReportRepository(db DB) domain.IFinancialReportRepository {
+	return &FinancialReportRepository{
+		db: db,
+	}
+}
+
+func scanFinReport(row Row) (*domain.FinancialReport, error) {
+	report := new(domain.FinancialReport)
+
+	// суммы хранятся в минимальных единицах валюты отчета
+	var currency string
+	err := row.Scan(&report.ID, &report.CompanyID, &report.Revenue.Amount, &report.Costs.Amount, &currency,
+		&report.Granularity, &report.Year, &report.Quarter, &report.Month)
+	if err != nil {
+		return nil, err
+	}
+	report.Revenue.Currency = currency
+	report.Costs.Currency = currency
+
+	return report, nil
+}
+
+func (r *FinancialReportRepository) Create(ctx context.Context, finRep *domain.FinancialReport) error {
+	if finRep.ID == uuid.Nil {
+		finRep.ID = uuid.New()
+	}
+
+	_, err := conn(ctx, r.db).ExecContext(ctx,
+		`insert into fin_reports (id, company_id, revenue, costs, currency, granularity, year, quarter, month)
+		values (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		finRep.ID, finRep.CompanyID, finRep.Revenue.Amount, finRep.Costs.Amount, finRep.Revenue.Currency,
+		finRep.Granularity, finRep.Year, finRep.Quarter, finRep.Month,
+	)
+	if err != nil {
+		return mapError(err, i18n.StorageFinReportNotFound)
+	}
+
+	return nil
+}
+
+func (r *FinancialReportRepository) GetById(ctx context.Context, id uuid.UUID) (*domain.FinancialReport, error) {
+	report, err := scanFinReport(conn(ctx, r.db).QueryRowContext(ctx,
+		"select "+finReportColumns+" from fin_reports where id = ?", id))
+	if err != nil {
+		return nil, mapError(err, i18n.StorageFinReportNotFound)
+	}
+
+	return report, nil
+}
+
+func (r *FinancialReportRepository) GetByCompany(ctx context.Context, companyId uuid.UUID, period *domain.Period) (
+	*domain.FinancialReportByPeriod, error) {
+	reports, err := queryAll(ctx, conn(ctx, r.db), scanFinReport,
+		`select `+finReportColumns+` from fin_reports
+		where company_id = ?
+		and year * 4 + case when granularity = 'year' then 1 else quarter end >= ?
+		and year * 4 + case when granularity = 'year' then 4 else quarter end <= ?
+		order by year, quarter, month`,
+		companyId, period.StartYear*4+period.StartQuarter, period.EndYear*4+period.EndQuarter,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	byPeriod := &domain.FinancialReportByPeriod{
+		Reports: make([]domain.FinancialReport, 0, len(reports)),
+		Period:  period,
+	}
+	for _, report := range reports {
+		byPeriod.Reports = append(byPeriod.Reports, *report)
+	}
+
+	return byPeriod, nil
+}
+
+func (r *FinancialReportRepository) GetByCompanies(ctx context.Context, companyIds []uuid.UUID, period *domain.Period) (
+	map[uuid.UUID]*domain.FinancialReportByPeriod, error) {
+	in, args := inList(companyIds)
+	reports, err := queryAll(ctx, conn(ctx, r.db), scanFinReport,
+		`select `+finReportColumns+` from fin_reports
+		where company_id `+in+`
+		and year * 4 + case when granularity = 'year' then 1 else quarter end >= ?
+		and year * 4 + case when granularity = 'year' then 4 else quarter end <= ?
+		order by year, quarter, month`,
+		append(args, period.StartYear*4+period.StartQuarter, period.EndYear*4+period.EndQuarter)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return groupByCompany(companyIds, period, reports), nil
+}
+
+func (r *FinancialReportRepository) Update(ctx context.Context, finRep *domain.FinancialReport) error {
+	return execOne(ctx, conn(ctx, r.db), i18n.StorageFinReportNotFound,
+		`update fin_reports set company_id = ?, revenue = ?, costs = ?, currency = ?, granularity = ?, year = ?,
+		quarter = ?, month = ? where id = ?`,
+		finRep.CompanyID, finRep.Revenue.Amount, finRep.Costs.Amount, finRep.Revenue.Currency,
+		finRep.Granularity, finRep.Year, finRep.Quarter, finRep.Month, finRep.ID,
+	)
+}
+
+func (r *FinancialReportRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	return deleteOne(ctx, conn(ctx, r.db), i18n.StorageFinReportNotFound, "delete from fin_reports where id = ?", id)
+}
+
+// LockCompanyYear начинает запись в транзакции: SQLite допускает одного писателя,
+// поэтому блокируется вся база, а не только отчеты компании за год.
+func (r *FinancialReportRepository) LockCompanyYear(ctx context.Context, companyId uuid.UUID, year int) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx,
+		"update fin_reports set year = year where company_id = ? and year = ?", companyId, year)
+	return err
+}
+
+func groupByCompany(companyIds []uuid.UUID, period *domain.Period, reports []*domain.FinancialReport) map[uuid.UUID]*domain.FinancialReportByPeriod {
+	grouped := make(map[uuid.UUID]*domain.FinancialReportByPeriod, len(companyIds))
+	for _, companyId := range companyIds {
+		grouped[companyId] = &domain.FinancialReportByPeriod{
+			Reports: make([]domain.FinancialReport, 0),
+			Period:  period,
+		}
+	}
+	for _, report := range reports {
+		byPeriod := grouped[report.CompanyID]
+		byPeriod.Reports = append(byPeriod.Reports, *report)
+	}
+
+	return grouped
+}
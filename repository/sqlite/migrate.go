@@ -0,0 +1,72 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"io/fs"
+	"path"
+	"sort"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+func Migrate(ctx context.Context, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, `create table if not exists schema_migrations (
+		version text primary key,
+		applied_at text not null default current_timestamp
+	)`)
+	if err != nil {
+		return i18n.Wrap(err, i18n.StorageMigrate, "schema_migrations")
+	}
+
+	names, err := fs.Glob(migrations, "migrations/*.sql")
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		err = applyMigration(ctx, db, name)
+		if err != nil {
+			return i18n.Wrap(err, i18n.StorageMigrate, path.Base(name))
+		}
+	}
+
+	return nil
+}
+
+func applyMigration(ctx context.Context, db *sql.DB, name string) error {
+	script, err := migrations.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	version := path.Base(name)
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var applied bool
+	err = tx.QueryRowContext(ctx, "select exists(select 1 from schema_migrations where version = ?)", version).
+		Scan(&applied)
+	if err != nil || applied {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, string(script))
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "insert into schema_migrations (version) values (?)", version)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
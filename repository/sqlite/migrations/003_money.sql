@@ -0,0 +1,23 @@
+-- суммы отчетов переводятся из real в целые копейки, отчеты получают валюту;
+-- sqlite не меняет тип колонки, поэтому таблица пересоздается
+create table fin_reports_money
+(
+    id         text primary key,
+    company_id text    not null references companies (id) on delete cascade,
+    revenue    integer not null,
+    costs      integer not null,
+    currency   text    not null default 'RUB',
+    year       integer not null,
+    quarter    integer not null check (quarter between 1 and 4),
+    unique (company_id, year, quarter)
+);
+
+insert into fin_reports_money (id, company_id, revenue, costs, year, quarter)
+select id, company_id, cast(round(revenue * 100) as integer), cast(round(costs * 100) as integer), year, quarter
+from fin_reports;
+
+drop table fin_reports;
+
+alter table fin_reports_money rename to fin_reports;
+
+alter table tax_schedules add column currency text not null default 'RUB';
@@ -0,0 +1,26 @@
+-- отчеты получают периодичность: месячные хранят квартал своего месяца, годовые — нулевой квартал;
+-- sqlite не меняет ограничения таблицы, поэтому таблица пересоздается
+create table fin_reports_granularity
+(
+    id          text primary key,
+    company_id  text    not null references companies (id) on delete cascade,
+    revenue     integer not null,
+    costs       integer not null,
+    currency    text    not null default 'RUB',
+    granularity text    not null default 'quarter',
+    year        integer not null,
+    quarter     integer not null,
+    month       integer not null default 0,
+    check ((granularity = 'month' and month between 1 and 12 and quarter = (month + 2) / 3) or
+           (granularity = 'quarter' and quarter between 1 and 4 and month = 0) or
+           (granularity = 'year' and quarter = 0 and month = 0)),
+    unique (company_id, year, quarter, month)
+);
+
+insert into fin_reports_granularity (id, company_id, revenue, costs, currency, year, quarter)
+select id, company_id, revenue, costs, currency, year, quarter
+from fin_reports;
+
+drop table fin_reports;
+
+alter table fin_reports_granularity rename to fin_reports;
@@ -0,0 +1,83 @@
+package sqlite
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"time"
+)
+
+type RefreshTokenRepository struct {
+	db DB
+}
+
+func NewRefreshTokenRepository(db DB) domain.IRefreshTokenRepository {
+	return &RefreshTokenRepository{
+		db: db,
+	}
+}
+
+func (r *RefreshTokenRepository) Create(ctx context.Context, token *domain.RefreshToken) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx,
+		"insert into refresh_tokens (id, user_id, username, expires_at, revoked) values (?, ?, ?, ?, ?)",
+		token.ID, token.UserId, token.Username, token.ExpiresAt.UTC().Format(time.RFC3339Nano), token.Revoked,
+	)
+	if err != nil {
+		return mapConstraintError(err)
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) GetById(ctx context.Context, id uuid.UUID) (*domain.RefreshToken, error) {
+	token := new(domain.RefreshToken)
+	var expiresAt string
+
+	err := conn(ctx, r.db).QueryRowContext(ctx,
+		"select id, user_id, username, expires_at, revoked from refresh_tokens where id = ?", id,
+	).Scan(&token.ID, &token.UserId, &token.Username, &expiresAt, &token.Revoked)
+	if err != nil {
+		return nil, mapError(err, i18n.AuthRefreshTokenNotFound)
+	}
+
+	token.ExpiresAt, err = time.Parse(time.RFC3339Nano, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func (r *RefreshTokenRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	res, err := conn(ctx, r.db).ExecContext(ctx, "update refresh_tokens set revoked = 1 where id = ? and not revoked", id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		_, err = r.IsRevoked(ctx, id)
+		if err != nil {
+			return err
+		}
+		return domain.NewError(domain.ErrConflict, i18n.AuthRefreshTokenAlreadyRevoked)
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) IsRevoked(ctx context.Context, id uuid.UUID) (bool, error) {
+	var revoked bool
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, "select revoked from refresh_tokens where id = ?", id).Scan(&revoked)
+	if err != nil {
+		return false, mapError(err, i18n.AuthRefreshTokenNotFound)
+	}
+
+	return revoked, nil
+}
@@ -0,0 +1,88 @@
+package sqlite
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+)
+
+const skillColumns = "id, name, description"
+
+var skillPages = pageSpec{
+	fields: domain.SkillSortFields,
+	orders: map[string]string{
+		"name": "name",
+	},
+	key: "id",
+}
+
+type SkillRepository struct {
+	db DB
+}
+
+func NewSkillRepository(db DB) domain.ISkillRepository {
+	return &SkillRepository{
+		db: db,
+	}
+}
+
+func scanSkill(row Row) (*domain.Skill, error) {
+	skill := new(domain.Skill)
+
+	err := row.Scan(&skill.ID, &skill.Name, &skill.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	return skill, nil
+}
+
+func (r *SkillRepository) Create(ctx context.Context, skill *domain.Skill) error {
+	if skill.ID == uuid.Nil {
+		skill.ID = uuid.New()
+	}
+
+	_, err := conn(ctx, r.db).ExecContext(ctx,
+		"insert into skills (id, name, description) values (?, ?, ?)",
+		skill.ID, skill.Name, skill.Description,
+	)
+	if err != nil {
+		return mapError(err, i18n.StorageSkillNotFound)
+	}
+
+	return nil
+}
+
+func (r *SkillRepository) GetById(ctx context.Context, id uuid.UUID) (*domain.Skill, error) {
+	skill, err := scanSkill(conn(ctx, r.db).QueryRowContext(ctx,
+		"select "+skillColumns+" from skills where id = ?", id))
+	if err != nil {
+		return nil, mapError(err, i18n.StorageSkillNotFound)
+	}
+
+	return skill, nil
+}
+
+func (r *SkillRepository) GetByIds(ctx context.Context, ids []uuid.UUID) ([]*domain.Skill, error) {
+	in, args := inList(ids)
+	return queryAll(ctx, conn(ctx, r.db), scanSkill,
+		"select "+skillColumns+" from skills where id "+in, args...)
+}
+
+func (r *SkillRepository) GetAll(ctx context.Context, req domain.PageRequest) (*domain.Page[*domain.Skill], error) {
+	return queryPage(ctx, conn(ctx, r.db), scanSkill, skillPages, req,
+		"select "+skillColumns, "from skills",
+	)
+}
+
+func (r *SkillRepository) Update(ctx context.Context, skill *domain.Skill) error {
+	return execOne(ctx, conn(ctx, r.db), i18n.StorageSkillNotFound,
+		"update skills set name = ?, description = ? where id = ?",
+		skill.Name, skill.Description, skill.ID,
+	)
+}
+
+func (r *SkillRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	return deleteOne(ctx, conn(ctx, r.db), i18n.StorageSkillNotFound, "delete from skills where id = ?", id)
+}
@@ -0,0 +1,349 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	ctx := context.Background()
+	db, err := Open(ctx, filepath.Join(t.TempDir(), "bl.db"))
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	require.Nil(t, Migrate(ctx, db))
+
+	return db
+}
+
+func createUser(t *testing.T, db DB, username string) *domain.User {
+	t.Helper()
+
+	user := &domain.User{
+		Username: username,
+		FullName: "Иванов Иван Иванович",
+		Gender:   "m",
+		Birthday: time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC),
+		City:     "Москва",
+		Role:     domain.RoleEntrepreneur,
+	}
+	require.Nil(t, NewUserRepository(db).Create(context.Background(), user))
+
+	return user
+}
+
+func createCompany(t *testing.T, db DB, ownerId uuid.UUID, name string) *domain.Company {
+	t.Helper()
+
+	field := &domain.ActivityField{Name: name, Description: "описание", Cost: 1.5}
+	require.Nil(t, NewActivityFieldRepository(db).Create(context.Background(), field))
+
+	company := &domain.Company{OwnerID: ownerId, ActivityFieldId: field.ID, Name: name, City: "Москва"}
+	require.Nil(t, NewCompanyRepository(db).Create(context.Background(), company))
+
+	return company
+}
+
+func rub(units int64) domain.Money {
+	return domain.NewMoney(units, domain.DefaultCurrency)
+}
+
+func TestForeignKeys(t *testing.T) {
+	db := newTestDB(t)
+
+	var enabled bool
+	require.Nil(t, db.QueryRow("pragma foreign_keys").Scan(&enabled))
+	require.True(t, enabled)
+}
+
+func TestUserRepository(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+	repo := NewUserRepository(db)
+
+	user := createUser(t, db, "ivan")
+	require.NotEqual(t, uuid.Nil, user.ID)
+
+	got, err := repo.GetById(ctx, user.ID)
+	require.Nil(t, err)
+	require.Equal(t, user, got)
+
+	got, err = repo.GetByUsername(ctx, "ivan")
+	require.Nil(t, err)
+	require.Equal(t, user.ID, got.ID)
+
+	err = repo.Create(ctx, &domain.User{Username: "ivan"})
+	require.ErrorIs(t, err, domain.ErrConflict)
+
+	user.City = "Казань"
+	require.Nil(t, repo.Update(ctx, user))
+	got, err = repo.GetById(ctx, user.ID)
+	require.Nil(t, err)
+	require.Equal(t, "Казань", got.City)
+
+	require.ErrorIs(t, repo.Update(ctx, &domain.User{ID: uuid.New()}), domain.ErrNotFound)
+
+	for _, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"} {
+		createUser(t, db, name)
+	}
+	first, err := repo.GetAll(ctx, domain.UserFilter{}, domain.PageRequest{})
+	require.Nil(t, err)
+	require.Len(t, first.Items, domain.PageSize)
+	require.Equal(t, "a", first.Items[0].Username)
+	require.Equal(t, "ivan", first.Items[9].Username)
+	second, err := repo.GetAll(ctx, domain.UserFilter{}, domain.PageRequest{Cursor: first.NextCursor})
+	require.Nil(t, err)
+	require.Len(t, second.Items, 2)
+	require.Equal(t, "k", second.Items[1].Username)
+
+	require.Nil(t, repo.DeleteById(ctx, user.ID))
+	_, err = repo.GetById(ctx, user.ID)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	require.ErrorIs(t, repo.DeleteById(ctx, user.ID), domain.ErrNotFound)
+}
+
+func TestAuthRepository(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+	repo := NewAuthRepository(db)
+
+	authInfo := &domain.UserAuth{Username: "ivan", HashedPass: "hash"}
+	require.Nil(t, repo.Register(ctx, authInfo))
+
+	got, err := repo.GetByUsername(ctx, "ivan")
+	require.Nil(t, err)
+	require.Equal(t, authInfo.ID, got.ID)
+	require.Equal(t, "hash", got.HashedPass)
+	require.Equal(t, domain.RoleGuest, got.Role)
+
+	require.ErrorIs(t, repo.Register(ctx, &domain.UserAuth{Username: "ivan"}), domain.ErrConflict)
+
+	_, err = repo.GetByUsername(ctx, "petr")
+	require.ErrorIs(t, err, domain.ErrNotFound)
+
+	user, err := NewUserRepository(db).GetById(ctx, authInfo.ID)
+	require.Nil(t, err)
+	require.True(t, user.Birthday.IsZero())
+}
+
+func TestActivityFieldRepository(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+	repo := NewActivityFieldRepository(db)
+
+	_, err := repo.GetMaxCost(ctx)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+
+	company := createCompany(t, db, createUser(t, db, "ivan").ID, "IT")
+	require.Nil(t, repo.Create(ctx, &domain.ActivityField{Name: "Торговля", Description: "описание", Cost: 3.25}))
+
+	maxCost, err := repo.GetMaxCost(ctx)
+	require.Nil(t, err)
+	require.Equal(t, float32(3.25), maxCost)
+
+	fields, err := repo.GetAll(ctx, domain.PageRequest{})
+	require.Nil(t, err)
+	require.Len(t, fields.Items, 2)
+	require.Equal(t, "IT", fields.Items[0].Name)
+
+	err = repo.DeleteById(ctx, company.ActivityFieldId)
+	require.ErrorIs(t, err, domain.ErrConflict)
+	require.Nil(t, NewCompanyRepository(db).DeleteById(ctx, company.ID))
+	require.Nil(t, repo.DeleteById(ctx, company.ActivityFieldId))
+}
+
+func TestCompanyRepository(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+	repo := NewCompanyRepository(db)
+
+	owner := createUser(t, db, "ivan")
+	first := createCompany(t, db, owner.ID, "Альфа")
+	createCompany(t, db, owner.ID, "Бета")
+	createCompany(t, db, createUser(t, db, "petr").ID, "Гамма")
+
+	companies, err := repo.GetByOwnerId(ctx, owner.ID, domain.PageRequest{})
+	require.Nil(t, err)
+	require.Len(t, companies.Items, 2)
+	require.Equal(t, first, companies.Items[0])
+
+	companies, err = repo.GetAll(ctx, domain.CompanyFilter{}, domain.PageRequest{})
+	require.Nil(t, err)
+	require.Len(t, companies.Items, 3)
+
+	err = repo.Create(ctx, &domain.Company{OwnerID: uuid.New(), ActivityFieldId: first.ActivityFieldId, Name: "Дельта"})
+	require.ErrorIs(t, err, domain.ErrNotFound)
+
+	require.Nil(t, NewUserRepository(db).DeleteById(ctx, owner.ID))
+	_, err = repo.GetById(ctx, first.ID)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestFinancialReportRepository(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+	repo := NewFinancialReportRepository(db)
+
+	company := createCompany(t, db, createUser(t, db, "ivan").ID, "Альфа")
+	for _, yq := range [][2]int{{2022, 4}, {2021, 1}, {2021, 3}, {2020, 4}, {2022, 1}} {
+		report := &domain.FinancialReport{
+			CompanyID:   company.ID,
+			Revenue:     rub(100),
+			Costs:       rub(50),
+			Granularity: domain.GranularityQuarter,
+			Year:        yq[0],
+			Quarter:     yq[1],
+		}
+		require.Nil(t, repo.Create(ctx, report))
+	}
+
+	err := repo.Create(ctx, &domain.FinancialReport{
+		CompanyID:   company.ID,
+		Granularity: domain.GranularityQuarter,
+		Year:        2021,
+		Quarter:     1,
+	})
+	require.ErrorIs(t, err, domain.ErrConflict)
+	require.Equal(t, "отчет компании за этот период уже существует", err.Error())
+
+	period := &domain.Period{StartYear: 2021, StartQuarter: 1, EndYear: 2022, EndQuarter: 1}
+	byPeriod, err := repo.GetByCompany(ctx, company.ID, period)
+	require.Nil(t, err)
+	require.Equal(t, period, byPeriod.Period)
+	require.Len(t, byPeriod.Reports, 3)
+	require.Equal(t, [2]int{2021, 1}, [2]int{byPeriod.Reports[0].Year, byPeriod.Reports[0].Quarter})
+	require.Equal(t, [2]int{2021, 3}, [2]int{byPeriod.Reports[1].Year, byPeriod.Reports[1].Quarter})
+	require.Equal(t, [2]int{2022, 1}, [2]int{byPeriod.Reports[2].Year, byPeriod.Reports[2].Quarter})
+
+	report := byPeriod.Reports[0]
+	report.Revenue = rub(200)
+	require.Nil(t, repo.Update(ctx, &report))
+	got, err := repo.GetById(ctx, report.ID)
+	require.Nil(t, err)
+	require.Equal(t, rub(200), got.Revenue)
+
+	require.Nil(t, repo.DeleteById(ctx, report.ID))
+	require.ErrorIs(t, repo.DeleteById(ctx, report.ID), domain.ErrNotFound)
+}
+
+func TestMigrateMoney(t *testing.T) {
+	ctx := context.Background()
+	// Open применяет все миграции, поэтому база открывается напрямую
+	db, err := sql.Open("sqlite", "file:"+filepath.Join(t.TempDir(), "bl.db")+"?_pragma=foreign_keys(1)")
+	require.Nil(t, err)
+	defer db.Close()
+
+	// схема до перевода сумм в копейки
+	_, err = db.ExecContext(ctx, `create table schema_migrations (
+		version text primary key,
+		applied_at text not null default current_timestamp
+	)`)
+	require.Nil(t, err)
+	require.Nil(t, applyMigration(ctx, db, "migrations/001_init.sql"))
+	require.Nil(t, applyMigration(ctx, db, "migrations/002_tax_schedules.sql"))
+
+	company := createCompany(t, db, createUser(t, db, "ivan").ID, "Альфа")
+	id := uuid.New()
+	_, err = db.ExecContext(ctx,
+		"insert into fin_reports (id, company_id, revenue, costs, year, quarter) values (?, ?, ?, ?, ?, ?)",
+		id, company.ID, float64(float32(1234567.5)), float64(float32(1234.56)), 2023, 1)
+	require.Nil(t, err)
+
+	require.Nil(t, Migrate(ctx, db))
+
+	got, err := NewFinancialReportRepository(db).GetById(ctx, id)
+	require.Nil(t, err)
+	require.Equal(t, domain.Money{Amount: 123456750, Currency: domain.DefaultCurrency}, got.Revenue)
+	require.Equal(t, domain.Money{Amount: 123456, Currency: domain.DefaultCurrency}, got.Costs)
+	// отчеты до появления периодичности квартальные
+	require.Equal(t, domain.GranularityQuarter, got.Granularity)
+}
+
+func TestUserSkillRepository(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+	repo := NewUserSkillRepository(db)
+	skills := NewSkillRepository(db)
+
+	user := createUser(t, db, "ivan")
+	skill := &domain.Skill{Name: "Go", Description: "язык программирования"}
+	require.Nil(t, skills.Create(ctx, skill))
+
+	pair := &domain.UserSkill{UserId: user.ID, SkillId: skill.ID}
+	require.Nil(t, repo.Create(ctx, pair))
+	require.ErrorIs(t, repo.Create(ctx, pair), domain.ErrConflict)
+	require.ErrorIs(t, repo.Create(ctx, &domain.UserSkill{UserId: user.ID, SkillId: uuid.New()}), domain.ErrNotFound)
+
+	pairs, err := repo.GetUserSkillsByUserId(ctx, user.ID, domain.PageRequest{})
+	require.Nil(t, err)
+	require.Equal(t, []*domain.UserSkill{pair}, pairs.Items)
+
+	pairs, err = repo.GetUserSkillsBySkillId(ctx, skill.ID, domain.PageRequest{})
+	require.Nil(t, err)
+	require.Equal(t, []*domain.UserSkill{pair}, pairs.Items)
+
+	require.Nil(t, skills.DeleteById(ctx, skill.ID))
+	pairs, err = repo.GetUserSkillsByUserId(ctx, user.ID, domain.PageRequest{})
+	require.Nil(t, err)
+	require.Empty(t, pairs.Items)
+	require.ErrorIs(t, repo.Delete(ctx, pair), domain.ErrNotFound)
+}
+
+func TestContactRepository(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+	repo := NewContactRepository(db)
+
+	user := createUser(t, db, "ivan")
+	contact := &domain.Contact{OwnerID: user.ID, Name: "email", Value: "ivan@example.com"}
+	require.Nil(t, repo.Create(ctx, contact))
+
+	contact.Value = "ivan@example.org"
+	require.Nil(t, repo.Update(ctx, contact))
+
+	contacts, err := repo.GetByOwnerId(ctx, user.ID, domain.PageRequest{})
+	require.Nil(t, err)
+	require.Equal(t, []*domain.Contact{contact}, contacts.Items)
+
+	require.Nil(t, repo.DeleteById(ctx, contact.ID))
+	_, err = repo.GetById(ctx, contact.ID)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestRefreshTokenRepository(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+	repo := NewRefreshTokenRepository(db)
+
+	user := createUser(t, db, "ivan")
+	token := &domain.RefreshToken{
+		ID:        uuid.New(),
+		UserId:    user.ID,
+		Username:  user.Username,
+		ExpiresAt: time.Now().Add(time.Hour).Truncate(time.Microsecond),
+	}
+	require.Nil(t, repo.Create(ctx, token))
+	require.ErrorIs(t, repo.Create(ctx, token), domain.ErrConflict)
+
+	got, err := repo.GetById(ctx, token.ID)
+	require.Nil(t, err)
+	require.True(t, token.ExpiresAt.Equal(got.ExpiresAt))
+
+	require.Nil(t, repo.Revoke(ctx, token.ID))
+	require.ErrorIs(t, repo.Revoke(ctx, token.ID), domain.ErrConflict)
+	require.ErrorIs(t, repo.Revoke(ctx, uuid.New()), domain.ErrNotFound)
+
+	revoked, err := repo.IsRevoked(ctx, token.ID)
+	require.Nil(t, err)
+	require.True(t, revoked)
+}
@@ -0,0 +1,123 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"time"
+)
+
+const userColumns = "id, username, full_name, gender, birthday, city, role"
+
+var userPages = pageSpec{
+	fields: domain.UserSortFields,
+	orders: map[string]string{
+		"username":  "username",
+		"full_name": "full_name",
+		"city":      "city",
+	},
+	key: "id",
+}
+
+type UserRepository struct {
+	db DB
+}
+
+func NewUserRepository(db DB) domain.IUserRepository {
+	return &UserRepository{
+		db: db,
+	}
+}
+
+func scanUser(row Row) (*domain.User, error) {
+	user := new(domain.User)
+	var birthday sql.NullString
+
+	err := row.Scan(&user.ID, &user.Username, &user.FullName, &user.Gender, &birthday, &user.City, &user.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	if birthday.Valid {
+		user.Birthday, err = time.Parse(dateLayout, birthday.String)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return user, nil
+}
+
+func formatBirthday(birthday time.Time) sql.NullString {
+	if birthday.IsZero() {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{String: birthday.Format(dateLayout), Valid: true}
+}
+
+func (r *UserRepository) Create(ctx context.Context, user *domain.User) error {
+	if user.ID == uuid.Nil {
+		user.ID = uuid.New()
+	}
+
+	_, err := conn(ctx, r.db).ExecContext(ctx,
+		`insert into users (id, username, full_name, gender, birthday, city, role)
+		values (?, ?, ?, ?, ?, ?, coalesce(nullif(?, ''), 'guest'))`,
+		user.ID, user.Username, user.FullName, user.Gender, formatBirthday(user.Birthday), user.City, user.Role,
+	)
+	if err != nil {
+		return mapError(err, i18n.StorageUserNotFound)
+	}
+
+	return nil
+}
+
+func (r *UserRepository) GetByUsername(ctx context.Context, username string) (*domain.User, error) {
+	user, err := scanUser(conn(ctx, r.db).QueryRowContext(ctx,
+		"select "+userColumns+" from users where username = ?", username))
+	if err != nil {
+		return nil, mapError(err, i18n.StorageUserNotFound)
+	}
+
+	return user, nil
+}
+
+func (r *UserRepository) GetById(ctx context.Context, userId uuid.UUID) (*domain.User, error) {
+	user, err := scanUser(conn(ctx, r.db).QueryRowContext(ctx,
+		"select "+userColumns+" from users where id = ?", userId))
+	if err != nil {
+		return nil, mapError(err, i18n.StorageUserNotFound)
+	}
+
+	return user, nil
+}
+
+func (r *UserRepository) GetByIds(ctx context.Context, ids []uuid.UUID) ([]*domain.User, error) {
+	in, args := inList(ids)
+	return queryAll(ctx, conn(ctx, r.db), scanUser,
+		"select "+userColumns+" from users where id "+in, args...)
+}
+
+func (r *UserRepository) GetAll(ctx context.Context, filter domain.UserFilter, req domain.PageRequest) (*domain.Page[*domain.User], error) {
+	w := userWhere(filter, time.Now())
+
+	return queryPage(ctx, conn(ctx, r.db), scanUser, userPages, req,
+		"select "+userColumns, "from users"+w.String(), w.args...,
+	)
+}
+
+func (r *UserRepository) Update(ctx context.Context, user *domain.User) error {
+	return execOne(ctx, conn(ctx, r.db), i18n.StorageUserNotFound,
+		`update users
+		set username = ?, full_name = ?, gender = ?, birthday = ?, city = ?, role = coalesce(nullif(?, ''), role)
+		where id = ?`,
+		user.Username, user.FullName, user.Gender, formatBirthday(user.Birthday), user.City, user.Role, user.ID,
+	)
+}
+
+func (r *UserRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	return deleteOne(ctx, conn(ctx, r.db), i18n.StorageUserNotFound, "delete from users where id = ?", id)
+}
@@ -0,0 +1,77 @@
+package sqlite
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+)
+
+var (
+	userSkillByUserPages = pageSpec{
+		fields: domain.UserSkillByUserSortFields,
+		orders: map[string]string{
+			"skill_id": "skill_id",
+		},
+		key: "skill_id",
+	}
+	userSkillBySkillPages = pageSpec{
+		fields: domain.UserSkillBySkillSortFields,
+		orders: map[string]string{
+			"user_id": "user_id",
+		},
+		key: "user_id",
+	}
+)
+
+type UserSkillRepository struct {
+	db DB
+}
+
+func NewUserSkillRepository(db DB) domain.IUserSkillRepository {
+	return &UserSkillRepository{
+		db: db,
+	}
+}
+
+func scanUserSkill(row Row) (*domain.UserSkill, error) {
+	pair := new(domain.UserSkill)
+
+	err := row.Scan(&pair.UserId, &pair.SkillId)
+	if err != nil {
+		return nil, err
+	}
+
+	return pair, nil
+}
+
+func (r *UserSkillRepository) Create(ctx context.Context, pair *domain.UserSkill) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx,
+		"insert into user_skills (user_id, skill_id) values (?, ?)",
+		pair.UserId, pair.SkillId,
+	)
+	if err != nil {
+		return mapError(err, i18n.StorageUserSkillNotFound)
+	}
+
+	return nil
+}
+
+func (r *UserSkillRepository) Delete(ctx context.Context, pair *domain.UserSkill) error {
+	return deleteOne(ctx, conn(ctx, r.db), i18n.StorageUserSkillNotFound,
+		"delete from user_skills where user_id = ? and skill_id = ?",
+		pair.UserId, pair.SkillId,
+	)
+}
+
+func (r *UserSkillRepository) GetUserSkillsByUserId(ctx context.Context, userId uuid.UUID, req domain.PageRequest) (*domain.Page[*domain.UserSkill], error) {
+	return queryPage(ctx, conn(ctx, r.db), scanUserSkill, userSkillByUserPages, req,
+		"select user_id, skill_id", "from user_skills where user_id = ?", userId,
+	)
+}
+
+func (r *UserSkillRepository) GetUserSkillsBySkillId(ctx context.Context, skillId uuid.UUID, req domain.PageRequest) (*domain.Page[*domain.UserSkill], error) {
+	return queryPage(ctx, conn(ctx, r.db), scanUserSkill, userSkillBySkillPages, req,
+		"select user_id, skill_id", "from user_skills where skill_id = ?", skillId,
+	)
+}
@@ -2,8 +2,8 @@ package activity_field
 
 import (
 	"context"
-	"fmt"
 	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
 	"github.com/dlankinl/bmstu-ppo-bl/pkg/logger"
 	"github.com/google/uuid"
 	"math"
@@ -27,107 +27,103 @@ func NewService(
 	}
 }
 
-func (s *Service) Create(data *domain.ActivityField) (err error) {
+func (s *Service) Create(ctx context.Context, data *domain.ActivityField) (err error) {
 	if data.Name == "" {
-		s.logger.Infof("должно быть указано название сферы деятельности")
-		return fmt.Errorf("должно быть указано название сферы деятельности")
+		s.logger.Infof("%v", i18n.ActivityFieldNameRequired)
+		return domain.NewValidationError("name", i18n.ActivityFieldNameRequired)
 	}
 
 	if data.Description == "" {
-		s.logger.Infof("должно быть указано описание сферы деятельности")
-		return fmt.Errorf("должно быть указано описание сферы деятельности")
+		s.logger.Infof("%v", i18n.ActivityFieldDescriptionRequired)
+		return domain.NewValidationError("description", i18n.ActivityFieldDescriptionRequired)
 	}
 
 	if math.Abs(float64(data.Cost)) < 1e-7 {
-		s.logger.Infof("вес сферы деятельности не может быть равен 0")
-		return fmt.Errorf("вес сферы деятельности не может быть равен 0")
+		s.logger.Infof("%v", i18n.ActivityFieldCostZero)
+		return domain.NewValidationError("cost", i18n.ActivityFieldCostZero)
 	}
 
-	ctx := context.Background()
-
 	err = s.actFieldRepo.Create(ctx, data)
 	if err != nil {
-		s.logger.Infof("создание сферы деятельности: %v", err)
-		return fmt.Errorf("создание сферы деятельности: %w", err)
+		s.logger.Infof("%v: %v", i18n.ActivityFieldCreate, err)
+		return i18n.Wrap(err, i18n.ActivityFieldCreate)
 	}
 
 	return nil
 }
 
-func (s *Service) DeleteById(id uuid.UUID) (err error) {
-	ctx := context.Background()
-
+func (s *Service) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
 	err = s.actFieldRepo.DeleteById(ctx, id)
 	if err != nil {
-		s.logger.Infof("удаление сферы деятельности по id: %v", err)
-		return fmt.Errorf("удаление сферы деятельности по id: %w", err)
+		s.logger.Infof("%v: %v", i18n.ActivityFieldDelete, err)
+		return i18n.Wrap(err, i18n.ActivityFieldDelete)
 	}
 
 	return nil
 }
 
-func (s *Service) Update(data *domain.ActivityField) (err error) {
-	ctx := context.Background()
-
+func (s *Service) Update(ctx context.Context, data *domain.ActivityField) (err error) {
 	err = s.actFieldRepo.Update(ctx, data)
 	if err != nil {
-		s.logger.Infof("обновление информации о cфере деятельности: %v", err)
-		return fmt.Errorf("обновление информации о cфере деятельности: %w", err)
+		s.logger.Infof("%v: %v", i18n.ActivityFieldUpdate, err)
+		return i18n.Wrap(err, i18n.ActivityFieldUpdate)
 	}
 
 	return nil
 }
 
-func (s *Service) GetById(id uuid.UUID) (data *domain.ActivityField, err error) {
-	ctx := context.Background()
-
+func (s *Service) GetById(ctx context.Context, id uuid.UUID) (data *domain.ActivityField, err error) {
 	data, err = s.actFieldRepo.GetById(ctx, id)
 	if err != nil {
-		s.logger.Infof("получение сферы деятельности по id: %v", err)
-		return nil, fmt.Errorf("получение сферы деятельности по id: %w", err)
+		s.logger.Infof("%v: %v", i18n.ActivityFieldGet, err)
+		return nil, i18n.Wrap(err, i18n.ActivityFieldGet)
 	}
 
 	return data, nil
 }
 
-func (s *Service) GetCostByCompanyId(companyId uuid.UUID) (cost float32, err error) {
-	ctx := context.Background()
+func (s *Service) GetByIds(ctx context.Context, ids []uuid.UUID) (data []*domain.ActivityField, err error) {
+	data, err = s.actFieldRepo.GetByIds(ctx, ids)
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.ActivityFieldGetByIds, err)
+		return nil, i18n.Wrap(err, i18n.ActivityFieldGetByIds)
+	}
 
+	return data, nil
+}
+
+func (s *Service) GetCostByCompanyId(ctx context.Context, companyId uuid.UUID) (cost float32, err error) {
 	company, err := s.compRepo.GetById(ctx, companyId)
 	if err != nil {
-		s.logger.Infof("получение компании по id: %v", err)
-		return 0, fmt.Errorf("получение компании по id: %w", err)
+		s.logger.Infof("%v: %v", i18n.CompanyGet, err)
+		return 0, i18n.Wrap(err, i18n.CompanyGet)
 	}
 
 	field, err := s.actFieldRepo.GetById(ctx, company.ActivityFieldId)
 	if err != nil {
-		s.logger.Infof("получение сферы деятельности по id: %v", err)
-		return 0, fmt.Errorf("получение сферы деятельности по id: %w", err)
+		s.logger.Infof("%v: %v", i18n.ActivityFieldGet, err)
+		return 0, i18n.Wrap(err, i18n.ActivityFieldGet)
 	}
 	cost = field.Cost
 
 	return cost, nil
 }
 
-func (s *Service) GetMaxCost() (maxCost float32, err error) {
-	ctx := context.Background()
-
+func (s *Service) GetMaxCost(ctx context.Context) (maxCost float32, err error) {
 	maxCost, err = s.actFieldRepo.GetMaxCost(ctx)
 	if err != nil {
-		s.logger.Infof("получение максимального веса сферы деятельности: %v", err)
-		return 0, fmt.Errorf("получение максимального веса сферы деятельности: %w", err)
+		s.logger.Infof("%v: %v", i18n.ActivityFieldGetMaxCost, err)
+		return 0, i18n.Wrap(err, i18n.ActivityFieldGetMaxCost)
 	}
 
 	return maxCost, nil
 }
 
-func (s *Service) GetAll(page int) (fields []*domain.ActivityField, err error) {
-	ctx := context.Background()
-
-	fields, err = s.actFieldRepo.GetAll(ctx, page)
+func (s *Service) GetAll(ctx context.Context, req domain.PageRequest) (fields *domain.Page[*domain.ActivityField], err error) {
+	fields, err = s.actFieldRepo.GetAll(ctx, req)
 	if err != nil {
-		s.logger.Infof("получение списка всех сфер деятельности: %v", err)
-		return nil, fmt.Errorf("получение списка всех сфер деятельности: %w", err)
+		s.logger.Infof("%v: %v", i18n.ActivityFieldGetAll, err)
+		return nil, i18n.Wrap(err, i18n.ActivityFieldGetAll)
 	}
 
 	return fields, nil
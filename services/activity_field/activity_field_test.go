@@ -17,7 +17,7 @@ func TestService_Create(t *testing.T) {
 	defer ctrl.Finish()
 
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	repo := mocks.NewMockIActivityFieldRepository(ctrl)
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	svc := NewService(repo, compRepo, logger)
@@ -140,7 +140,7 @@ func TestService_Create(t *testing.T) {
 				tc.beforeTest(*repo)
 			}
 
-			err := svc.Create(tc.data)
+			err := svc.Create(context.Background(), tc.data)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -156,7 +156,7 @@ func TestService_DeleteById(t *testing.T) {
 	defer ctrl.Finish()
 
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	repo := mocks.NewMockIActivityFieldRepository(ctrl)
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	svc := NewService(repo, compRepo, logger)
@@ -198,7 +198,7 @@ func TestService_DeleteById(t *testing.T) {
 				tc.beforeTest(*repo)
 			}
 
-			err := svc.DeleteById(tc.id)
+			err := svc.DeleteById(context.Background(), tc.id)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -214,7 +214,7 @@ func TestService_GetById(t *testing.T) {
 	defer ctrl.Finish()
 
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	repo := mocks.NewMockIActivityFieldRepository(ctrl)
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	svc := NewService(repo, compRepo, logger)
@@ -270,7 +270,7 @@ func TestService_GetById(t *testing.T) {
 				tc.beforeTest(*repo)
 			}
 
-			company, err := svc.GetById(tc.id)
+			company, err := svc.GetById(context.Background(), tc.id)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -287,7 +287,7 @@ func TestService_Update(t *testing.T) {
 	defer ctrl.Finish()
 
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	repo := mocks.NewMockIActivityFieldRepository(ctrl)
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	svc := NewService(repo, compRepo, logger)
@@ -343,7 +343,7 @@ func TestService_Update(t *testing.T) {
 				tc.beforeTest(*repo)
 			}
 
-			err := svc.Update(tc.data)
+			err := svc.Update(context.Background(), tc.data)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
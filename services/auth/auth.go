@@ -2,92 +2,211 @@ package auth
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"github.com/dlankinl/bmstu-ppo-bl/domain"
 	"github.com/dlankinl/bmstu-ppo-bl/pkg/base"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
 	"github.com/dlankinl/bmstu-ppo-bl/pkg/logger"
+	"github.com/google/uuid"
+	"time"
 )
 
-type Service struct {
+// Registrar регистрирует учетные записи. Ему не нужны ключи подписи и хранилище refresh-токенов,
+// поэтому он используется отдельно от Service там, где токены не выдаются.
+type Registrar struct {
 	authRepo domain.IAuthRepository
 	crypto   base.IHashCrypto
-	jwtKey   string
 	logger   logger.ILogger
 }
 
+func NewRegistrar(repo domain.IAuthRepository, crypto base.IHashCrypto, logger logger.ILogger) domain.IRegistrationService {
+	return &Registrar{
+		authRepo: repo,
+		crypto:   crypto,
+		logger:   logger,
+	}
+}
+
+type Service struct {
+	Registrar
+	tokenRepo domain.IRefreshTokenRepository
+	keys      base.IKeyProvider
+	tokenOpts base.TokenOptions
+}
+
 func NewService(
 	repo domain.IAuthRepository,
+	tokenRepo domain.IRefreshTokenRepository,
 	crypto base.IHashCrypto,
-	jwtKey string,
+	keys base.IKeyProvider,
+	tokenOpts base.TokenOptions,
 	logger logger.ILogger,
 ) domain.IAuthService {
 	return &Service{
-		authRepo: repo,
-		crypto:   crypto,
-		jwtKey:   jwtKey,
-		logger:   logger,
+		Registrar: Registrar{
+			authRepo: repo,
+			crypto:   crypto,
+			logger:   logger,
+		},
+		tokenRepo: tokenRepo,
+		keys:      keys,
+		tokenOpts: tokenOpts,
 	}
 }
 
-func (s *Service) Register(authInfo *domain.UserAuth) (err error) {
+func (s *Registrar) Register(ctx context.Context, authInfo *domain.UserAuth) (err error) {
 	if authInfo.Username == "" {
-		s.logger.Infof("должно быть указано имя пользователя")
-		return fmt.Errorf("должно быть указано имя пользователя")
+		s.logger.Infof("%v", i18n.AuthUsernameRequired)
+		return domain.NewValidationError("username", i18n.AuthUsernameRequired)
 	}
 
 	if authInfo.Password == "" {
-		s.logger.Infof("должен быть указан пароль")
-		return fmt.Errorf("должен быть указан пароль")
+		s.logger.Infof("%v", i18n.AuthPasswordRequired)
+		return domain.NewValidationError("password", i18n.AuthPasswordRequired)
 	}
 
 	hashedPass, err := s.crypto.GenerateHashPass(authInfo.Password)
 	if err != nil {
-		s.logger.Infof("генерация хэша: %v", err)
-		return fmt.Errorf("генерация хэша: %w", err)
+		s.logger.Infof("%v: %v", i18n.AuthHashGenerate, err)
+		return i18n.Wrap(err, i18n.AuthHashGenerate)
 	}
 
 	authInfo.HashedPass = hashedPass
 
-	ctx := context.Background()
-
 	err = s.authRepo.Register(ctx, authInfo)
 	if err != nil {
-		s.logger.Infof("регистрация пользователя: %v", err)
-		return fmt.Errorf("регистрация пользователя: %w", err)
+		s.logger.Infof("%v: %v", i18n.AuthRegister, err)
+		return i18n.Wrap(err, i18n.AuthRegister)
 	}
 
 	return nil
 }
 
-func (s *Service) Login(authInfo *domain.UserAuth) (token string, err error) {
+func (s *Service) Login(ctx context.Context, authInfo *domain.UserAuth) (tokens *domain.TokenPair, err error) {
 	if authInfo.Username == "" {
-		s.logger.Infof("должно быть указано имя пользователя")
-		return "", fmt.Errorf("должно быть указано имя пользователя")
+		s.logger.Infof("%v", i18n.AuthUsernameRequired)
+		return nil, domain.NewValidationError("username", i18n.AuthUsernameRequired)
 	}
 
 	if authInfo.Password == "" {
-		s.logger.Infof("должен быть указан пароль")
-		return "", fmt.Errorf("должен быть указан пароль")
+		s.logger.Infof("%v", i18n.AuthPasswordRequired)
+		return nil, domain.NewValidationError("password", i18n.AuthPasswordRequired)
 	}
 
-	ctx := context.Background()
-
 	userAuth, err := s.authRepo.GetByUsername(ctx, authInfo.Username)
 	if err != nil {
-		s.logger.Infof("получение пользователя по username: %v", err)
-		return "", fmt.Errorf("получение пользователя по username: %w", err)
+		s.logger.Infof("%v: %v", i18n.UserGetByUsername, err)
+		err = i18n.Wrap(err, i18n.UserGetByUsername)
+		if errors.Is(err, domain.ErrNotFound) {
+			err = domain.WithKind(domain.ErrUnauthenticated, err)
+		}
+		return nil, err
 	}
 
 	if !s.crypto.CheckPasswordHash(authInfo.Password, userAuth.HashedPass) {
-		s.logger.Infof("неверный пароль")
-		return "", fmt.Errorf("неверный пароль")
+		s.logger.Infof("%v", i18n.AuthWrongPassword)
+		return nil, domain.NewError(domain.ErrUnauthenticated, i18n.AuthWrongPassword)
+	}
+
+	tokens, err = s.issueTokens(ctx, userAuth)
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.AuthTokensGenerate, err)
+		return nil, i18n.Wrap(err, i18n.AuthTokensGenerate)
+	}
+
+	return tokens, nil
+}
+
+func (s *Service) Refresh(ctx context.Context, refreshToken string) (tokens *domain.TokenPair, err error) {
+	sessionId, err := base.VerifyRefreshToken(refreshToken, s.keys, s.tokenOpts)
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.AuthRefreshTokenVerify, err)
+		return nil, domain.WithKind(domain.ErrUnauthenticated, i18n.Wrap(err, i18n.AuthRefreshTokenVerify))
+	}
+
+	session, err := s.tokenRepo.GetById(ctx, sessionId)
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.AuthRefreshTokenGet, err)
+		err = i18n.Wrap(err, i18n.AuthRefreshTokenGet)
+		if errors.Is(err, domain.ErrNotFound) {
+			err = domain.WithKind(domain.ErrUnauthenticated, err)
+		}
+		return nil, err
+	}
+
+	if session.Revoked {
+		s.logger.Infof("%v", i18n.AuthRefreshTokenRevoked)
+		return nil, domain.NewError(domain.ErrUnauthenticated, i18n.AuthRefreshTokenRevoked)
+	}
+
+	if time.Now().After(session.ExpiresAt) {
+		s.logger.Infof("%v", i18n.AuthRefreshTokenExpired)
+		return nil, domain.NewError(domain.ErrUnauthenticated, i18n.AuthRefreshTokenExpired)
+	}
+
+	userAuth, err := s.authRepo.GetByUsername(ctx, session.Username)
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.UserGetByUsername, err)
+		return nil, i18n.Wrap(err, i18n.UserGetByUsername)
+	}
+
+	err = s.tokenRepo.Revoke(ctx, session.ID)
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.AuthRefreshTokenRevoke, err)
+		return nil, i18n.Wrap(err, i18n.AuthRefreshTokenRevoke)
+	}
+
+	tokens, err = s.issueTokens(ctx, userAuth)
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.AuthTokensGenerate, err)
+		return nil, i18n.Wrap(err, i18n.AuthTokensGenerate)
+	}
+
+	return tokens, nil
+}
+
+func (s *Service) Logout(ctx context.Context, refreshToken string) (err error) {
+	sessionId, err := base.VerifyRefreshToken(refreshToken, s.keys, s.tokenOpts)
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.AuthRefreshTokenVerify, err)
+		return domain.WithKind(domain.ErrUnauthenticated, i18n.Wrap(err, i18n.AuthRefreshTokenVerify))
+	}
+
+	err = s.tokenRepo.Revoke(ctx, sessionId)
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.AuthRefreshTokenRevoke, err)
+		return i18n.Wrap(err, i18n.AuthRefreshTokenRevoke)
+	}
+
+	return nil
+}
+
+func (s *Service) issueTokens(ctx context.Context, userAuth *domain.UserAuth) (tokens *domain.TokenPair, err error) {
+	session := &domain.RefreshToken{
+		ID:        uuid.New(),
+		UserId:    userAuth.ID,
+		Username:  userAuth.Username,
+		ExpiresAt: time.Now().Add(base.RefreshTokenTTL),
+	}
+
+	err = s.tokenRepo.Create(ctx, session)
+	if err != nil {
+		return nil, i18n.Wrap(err, i18n.AuthRefreshTokenSave)
+	}
+
+	tokens = new(domain.TokenPair)
+
+	tokens.AccessToken, err = base.GenerateAuthToken(
+		userAuth.ID, userAuth.Username, userAuth.Role, session.ID, s.keys, s.tokenOpts)
+	if err != nil {
+		return nil, i18n.Wrap(err, i18n.AuthAccessTokenGenerate)
 	}
 
-	token, err = base.GenerateAuthToken(authInfo.Username, s.jwtKey, userAuth.Role)
+	tokens.RefreshToken, err = base.GenerateRefreshToken(
+		userAuth.ID, session.ID, s.keys, s.tokenOpts, session.ExpiresAt)
 	if err != nil {
-		s.logger.Infof("генерация токена: %v", err)
-		return "", fmt.Errorf("генерация токена: %w", err)
+		return nil, i18n.Wrap(err, i18n.AuthRefreshTokenGenerate)
 	}
 
-	return token, nil
+	return tokens, nil
 }
@@ -2,26 +2,47 @@ package auth
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"github.com/dlankinl/bmstu-ppo-bl/domain"
 	"github.com/dlankinl/bmstu-ppo-bl/mocks"
 	"github.com/dlankinl/bmstu-ppo-bl/pkg/base"
+	"github.com/dlankinl/bmstu-ppo-bl/repository/memory"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"testing"
+	"time"
 )
 
+var tokenOpts = base.TokenOptions{
+	Issuer:   "bmstu-ppo-bl",
+	Audience: "test",
+}
+
+func newKeySet(t *testing.T) *base.KeySet {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	require.Nil(t, err)
+
+	keys, err := base.NewKeySet(base.NewEd25519Key("test", private))
+	require.Nil(t, err)
+
+	return keys
+}
+
 func TestAuthService_Login(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	jwtKey := "abcdefgh123"
+	keys := newKeySet(t)
 	repo := mocks.NewMockIAuthRepository(ctrl)
 	crypto := mocks.NewMockIHashCrypto(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
-	svc := NewService(repo, crypto, jwtKey, logger)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	tokenRepo := memory.NewRefreshTokenRepository()
+	svc := NewService(repo, tokenRepo, crypto, keys, tokenOpts, logger)
 
 	testCases := []struct {
 		name       string
@@ -43,6 +64,7 @@ func TestAuthService_Login(t *testing.T) {
 						"test123",
 					).
 					Return(&domain.UserAuth{
+						ID:         uuid.UUID{1},
 						Username:   "test123",
 						Password:   "pass123",
 						HashedPass: "hashedPass123",
@@ -93,6 +115,7 @@ func TestAuthService_Login(t *testing.T) {
 						"test123",
 					).
 					Return(&domain.UserAuth{
+						ID:         uuid.UUID{1},
 						Username:   "test123",
 						Password:   "pass123",
 						HashedPass: "hashedPass123",
@@ -112,13 +135,19 @@ func TestAuthService_Login(t *testing.T) {
 				tc.beforeTest(*repo, *crypto)
 			}
 
-			token, err := svc.Login(tc.authInfo)
-			_, verifErr := base.VerifyAuthToken(token, jwtKey)
+			tokens, err := svc.Login(context.Background(), tc.authInfo)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
 			} else {
 				require.Nil(t, err)
+
+				payload, verifErr := base.VerifyAuthToken(context.Background(), tokens.AccessToken, keys, tokenOpts, tokenRepo)
+				require.Nil(t, verifErr)
+				require.Equal(t, uuid.UUID{1}, payload.UserId)
+				require.Equal(t, "test123", payload.Username)
+
+				_, verifErr = base.VerifyRefreshToken(tokens.RefreshToken, keys, tokenOpts)
 				require.Nil(t, verifErr)
 			}
 		})
@@ -132,8 +161,8 @@ func TestAuthService_Register(t *testing.T) {
 	repo := mocks.NewMockIAuthRepository(ctrl)
 	crypto := mocks.NewMockIHashCrypto(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
-	svc := NewService(repo, crypto, "abcdefgh123", logger)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	svc := NewService(repo, memory.NewRefreshTokenRepository(), crypto, newKeySet(t), tokenOpts, logger)
 
 	testCases := []struct {
 		name       string
@@ -222,7 +251,7 @@ func TestAuthService_Register(t *testing.T) {
 				tc.beforeTest(*repo, *crypto)
 			}
 
-			err := svc.Register(tc.authInfo)
+			err := svc.Register(context.Background(), tc.authInfo)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -232,3 +261,209 @@ func TestAuthService_Register(t *testing.T) {
 		})
 	}
 }
+
+func TestRegistrar_Register(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	repo := memory.NewAuthRepository()
+	crypto := base.NewHashCrypto()
+	// регистрации не нужны ключи подписи и хранилище refresh-токенов
+	registrar := NewRegistrar(repo, crypto, logger)
+
+	require.Nil(t, registrar.Register(context.Background(), &domain.UserAuth{Username: "admin", Password: "secret"}))
+
+	got, err := repo.GetByUsername(context.Background(), "admin")
+	require.Nil(t, err)
+	require.True(t, crypto.CheckPasswordHash("secret", got.HashedPass))
+
+	err = registrar.Register(context.Background(), &domain.UserAuth{Username: "admin", Password: "secret"})
+	require.ErrorIs(t, err, domain.ErrConflict)
+}
+
+func TestAuthService_Refresh(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keys := newKeySet(t)
+	repo := mocks.NewMockIAuthRepository(ctrl)
+	crypto := mocks.NewMockIHashCrypto(ctrl)
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	tokenRepo := memory.NewRefreshTokenRepository()
+	svc := NewService(repo, tokenRepo, crypto, keys, tokenOpts, logger)
+
+	repo.EXPECT().
+		GetByUsername(context.Background(), "test123").
+		Return(&domain.UserAuth{
+			ID:         uuid.UUID{1},
+			Username:   "test123",
+			HashedPass: "hashedPass123",
+			Role:       "admin",
+		}, nil).
+		AnyTimes()
+	crypto.EXPECT().
+		CheckPasswordHash("pass123", "hashedPass123").
+		Return(true).
+		AnyTimes()
+
+	login := func(t *testing.T) *domain.TokenPair {
+		tokens, err := svc.Login(context.Background(), &domain.UserAuth{Username: "test123", Password: "pass123"})
+		require.Nil(t, err)
+		return tokens
+	}
+
+	testCases := []struct {
+		name         string
+		refreshToken func(t *testing.T) string
+		wantErr      bool
+		errStr       error
+	}{
+		{
+			name: "успешное обновление токенов",
+			refreshToken: func(t *testing.T) string {
+				return login(t).RefreshToken
+			},
+			wantErr: false,
+		},
+		{
+			name: "повторное использование токена обновления",
+			refreshToken: func(t *testing.T) string {
+				tokens := login(t)
+				_, err := svc.Refresh(context.Background(), tokens.RefreshToken)
+				require.Nil(t, err)
+				return tokens.RefreshToken
+			},
+			wantErr: true,
+			errStr:  errors.New("токен обновления отозван"),
+		},
+		{
+			name: "токен обновления после выхода",
+			refreshToken: func(t *testing.T) string {
+				tokens := login(t)
+				require.Nil(t, svc.Logout(context.Background(), tokens.RefreshToken))
+				return tokens.RefreshToken
+			},
+			wantErr: true,
+			errStr:  errors.New("токен обновления отозван"),
+		},
+		{
+			name: "передан токен доступа вместо токена обновления",
+			refreshToken: func(t *testing.T) string {
+				return login(t).AccessToken
+			},
+			wantErr: true,
+			errStr:  errors.New("проверка токена обновления: неверный тип токена"),
+		},
+		{
+			name: "неизвестный токен обновления",
+			refreshToken: func(t *testing.T) string {
+				token, err := base.GenerateRefreshToken(uuid.UUID{1}, uuid.New(), keys, tokenOpts, time.Now().Add(time.Hour))
+				require.Nil(t, err)
+				return token
+			},
+			wantErr: true,
+			errStr:  errors.New("получение токена обновления по id: токен обновления не найден"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			refreshToken := tc.refreshToken(t)
+
+			tokens, err := svc.Refresh(context.Background(), refreshToken)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+
+				payload, verifErr := base.VerifyAuthToken(context.Background(), tokens.AccessToken, keys, tokenOpts, tokenRepo)
+				require.Nil(t, verifErr)
+				require.Equal(t, uuid.UUID{1}, payload.UserId)
+				require.Equal(t, "test123", payload.Username)
+				require.Equal(t, "admin", payload.Role)
+			}
+		})
+	}
+}
+
+func TestAuthService_Logout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keys := newKeySet(t)
+	repo := mocks.NewMockIAuthRepository(ctrl)
+	crypto := mocks.NewMockIHashCrypto(ctrl)
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	tokenRepo := memory.NewRefreshTokenRepository()
+	svc := NewService(repo, tokenRepo, crypto, keys, tokenOpts, logger)
+
+	repo.EXPECT().
+		GetByUsername(context.Background(), "test123").
+		Return(&domain.UserAuth{
+			ID:         uuid.UUID{1},
+			Username:   "test123",
+			HashedPass: "hashedPass123",
+		}, nil).
+		AnyTimes()
+	crypto.EXPECT().
+		CheckPasswordHash("pass123", "hashedPass123").
+		Return(true).
+		AnyTimes()
+
+	tokens, err := svc.Login(context.Background(), &domain.UserAuth{Username: "test123", Password: "pass123"})
+	require.Nil(t, err)
+
+	_, err = base.VerifyAuthToken(context.Background(), tokens.AccessToken, keys, tokenOpts, tokenRepo)
+	require.Nil(t, err)
+
+	err = svc.Logout(context.Background(), tokens.RefreshToken)
+	require.Nil(t, err)
+
+	_, err = base.VerifyAuthToken(context.Background(), tokens.AccessToken, keys, tokenOpts, tokenRepo)
+	require.ErrorIs(t, err, base.ErrTokenRevoked)
+
+	err = svc.Logout(context.Background(), tokens.RefreshToken)
+	require.Equal(t, "отзыв токена обновления: токен обновления уже отозван", err.Error())
+
+	err = svc.Logout(context.Background(), "invalid")
+	require.NotNil(t, err)
+}
+
+func TestAuthService_Login_ErrorKinds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockIAuthRepository(ctrl)
+	crypto := mocks.NewMockIHashCrypto(ctrl)
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	svc := NewService(repo, memory.NewRefreshTokenRepository(), crypto, newKeySet(t), tokenOpts, logger)
+
+	_, err := svc.Login(context.Background(), &domain.UserAuth{Username: "test123"})
+	require.ErrorIs(t, err, domain.ErrValidation)
+
+	repo.EXPECT().
+		GetByUsername(context.Background(), "unknown").
+		Return(nil, domain.NewError(domain.ErrNotFound, "пользователь не найден"))
+
+	_, err = svc.Login(context.Background(), &domain.UserAuth{Username: "unknown", Password: "pass123"})
+	require.ErrorIs(t, err, domain.ErrUnauthenticated)
+
+	repo.EXPECT().
+		GetByUsername(context.Background(), "test123").
+		Return(&domain.UserAuth{Username: "test123", HashedPass: "hashedPass123"}, nil)
+	crypto.EXPECT().
+		CheckPasswordHash("wrong", "hashedPass123").
+		Return(false)
+
+	_, err = svc.Login(context.Background(), &domain.UserAuth{Username: "test123", Password: "wrong"})
+	require.ErrorIs(t, err, domain.ErrUnauthenticated)
+
+	_, err = svc.Refresh(context.Background(), "invalid")
+	require.ErrorIs(t, err, domain.ErrUnauthenticated)
+	require.ErrorIs(t, err, base.ErrTokenMalformed)
+}
@@ -0,0 +1,65 @@
+package authz
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+)
+
+type ActivityFieldService struct {
+	next domain.IActivityFieldService
+}
+
+func NewActivityFieldService(next domain.IActivityFieldService) domain.IActivityFieldService {
+	return &ActivityFieldService{
+		next: next,
+	}
+}
+
+func (s *ActivityFieldService) Create(ctx context.Context, data *domain.ActivityField) (err error) {
+	err = requireAdmin(ctx, i18n.ActivityFieldCreate)
+	if err != nil {
+		return err
+	}
+
+	return s.next.Create(ctx, data)
+}
+
+func (s *ActivityFieldService) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
+	err = requireAdmin(ctx, i18n.ActivityFieldDelete)
+	if err != nil {
+		return err
+	}
+
+	return s.next.DeleteById(ctx, id)
+}
+
+func (s *ActivityFieldService) Update(ctx context.Context, data *domain.ActivityField) (err error) {
+	err = requireAdmin(ctx, i18n.ActivityFieldUpdate)
+	if err != nil {
+		return err
+	}
+
+	return s.next.Update(ctx, data)
+}
+
+func (s *ActivityFieldService) GetById(ctx context.Context, id uuid.UUID) (*domain.ActivityField, error) {
+	return s.next.GetById(ctx, id)
+}
+
+func (s *ActivityFieldService) GetByIds(ctx context.Context, ids []uuid.UUID) ([]*domain.ActivityField, error) {
+	return s.next.GetByIds(ctx, ids)
+}
+
+func (s *ActivityFieldService) GetCostByCompanyId(ctx context.Context, companyId uuid.UUID) (float32, error) {
+	return s.next.GetCostByCompanyId(ctx, companyId)
+}
+
+func (s *ActivityFieldService) GetMaxCost(ctx context.Context) (float32, error) {
+	return s.next.GetMaxCost(ctx)
+}
+
+func (s *ActivityFieldService) GetAll(ctx context.Context, req domain.PageRequest) (*domain.Page[*domain.ActivityField], error) {
+	return s.next.GetAll(ctx, req)
+}
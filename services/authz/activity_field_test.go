@@ -0,0 +1,72 @@
+package authz
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestActivityFieldService_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	next := mocks.NewMockIActivityFieldService(ctrl)
+
+	testCases := []struct {
+		name       string
+		ctx        context.Context
+		beforeTest func(next *mocks.MockIActivityFieldService)
+		wantErr    bool
+	}{
+		{
+			name: "изменение веса администратором",
+			ctx:  adminCtx,
+			beforeTest: func(next *mocks.MockIActivityFieldService) {
+				next.EXPECT().
+					Update(gomock.Any(), &domain.ActivityField{ID: uuid.UUID{1}, Cost: 100}).
+					Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:    "изменение веса предпринимателем",
+			ctx:     ownerCtx,
+			wantErr: true,
+		},
+		{
+			name:    "изменение веса гостем",
+			ctx:     guestCtx,
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(next)
+			}
+
+			err := NewActivityFieldService(next).Update(tc.ctx, &domain.ActivityField{ID: uuid.UUID{1}, Cost: 100})
+
+			if tc.wantErr {
+				require.ErrorIs(t, err, ErrForbidden)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestActivityFieldService_GetAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	next := mocks.NewMockIActivityFieldService(ctrl)
+	next.EXPECT().GetAll(gomock.Any(), domain.PageRequest{}).Return(&domain.Page[*domain.ActivityField]{Items: []*domain.ActivityField{}}, nil)
+
+	_, err := NewActivityFieldService(next).GetAll(guestCtx, domain.PageRequest{})
+	require.Nil(t, err)
+}
@@ -0,0 +1,42 @@
+package authz
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+)
+
+type AuthService struct {
+	next domain.IAuthService
+}
+
+func NewAuthService(next domain.IAuthService) domain.IAuthService {
+	return &AuthService{
+		next: next,
+	}
+}
+
+func (s *AuthService) Login(ctx context.Context, authInfo *domain.UserAuth) (*domain.TokenPair, error) {
+	return s.next.Login(ctx, authInfo)
+}
+
+func (s *AuthService) Register(ctx context.Context, authInfo *domain.UserAuth) (err error) {
+	switch authInfo.Role {
+	case "", domain.RoleGuest, domain.RoleEntrepreneur:
+	default:
+		err = requireAdmin(ctx, i18n.AuthRegister)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.next.Register(ctx, authInfo)
+}
+
+func (s *AuthService) Refresh(ctx context.Context, refreshToken string) (*domain.TokenPair, error) {
+	return s.next.Refresh(ctx, refreshToken)
+}
+
+func (s *AuthService) Logout(ctx context.Context, refreshToken string) error {
+	return s.next.Logout(ctx, refreshToken)
+}
@@ -0,0 +1,75 @@
+package authz
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+)
+
+type CompanyService struct {
+	next domain.ICompanyService
+}
+
+func NewCompanyService(next domain.ICompanyService) domain.ICompanyService {
+	return &CompanyService{
+		next: next,
+	}
+}
+
+func (s *CompanyService) Create(ctx context.Context, company *domain.Company) (err error) {
+	err = requireOwner(ctx, company.OwnerID, i18n.CompanyCreate)
+	if err != nil {
+		return err
+	}
+
+	return s.next.Create(ctx, company)
+}
+
+func (s *CompanyService) GetById(ctx context.Context, id uuid.UUID) (*domain.Company, error) {
+	return s.next.GetById(ctx, id)
+}
+
+func (s *CompanyService) GetByOwnerId(ctx context.Context, id uuid.UUID, req domain.PageRequest) (*domain.Page[*domain.Company], error) {
+	return s.next.GetByOwnerId(ctx, id, req)
+}
+
+func (s *CompanyService) GetAll(ctx context.Context, filter domain.CompanyFilter, req domain.PageRequest) (*domain.Page[*domain.Company], error) {
+	return s.next.GetAll(ctx, filter, req)
+}
+
+func (s *CompanyService) Update(ctx context.Context, company *domain.Company) (err error) {
+	err = s.checkOwner(ctx, company.ID, i18n.CompanyUpdate)
+	if err != nil {
+		return err
+	}
+
+	err = requireOwner(ctx, company.OwnerID, i18n.CompanyUpdate)
+	if err != nil {
+		return err
+	}
+
+	return s.next.Update(ctx, company)
+}
+
+func (s *CompanyService) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
+	err = s.checkOwner(ctx, id, i18n.CompanyDeleteAction)
+	if err != nil {
+		return err
+	}
+
+	return s.next.DeleteById(ctx, id)
+}
+
+func (s *CompanyService) checkOwner(ctx context.Context, id uuid.UUID, action i18n.Code) (err error) {
+	if Caller(ctx).IsAdmin() {
+		return nil
+	}
+
+	company, err := s.next.GetById(ctx, id)
+	if err != nil {
+		return i18n.Wrap(err, action)
+	}
+
+	return requireOwner(ctx, company.OwnerID, action)
+}
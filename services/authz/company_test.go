@@ -0,0 +1,104 @@
+package authz
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestCompanyService_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	next := mocks.NewMockICompanyService(ctrl)
+	next.EXPECT().
+		GetById(gomock.Any(), uuid.UUID{1}).
+		Return(&domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{1}}, nil).
+		AnyTimes()
+
+	testCases := []struct {
+		name       string
+		ctx        context.Context
+		company    *domain.Company
+		beforeTest func(next *mocks.MockICompanyService)
+		wantErr    bool
+	}{
+		{
+			name:    "обновление владельцем",
+			ctx:     ownerCtx,
+			company: &domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{1}, Name: "a"},
+			beforeTest: func(next *mocks.MockICompanyService) {
+				next.EXPECT().
+					Update(gomock.Any(), &domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{1}, Name: "a"}).
+					Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:    "обновление администратором",
+			ctx:     adminCtx,
+			company: &domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{2}, Name: "a"},
+			beforeTest: func(next *mocks.MockICompanyService) {
+				next.EXPECT().
+					Update(gomock.Any(), &domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{2}, Name: "a"}).
+					Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:    "обновление чужой компании",
+			ctx:     otherCtx,
+			company: &domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{2}, Name: "a"},
+			wantErr: true,
+		},
+		{
+			name:    "передача компании другому владельцу",
+			ctx:     ownerCtx,
+			company: &domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{2}, Name: "a"},
+			wantErr: true,
+		},
+		{
+			name:    "обновление гостем",
+			ctx:     guestCtx,
+			company: &domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{1}, Name: "a"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(next)
+			}
+
+			err := NewCompanyService(next).Update(tc.ctx, tc.company)
+
+			if tc.wantErr {
+				require.ErrorIs(t, err, ErrForbidden)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestCompanyService_DeleteById(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	next := mocks.NewMockICompanyService(ctrl)
+	next.EXPECT().
+		GetById(gomock.Any(), uuid.UUID{1}).
+		Return(&domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{1}}, nil).
+		AnyTimes()
+
+	err := NewCompanyService(next).DeleteById(otherCtx, uuid.UUID{1})
+	require.ErrorIs(t, err, ErrForbidden)
+
+	next.EXPECT().DeleteById(gomock.Any(), uuid.UUID{1}).Return(nil)
+	err = NewCompanyService(next).DeleteById(ownerCtx, uuid.UUID{1})
+	require.Nil(t, err)
+}
@@ -0,0 +1,71 @@
+package authz
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+)
+
+type ContactService struct {
+	next domain.IContactsService
+}
+
+func NewContactService(next domain.IContactsService) domain.IContactsService {
+	return &ContactService{
+		next: next,
+	}
+}
+
+func (s *ContactService) Create(ctx context.Context, contact *domain.Contact) (err error) {
+	err = requireOwner(ctx, contact.OwnerID, i18n.ContactCreate)
+	if err != nil {
+		return err
+	}
+
+	return s.next.Create(ctx, contact)
+}
+
+func (s *ContactService) GetById(ctx context.Context, id uuid.UUID) (*domain.Contact, error) {
+	return s.next.GetById(ctx, id)
+}
+
+func (s *ContactService) GetByOwnerId(ctx context.Context, id uuid.UUID, req domain.PageRequest) (*domain.Page[*domain.Contact], error) {
+	return s.next.GetByOwnerId(ctx, id, req)
+}
+
+func (s *ContactService) Update(ctx context.Context, contact *domain.Contact) (err error) {
+	err = s.checkOwner(ctx, contact.ID, i18n.ContactUpdateAction)
+	if err != nil {
+		return err
+	}
+
+	err = requireOwner(ctx, contact.OwnerID, i18n.ContactUpdateAction)
+	if err != nil {
+		return err
+	}
+
+	return s.next.Update(ctx, contact)
+}
+
+func (s *ContactService) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
+	err = s.checkOwner(ctx, id, i18n.ContactDeleteAction)
+	if err != nil {
+		return err
+	}
+
+	return s.next.DeleteById(ctx, id)
+}
+
+func (s *ContactService) checkOwner(ctx context.Context, id uuid.UUID, action i18n.Code) (err error) {
+	if Caller(ctx).IsAdmin() {
+		return nil
+	}
+
+	contact, err := s.next.GetById(ctx, id)
+	if err != nil {
+		return i18n.Wrap(err, action)
+	}
+
+	return requireOwner(ctx, contact.OwnerID, action)
+}
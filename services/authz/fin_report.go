@@ -0,0 +1,118 @@
+package authz
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+)
+
+type FinancialReportService struct {
+	next        domain.IFinancialReportService
+	compService domain.ICompanyService
+}
+
+func NewFinancialReportService(
+	next domain.IFinancialReportService,
+	compSvc domain.ICompanyService,
+) domain.IFinancialReportService {
+	return &FinancialReportService{
+		next:        next,
+		compService: compSvc,
+	}
+}
+
+func (s *FinancialReportService) Create(ctx context.Context, finRep *domain.FinancialReport) (err error) {
+	err = s.checkCompanyOwner(ctx, finRep.CompanyID, i18n.FinReportCreate)
+	if err != nil {
+		return err
+	}
+
+	return s.next.Create(ctx, finRep)
+}
+
+func (s *FinancialReportService) CreateByPeriod(ctx context.Context, finReportByPeriod *domain.FinancialReportByPeriod) (err error) {
+	checked := make(map[uuid.UUID]struct{})
+	for _, report := range finReportByPeriod.Reports {
+		if _, ok := checked[report.CompanyID]; ok {
+			continue
+		}
+
+		err = s.checkCompanyOwner(ctx, report.CompanyID, i18n.FinReportCreateByPeriod)
+		if err != nil {
+			return err
+		}
+		checked[report.CompanyID] = struct{}{}
+	}
+
+	return s.next.CreateByPeriod(ctx, finReportByPeriod)
+}
+
+func (s *FinancialReportService) GetById(ctx context.Context, id uuid.UUID) (*domain.FinancialReport, error) {
+	return s.next.GetById(ctx, id)
+}
+
+func (s *FinancialReportService) GetByCompany(ctx context.Context, companyId uuid.UUID, period *domain.Period) (*domain.FinancialReportByPeriod, error) {
+	return s.next.GetByCompany(ctx, companyId, period)
+}
+
+func (s *FinancialReportService) GetDetailedByCompany(ctx context.Context, companyId uuid.UUID, period *domain.Period) (*domain.FinancialReportByPeriod, error) {
+	return s.next.GetDetailedByCompany(ctx, companyId, period)
+}
+
+func (s *FinancialReportService) GetUnconvertedByCompany(ctx context.Context, companyId uuid.UUID, period *domain.Period) (*domain.FinancialReportByPeriod, error) {
+	return s.next.GetUnconvertedByCompany(ctx, companyId, period)
+}
+
+func (s *FinancialReportService) GetByCompanies(ctx context.Context, companyIds []uuid.UUID, period *domain.Period) (map[uuid.UUID]*domain.FinancialReportByPeriod, error) {
+	return s.next.GetByCompanies(ctx, companyIds, period)
+}
+
+func (s *FinancialReportService) Update(ctx context.Context, finRep *domain.FinancialReport) (err error) {
+	err = s.checkReportOwner(ctx, finRep.ID, i18n.FinReportUpdate)
+	if err != nil {
+		return err
+	}
+
+	err = s.checkCompanyOwner(ctx, finRep.CompanyID, i18n.FinReportUpdate)
+	if err != nil {
+		return err
+	}
+
+	return s.next.Update(ctx, finRep)
+}
+
+func (s *FinancialReportService) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
+	err = s.checkReportOwner(ctx, id, i18n.FinReportDelete)
+	if err != nil {
+		return err
+	}
+
+	return s.next.DeleteById(ctx, id)
+}
+
+func (s *FinancialReportService) checkReportOwner(ctx context.Context, id uuid.UUID, action i18n.Code) (err error) {
+	if Caller(ctx).IsAdmin() {
+		return nil
+	}
+
+	report, err := s.next.GetById(ctx, id)
+	if err != nil {
+		return i18n.Wrap(err, action)
+	}
+
+	return s.checkCompanyOwner(ctx, report.CompanyID, action)
+}
+
+func (s *FinancialReportService) checkCompanyOwner(ctx context.Context, companyId uuid.UUID, action i18n.Code) (err error) {
+	if Caller(ctx).IsAdmin() {
+		return nil
+	}
+
+	company, err := s.compService.GetById(ctx, companyId)
+	if err != nil {
+		return i18n.Wrap(err, action)
+	}
+
+	return requireOwner(ctx, company.OwnerID, action)
+}
@@ -0,0 +1,99 @@
+package authz
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestFinancialReportService_CreateByPeriod(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	next := mocks.NewMockIFinancialReportService(ctrl)
+	compSvc := mocks.NewMockICompanyService(ctrl)
+	compSvc.EXPECT().
+		GetById(gomock.Any(), uuid.UUID{1}).
+		Return(&domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{1}}, nil).
+		AnyTimes()
+	compSvc.EXPECT().
+		GetById(gomock.Any(), uuid.UUID{2}).
+		Return(&domain.Company{ID: uuid.UUID{2}, OwnerID: uuid.UUID{2}}, nil).
+		AnyTimes()
+
+	testCases := []struct {
+		name       string
+		ctx        context.Context
+		reports    *domain.FinancialReportByPeriod
+		beforeTest func(next *mocks.MockIFinancialReportService)
+		wantErr    bool
+	}{
+		{
+			name: "отчеты своей компании",
+			ctx:  ownerCtx,
+			reports: &domain.FinancialReportByPeriod{
+				Reports: []domain.FinancialReport{
+					{CompanyID: uuid.UUID{1}, Year: 2023, Quarter: 1},
+					{CompanyID: uuid.UUID{1}, Year: 2023, Quarter: 2},
+				},
+			},
+			beforeTest: func(next *mocks.MockIFinancialReportService) {
+				next.EXPECT().CreateByPeriod(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "среди отчетов есть отчет чужой компании",
+			ctx:  ownerCtx,
+			reports: &domain.FinancialReportByPeriod{
+				Reports: []domain.FinancialReport{
+					{CompanyID: uuid.UUID{1}, Year: 2023, Quarter: 1},
+					{CompanyID: uuid.UUID{2}, Year: 2023, Quarter: 1},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(next)
+			}
+
+			err := NewFinancialReportService(next, compSvc).CreateByPeriod(tc.ctx, tc.reports)
+
+			if tc.wantErr {
+				require.ErrorIs(t, err, ErrForbidden)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestFinancialReportService_DeleteById(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	next := mocks.NewMockIFinancialReportService(ctrl)
+	compSvc := mocks.NewMockICompanyService(ctrl)
+	next.EXPECT().
+		GetById(gomock.Any(), uuid.UUID{5}).
+		Return(&domain.FinancialReport{ID: uuid.UUID{5}, CompanyID: uuid.UUID{1}}, nil).
+		AnyTimes()
+	compSvc.EXPECT().
+		GetById(gomock.Any(), uuid.UUID{1}).
+		Return(&domain.Company{ID: uuid.UUID{1}, OwnerID: uuid.UUID{1}}, nil).
+		AnyTimes()
+
+	err := NewFinancialReportService(next, compSvc).DeleteById(otherCtx, uuid.UUID{5})
+	require.ErrorIs(t, err, ErrForbidden)
+
+	next.EXPECT().DeleteById(gomock.Any(), uuid.UUID{5}).Return(nil)
+	err = NewFinancialReportService(next, compSvc).DeleteById(ownerCtx, uuid.UUID{5})
+	require.Nil(t, err)
+}
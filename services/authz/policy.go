@@ -0,0 +1,81 @@
+package authz
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/base"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+)
+
+var ErrForbidden = domain.ErrForbidden
+
+type Identity struct {
+	UserId uuid.UUID
+	Role   string
+}
+
+type identityKey struct{}
+
+func WithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+func IdentityFromPayload(payload *base.JwtPayload) *Identity {
+	return &Identity{
+		UserId: payload.UserId,
+		Role:   payload.Role,
+	}
+}
+
+func Caller(ctx context.Context) *Identity {
+	identity, ok := ctx.Value(identityKey{}).(*Identity)
+	if !ok || identity == nil {
+		return &Identity{Role: domain.RoleGuest}
+	}
+
+	switch identity.Role {
+	case domain.RoleAdmin, domain.RoleEntrepreneur:
+		return identity
+	default:
+		return &Identity{UserId: identity.UserId, Role: domain.RoleGuest}
+	}
+}
+
+func (i *Identity) IsAdmin() bool {
+	return i.Role == domain.RoleAdmin
+}
+
+func requireAdmin(ctx context.Context, action i18n.Code) error {
+	if !Caller(ctx).IsAdmin() {
+		return i18n.Wrap(ErrForbidden, action)
+	}
+
+	return nil
+}
+
+func requireSelf(ctx context.Context, userId uuid.UUID, action i18n.Code) error {
+	caller := Caller(ctx)
+	if caller.IsAdmin() {
+		return nil
+	}
+
+	if caller.UserId == uuid.Nil || caller.UserId != userId {
+		return i18n.Wrap(ErrForbidden, action)
+	}
+
+	return nil
+}
+
+func requireOwner(ctx context.Context, ownerId uuid.UUID, action i18n.Code) error {
+	caller := Caller(ctx)
+	if caller.IsAdmin() {
+		return nil
+	}
+
+	if caller.Role != domain.RoleEntrepreneur || caller.UserId != ownerId {
+		return i18n.Wrap(ErrForbidden, action)
+	}
+
+	return nil
+}
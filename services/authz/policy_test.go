@@ -0,0 +1,90 @@
+package authz
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var (
+	adminCtx = WithIdentity(context.Background(), &Identity{UserId: uuid.UUID{9}, Role: domain.RoleAdmin})
+	ownerCtx = WithIdentity(context.Background(), &Identity{UserId: uuid.UUID{1}, Role: domain.RoleEntrepreneur})
+	otherCtx = WithIdentity(context.Background(), &Identity{UserId: uuid.UUID{2}, Role: domain.RoleEntrepreneur})
+	guestCtx = context.Background()
+)
+
+func TestCaller(t *testing.T) {
+	testCases := []struct {
+		name     string
+		ctx      context.Context
+		expected *Identity
+	}{
+		{
+			name:     "нет данных о пользователе",
+			ctx:      context.Background(),
+			expected: &Identity{Role: domain.RoleGuest},
+		},
+		{
+			name:     "администратор",
+			ctx:      adminCtx,
+			expected: &Identity{UserId: uuid.UUID{9}, Role: domain.RoleAdmin},
+		},
+		{
+			name:     "неизвестная роль",
+			ctx:      WithIdentity(context.Background(), &Identity{UserId: uuid.UUID{3}, Role: "superuser"}),
+			expected: &Identity{UserId: uuid.UUID{3}, Role: domain.RoleGuest},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, Caller(tc.ctx))
+		})
+	}
+}
+
+func TestRequireOwner(t *testing.T) {
+	testCases := []struct {
+		name    string
+		ctx     context.Context
+		wantErr bool
+	}{
+		{
+			name:    "владелец",
+			ctx:     ownerCtx,
+			wantErr: false,
+		},
+		{
+			name:    "администратор",
+			ctx:     adminCtx,
+			wantErr: false,
+		},
+		{
+			name:    "другой предприниматель",
+			ctx:     otherCtx,
+			wantErr: true,
+		},
+		{
+			name:    "гость с тем же id",
+			ctx:     WithIdentity(context.Background(), &Identity{UserId: uuid.UUID{1}, Role: domain.RoleGuest}),
+			wantErr: true,
+		},
+		{
+			name:    "анонимный пользователь",
+			ctx:     guestCtx,
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := requireOwner(tc.ctx, uuid.UUID{1}, "действие")
+
+			if tc.wantErr {
+				require.ErrorIs(t, err, ErrForbidden)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
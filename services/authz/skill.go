@@ -0,0 +1,57 @@
+package authz
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+)
+
+type SkillService struct {
+	next domain.ISkillService
+}
+
+func NewSkillService(next domain.ISkillService) domain.ISkillService {
+	return &SkillService{
+		next: next,
+	}
+}
+
+func (s *SkillService) Create(ctx context.Context, skill *domain.Skill) (err error) {
+	err = requireAdmin(ctx, i18n.SkillCreate)
+	if err != nil {
+		return err
+	}
+
+	return s.next.Create(ctx, skill)
+}
+
+func (s *SkillService) GetById(ctx context.Context, id uuid.UUID) (*domain.Skill, error) {
+	return s.next.GetById(ctx, id)
+}
+
+func (s *SkillService) GetByIds(ctx context.Context, ids []uuid.UUID) ([]*domain.Skill, error) {
+	return s.next.GetByIds(ctx, ids)
+}
+
+func (s *SkillService) GetAll(ctx context.Context, req domain.PageRequest) (*domain.Page[*domain.Skill], error) {
+	return s.next.GetAll(ctx, req)
+}
+
+func (s *SkillService) Update(ctx context.Context, skill *domain.Skill) (err error) {
+	err = requireAdmin(ctx, i18n.SkillUpdate)
+	if err != nil {
+		return err
+	}
+
+	return s.next.Update(ctx, skill)
+}
+
+func (s *SkillService) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
+	err = requireAdmin(ctx, i18n.SkillDelete)
+	if err != nil {
+		return err
+	}
+
+	return s.next.DeleteById(ctx, id)
+}
@@ -0,0 +1,48 @@
+package authz
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+)
+
+type TaxScheduleService struct {
+	next domain.ITaxScheduleService
+}
+
+func NewTaxScheduleService(next domain.ITaxScheduleService) domain.ITaxScheduleService {
+	return &TaxScheduleService{
+		next: next,
+	}
+}
+
+func (s *TaxScheduleService) Create(ctx context.Context, schedule *domain.TaxSchedule) (err error) {
+	err = requireAdmin(ctx, i18n.TaxScheduleCreate)
+	if err != nil {
+		return err
+	}
+
+	return s.next.Create(ctx, schedule)
+}
+
+func (s *TaxScheduleService) GetById(ctx context.Context, id uuid.UUID) (*domain.TaxSchedule, error) {
+	return s.next.GetById(ctx, id)
+}
+
+func (s *TaxScheduleService) GetByJurisdiction(ctx context.Context, jurisdiction string) ([]*domain.TaxSchedule, error) {
+	return s.next.GetByJurisdiction(ctx, jurisdiction)
+}
+
+func (s *TaxScheduleService) GetInForce(ctx context.Context, year int, activityFieldId uuid.UUID) (*domain.TaxSchedule, error) {
+	return s.next.GetInForce(ctx, year, activityFieldId)
+}
+
+func (s *TaxScheduleService) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
+	err = requireAdmin(ctx, i18n.TaxScheduleDelete)
+	if err != nil {
+		return err
+	}
+
+	return s.next.DeleteById(ctx, id)
+}
@@ -0,0 +1,73 @@
+package authz
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+)
+
+type UserService struct {
+	next domain.IUserService
+}
+
+func NewUserService(next domain.IUserService) domain.IUserService {
+	return &UserService{
+		next: next,
+	}
+}
+
+func (s *UserService) Create(ctx context.Context, user *domain.User) (err error) {
+	err = requireSelf(ctx, user.ID, i18n.UserCreate)
+	if err != nil {
+		return err
+	}
+
+	caller := Caller(ctx)
+	if !caller.IsAdmin() && user.Role != caller.Role {
+		return i18n.Wrap(ErrForbidden, i18n.UserCreate)
+	}
+
+	return s.next.Create(ctx, user)
+}
+
+func (s *UserService) GetByUsername(ctx context.Context, username string) (*domain.User, error) {
+	return s.next.GetByUsername(ctx, username)
+}
+
+func (s *UserService) GetById(ctx context.Context, userId uuid.UUID) (*domain.User, error) {
+	return s.next.GetById(ctx, userId)
+}
+
+func (s *UserService) GetAll(ctx context.Context, filter domain.UserFilter, req domain.PageRequest) (*domain.Page[*domain.User], error) {
+	return s.next.GetAll(ctx, filter, req)
+}
+
+func (s *UserService) Update(ctx context.Context, user *domain.User) (err error) {
+	err = requireSelf(ctx, user.ID, i18n.UserUpdate)
+	if err != nil {
+		return err
+	}
+
+	if !Caller(ctx).IsAdmin() {
+		existing, err := s.next.GetById(ctx, user.ID)
+		if err != nil {
+			return i18n.Wrap(err, i18n.UserUpdate)
+		}
+
+		if existing.Role != user.Role {
+			return i18n.Wrap(ErrForbidden, i18n.UserRoleChange)
+		}
+	}
+
+	return s.next.Update(ctx, user)
+}
+
+func (s *UserService) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
+	err = requireSelf(ctx, id, i18n.UserDelete)
+	if err != nil {
+		return err
+	}
+
+	return s.next.DeleteById(ctx, id)
+}
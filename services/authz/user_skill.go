@@ -0,0 +1,53 @@
+package authz
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+)
+
+type UserSkillService struct {
+	next domain.IUserSkillService
+}
+
+func NewUserSkillService(next domain.IUserSkillService) domain.IUserSkillService {
+	return &UserSkillService{
+		next: next,
+	}
+}
+
+func (s *UserSkillService) Create(ctx context.Context, pair *domain.UserSkill) (err error) {
+	err = requireSelf(ctx, pair.UserId, i18n.UserSkillCreate)
+	if err != nil {
+		return err
+	}
+
+	return s.next.Create(ctx, pair)
+}
+
+func (s *UserSkillService) Delete(ctx context.Context, pair *domain.UserSkill) (err error) {
+	err = requireSelf(ctx, pair.UserId, i18n.UserSkillDelete)
+	if err != nil {
+		return err
+	}
+
+	return s.next.Delete(ctx, pair)
+}
+
+func (s *UserSkillService) GetSkillsForUser(ctx context.Context, userId uuid.UUID, req domain.PageRequest) (*domain.Page[*domain.Skill], error) {
+	return s.next.GetSkillsForUser(ctx, userId, req)
+}
+
+func (s *UserSkillService) GetUsersForSkill(ctx context.Context, skillId uuid.UUID, req domain.PageRequest) (*domain.Page[*domain.User], error) {
+	return s.next.GetUsersForSkill(ctx, skillId, req)
+}
+
+func (s *UserSkillService) DeleteSkillsForUser(ctx context.Context, userId uuid.UUID) (err error) {
+	err = requireSelf(ctx, userId, i18n.UserSkillDeleteForUser)
+	if err != nil {
+		return err
+	}
+
+	return s.next.DeleteSkillsForUser(ctx, userId)
+}
@@ -0,0 +1,49 @@
+package authz
+
+import (
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestUserService_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	next := mocks.NewMockIUserService(ctrl)
+	next.EXPECT().
+		GetById(gomock.Any(), uuid.UUID{1}).
+		Return(&domain.User{ID: uuid.UUID{1}, Role: domain.RoleEntrepreneur}, nil).
+		AnyTimes()
+
+	err := NewUserService(next).Update(ownerCtx, &domain.User{ID: uuid.UUID{1}, Role: domain.RoleAdmin})
+	require.ErrorIs(t, err, ErrForbidden)
+
+	err = NewUserService(next).Update(otherCtx, &domain.User{ID: uuid.UUID{1}, Role: domain.RoleEntrepreneur})
+	require.ErrorIs(t, err, ErrForbidden)
+
+	next.EXPECT().Update(gomock.Any(), &domain.User{ID: uuid.UUID{1}, City: "a", Role: domain.RoleEntrepreneur}).Return(nil)
+	err = NewUserService(next).Update(ownerCtx, &domain.User{ID: uuid.UUID{1}, City: "a", Role: domain.RoleEntrepreneur})
+	require.Nil(t, err)
+}
+
+func TestAuthService_Register(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	next := mocks.NewMockIAuthService(ctrl)
+
+	err := NewAuthService(next).Register(guestCtx, &domain.UserAuth{Username: "a", Role: domain.RoleAdmin})
+	require.ErrorIs(t, err, ErrForbidden)
+
+	next.EXPECT().Register(gomock.Any(), &domain.UserAuth{Username: "a", Role: domain.RoleEntrepreneur}).Return(nil)
+	err = NewAuthService(next).Register(guestCtx, &domain.UserAuth{Username: "a", Role: domain.RoleEntrepreneur})
+	require.Nil(t, err)
+
+	next.EXPECT().Register(gomock.Any(), &domain.UserAuth{Username: "b", Role: domain.RoleAdmin}).Return(nil)
+	err = NewAuthService(next).Register(adminCtx, &domain.UserAuth{Username: "b", Role: domain.RoleAdmin})
+	require.Nil(t, err)
+}
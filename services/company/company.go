@@ -2,8 +2,8 @@ package company
 
 import (
 	"context"
-	"fmt"
 	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
 	"github.com/dlankinl/bmstu-ppo-bl/pkg/logger"
 	"github.com/google/uuid"
 )
@@ -23,83 +23,77 @@ func NewService(
 	}
 }
 
-func (s *Service) Create(company *domain.Company) (err error) {
+func (s *Service) Create(ctx context.Context, company *domain.Company) (err error) {
 	if company.Name == "" {
-		s.logger.Infof("должно быть указано название компании")
-		return fmt.Errorf("должно быть указано название компании")
+		s.logger.Infof("%v", i18n.CompanyNameRequired)
+		return domain.NewValidationError("name", i18n.CompanyNameRequired)
 	}
 
 	if company.City == "" {
-		s.logger.Infof("должно быть указано название города")
-		return fmt.Errorf("должно быть указано название города")
+		s.logger.Infof("%v", i18n.CityRequired)
+		return domain.NewValidationError("city", i18n.CityRequired)
 	}
 
-	ctx := context.Background()
-
 	err = s.companyRepo.Create(ctx, company)
 	if err != nil {
-		s.logger.Infof("добавление компании: %v", err)
-		return fmt.Errorf("добавление компании: %w", err)
+		s.logger.Infof("%v: %v", i18n.CompanyCreate, err)
+		return i18n.Wrap(err, i18n.CompanyCreate)
 	}
 
 	return nil
 }
 
-func (s *Service) GetById(id uuid.UUID) (company *domain.Company, err error) {
-	ctx := context.Background()
-
+func (s *Service) GetById(ctx context.Context, id uuid.UUID) (company *domain.Company, err error) {
 	company, err = s.companyRepo.GetById(ctx, id)
 	if err != nil {
-		s.logger.Infof("получение компании по id: %v", err)
-		return nil, fmt.Errorf("получение компании по id: %w", err)
+		s.logger.Infof("%v: %v", i18n.CompanyGet, err)
+		return nil, i18n.Wrap(err, i18n.CompanyGet)
 	}
 
 	return company, nil
 }
 
-func (s *Service) GetByOwnerId(id uuid.UUID, page int) (companies []*domain.Company, err error) {
-	ctx := context.Background()
-
-	companies, err = s.companyRepo.GetByOwnerId(ctx, id, page)
+func (s *Service) GetByOwnerId(ctx context.Context, id uuid.UUID, req domain.PageRequest) (companies *domain.Page[*domain.Company], err error) {
+	companies, err = s.companyRepo.GetByOwnerId(ctx, id, req)
 	if err != nil {
-		s.logger.Infof("получение списка компаний по id владельца: %v", err)
-		return nil, fmt.Errorf("получение списка компаний по id владельца: %w", err)
+		s.logger.Infof("%v: %v", i18n.CompanyGetByOwner, err)
+		return nil, i18n.Wrap(err, i18n.CompanyGetByOwner)
 	}
 
 	return companies, nil
 }
 
-func (s *Service) GetAll(page int) (companies []*domain.Company, err error) {
-	ctx := context.Background()
+func (s *Service) GetAll(ctx context.Context, filter domain.CompanyFilter, req domain.PageRequest) (companies *domain.Page[*domain.Company], err error) {
+	err = filter.Validate()
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.CompanyGetAll, err)
+		return nil, err
+	}
 
-	companies, err = s.companyRepo.GetAll(ctx, page)
+	companies, err = s.companyRepo.GetAll(ctx, filter, req)
 	if err != nil {
-		s.logger.Infof("получение списка всех компаний: %v", err)
-		return nil, fmt.Errorf("получение списка всех компаний: %w", err)
+		s.logger.Infof("%v: %v", i18n.CompanyGetAll, err)
+		return nil, i18n.Wrap(err, i18n.CompanyGetAll)
 	}
 
 	return companies, nil
 }
 
-func (s *Service) Update(company *domain.Company) (err error) {
-	ctx := context.Background()
-
+func (s *Service) Update(ctx context.Context, company *domain.Company) (err error) {
 	err = s.companyRepo.Update(ctx, company)
 	if err != nil {
-		s.logger.Infof("обновление информации о компании: %v", err)
-		return fmt.Errorf("обновление информации о компании: %w", err)
+		s.logger.Infof("%v: %v", i18n.CompanyUpdate, err)
+		return i18n.Wrap(err, i18n.CompanyUpdate)
 	}
 
 	return nil
 }
 
-func (s *Service) DeleteById(id uuid.UUID) (err error) {
-	ctx := context.Background()
-
+func (s *Service) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
 	err = s.companyRepo.DeleteById(ctx, id)
 	if err != nil {
-		s.logger.Infof("удаление компании по id: %v", err)
-		return fmt.Errorf("удаление компании по id: %w", err)
+		s.logger.Infof("%v: %v", i18n.CompanyDelete, err)
+		return i18n.Wrap(err, i18n.CompanyDelete)
 	}
 
 	return nil
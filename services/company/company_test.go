@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"strings"
 	"testing"
 )
 
@@ -18,7 +19,7 @@ func TestCompanyService_Create(t *testing.T) {
 
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	svc := NewService(compRepo, logger)
 
 	testCases := []struct {
@@ -113,7 +114,7 @@ func TestCompanyService_Create(t *testing.T) {
 				tc.beforeTest(*compRepo)
 			}
 
-			err := svc.Create(tc.company)
+			err := svc.Create(context.Background(), tc.company)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -130,7 +131,7 @@ func TestCompanyService_DeleteById(t *testing.T) {
 
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	svc := NewService(compRepo, logger)
 
 	curUuid := uuid.New()
@@ -170,7 +171,7 @@ func TestCompanyService_DeleteById(t *testing.T) {
 				tc.beforeTest(*compRepo)
 			}
 
-			err := svc.DeleteById(tc.id)
+			err := svc.DeleteById(context.Background(), tc.id)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -187,13 +188,14 @@ func TestCompanyService_GetAll(t *testing.T) {
 
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	svc := NewService(compRepo, logger)
 
 	testCases := []struct {
 		name       string
+		filter     domain.CompanyFilter
 		beforeTest func(compRepo mocks.MockICompanyRepository)
-		expected   []*domain.Company
+		expected   *domain.Page[*domain.Company]
 		wantErr    bool
 		errStr     error
 	}{
@@ -201,8 +203,8 @@ func TestCompanyService_GetAll(t *testing.T) {
 			name: "успешное получение списка всех компаний",
 			beforeTest: func(compRepo mocks.MockICompanyRepository) {
 				compRepo.EXPECT().
-					GetAll(context.Background(), 1).
-					Return([]*domain.Company{
+					GetAll(context.Background(), domain.CompanyFilter{}, domain.PageRequest{}).
+					Return(&domain.Page[*domain.Company]{Items: []*domain.Company{
 						{
 							ID:   uuid.UUID{1},
 							Name: "a",
@@ -218,9 +220,9 @@ func TestCompanyService_GetAll(t *testing.T) {
 							Name: "c",
 							City: "c",
 						},
-					}, nil)
+					}}, nil)
 			},
-			expected: []*domain.Company{
+			expected: &domain.Page[*domain.Company]{Items: []*domain.Company{
 				{
 					ID:   uuid.UUID{1},
 					Name: "a",
@@ -236,19 +238,35 @@ func TestCompanyService_GetAll(t *testing.T) {
 					Name: "c",
 					City: "c",
 				},
-			},
+			}},
 			wantErr: false,
 		},
 		{
 			name: "ошибка получения данных в репозитории",
 			beforeTest: func(compRepo mocks.MockICompanyRepository) {
 				compRepo.EXPECT().
-					GetAll(context.Background(), 1).
+					GetAll(context.Background(), domain.CompanyFilter{}, domain.PageRequest{}).
 					Return(nil, fmt.Errorf("sql error"))
 			},
 			wantErr: true,
 			errStr:  errors.New("получение списка всех компаний: sql error"),
 		},
+		{
+			name:   "фильтр передается в репозиторий",
+			filter: domain.CompanyFilter{City: "Москва", ActivityFieldId: uuid.UUID{2}, Search: "альфа"},
+			beforeTest: func(compRepo mocks.MockICompanyRepository) {
+				compRepo.EXPECT().
+					GetAll(context.Background(), domain.CompanyFilter{City: "Москва", ActivityFieldId: uuid.UUID{2}, Search: "альфа"}, domain.PageRequest{}).
+					Return(&domain.Page[*domain.Company]{Items: []*domain.Company{}}, nil)
+			},
+			expected: &domain.Page[*domain.Company]{Items: []*domain.Company{}},
+		},
+		{
+			name:    "слишком длинная строка поиска",
+			filter:  domain.CompanyFilter{Search: strings.Repeat("я", domain.MaxSearchLength+1)},
+			wantErr: true,
+			errStr:  errors.New("строка поиска длиннее 100 символов"),
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -256,7 +274,7 @@ func TestCompanyService_GetAll(t *testing.T) {
 				tc.beforeTest(*compRepo)
 			}
 
-			companies, err := svc.GetAll(1)
+			companies, err := svc.GetAll(context.Background(), tc.filter, domain.PageRequest{})
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -274,7 +292,7 @@ func TestCompanyService_GetById(t *testing.T) {
 
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	svc := NewService(compRepo, logger)
 
 	testCases := []struct {
@@ -328,7 +346,7 @@ func TestCompanyService_GetById(t *testing.T) {
 				tc.beforeTest(*compRepo)
 			}
 
-			company, err := svc.GetById(tc.id)
+			company, err := svc.GetById(context.Background(), tc.id)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -346,14 +364,14 @@ func TestCompanyService_GetByOwnerId(t *testing.T) {
 
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	svc := NewService(compRepo, logger)
 
 	testCases := []struct {
 		name       string
 		id         uuid.UUID
 		beforeTest func(compRepo mocks.MockICompanyRepository)
-		expected   []*domain.Company
+		expected   *domain.Page[*domain.Company]
 		wantErr    bool
 		errStr     error
 	}{
@@ -365,9 +383,9 @@ func TestCompanyService_GetByOwnerId(t *testing.T) {
 					GetByOwnerId(
 						context.Background(),
 						uuid.UUID{1},
-						1,
+						domain.PageRequest{},
 					).
-					Return([]*domain.Company{
+					Return(&domain.Page[*domain.Company]{Items: []*domain.Company{
 						{
 							ID:      uuid.UUID{1},
 							OwnerID: uuid.UUID{1},
@@ -386,9 +404,9 @@ func TestCompanyService_GetByOwnerId(t *testing.T) {
 							Name:    "c",
 							City:    "c",
 						},
-					}, nil)
+					}}, nil)
 			},
-			expected: []*domain.Company{
+			expected: &domain.Page[*domain.Company]{Items: []*domain.Company{
 				{
 					ID:      uuid.UUID{1},
 					OwnerID: uuid.UUID{1},
@@ -407,7 +425,7 @@ func TestCompanyService_GetByOwnerId(t *testing.T) {
 					Name:    "c",
 					City:    "c",
 				},
-			},
+			}},
 			wantErr: false,
 		},
 		{
@@ -418,7 +436,7 @@ func TestCompanyService_GetByOwnerId(t *testing.T) {
 					GetByOwnerId(
 						context.Background(),
 						uuid.UUID{1},
-						1,
+						domain.PageRequest{},
 					).
 					Return(nil, fmt.Errorf("sql error"))
 			},
@@ -432,7 +450,7 @@ func TestCompanyService_GetByOwnerId(t *testing.T) {
 				tc.beforeTest(*compRepo)
 			}
 
-			companies, err := svc.GetByOwnerId(tc.id, 1)
+			companies, err := svc.GetByOwnerId(context.Background(), tc.id, domain.PageRequest{})
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -450,7 +468,7 @@ func TestCompanyService_Update(t *testing.T) {
 
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	svc := NewService(compRepo, logger)
 
 	testCases := []struct {
@@ -504,7 +522,7 @@ func TestCompanyService_Update(t *testing.T) {
 				tc.beforeTest(*compRepo)
 			}
 
-			err := svc.Update(tc.company)
+			err := svc.Update(context.Background(), tc.company)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -514,3 +532,28 @@ func TestCompanyService_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestCompanyService_Create_ErrorKinds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	compRepo := mocks.NewMockICompanyRepository(ctrl)
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	svc := NewService(compRepo, logger)
+
+	err := svc.Create(context.Background(), &domain.Company{City: "ccc"})
+	require.ErrorIs(t, err, domain.ErrValidation)
+
+	var validationErr *domain.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	require.Equal(t, "name", validationErr.Field)
+
+	compRepo.EXPECT().
+		Create(context.Background(), &domain.Company{Name: "aaa", City: "ccc"}).
+		Return(domain.NewError(domain.ErrConflict, "компания уже существует"))
+
+	err = svc.Create(context.Background(), &domain.Company{Name: "aaa", City: "ccc"})
+	require.ErrorIs(t, err, domain.ErrConflict)
+	require.NotErrorIs(t, err, domain.ErrValidation)
+}
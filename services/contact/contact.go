@@ -2,8 +2,8 @@ package contact
 
 import (
 	"context"
-	"fmt"
 	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
 	"github.com/dlankinl/bmstu-ppo-bl/pkg/logger"
 	"github.com/google/uuid"
 )
@@ -23,71 +23,61 @@ func NewService(
 	}
 }
 
-func (s *Service) Create(contact *domain.Contact) (err error) {
+func (s *Service) Create(ctx context.Context, contact *domain.Contact) (err error) {
 	if contact.Name == "" {
-		s.logger.Infof("должно быть указано название средства связи")
-		return fmt.Errorf("должно быть указано название средства связи")
+		s.logger.Infof("%v", i18n.ContactNameRequired)
+		return domain.NewValidationError("name", i18n.ContactNameRequired)
 	}
 
 	if contact.Value == "" {
-		s.logger.Infof("должно быть указано значение средства связи")
-		return fmt.Errorf("должно быть указано значение средства связи")
+		s.logger.Infof("%v", i18n.ContactValueRequired)
+		return domain.NewValidationError("value", i18n.ContactValueRequired)
 	}
 
-	ctx := context.Background()
-
 	err = s.contactRepo.Create(ctx, contact)
 	if err != nil {
-		s.logger.Infof("добавление средства связи: %v", err)
-		return fmt.Errorf("добавление средства связи: %w", err)
+		s.logger.Infof("%v: %v", i18n.ContactCreate, err)
+		return i18n.Wrap(err, i18n.ContactCreate)
 	}
 
 	return nil
 }
 
-func (s *Service) GetById(id uuid.UUID) (contact *domain.Contact, err error) {
-	ctx := context.Background()
-
+func (s *Service) GetById(ctx context.Context, id uuid.UUID) (contact *domain.Contact, err error) {
 	contact, err = s.contactRepo.GetById(ctx, id)
 	if err != nil {
-		s.logger.Infof("получение средства связи по id: %v", err)
-		return nil, fmt.Errorf("получение средства связи по id: %w", err)
+		s.logger.Infof("%v: %v", i18n.ContactGet, err)
+		return nil, i18n.Wrap(err, i18n.ContactGet)
 	}
 
 	return contact, nil
 }
 
-func (s *Service) GetByOwnerId(id uuid.UUID, page int) (contacts []*domain.Contact, err error) {
-	ctx := context.Background()
-
-	contacts, err = s.contactRepo.GetByOwnerId(ctx, id, page)
+func (s *Service) GetByOwnerId(ctx context.Context, id uuid.UUID, req domain.PageRequest) (contacts *domain.Page[*domain.Contact], err error) {
+	contacts, err = s.contactRepo.GetByOwnerId(ctx, id, req)
 	if err != nil {
-		s.logger.Infof("получение всех средств связи по id владельца: %v", err)
-		return nil, fmt.Errorf("получение всех средств связи по id владельца: %w", err)
+		s.logger.Infof("%v: %v", i18n.ContactGetByOwner, err)
+		return nil, i18n.Wrap(err, i18n.ContactGetByOwner)
 	}
 
 	return contacts, nil
 }
 
-func (s *Service) Update(contact *domain.Contact) (err error) {
-	ctx := context.Background()
-
+func (s *Service) Update(ctx context.Context, contact *domain.Contact) (err error) {
 	err = s.contactRepo.Update(ctx, contact)
 	if err != nil {
-		s.logger.Infof("обновление информации о средстве связи: %v", err)
-		return fmt.Errorf("обновление информации о средстве связи: %w", err)
+		s.logger.Infof("%v: %v", i18n.ContactUpdate, err)
+		return i18n.Wrap(err, i18n.ContactUpdate)
 	}
 
 	return nil
 }
 
-func (s *Service) DeleteById(id uuid.UUID) (err error) {
-	ctx := context.Background()
-
+func (s *Service) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
 	err = s.contactRepo.DeleteById(ctx, id)
 	if err != nil {
-		s.logger.Infof("удаление средства связи по id: %v", err)
-		return fmt.Errorf("удаление средства связи по id: %w", err)
+		s.logger.Infof("%v: %v", i18n.ContactDelete, err)
+		return i18n.Wrap(err, i18n.ContactDelete)
 	}
 
 	return nil
@@ -18,7 +18,7 @@ func TestContactService_Create(t *testing.T) {
 
 	conRepo := mocks.NewMockIContactsRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	svc := NewService(conRepo, logger)
 
 	testCases := []struct {
@@ -113,7 +113,7 @@ func TestContactService_Create(t *testing.T) {
 				tc.beforeTest(*conRepo)
 			}
 
-			err := svc.Create(tc.data)
+			err := svc.Create(context.Background(), tc.data)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -130,7 +130,7 @@ func TestContactService_DeleteById(t *testing.T) {
 
 	conRepo := mocks.NewMockIContactsRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	svc := NewService(conRepo, logger)
 
 	curUuid := uuid.New()
@@ -170,7 +170,7 @@ func TestContactService_DeleteById(t *testing.T) {
 				tc.beforeTest(*conRepo)
 			}
 
-			err := svc.DeleteById(tc.id)
+			err := svc.DeleteById(context.Background(), tc.id)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -187,14 +187,14 @@ func TestContactService_GetByOwnerId(t *testing.T) {
 
 	conRepo := mocks.NewMockIContactsRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	svc := NewService(conRepo, logger)
 
 	testCases := []struct {
 		name       string
 		id         uuid.UUID
 		beforeTest func(conRepo mocks.MockIContactsRepository)
-		expected   []*domain.Contact
+		expected   *domain.Page[*domain.Contact]
 		wantErr    bool
 		errStr     error
 	}{
@@ -206,9 +206,9 @@ func TestContactService_GetByOwnerId(t *testing.T) {
 					GetByOwnerId(
 						context.Background(),
 						uuid.UUID{1},
-						1,
+						domain.PageRequest{},
 					).
-					Return([]*domain.Contact{
+					Return(&domain.Page[*domain.Contact]{Items: []*domain.Contact{
 						{
 							ID:      uuid.UUID{1},
 							OwnerID: uuid.UUID{1},
@@ -227,9 +227,9 @@ func TestContactService_GetByOwnerId(t *testing.T) {
 							Name:    "c",
 							Value:   "c",
 						},
-					}, nil)
+					}}, nil)
 			},
-			expected: []*domain.Contact{
+			expected: &domain.Page[*domain.Contact]{Items: []*domain.Contact{
 				{
 					ID:      uuid.UUID{1},
 					OwnerID: uuid.UUID{1},
@@ -248,7 +248,7 @@ func TestContactService_GetByOwnerId(t *testing.T) {
 					Name:    "c",
 					Value:   "c",
 				},
-			},
+			}},
 			wantErr: false,
 		},
 		{
@@ -259,7 +259,7 @@ func TestContactService_GetByOwnerId(t *testing.T) {
 					GetByOwnerId(
 						context.Background(),
 						uuid.UUID{1},
-						1,
+						domain.PageRequest{},
 					).
 					Return(nil, fmt.Errorf("sql error"))
 			},
@@ -273,7 +273,7 @@ func TestContactService_GetByOwnerId(t *testing.T) {
 				tc.beforeTest(*conRepo)
 			}
 
-			companies, err := svc.GetByOwnerId(tc.id, 1)
+			companies, err := svc.GetByOwnerId(context.Background(), tc.id, domain.PageRequest{})
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -291,7 +291,7 @@ func TestContactService_GetById(t *testing.T) {
 
 	conRepo := mocks.NewMockIContactsRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	svc := NewService(conRepo, logger)
 
 	testCases := []struct {
@@ -345,7 +345,7 @@ func TestContactService_GetById(t *testing.T) {
 				tc.beforeTest(*conRepo)
 			}
 
-			company, err := svc.GetById(tc.id)
+			company, err := svc.GetById(context.Background(), tc.id)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -363,7 +363,7 @@ func TestContactService_Update(t *testing.T) {
 
 	conRepo := mocks.NewMockIContactsRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	svc := NewService(conRepo, logger)
 
 	testCases := []struct {
@@ -417,7 +417,7 @@ func TestContactService_Update(t *testing.T) {
 				tc.beforeTest(*conRepo)
 			}
 
-			err := svc.Update(tc.data)
+			err := svc.Update(context.Background(), tc.data)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
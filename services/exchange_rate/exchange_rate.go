@@ -0,0 +1,89 @@
+package exchange_rate
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"math/big"
+)
+
+type rateKey struct {
+	currency string
+	year     int
+	quarter  int
+}
+
+// Table переводит суммы по заранее загруженной таблице квартальных курсов к domain.DefaultCurrency.
+type Table struct {
+	rates      map[rateKey]*big.Rat
+	currencies map[string]bool
+}
+
+// NewTable создает провайдер курсов по таблице rates. Курс DefaultCurrency к самой себе равен единице
+// и в таблице не указывается, поэтому пустая таблица переводит суммы только в DefaultCurrency.
+func NewTable(rates []domain.ExchangeRate) (domain.IExchangeRateProvider, error) {
+	t := &Table{
+		rates:      make(map[rateKey]*big.Rat, len(rates)),
+		currencies: map[string]bool{domain.DefaultCurrency: true},
+	}
+
+	for _, rate := range rates {
+		if rate.Currency == "" || rate.Currency == domain.DefaultCurrency {
+			return nil, domain.NewValidationError("currency", i18n.ExchangeRateCurrencyInvalid, rate.Currency)
+		}
+
+		if rate.Quarter < 1 || rate.Quarter > 4 {
+			return nil, domain.NewValidationError("quarter", i18n.ExchangeRateQuarterRange)
+		}
+
+		if rate.Rate == nil || rate.Rate.Sign() <= 0 {
+			return nil, domain.NewValidationError("rate", i18n.ExchangeRateNotPositive)
+		}
+
+		key := rateKey{currency: rate.Currency, year: rate.Year, quarter: rate.Quarter}
+		if _, ok := t.rates[key]; ok {
+			return nil, domain.NewValidationError("rate", i18n.ExchangeRateDuplicate, rate.Currency, rate.Quarter, rate.Year)
+		}
+
+		t.rates[key] = new(big.Rat).Set(rate.Rate)
+		t.currencies[rate.Currency] = true
+	}
+
+	return t, nil
+}
+
+func (t *Table) Supports(_ context.Context, currency string) (bool, error) {
+	return t.currencies[currency], nil
+}
+
+func (t *Table) Convert(_ context.Context, amount domain.Money, currency string, year, quarter int) (domain.Money, error) {
+	if amount.Currency == currency {
+		return amount, nil
+	}
+
+	from, err := t.rate(amount.Currency, year, quarter)
+	if err != nil {
+		return domain.Money{}, err
+	}
+
+	to, err := t.rate(currency, year, quarter)
+	if err != nil {
+		return domain.Money{}, err
+	}
+
+	return amount.Exchange(from.Quo(from, to), currency), nil
+}
+
+// rate возвращает копию курса валюты currency к DefaultCurrency.
+func (t *Table) rate(currency string, year, quarter int) (*big.Rat, error) {
+	if currency == domain.DefaultCurrency {
+		return big.NewRat(1, 1), nil
+	}
+
+	rate, ok := t.rates[rateKey{currency: currency, year: year, quarter: quarter}]
+	if !ok {
+		return nil, domain.NewError(domain.ErrNotFound, i18n.ExchangeRateNotFound, currency, quarter, year)
+	}
+
+	return new(big.Rat).Set(rate), nil
+}
@@ -0,0 +1,177 @@
+package exchange_rate
+
+import (
+	"context"
+	"errors"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"testing"
+)
+
+func TestNewTable(t *testing.T) {
+	testCases := []struct {
+		name    string
+		rates   []domain.ExchangeRate
+		wantErr bool
+		errStr  error
+	}{
+		{
+			name: "успешное создание",
+			rates: []domain.ExchangeRate{
+				{Currency: "USD", Year: 2023, Quarter: 1, Rate: big.NewRat(73, 1)},
+				{Currency: "USD", Year: 2023, Quarter: 2, Rate: big.NewRat(81, 1)},
+				{Currency: "EUR", Year: 2023, Quarter: 1, Rate: big.NewRat(79, 1)},
+			},
+		},
+		{
+			name:  "пустая таблица",
+			rates: nil,
+		},
+		{
+			name:    "курс валюты по умолчанию",
+			rates:   []domain.ExchangeRate{{Currency: "RUB", Year: 2023, Quarter: 1, Rate: big.NewRat(1, 1)}},
+			wantErr: true,
+			errStr:  errors.New(`курс не задается для валюты "RUB"`),
+		},
+		{
+			name:    "квартал вне диапазона",
+			rates:   []domain.ExchangeRate{{Currency: "USD", Year: 2023, Quarter: 5, Rate: big.NewRat(73, 1)}},
+			wantErr: true,
+			errStr:  errors.New("квартал курса должен быть от 1 до 4"),
+		},
+		{
+			name:    "нулевой курс",
+			rates:   []domain.ExchangeRate{{Currency: "USD", Year: 2023, Quarter: 1, Rate: new(big.Rat)}},
+			wantErr: true,
+			errStr:  errors.New("курс должен быть положительным"),
+		},
+		{
+			name: "курс указан дважды",
+			rates: []domain.ExchangeRate{
+				{Currency: "USD", Year: 2023, Quarter: 1, Rate: big.NewRat(73, 1)},
+				{Currency: "USD", Year: 2023, Quarter: 1, Rate: big.NewRat(74, 1)},
+			},
+			wantErr: true,
+			errStr:  errors.New("курс USD за 1 квартал 2023 года указан дважды"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewTable(tc.rates)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestTable_Convert(t *testing.T) {
+	table, err := NewTable([]domain.ExchangeRate{
+		{Currency: "USD", Year: 2023, Quarter: 1, Rate: big.NewRat(7345, 100)},
+		{Currency: "USD", Year: 2023, Quarter: 2, Rate: big.NewRat(81, 1)},
+		{Currency: "EUR", Year: 2023, Quarter: 1, Rate: big.NewRat(80, 1)},
+	})
+	require.Nil(t, err)
+
+	testCases := []struct {
+		name     string
+		amount   domain.Money
+		currency string
+		quarter  int
+		expected domain.Money
+		wantErr  bool
+		errStr   error
+	}{
+		{
+			name:     "в валюту по умолчанию",
+			amount:   domain.NewMoney(100, "USD"),
+			currency: "RUB",
+			quarter:  1,
+			expected: domain.NewMoney(7345, "RUB"),
+		},
+		{
+			name:     "курс своего квартала",
+			amount:   domain.NewMoney(100, "USD"),
+			currency: "RUB",
+			quarter:  2,
+			expected: domain.NewMoney(8100, "RUB"),
+		},
+		{
+			name:     "из валюты по умолчанию с округлением",
+			amount:   domain.NewMoney(1000, "RUB"),
+			currency: "USD",
+			quarter:  1,
+			// 1000 / 73.45 = 13.6147…
+			expected: domain.Money{Amount: 1361, Currency: "USD"},
+		},
+		{
+			name:     "половина копейки округляется от нуля",
+			amount:   domain.Money{Amount: -10, Currency: "USD"},
+			currency: "RUB",
+			quarter:  1,
+			// -0.10 * 73.45 = -7.345
+			expected: domain.Money{Amount: -735, Currency: "RUB"},
+		},
+		{
+			name:     "кросс-курс",
+			amount:   domain.NewMoney(80, "EUR"),
+			currency: "USD",
+			quarter:  1,
+			// 80 * 80 / 73.45 = 87.1341…
+			expected: domain.Money{Amount: 8713, Currency: "USD"},
+		},
+		{
+			name:     "та же валюта",
+			amount:   domain.Money{Amount: 12345, Currency: "USD"},
+			currency: "USD",
+			quarter:  4,
+			expected: domain.Money{Amount: 12345, Currency: "USD"},
+		},
+		{
+			name:     "нет курса за квартал",
+			amount:   domain.NewMoney(100, "EUR"),
+			currency: "RUB",
+			quarter:  2,
+			wantErr:  true,
+			errStr:   errors.New("нет курса EUR за 2 квартал 2023 года"),
+		},
+		{
+			name:     "неизвестная валюта",
+			amount:   domain.NewMoney(100, "RUB"),
+			currency: "GBP",
+			quarter:  1,
+			wantErr:  true,
+			errStr:   errors.New("нет курса GBP за 1 квартал 2023 года"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			converted, err := table.Convert(context.Background(), tc.amount, tc.currency, 2023, tc.quarter)
+
+			if tc.wantErr {
+				require.ErrorIs(t, err, domain.ErrNotFound)
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tc.expected, converted)
+			}
+		})
+	}
+}
+
+func TestTable_Supports(t *testing.T) {
+	table, err := NewTable([]domain.ExchangeRate{{Currency: "USD", Year: 2023, Quarter: 1, Rate: big.NewRat(73, 1)}})
+	require.Nil(t, err)
+
+	for currency, expected := range map[string]bool{"RUB": true, "USD": true, "EUR": false, "": false} {
+		supported, err := table.Supports(context.Background(), currency)
+		require.Nil(t, err)
+		require.Equal(t, expected, supported, currency)
+	}
+}
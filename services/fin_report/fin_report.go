@@ -2,128 +2,332 @@ package fin_report
 
 import (
 	"context"
-	"fmt"
 	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
 	"github.com/dlankinl/bmstu-ppo-bl/pkg/logger"
 	"github.com/google/uuid"
 	"time"
 )
 
 type Service struct {
-	finRepo domain.IFinancialReportRepository
-	logger  logger.ILogger
+	finRepo   domain.IFinancialReportRepository
+	txManager domain.ITxManager
+	rates     domain.IExchangeRateProvider
+	currency  string
+	logger    logger.ILogger
 }
 
+// NewService создает сервис отчетов. Отчеты за период приводятся к валюте отчетности currency
+// по курсам rates: сумма каждого отчета переводится по курсу своего квартала.
 func NewService(
 	finRepo domain.IFinancialReportRepository,
+	txManager domain.ITxManager,
+	rates domain.IExchangeRateProvider,
+	currency string,
 	logger logger.ILogger,
 ) domain.IFinancialReportService {
 	return &Service{
-		finRepo: finRepo,
-		logger:  logger,
+		finRepo:   finRepo,
+		txManager: txManager,
+		rates:     rates,
+		currency:  currency,
+		logger:    logger,
 	}
 }
 
-func (s *Service) Create(finReport *domain.FinancialReport) (err error) {
-	if finReport.Revenue < 0 {
-		s.logger.Infof("выручка не может быть отрицательной")
-		return fmt.Errorf("выручка не может быть отрицательной")
+// validateCurrency проверяет, что суммы отчета указаны в одной валюте, которую можно перевести в валюту отчетности.
+func (s *Service) validateCurrency(ctx context.Context, finReport *domain.FinancialReport) error {
+	if finReport.Revenue.Currency != finReport.Costs.Currency {
+		return domain.NewValidationError("currency", i18n.FinReportCurrencyMismatch)
 	}
 
-	if finReport.Costs < 0 {
-		s.logger.Infof("расходы не могут быть отрицательными")
-		return fmt.Errorf("расходы не могут быть отрицательными")
+	supported, err := s.rates.Supports(ctx, finReport.Revenue.Currency)
+	if err != nil {
+		return err
+	}
+	if !supported {
+		return domain.NewValidationError("currency", i18n.CurrencyUnsupported, finReport.Revenue.Currency)
+	}
+
+	return nil
+}
+
+// convert переводит суммы отчетов в валюту отчетности по курсам их последних кварталов.
+func (s *Service) convert(ctx context.Context, finReport *domain.FinancialReportByPeriod) (err error) {
+	for i := range finReport.Reports {
+		rep := &finReport.Reports[i]
+
+		rep.Revenue, err = s.rates.Convert(ctx, rep.Revenue, s.currency, rep.Year, rep.LastQuarter())
+		if err != nil {
+			return err
+		}
+
+		rep.Costs, err = s.rates.Convert(ctx, rep.Costs, s.currency, rep.Year, rep.LastQuarter())
+		if err != nil {
+			return err
+		}
+	}
+	finReport.Currency = s.currency
+	finReport.Taxes = domain.Money{Currency: s.currency}
+
+	return finReport.CheckCurrency()
+}
+
+// validate проверяет отчет перед созданием или обновлением.
+func (s *Service) validate(ctx context.Context, finReport *domain.FinancialReport) error {
+	err := s.validateCurrency(ctx, finReport)
+	if err != nil {
+		return err
 	}
 
-	if finReport.Quarter > 4 || finReport.Quarter < 1 {
-		s.logger.Infof("значение квартала должно находиться в отрезке от 1 до 4")
-		return fmt.Errorf("значение квартала должно находиться в отрезке от 1 до 4")
+	if finReport.Revenue.Sign() < 0 {
+		return domain.NewValidationError("revenue", i18n.FinReportRevenueNegative)
+	}
+
+	if finReport.Costs.Sign() < 0 {
+		return domain.NewValidationError("costs", i18n.FinReportCostsNegative)
+	}
+
+	return validatePeriod(finReport)
+}
+
+func (s *Service) Create(ctx context.Context, finReport *domain.FinancialReport) (err error) {
+	err = s.validate(ctx, finReport)
+	if err != nil {
+		s.logger.Infof("%v", err)
+		return err
+	}
+
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		return s.create(ctx, finReport)
+	})
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.FinReportCreate, err)
+		return i18n.Wrap(err, i18n.FinReportCreate)
+	}
+
+	return nil
+}
+
+// create сохраняет проверенный validate отчет, если его период свободен.
+func (s *Service) create(ctx context.Context, finReport *domain.FinancialReport) error {
+	err := s.checkOverlap(ctx, finReport)
+	if err != nil {
+		return err
+	}
+
+	return s.finRepo.Create(ctx, finReport)
+}
+
+// validatePeriod проверяет период отчета и заполняет поля, которые следуют из периодичности:
+// квартал месячного отчета и нулевые месяц и квартал у отчетов за более длинный период.
+// Отчет без периодичности считается квартальным.
+func validatePeriod(finReport *domain.FinancialReport) error {
+	if finReport.Granularity == "" {
+		finReport.Granularity = domain.GranularityQuarter
+	}
+
+	switch finReport.Granularity {
+	case domain.GranularityMonth:
+		if finReport.Month < 1 || finReport.Month > 12 {
+			return domain.NewValidationError("month", i18n.FinReportMonthRange)
+		}
+		finReport.Quarter = (finReport.Month + 2) / 3
+	case domain.GranularityQuarter:
+		if finReport.Quarter > 4 || finReport.Quarter < 1 {
+			return domain.NewValidationError("quarter", i18n.FinReportQuarterRange)
+		}
+		finReport.Month = 0
+	case domain.GranularityYear:
+		finReport.Quarter, finReport.Month = 0, 0
+	default:
+		return domain.NewValidationError("granularity", i18n.FinReportGranularityUnknown, finReport.Granularity)
 	}
 
 	now := time.Now()
 	if finReport.Year > now.Year() {
-		s.logger.Infof("значение года не может быть больше текущего года")
-		return fmt.Errorf("значение года не может быть больше текущего года")
+		return domain.NewValidationError("year", i18n.FinReportYearInFuture)
 	}
 
-	if finReport.Year == now.Year() && finReport.Quarter > (int(now.Month()-1)/3) {
-		s.logger.Infof("нельзя добавить отчет за квартал, который еще не закончился")
-		return fmt.Errorf("нельзя добавить отчет за квартал, который еще не закончился")
+	_, last := finReport.Months()
+	if finReport.Year == now.Year() && last >= int(now.Month()) {
+		if finReport.Granularity == domain.GranularityQuarter {
+			return domain.NewValidationError("quarter", i18n.FinReportQuarterNotFinished)
+		}
+		return domain.NewValidationError(finReport.Granularity, i18n.FinReportPeriodNotFinished)
 	}
 
-	ctx := context.Background()
+	return nil
+}
+
+// checkOverlap проверяет, что период отчета не пересекается с другими отчетами компании,
+// например квартальный отчет с месячными отчетами того же квартала. Запись отчетов компании
+// за год блокируется до конца транзакции, иначе параллельная запись пройдет ту же проверку.
+func (s *Service) checkOverlap(ctx context.Context, finReport *domain.FinancialReport) error {
+	err := s.finRepo.LockCompanyYear(ctx, finReport.CompanyID, finReport.Year)
+	if err != nil {
+		return err
+	}
 
-	err = s.finRepo.Create(ctx, finReport)
+	year := &domain.Period{StartYear: finReport.Year, StartQuarter: 1, EndYear: finReport.Year, EndQuarter: 4}
+	stored, err := s.finRepo.GetByCompany(ctx, finReport.CompanyID, year)
 	if err != nil {
-		s.logger.Infof("добавление финансового отчета: %v", err)
-		return fmt.Errorf("добавление финансового отчета: %w", err)
+		return err
+	}
+
+	for i := range stored.Reports {
+		if stored.Reports[i].ID != finReport.ID && stored.Reports[i].Overlaps(finReport) {
+			return domain.NewError(domain.ErrConflict, i18n.FinReportOverlap)
+		}
 	}
 
 	return nil
 }
 
-func (s *Service) CreateByPeriod(finReportByPeriod *domain.FinancialReportByPeriod) (err error) {
-	for _, report := range finReportByPeriod.Reports {
-		err = s.Create(&report)
+func (s *Service) CreateByPeriod(ctx context.Context, finReportByPeriod *domain.FinancialReportByPeriod) (err error) {
+	for i := range finReportByPeriod.Reports {
+		err = s.validate(ctx, &finReportByPeriod.Reports[i])
 		if err != nil {
-			s.logger.Infof("добавление отчетов за период: %v", err)
-			return fmt.Errorf("добавление отчетов за период: %w", err)
+			s.logger.Infof("%v", err)
+			return err
+		}
+	}
+
+	// загруженные отчеты возвращаются без перевода в валюту отчетности, и их суммы складываются как есть
+	err = finReportByPeriod.CheckCurrency()
+	if err != nil {
+		s.logger.Infof("%v", err)
+		return err
+	}
+
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		for i := range finReportByPeriod.Reports {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			err := s.create(ctx, &finReportByPeriod.Reports[i])
+			if err != nil {
+				return i18n.Wrap(err, i18n.FinReportCreate)
+			}
 		}
+
+		return nil
+	})
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.FinReportCreateByPeriod, err)
+		return i18n.Wrap(err, i18n.FinReportCreateByPeriod)
 	}
 
 	return nil
 }
 
-func (s *Service) GetById(id uuid.UUID) (finReport *domain.FinancialReport, err error) {
-	ctx := context.Background()
-
+func (s *Service) GetById(ctx context.Context, id uuid.UUID) (finReport *domain.FinancialReport, err error) {
 	finReport, err = s.finRepo.GetById(ctx, id)
 	if err != nil {
-		s.logger.Infof("получение финансового отчета по id: %v", err)
-		return nil, fmt.Errorf("получение финансового отчета по id: %w", err)
+		s.logger.Infof("%v: %v", i18n.FinReportGet, err)
+		return nil, i18n.Wrap(err, i18n.FinReportGet)
+	}
+
+	return finReport, nil
+}
+
+func (s *Service) GetByCompany(ctx context.Context, companyId uuid.UUID, period *domain.Period) (
+	finReport *domain.FinancialReportByPeriod, err error) {
+	finReport, err = s.GetDetailedByCompany(ctx, companyId, period)
+	if err != nil {
+		return nil, err
 	}
+	finReport.Reports = domain.RollUp(finReport.Reports)
 
 	return finReport, nil
 }
 
-func (s *Service) GetByCompany(companyId uuid.UUID, period *domain.Period) (
+func (s *Service) GetDetailedByCompany(ctx context.Context, companyId uuid.UUID, period *domain.Period) (
 	finReport *domain.FinancialReportByPeriod, err error) {
-	if period.StartYear > period.EndYear ||
-		(period.StartYear == period.EndYear && period.StartQuarter > period.EndQuarter) {
-		s.logger.Infof("дата конца периода должна быть позже даты начала")
-		return nil, fmt.Errorf("дата конца периода должна быть позже даты начала")
+	finReport, err = s.GetUnconvertedByCompany(ctx, companyId, period)
+	if err != nil {
+		return nil, err
 	}
 
-	ctx := context.Background()
+	err = s.convert(ctx, finReport)
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.FinReportConvert, err)
+		return nil, i18n.Wrap(err, i18n.FinReportConvert)
+	}
+
+	return finReport, nil
+}
+
+func (s *Service) GetUnconvertedByCompany(ctx context.Context, companyId uuid.UUID, period *domain.Period) (
+	finReport *domain.FinancialReportByPeriod, err error) {
+	if !period.Valid() {
+		s.logger.Infof("%v", i18n.FinReportPeriodOrder)
+		return nil, domain.NewValidationError("period", i18n.FinReportPeriodOrder)
+	}
 
 	finReport, err = s.finRepo.GetByCompany(ctx, companyId, period)
 	if err != nil {
-		s.logger.Infof("получение финансового отчета по id компании: %v", err)
-		return nil, fmt.Errorf("получение финансового отчета по id компании: %w", err)
+		s.logger.Infof("%v: %v", i18n.FinReportGetByCompany, err)
+		return nil, i18n.Wrap(err, i18n.FinReportGetByCompany)
 	}
 
 	return finReport, nil
 }
 
-func (s *Service) Update(finReport *domain.FinancialReport) (err error) {
-	ctx := context.Background()
+func (s *Service) GetByCompanies(ctx context.Context, companyIds []uuid.UUID, period *domain.Period) (
+	finReports map[uuid.UUID]*domain.FinancialReportByPeriod, err error) {
+	if !period.Valid() {
+		s.logger.Infof("%v", i18n.FinReportPeriodOrder)
+		return nil, domain.NewValidationError("period", i18n.FinReportPeriodOrder)
+	}
 
-	err = s.finRepo.Update(ctx, finReport)
+	finReports, err = s.finRepo.GetByCompanies(ctx, companyIds, period)
 	if err != nil {
-		s.logger.Infof("обновление отчета: %v", err)
-		return fmt.Errorf("обновление отчета: %w", err)
+		s.logger.Infof("%v: %v", i18n.FinReportGetByCompanies, err)
+		return nil, i18n.Wrap(err, i18n.FinReportGetByCompanies)
 	}
 
-	return nil
+	for _, finReport := range finReports {
+		err = s.convert(ctx, finReport)
+		if err != nil {
+			s.logger.Infof("%v: %v", i18n.FinReportConvert, err)
+			return nil, i18n.Wrap(err, i18n.FinReportConvert)
+		}
+		finReport.Reports = domain.RollUp(finReport.Reports)
+	}
+
+	return finReports, nil
 }
 
-func (s *Service) DeleteById(id uuid.UUID) (err error) {
-	ctx := context.Background()
+func (s *Service) Update(ctx context.Context, finReport *domain.FinancialReport) (err error) {
+	err = s.validate(ctx, finReport)
+	if err != nil {
+		s.logger.Infof("%v", err)
+		return err
+	}
+
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		err := s.checkOverlap(ctx, finReport)
+		if err != nil {
+			return err
+		}
+
+		return s.finRepo.Update(ctx, finReport)
+	})
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.FinReportUpdate, err)
+		return i18n.Wrap(err, i18n.FinReportUpdate)
+	}
+
+	return nil
+}
 
+func (s *Service) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
 	err = s.finRepo.DeleteById(ctx, id)
 	if err != nil {
-		s.logger.Infof("удаление отчета по id: %v", err)
-		return fmt.Errorf("удаление отчета по id: %w", err)
+		s.logger.Infof("%v: %v", i18n.FinReportDelete, err)
+		return i18n.Wrap(err, i18n.FinReportDelete)
 	}
 
 	return nil
@@ -6,10 +6,14 @@ import (
 	"fmt"
 	"github.com/dlankinl/bmstu-ppo-bl/domain"
 	"github.com/dlankinl/bmstu-ppo-bl/mocks"
+	"github.com/dlankinl/bmstu-ppo-bl/repository/memory"
+	"github.com/dlankinl/bmstu-ppo-bl/services/exchange_rate"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"math/big"
 	"testing"
+	"time"
 )
 
 func TestFinReportService_Create(t *testing.T) {
@@ -18,8 +22,8 @@ func TestFinReportService_Create(t *testing.T) {
 
 	finRepo := mocks.NewMockIFinancialReportRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
-	svc := NewService(finRepo, logger)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	svc := NewService(finRepo, newTxManager(ctrl), newRates(t), domain.DefaultCurrency, logger)
 
 	testCases := []struct {
 		name       string
@@ -31,22 +35,62 @@ func TestFinReportService_Create(t *testing.T) {
 		{
 			name: "успешное добавление",
 			data: &domain.FinancialReport{
-				CompanyID: uuid.UUID{1},
-				Revenue:   1,
-				Costs:     1,
-				Year:      1,
-				Quarter:   1,
+				CompanyID:   uuid.UUID{1},
+				Revenue:     rub(1),
+				Costs:       rub(1),
+				Year:        1,
+				Granularity: domain.GranularityQuarter,
+				Quarter:     1,
+			},
+			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
+				finRepo.EXPECT().
+					LockCompanyYear(context.Background(), uuid.UUID{1}, gomock.Any()).
+					Return(nil)
+				finRepo.EXPECT().
+					GetByCompany(context.Background(), uuid.UUID{1}, gomock.Any()).
+					Return(&domain.FinancialReportByPeriod{}, nil)
+				finRepo.EXPECT().
+					Create(
+						context.Background(),
+						&domain.FinancialReport{
+							CompanyID:   uuid.UUID{1},
+							Revenue:     rub(1),
+							Costs:       rub(1),
+							Year:        1,
+							Granularity: domain.GranularityQuarter,
+							Quarter:     1,
+						},
+					).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "добавление отчета в валюте с курсами",
+			data: &domain.FinancialReport{
+				CompanyID:   uuid.UUID{1},
+				Revenue:     domain.NewMoney(1, "USD"),
+				Costs:       domain.NewMoney(1, "USD"),
+				Year:        1,
+				Granularity: domain.GranularityQuarter,
+				Quarter:     1,
 			},
 			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
+				finRepo.EXPECT().
+					LockCompanyYear(context.Background(), uuid.UUID{1}, gomock.Any()).
+					Return(nil)
+				finRepo.EXPECT().
+					GetByCompany(context.Background(), uuid.UUID{1}, gomock.Any()).
+					Return(&domain.FinancialReportByPeriod{}, nil)
 				finRepo.EXPECT().
 					Create(
 						context.Background(),
 						&domain.FinancialReport{
-							CompanyID: uuid.UUID{1},
-							Revenue:   1,
-							Costs:     1,
-							Year:      1,
-							Quarter:   1,
+							CompanyID:   uuid.UUID{1},
+							Revenue:     domain.NewMoney(1, "USD"),
+							Costs:       domain.NewMoney(1, "USD"),
+							Year:        1,
+							Granularity: domain.GranularityQuarter,
+							Quarter:     1,
 						},
 					).Return(nil)
 			},
@@ -55,22 +99,24 @@ func TestFinReportService_Create(t *testing.T) {
 		{
 			name: "отрицательная выручка",
 			data: &domain.FinancialReport{
-				CompanyID: uuid.UUID{1},
-				Revenue:   -1,
-				Costs:     1,
-				Year:      1,
-				Quarter:   1,
+				CompanyID:   uuid.UUID{1},
+				Revenue:     rub(-1),
+				Costs:       rub(1),
+				Year:        1,
+				Granularity: domain.GranularityQuarter,
+				Quarter:     1,
 			},
 			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
 				finRepo.EXPECT().
 					Create(
 						context.Background(),
 						&domain.FinancialReport{
-							CompanyID: uuid.UUID{1},
-							Revenue:   -1,
-							Costs:     1,
-							Year:      1,
-							Quarter:   1,
+							CompanyID:   uuid.UUID{1},
+							Revenue:     rub(-1),
+							Costs:       rub(1),
+							Year:        1,
+							Granularity: domain.GranularityQuarter,
+							Quarter:     1,
 						},
 					).
 					Return(nil).
@@ -82,22 +128,24 @@ func TestFinReportService_Create(t *testing.T) {
 		{
 			name: "отрицательные расходы",
 			data: &domain.FinancialReport{
-				CompanyID: uuid.UUID{1},
-				Revenue:   1,
-				Costs:     -1,
-				Year:      1,
-				Quarter:   1,
+				CompanyID:   uuid.UUID{1},
+				Revenue:     rub(1),
+				Costs:       rub(-1),
+				Year:        1,
+				Granularity: domain.GranularityQuarter,
+				Quarter:     1,
 			},
 			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
 				finRepo.EXPECT().
 					Create(
 						context.Background(),
 						&domain.FinancialReport{
-							CompanyID: uuid.UUID{1},
-							Revenue:   1,
-							Costs:     -1,
-							Year:      1,
-							Quarter:   1,
+							CompanyID:   uuid.UUID{1},
+							Revenue:     rub(1),
+							Costs:       rub(-1),
+							Year:        1,
+							Granularity: domain.GranularityQuarter,
+							Quarter:     1,
 						},
 					).
 					Return(nil).
@@ -106,25 +154,53 @@ func TestFinReportService_Create(t *testing.T) {
 			wantErr: true,
 			errStr:  errors.New("расходы не могут быть отрицательными"),
 		},
+		{
+			name: "выручка и расходы в разных валютах",
+			data: &domain.FinancialReport{
+				CompanyID:   uuid.UUID{1},
+				Revenue:     rub(1),
+				Costs:       domain.NewMoney(1, "USD"),
+				Year:        1,
+				Granularity: domain.GranularityQuarter,
+				Quarter:     1,
+			},
+			wantErr: true,
+			errStr:  errors.New("выручка и расходы должны быть указаны в одной валюте"),
+		},
+		{
+			name: "неподдерживаемая валюта",
+			data: &domain.FinancialReport{
+				CompanyID:   uuid.UUID{1},
+				Revenue:     domain.NewMoney(1, "GBP"),
+				Costs:       domain.NewMoney(1, "GBP"),
+				Year:        1,
+				Granularity: domain.GranularityQuarter,
+				Quarter:     1,
+			},
+			wantErr: true,
+			errStr:  errors.New("валюта GBP не поддерживается"),
+		},
 		{
 			name: "некорректное значение квартала",
 			data: &domain.FinancialReport{
-				CompanyID: uuid.UUID{1},
-				Revenue:   1,
-				Costs:     1,
-				Year:      1,
-				Quarter:   5,
+				CompanyID:   uuid.UUID{1},
+				Revenue:     rub(1),
+				Costs:       rub(1),
+				Year:        1,
+				Granularity: domain.GranularityQuarter,
+				Quarter:     5,
 			},
 			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
 				finRepo.EXPECT().
 					Create(
 						context.Background(),
 						&domain.FinancialReport{
-							CompanyID: uuid.UUID{1},
-							Revenue:   1,
-							Costs:     1,
-							Year:      1,
-							Quarter:   5,
+							CompanyID:   uuid.UUID{1},
+							Revenue:     rub(1),
+							Costs:       rub(1),
+							Year:        1,
+							Granularity: domain.GranularityQuarter,
+							Quarter:     5,
 						},
 					).
 					Return(nil).
@@ -136,22 +212,24 @@ func TestFinReportService_Create(t *testing.T) {
 		{
 			name: "указан год, больший текущего",
 			data: &domain.FinancialReport{
-				CompanyID: uuid.UUID{1},
-				Revenue:   1,
-				Costs:     1,
-				Year:      2025,
-				Quarter:   1,
+				CompanyID:   uuid.UUID{1},
+				Revenue:     rub(1),
+				Costs:       rub(1),
+				Year:        time.Now().Year() + 1,
+				Granularity: domain.GranularityQuarter,
+				Quarter:     1,
 			},
 			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
 				finRepo.EXPECT().
 					Create(
 						context.Background(),
 						&domain.FinancialReport{
-							CompanyID: uuid.UUID{1},
-							Revenue:   1,
-							Costs:     1,
-							Year:      2025,
-							Quarter:   1,
+							CompanyID:   uuid.UUID{1},
+							Revenue:     rub(1),
+							Costs:       rub(1),
+							Year:        time.Now().Year() + 1,
+							Granularity: domain.GranularityQuarter,
+							Quarter:     1,
 						},
 					).
 					Return(nil).
@@ -163,22 +241,24 @@ func TestFinReportService_Create(t *testing.T) {
 		{
 			name: "указан квартал, который еще не закончен",
 			data: &domain.FinancialReport{
-				CompanyID: uuid.UUID{1},
-				Revenue:   1,
-				Costs:     1,
-				Year:      2024,
-				Quarter:   2,
+				CompanyID:   uuid.UUID{1},
+				Revenue:     rub(1),
+				Costs:       rub(1),
+				Year:        time.Now().Year(),
+				Granularity: domain.GranularityQuarter,
+				Quarter:     4,
 			},
 			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
 				finRepo.EXPECT().
 					Create(
 						context.Background(),
 						&domain.FinancialReport{
-							CompanyID: uuid.UUID{1},
-							Revenue:   1,
-							Costs:     1,
-							Year:      2024,
-							Quarter:   2,
+							CompanyID:   uuid.UUID{1},
+							Revenue:     rub(1),
+							Costs:       rub(1),
+							Year:        time.Now().Year(),
+							Granularity: domain.GranularityQuarter,
+							Quarter:     4,
 						},
 					).
 					Return(nil).
@@ -187,25 +267,188 @@ func TestFinReportService_Create(t *testing.T) {
 			wantErr: true,
 			errStr:  errors.New("нельзя добавить отчет за квартал, который еще не закончился"),
 		},
+		{
+			name: "месячный отчет относится к кварталу своего месяца",
+			data: &domain.FinancialReport{
+				CompanyID:   uuid.UUID{1},
+				Revenue:     rub(1),
+				Costs:       rub(1),
+				Granularity: domain.GranularityMonth,
+				Year:        2023,
+				Month:       5,
+			},
+			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
+				finRepo.EXPECT().
+					LockCompanyYear(context.Background(), uuid.UUID{1}, gomock.Any()).
+					Return(nil)
+				finRepo.EXPECT().
+					GetByCompany(context.Background(), uuid.UUID{1}, gomock.Any()).
+					Return(&domain.FinancialReportByPeriod{
+						Reports: []domain.FinancialReport{
+							{ID: uuid.UUID{2}, CompanyID: uuid.UUID{1}, Granularity: domain.GranularityMonth, Year: 2023, Quarter: 2, Month: 4},
+							{ID: uuid.UUID{3}, CompanyID: uuid.UUID{1}, Granularity: domain.GranularityQuarter, Year: 2023, Quarter: 1},
+						},
+					}, nil)
+				finRepo.EXPECT().
+					Create(
+						context.Background(),
+						&domain.FinancialReport{
+							CompanyID:   uuid.UUID{1},
+							Revenue:     rub(1),
+							Costs:       rub(1),
+							Granularity: domain.GranularityMonth,
+							Year:        2023,
+							Quarter:     2,
+							Month:       5,
+						},
+					).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "годовой отчет",
+			data: &domain.FinancialReport{
+				CompanyID:   uuid.UUID{1},
+				Revenue:     rub(1),
+				Costs:       rub(1),
+				Granularity: domain.GranularityYear,
+				Year:        2022,
+				Quarter:     3,
+			},
+			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
+				finRepo.EXPECT().
+					LockCompanyYear(context.Background(), uuid.UUID{1}, gomock.Any()).
+					Return(nil)
+				finRepo.EXPECT().
+					GetByCompany(context.Background(), uuid.UUID{1}, gomock.Any()).
+					Return(&domain.FinancialReportByPeriod{}, nil)
+				finRepo.EXPECT().
+					Create(
+						context.Background(),
+						&domain.FinancialReport{
+							CompanyID:   uuid.UUID{1},
+							Revenue:     rub(1),
+							Costs:       rub(1),
+							Granularity: domain.GranularityYear,
+							Year:        2022,
+						},
+					).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "месячный отчет пересекается с квартальным",
+			data: &domain.FinancialReport{
+				CompanyID:   uuid.UUID{1},
+				Revenue:     rub(1),
+				Costs:       rub(1),
+				Granularity: domain.GranularityMonth,
+				Year:        2023,
+				Month:       1,
+			},
+			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
+				finRepo.EXPECT().
+					LockCompanyYear(context.Background(), uuid.UUID{1}, gomock.Any()).
+					Return(nil)
+				finRepo.EXPECT().
+					GetByCompany(context.Background(), uuid.UUID{1}, gomock.Any()).
+					Return(&domain.FinancialReportByPeriod{
+						Reports: []domain.FinancialReport{
+							{ID: uuid.UUID{2}, CompanyID: uuid.UUID{1}, Granularity: domain.GranularityQuarter, Year: 2023, Quarter: 1},
+						},
+					}, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("добавление финансового отчета: период отчета пересекается с уже добавленным отчетом компании"),
+		},
+		{
+			name: "годовой отчет пересекается с месячным",
+			data: &domain.FinancialReport{
+				CompanyID:   uuid.UUID{1},
+				Revenue:     rub(1),
+				Costs:       rub(1),
+				Granularity: domain.GranularityYear,
+				Year:        2023,
+			},
+			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
+				finRepo.EXPECT().
+					LockCompanyYear(context.Background(), uuid.UUID{1}, gomock.Any()).
+					Return(nil)
+				finRepo.EXPECT().
+					GetByCompany(context.Background(), uuid.UUID{1}, gomock.Any()).
+					Return(&domain.FinancialReportByPeriod{
+						Reports: []domain.FinancialReport{
+							{ID: uuid.UUID{2}, CompanyID: uuid.UUID{1}, Granularity: domain.GranularityMonth, Year: 2023, Quarter: 4, Month: 12},
+						},
+					}, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("добавление финансового отчета: период отчета пересекается с уже добавленным отчетом компании"),
+		},
+		{
+			name: "некорректное значение месяца",
+			data: &domain.FinancialReport{
+				CompanyID:   uuid.UUID{1},
+				Revenue:     rub(1),
+				Costs:       rub(1),
+				Granularity: domain.GranularityMonth,
+				Year:        2023,
+				Month:       13,
+			},
+			wantErr: true,
+			errStr:  errors.New("значение месяца должно находиться в отрезке от 1 до 12"),
+		},
+		{
+			name: "неизвестная периодичность",
+			data: &domain.FinancialReport{
+				CompanyID:   uuid.UUID{1},
+				Revenue:     rub(1),
+				Costs:       rub(1),
+				Granularity: "week",
+				Year:        2023,
+			},
+			wantErr: true,
+			errStr:  errors.New("неизвестная периодичность отчета week"),
+		},
+		{
+			name: "указан год, который еще не закончен",
+			data: &domain.FinancialReport{
+				CompanyID:   uuid.UUID{1},
+				Revenue:     rub(1),
+				Costs:       rub(1),
+				Granularity: domain.GranularityYear,
+				Year:        time.Now().Year(),
+			},
+			wantErr: true,
+			errStr:  errors.New("нельзя добавить отчет за период, который еще не закончился"),
+		},
 		{
 			name: "ошибка выполнения запроса в репозитории",
 			data: &domain.FinancialReport{
-				CompanyID: uuid.UUID{1},
-				Revenue:   1,
-				Costs:     1,
-				Year:      2023,
-				Quarter:   1,
+				CompanyID:   uuid.UUID{1},
+				Revenue:     rub(1),
+				Costs:       rub(1),
+				Year:        2023,
+				Granularity: domain.GranularityQuarter,
+				Quarter:     1,
 			},
 			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
+				finRepo.EXPECT().
+					LockCompanyYear(context.Background(), uuid.UUID{1}, gomock.Any()).
+					Return(nil)
+				finRepo.EXPECT().
+					GetByCompany(context.Background(), uuid.UUID{1}, gomock.Any()).
+					Return(&domain.FinancialReportByPeriod{}, nil)
 				finRepo.EXPECT().
 					Create(
 						context.Background(),
 						&domain.FinancialReport{
-							CompanyID: uuid.UUID{1},
-							Revenue:   1,
-							Costs:     1,
-							Year:      2023,
-							Quarter:   1,
+							CompanyID:   uuid.UUID{1},
+							Revenue:     rub(1),
+							Costs:       rub(1),
+							Year:        2023,
+							Granularity: domain.GranularityQuarter,
+							Quarter:     1,
 						},
 					).
 					Return(fmt.Errorf("sql error")).
@@ -214,6 +457,24 @@ func TestFinReportService_Create(t *testing.T) {
 			wantErr: true,
 			errStr:  errors.New("добавление финансового отчета: sql error"),
 		},
+		{
+			name: "ошибка блокировки отчетов компании за год",
+			data: &domain.FinancialReport{
+				CompanyID:   uuid.UUID{1},
+				Revenue:     rub(1),
+				Costs:       rub(1),
+				Year:        2023,
+				Granularity: domain.GranularityQuarter,
+				Quarter:     1,
+			},
+			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
+				finRepo.EXPECT().
+					LockCompanyYear(context.Background(), uuid.UUID{1}, 2023).
+					Return(fmt.Errorf("lock timeout"))
+			},
+			wantErr: true,
+			errStr:  errors.New("добавление финансового отчета: lock timeout"),
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -221,7 +482,7 @@ func TestFinReportService_Create(t *testing.T) {
 				tc.beforeTest(*finRepo)
 			}
 
-			err := svc.Create(tc.data)
+			err := svc.Create(context.Background(), tc.data)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -238,8 +499,8 @@ func TestFinReportService_DeleteById(t *testing.T) {
 
 	finRepo := mocks.NewMockIFinancialReportRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
-	svc := NewService(finRepo, logger)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	svc := NewService(finRepo, newTxManager(ctrl), newRates(t), domain.DefaultCurrency, logger)
 
 	curUuid := uuid.New()
 
@@ -278,7 +539,7 @@ func TestFinReportService_DeleteById(t *testing.T) {
 				tc.beforeTest(*finRepo)
 			}
 
-			err := svc.DeleteById(tc.id)
+			err := svc.DeleteById(context.Background(), tc.id)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -295,8 +556,8 @@ func TestFinReportService_GetByCompany(t *testing.T) {
 
 	finRepo := mocks.NewMockIFinancialReportRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
-	svc := NewService(finRepo, logger)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	svc := NewService(finRepo, newTxManager(ctrl), newRates(t), domain.DefaultCurrency, logger)
 
 	testCases := []struct {
 		name       string
@@ -334,78 +595,78 @@ func TestFinReportService_GetByCompany(t *testing.T) {
 								ID:      uuid.UUID{1},
 								Year:    2021,
 								Quarter: 2,
-								Revenue: 1432523,
-								Costs:   75423,
+								Revenue: rub(1432523),
+								Costs:   rub(75423),
 							},
 							{
 								ID:      uuid.UUID{2},
 								Year:    2021,
 								Quarter: 3,
-								Revenue: 7435235,
-								Costs:   125654,
+								Revenue: rub(7435235),
+								Costs:   rub(125654),
 							},
 							{
 								ID:      uuid.UUID{3},
 								Year:    2021,
 								Quarter: 4,
-								Revenue: 65742,
-								Costs:   7845634,
+								Revenue: rub(65742),
+								Costs:   rub(7845634),
 							},
 							{
 								ID:      uuid.UUID{4},
 								Year:    2022,
 								Quarter: 1,
-								Revenue: 43635325,
-								Costs:   12362332,
+								Revenue: rub(43635325),
+								Costs:   rub(12362332),
 							},
 							{
 								ID:      uuid.UUID{5},
 								Year:    2022,
 								Quarter: 2,
-								Revenue: 50934123,
-								Costs:   13543623,
+								Revenue: rub(50934123),
+								Costs:   rub(13543623),
 							},
 							{
 								ID:      uuid.UUID{6},
 								Year:    2022,
 								Quarter: 3,
-								Revenue: 78902453,
-								Costs:   15326443,
+								Revenue: rub(78902453),
+								Costs:   rub(15326443),
 							},
 							{
 								ID:      uuid.UUID{7},
 								Year:    2022,
 								Quarter: 4,
-								Revenue: 64352357,
-								Costs:   23534252,
+								Revenue: rub(64352357),
+								Costs:   rub(23534252),
 							}, // 173 057 608 => 34 611 521.6; 237 824 258 => 14.5534025
 							{
 								ID:      uuid.UUID{8},
 								Year:    2023,
 								Quarter: 1,
-								Revenue: 32532513,
-								Costs:   5436438,
+								Revenue: rub(32532513),
+								Costs:   rub(5436438),
 							},
 							{
 								ID:      uuid.UUID{9},
 								Year:    2023,
 								Quarter: 2,
-								Revenue: 6743634,
-								Costs:   9876967,
+								Revenue: rub(6743634),
+								Costs:   rub(9876967),
 							},
 							{
 								ID:      uuid.UUID{10},
 								Year:    2023,
 								Quarter: 3,
-								Revenue: 46754124,
-								Costs:   24367653,
+								Revenue: rub(46754124),
+								Costs:   rub(24367653),
 							},
 							{
 								ID:      uuid.UUID{11},
 								Year:    2023,
 								Quarter: 4,
-								Revenue: 14385253,
-								Costs:   7546424,
+								Revenue: rub(14385253),
+								Costs:   rub(7546424),
 							},
 						},
 						Period: &domain.Period{
@@ -422,78 +683,35 @@ func TestFinReportService_GetByCompany(t *testing.T) {
 						ID:      uuid.UUID{1},
 						Year:    2021,
 						Quarter: 2,
-						Revenue: 1432523,
-						Costs:   75423,
+						Revenue: rub(1432523),
+						Costs:   rub(75423),
 					},
 					{
 						ID:      uuid.UUID{2},
 						Year:    2021,
 						Quarter: 3,
-						Revenue: 7435235,
-						Costs:   125654,
+						Revenue: rub(7435235),
+						Costs:   rub(125654),
 					},
 					{
 						ID:      uuid.UUID{3},
 						Year:    2021,
 						Quarter: 4,
-						Revenue: 65742,
-						Costs:   7845634,
-					},
-					{
-						ID:      uuid.UUID{4},
-						Year:    2022,
-						Quarter: 1,
-						Revenue: 43635325,
-						Costs:   12362332,
+						Revenue: rub(65742),
+						Costs:   rub(7845634),
 					},
+					// кварталы полных лет сворачиваются в годовые отчеты
 					{
-						ID:      uuid.UUID{5},
-						Year:    2022,
-						Quarter: 2,
-						Revenue: 50934123,
-						Costs:   13543623,
+						Granularity: domain.GranularityYear,
+						Year:        2022,
+						Revenue:     rub(237824258),
+						Costs:       rub(64766650),
 					},
 					{
-						ID:      uuid.UUID{6},
-						Year:    2022,
-						Quarter: 3,
-						Revenue: 78902453,
-						Costs:   15326443,
-					},
-					{
-						ID:      uuid.UUID{7},
-						Year:    2022,
-						Quarter: 4,
-						Revenue: 64352357,
-						Costs:   23534252,
-					}, // 173 057 608 => 34 611 521.6; 237 824 258 => 14.5534025
-					{
-						ID:      uuid.UUID{8},
-						Year:    2023,
-						Quarter: 1,
-						Revenue: 32532513,
-						Costs:   5436438,
-					},
-					{
-						ID:      uuid.UUID{9},
-						Year:    2023,
-						Quarter: 2,
-						Revenue: 6743634,
-						Costs:   9876967,
-					},
-					{
-						ID:      uuid.UUID{10},
-						Year:    2023,
-						Quarter: 3,
-						Revenue: 46754124,
-						Costs:   24367653,
-					},
-					{
-						ID:      uuid.UUID{11},
-						Year:    2023,
-						Quarter: 4,
-						Revenue: 14385253,
-						Costs:   7546424,
+						Granularity: domain.GranularityYear,
+						Year:        2023,
+						Revenue:     rub(100415524),
+						Costs:       rub(47227482),
 					},
 				},
 				Period: &domain.Period{
@@ -559,6 +777,73 @@ func TestFinReportService_GetByCompany(t *testing.T) {
 			wantErr: true,
 			errStr:  errors.New("дата конца периода должна быть позже даты начала"),
 		},
+		{
+			name:   "отчеты в валюте переводятся по курсу своего квартала",
+			id:     uuid.UUID{2},
+			period: &domain.Period{StartYear: 2023, EndYear: 2023, StartQuarter: 1, EndQuarter: 2},
+			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
+				finRepo.EXPECT().
+					GetByCompany(context.Background(), uuid.UUID{2}, &domain.Period{StartYear: 2023, EndYear: 2023, StartQuarter: 1, EndQuarter: 2}).
+					Return(&domain.FinancialReportByPeriod{
+						Reports: []domain.FinancialReport{
+							{ID: uuid.UUID{1}, Year: 2023, Quarter: 1, Revenue: domain.NewMoney(100, "USD"), Costs: domain.NewMoney(40, "USD")},
+							{ID: uuid.UUID{2}, Year: 2023, Quarter: 2, Revenue: domain.NewMoney(50, "USD"), Costs: domain.Money{Amount: 1, Currency: "USD"}},
+							{ID: uuid.UUID{3}, Year: 2023, Quarter: 2, Revenue: rub(1000), Costs: rub(500)},
+						},
+						Period: &domain.Period{StartYear: 2023, EndYear: 2023, StartQuarter: 1, EndQuarter: 2},
+					}, nil)
+			},
+			expected: &domain.FinancialReportByPeriod{
+				Reports: []domain.FinancialReport{
+					{ID: uuid.UUID{1}, Year: 2023, Quarter: 1, Revenue: rub(7500), Costs: rub(3000)},
+					{ID: uuid.UUID{2}, Year: 2023, Quarter: 2, Revenue: rub(4000), Costs: domain.Money{Amount: 80, Currency: "RUB"}},
+					{ID: uuid.UUID{3}, Year: 2023, Quarter: 2, Revenue: rub(1000), Costs: rub(500)},
+				},
+				Period: &domain.Period{StartYear: 2023, EndYear: 2023, StartQuarter: 1, EndQuarter: 2},
+			},
+		},
+		{
+			name:   "месяцы полного квартала сворачиваются в квартал",
+			id:     uuid.UUID{2},
+			period: &domain.Period{StartYear: 2023, EndYear: 2023, StartQuarter: 1, EndQuarter: 2},
+			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
+				finRepo.EXPECT().
+					GetByCompany(context.Background(), uuid.UUID{2}, &domain.Period{StartYear: 2023, EndYear: 2023, StartQuarter: 1, EndQuarter: 2}).
+					Return(&domain.FinancialReportByPeriod{
+						Reports: []domain.FinancialReport{
+							{ID: uuid.UUID{1}, CompanyID: uuid.UUID{2}, Granularity: domain.GranularityMonth, Year: 2023, Quarter: 1, Month: 1, Revenue: domain.NewMoney(10, "USD"), Costs: domain.NewMoney(1, "USD")},
+							{ID: uuid.UUID{2}, CompanyID: uuid.UUID{2}, Granularity: domain.GranularityMonth, Year: 2023, Quarter: 1, Month: 2, Revenue: rub(100), Costs: rub(10)},
+							{ID: uuid.UUID{3}, CompanyID: uuid.UUID{2}, Granularity: domain.GranularityMonth, Year: 2023, Quarter: 1, Month: 3, Revenue: rub(100), Costs: rub(10)},
+							{ID: uuid.UUID{4}, CompanyID: uuid.UUID{2}, Granularity: domain.GranularityMonth, Year: 2023, Quarter: 2, Month: 4, Revenue: rub(100), Costs: rub(10)},
+						},
+						Period: &domain.Period{StartYear: 2023, EndYear: 2023, StartQuarter: 1, EndQuarter: 2},
+					}, nil)
+			},
+			expected: &domain.FinancialReportByPeriod{
+				Reports: []domain.FinancialReport{
+					{CompanyID: uuid.UUID{2}, Granularity: domain.GranularityQuarter, Year: 2023, Quarter: 1, Revenue: rub(950), Costs: rub(95)},
+					{ID: uuid.UUID{4}, CompanyID: uuid.UUID{2}, Granularity: domain.GranularityMonth, Year: 2023, Quarter: 2, Month: 4, Revenue: rub(100), Costs: rub(10)},
+				},
+				Period: &domain.Period{StartYear: 2023, EndYear: 2023, StartQuarter: 1, EndQuarter: 2},
+			},
+		},
+		{
+			name:   "нет курса валюты за квартал",
+			id:     uuid.UUID{2},
+			period: &domain.Period{StartYear: 2022, EndYear: 2022, StartQuarter: 1, EndQuarter: 1},
+			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
+				finRepo.EXPECT().
+					GetByCompany(context.Background(), uuid.UUID{2}, &domain.Period{StartYear: 2022, EndYear: 2022, StartQuarter: 1, EndQuarter: 1}).
+					Return(&domain.FinancialReportByPeriod{
+						Reports: []domain.FinancialReport{
+							{ID: uuid.UUID{1}, Year: 2022, Quarter: 1, Revenue: domain.NewMoney(100, "USD"), Costs: domain.NewMoney(40, "USD")},
+						},
+						Period: &domain.Period{StartYear: 2022, EndYear: 2022, StartQuarter: 1, EndQuarter: 1},
+					}, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("перевод отчетов в валюту отчетности: нет курса USD за 1 квартал 2022 года"),
+		},
 		{
 			name: "ошибка получения данных в репозитории",
 			id:   uuid.UUID{1},
@@ -592,7 +877,7 @@ func TestFinReportService_GetByCompany(t *testing.T) {
 				tc.beforeTest(*finRepo)
 			}
 
-			report, err := svc.GetByCompany(tc.id, tc.period)
+			report, err := svc.GetByCompany(context.Background(), tc.id, tc.period)
 			//fmt.Println(report.Period)
 
 			if tc.wantErr {
@@ -601,6 +886,7 @@ func TestFinReportService_GetByCompany(t *testing.T) {
 				require.Nil(t, err)
 				require.Equal(t, report.Reports, tc.expected.Reports)
 				require.Equal(t, report.Period, tc.expected.Period)
+				require.Equal(t, domain.DefaultCurrency, report.Currency)
 				//require.InEpsilon(t, report.Taxes, tc.expected.Taxes, 1e-7)
 				//require.InEpsilon(t, report.TaxLoad, tc.expected.TaxLoad, 1e-7)
 			}
@@ -614,8 +900,8 @@ func TestFinReportService_GetById(t *testing.T) {
 
 	repo := mocks.NewMockIFinancialReportRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
-	svc := NewService(repo, logger)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	svc := NewService(repo, newTxManager(ctrl), newRates(t), domain.DefaultCurrency, logger)
 
 	testCases := []struct {
 		name       string
@@ -637,8 +923,8 @@ func TestFinReportService_GetById(t *testing.T) {
 					Return(&domain.FinancialReport{
 						ID:        uuid.UUID{1},
 						CompanyID: uuid.UUID{1},
-						Revenue:   1,
-						Costs:     1,
+						Revenue:   rub(1),
+						Costs:     rub(1),
 						Year:      1,
 						Quarter:   1,
 					}, nil)
@@ -646,8 +932,8 @@ func TestFinReportService_GetById(t *testing.T) {
 			expected: &domain.FinancialReport{
 				ID:        uuid.UUID{1},
 				CompanyID: uuid.UUID{1},
-				Revenue:   1,
-				Costs:     1,
+				Revenue:   rub(1),
+				Costs:     rub(1),
 				Year:      1,
 				Quarter:   1,
 			},
@@ -674,7 +960,7 @@ func TestFinReportService_GetById(t *testing.T) {
 				tc.beforeTest(*repo)
 			}
 
-			report, err := svc.GetById(tc.id)
+			report, err := svc.GetById(context.Background(), tc.id)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -692,8 +978,8 @@ func TestFinReportService_Update(t *testing.T) {
 
 	repo := mocks.NewMockIFinancialReportRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
-	svc := NewService(repo, logger)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	svc := NewService(repo, newTxManager(ctrl), newRates(t), domain.DefaultCurrency, logger)
 
 	testCases := []struct {
 		name       string
@@ -706,15 +992,32 @@ func TestFinReportService_Update(t *testing.T) {
 			name: "успешное обновление",
 			report: &domain.FinancialReport{
 				ID:      uuid.UUID{1},
-				Revenue: 2,
+				Revenue: rub(2),
+				Costs:   rub(1),
+				Year:    2023,
+				Quarter: 1,
 			},
 			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
+				finRepo.EXPECT().
+					LockCompanyYear(context.Background(), uuid.Nil, gomock.Any()).
+					Return(nil)
+				finRepo.EXPECT().
+					GetByCompany(context.Background(), uuid.Nil, gomock.Any()).
+					Return(&domain.FinancialReportByPeriod{
+						Reports: []domain.FinancialReport{
+							{ID: uuid.UUID{1}, Granularity: domain.GranularityQuarter, Year: 2023, Quarter: 1},
+						},
+					}, nil)
 				finRepo.EXPECT().
 					Update(
 						context.Background(),
 						&domain.FinancialReport{
-							ID:      uuid.UUID{1},
-							Revenue: 2,
+							ID:          uuid.UUID{1},
+							Revenue:     rub(2),
+							Costs:       rub(1),
+							Granularity: domain.GranularityQuarter,
+							Year:        2023,
+							Quarter:     1,
 						},
 					).Return(nil)
 			},
@@ -724,21 +1027,97 @@ func TestFinReportService_Update(t *testing.T) {
 			name: "ошибка выполнения запроса в репозитории",
 			report: &domain.FinancialReport{
 				ID:      uuid.UUID{1},
-				Revenue: 2,
+				Revenue: rub(2),
+				Costs:   rub(1),
+				Year:    2023,
+				Quarter: 1,
 			},
 			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
+				finRepo.EXPECT().
+					LockCompanyYear(context.Background(), uuid.Nil, gomock.Any()).
+					Return(nil)
+				finRepo.EXPECT().
+					GetByCompany(context.Background(), uuid.Nil, gomock.Any()).
+					Return(&domain.FinancialReportByPeriod{
+						Reports: []domain.FinancialReport{
+							{ID: uuid.UUID{1}, Granularity: domain.GranularityQuarter, Year: 2023, Quarter: 1},
+						},
+					}, nil)
 				finRepo.EXPECT().
 					Update(
 						context.Background(),
 						&domain.FinancialReport{
-							ID:      uuid.UUID{1},
-							Revenue: 2,
+							ID:          uuid.UUID{1},
+							Revenue:     rub(2),
+							Costs:       rub(1),
+							Granularity: domain.GranularityQuarter,
+							Year:        2023,
+							Quarter:     1,
 						},
 					).Return(fmt.Errorf("sql error"))
 			},
 			wantErr: true,
 			errStr:  errors.New("обновление отчета: sql error"),
 		},
+		{
+			name: "новый период пересекается с другим отчетом",
+			report: &domain.FinancialReport{
+				ID:          uuid.UUID{1},
+				Revenue:     rub(2),
+				Costs:       rub(1),
+				Granularity: domain.GranularityYear,
+				Year:        2023,
+			},
+			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
+				finRepo.EXPECT().
+					LockCompanyYear(context.Background(), uuid.Nil, gomock.Any()).
+					Return(nil)
+				finRepo.EXPECT().
+					GetByCompany(context.Background(), uuid.Nil, gomock.Any()).
+					Return(&domain.FinancialReportByPeriod{
+						Reports: []domain.FinancialReport{
+							{ID: uuid.UUID{1}, Granularity: domain.GranularityQuarter, Year: 2023, Quarter: 1},
+							{ID: uuid.UUID{2}, Granularity: domain.GranularityQuarter, Year: 2023, Quarter: 2},
+						},
+					}, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("обновление отчета: период отчета пересекается с уже добавленным отчетом компании"),
+		},
+		{
+			name: "выручка и расходы в разных валютах",
+			report: &domain.FinancialReport{
+				ID:      uuid.UUID{1},
+				Revenue: rub(2),
+				Costs:   domain.NewMoney(1, "USD"),
+			},
+			wantErr: true,
+			errStr:  errors.New("выручка и расходы должны быть указаны в одной валюте"),
+		},
+		{
+			name: "отрицательные расходы",
+			report: &domain.FinancialReport{
+				ID:      uuid.UUID{1},
+				Revenue: rub(2),
+				Costs:   rub(-1),
+				Year:    2023,
+				Quarter: 1,
+			},
+			wantErr: true,
+			errStr:  errors.New("расходы не могут быть отрицательными"),
+		},
+		{
+			name: "отрицательная выручка",
+			report: &domain.FinancialReport{
+				ID:      uuid.UUID{1},
+				Revenue: rub(-2),
+				Costs:   rub(1),
+				Year:    2023,
+				Quarter: 1,
+			},
+			wantErr: true,
+			errStr:  errors.New("выручка не может быть отрицательной"),
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -746,7 +1125,7 @@ func TestFinReportService_Update(t *testing.T) {
 				tc.beforeTest(*repo)
 			}
 
-			err := svc.Update(tc.report)
+			err := svc.Update(context.Background(), tc.report)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -756,3 +1135,128 @@ func TestFinReportService_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestFinReportService_CreateByPeriod_Cancelled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	finRepo := mocks.NewMockIFinancialReportRepository(ctrl)
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	svc := NewService(finRepo, newTxManager(ctrl), newRates(t), domain.DefaultCurrency, logger)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reports := &domain.FinancialReportByPeriod{
+		Reports: []domain.FinancialReport{
+			{CompanyID: uuid.UUID{1}, Revenue: rub(1), Costs: rub(1), Year: 2023, Quarter: 1},
+			{CompanyID: uuid.UUID{1}, Revenue: rub(1), Costs: rub(1), Year: 2023, Quarter: 2},
+		},
+	}
+
+	finRepo.EXPECT().
+		LockCompanyYear(ctx, uuid.UUID{1}, gomock.Any()).
+		Return(nil)
+	finRepo.EXPECT().
+		GetByCompany(ctx, uuid.UUID{1}, gomock.Any()).
+		Return(&domain.FinancialReportByPeriod{}, nil)
+	finRepo.EXPECT().
+		Create(ctx, &reports.Reports[0]).
+		DoAndReturn(func(context.Context, *domain.FinancialReport) error {
+			cancel()
+			return nil
+		})
+
+	err := svc.CreateByPeriod(ctx, reports)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func newTxManager(ctrl *gomock.Controller) *mocks.MockITxManager {
+	txManager := mocks.NewMockITxManager(ctrl)
+	txManager.EXPECT().
+		WithinTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).AnyTimes()
+
+	return txManager
+}
+
+func rub(units int64) domain.Money {
+	return domain.NewMoney(units, domain.DefaultCurrency)
+}
+
+// newRates возвращает таблицу курсов доллара за кварталы 2023 года.
+func newRates(t *testing.T) domain.IExchangeRateProvider {
+	rates, err := exchange_rate.NewTable([]domain.ExchangeRate{
+		{Currency: "USD", Year: 2023, Quarter: 1, Rate: big.NewRat(75, 1)},
+		{Currency: "USD", Year: 2023, Quarter: 2, Rate: big.NewRat(80, 1)},
+		{Currency: "USD", Year: 2023, Quarter: 3, Rate: big.NewRat(90, 1)},
+		{Currency: "USD", Year: 2023, Quarter: 4, Rate: big.NewRat(100, 1)},
+	})
+	require.Nil(t, err)
+
+	return rates
+}
+
+func TestFinReportService_CreateByPeriod_Atomic(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+
+	repo := memory.NewFinancialReportRepository()
+	svc := NewService(repo, memory.NewTxManager(), newRates(t), domain.DefaultCurrency, logger)
+	ctx := context.Background()
+
+	err := svc.CreateByPeriod(ctx, &domain.FinancialReportByPeriod{
+		Reports: []domain.FinancialReport{
+			{CompanyID: uuid.UUID{1}, Revenue: rub(1), Costs: rub(1), Year: 2021, Quarter: 1},
+			{CompanyID: uuid.UUID{1}, Revenue: rub(1), Costs: rub(1), Year: 2021, Quarter: 2},
+			{CompanyID: uuid.UUID{1}, Revenue: rub(-1), Costs: rub(1), Year: 2021, Quarter: 3},
+		},
+	})
+	require.ErrorIs(t, err, domain.ErrValidation)
+
+	byPeriod, err := repo.GetByCompany(ctx, uuid.UUID{1}, &domain.Period{
+		StartYear:    2021,
+		StartQuarter: 1,
+		EndYear:      2021,
+		EndQuarter:   4,
+	})
+	require.Nil(t, err)
+	require.Empty(t, byPeriod.Reports)
+}
+
+func TestFinReportService_CreateByPeriod_Currency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+
+	repo := memory.NewFinancialReportRepository()
+	svc := NewService(repo, memory.NewTxManager(), newRates(t), domain.DefaultCurrency, logger)
+	ctx := context.Background()
+
+	batch := &domain.FinancialReportByPeriod{
+		Reports: []domain.FinancialReport{
+			{CompanyID: uuid.UUID{1}, Revenue: rub(100), Costs: rub(40), Year: 2023, Quarter: 1},
+			{CompanyID: uuid.UUID{1}, Revenue: domain.NewMoney(1, "USD"), Costs: domain.NewMoney(1, "USD"), Year: 2023, Quarter: 2},
+		},
+	}
+	err := svc.CreateByPeriod(ctx, batch)
+	require.ErrorIs(t, err, domain.ErrValidation)
+	require.Equal(t, "суммы в разных валютах RUB и USD нельзя складывать и сравнивать", err.Error())
+
+	byPeriod, err := repo.GetByCompany(ctx, uuid.UUID{1}, &domain.Period{
+		StartYear:    2023,
+		StartQuarter: 1,
+		EndYear:      2023,
+		EndQuarter:   4,
+	})
+	require.Nil(t, err)
+	require.Empty(t, byPeriod.Reports)
+}
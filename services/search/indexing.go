@@ -0,0 +1,298 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+)
+
+// Декораторы сервисов обновляют поисковый индекс после успешного изменения сущности.
+
+type SkillService struct {
+	next  domain.ISkillService
+	index domain.ISearchIndex
+}
+
+func NewSkillService(next domain.ISkillService, index domain.ISearchIndex) domain.ISkillService {
+	return &SkillService{
+		next:  next,
+		index: index,
+	}
+}
+
+func (s *SkillService) Create(ctx context.Context, skill *domain.Skill) (err error) {
+	err = s.next.Create(ctx, skill)
+	if err != nil {
+		return err
+	}
+
+	return put(ctx, s.index, domain.SkillDocument(skill))
+}
+
+func (s *SkillService) GetById(ctx context.Context, id uuid.UUID) (*domain.Skill, error) {
+	return s.next.GetById(ctx, id)
+}
+
+func (s *SkillService) GetByIds(ctx context.Context, ids []uuid.UUID) ([]*domain.Skill, error) {
+	return s.next.GetByIds(ctx, ids)
+}
+
+func (s *SkillService) GetAll(ctx context.Context, req domain.PageRequest) (*domain.Page[*domain.Skill], error) {
+	return s.next.GetAll(ctx, req)
+}
+
+func (s *SkillService) Update(ctx context.Context, skill *domain.Skill) (err error) {
+	err = s.next.Update(ctx, skill)
+	if err != nil {
+		return err
+	}
+
+	return put(ctx, s.index, domain.SkillDocument(skill))
+}
+
+func (s *SkillService) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
+	err = s.next.DeleteById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return remove(ctx, s.index, domain.SearchKindSkill, id)
+}
+
+// ActivityFieldService убирает из индекса компании, удаленные хранилищем вместе со сферой деятельности.
+type ActivityFieldService struct {
+	next        domain.IActivityFieldService
+	compService domain.ICompanyService
+	index       domain.ISearchIndex
+}
+
+func NewActivityFieldService(next domain.IActivityFieldService, compService domain.ICompanyService, index domain.ISearchIndex) domain.IActivityFieldService {
+	return &ActivityFieldService{
+		next:        next,
+		compService: compService,
+		index:       index,
+	}
+}
+
+func (s *ActivityFieldService) Create(ctx context.Context, data *domain.ActivityField) (err error) {
+	err = s.next.Create(ctx, data)
+	if err != nil {
+		return err
+	}
+
+	return put(ctx, s.index, domain.ActivityFieldDocument(data))
+}
+
+func (s *ActivityFieldService) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
+	companies, err := allCompanies(ctx, func(ctx context.Context, req domain.PageRequest) (*domain.Page[*domain.Company], error) {
+		return s.compService.GetAll(ctx, domain.CompanyFilter{ActivityFieldId: id}, req)
+	})
+	if err != nil {
+		return i18n.Wrap(err, i18n.SearchIndex)
+	}
+
+	err = s.next.DeleteById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = remove(ctx, s.index, domain.SearchKindActivityField, id)
+	if err != nil {
+		return err
+	}
+
+	return removeDeleted(ctx, s.compService, s.index, companies)
+}
+
+func (s *ActivityFieldService) Update(ctx context.Context, data *domain.ActivityField) (err error) {
+	err = s.next.Update(ctx, data)
+	if err != nil {
+		return err
+	}
+
+	return put(ctx, s.index, domain.ActivityFieldDocument(data))
+}
+
+func (s *ActivityFieldService) GetById(ctx context.Context, id uuid.UUID) (*domain.ActivityField, error) {
+	return s.next.GetById(ctx, id)
+}
+
+func (s *ActivityFieldService) GetByIds(ctx context.Context, ids []uuid.UUID) ([]*domain.ActivityField, error) {
+	return s.next.GetByIds(ctx, ids)
+}
+
+func (s *ActivityFieldService) GetCostByCompanyId(ctx context.Context, companyId uuid.UUID) (float32, error) {
+	return s.next.GetCostByCompanyId(ctx, companyId)
+}
+
+func (s *ActivityFieldService) GetMaxCost(ctx context.Context) (float32, error) {
+	return s.next.GetMaxCost(ctx)
+}
+
+func (s *ActivityFieldService) GetAll(ctx context.Context, req domain.PageRequest) (*domain.Page[*domain.ActivityField], error) {
+	return s.next.GetAll(ctx, req)
+}
+
+type CompanyService struct {
+	next  domain.ICompanyService
+	index domain.ISearchIndex
+}
+
+func NewCompanyService(next domain.ICompanyService, index domain.ISearchIndex) domain.ICompanyService {
+	return &CompanyService{
+		next:  next,
+		index: index,
+	}
+}
+
+func (s *CompanyService) Create(ctx context.Context, company *domain.Company) (err error) {
+	err = s.next.Create(ctx, company)
+	if err != nil {
+		return err
+	}
+
+	return put(ctx, s.index, domain.CompanyDocument(company))
+}
+
+func (s *CompanyService) GetById(ctx context.Context, id uuid.UUID) (*domain.Company, error) {
+	return s.next.GetById(ctx, id)
+}
+
+func (s *CompanyService) GetByOwnerId(ctx context.Context, id uuid.UUID, req domain.PageRequest) (*domain.Page[*domain.Company], error) {
+	return s.next.GetByOwnerId(ctx, id, req)
+}
+
+func (s *CompanyService) GetAll(ctx context.Context, filter domain.CompanyFilter, req domain.PageRequest) (*domain.Page[*domain.Company], error) {
+	return s.next.GetAll(ctx, filter, req)
+}
+
+func (s *CompanyService) Update(ctx context.Context, company *domain.Company) (err error) {
+	err = s.next.Update(ctx, company)
+	if err != nil {
+		return err
+	}
+
+	return put(ctx, s.index, domain.CompanyDocument(company))
+}
+
+func (s *CompanyService) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
+	err = s.next.DeleteById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return remove(ctx, s.index, domain.SearchKindCompany, id)
+}
+
+// UserService убирает из индекса компании, удаленные хранилищем вместе с владельцем.
+type UserService struct {
+	next        domain.IUserService
+	compService domain.ICompanyService
+	index       domain.ISearchIndex
+}
+
+func NewUserService(next domain.IUserService, compService domain.ICompanyService, index domain.ISearchIndex) domain.IUserService {
+	return &UserService{
+		next:        next,
+		compService: compService,
+		index:       index,
+	}
+}
+
+func (s *UserService) Create(ctx context.Context, user *domain.User) error {
+	return s.next.Create(ctx, user)
+}
+
+func (s *UserService) GetByUsername(ctx context.Context, username string) (*domain.User, error) {
+	return s.next.GetByUsername(ctx, username)
+}
+
+func (s *UserService) GetById(ctx context.Context, userId uuid.UUID) (*domain.User, error) {
+	return s.next.GetById(ctx, userId)
+}
+
+func (s *UserService) GetAll(ctx context.Context, filter domain.UserFilter, req domain.PageRequest) (*domain.Page[*domain.User], error) {
+	return s.next.GetAll(ctx, filter, req)
+}
+
+func (s *UserService) Update(ctx context.Context, user *domain.User) error {
+	return s.next.Update(ctx, user)
+}
+
+func (s *UserService) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
+	companies, err := allCompanies(ctx, func(ctx context.Context, req domain.PageRequest) (*domain.Page[*domain.Company], error) {
+		return s.compService.GetByOwnerId(ctx, id, req)
+	})
+	if err != nil {
+		return i18n.Wrap(err, i18n.SearchIndex)
+	}
+
+	err = s.next.DeleteById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return removeDeleted(ctx, s.compService, s.index, companies)
+}
+
+// allCompanies собирает компании со всех страниц выборки fetch.
+func allCompanies(
+	ctx context.Context,
+	fetch func(context.Context, domain.PageRequest) (*domain.Page[*domain.Company], error),
+) ([]*domain.Company, error) {
+	companies := make([]*domain.Company, 0)
+
+	req := domain.PageRequest{Limit: domain.MaxPageSize}
+	for {
+		page, err := fetch(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		companies = append(companies, page.Items...)
+		if page.NextCursor == "" {
+			return companies, nil
+		}
+		req.Cursor = page.NextCursor
+	}
+}
+
+// removeDeleted убирает из индекса компании, которых после каскадного удаления нет в хранилище.
+func removeDeleted(ctx context.Context, compService domain.ICompanyService, index domain.ISearchIndex, companies []*domain.Company) error {
+	for _, company := range companies {
+		_, err := compService.GetById(ctx, company.ID)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, domain.ErrNotFound) {
+			return i18n.Wrap(err, i18n.SearchIndex)
+		}
+
+		err = remove(ctx, index, domain.SearchKindCompany, company.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func put(ctx context.Context, index domain.ISearchIndex, doc *domain.SearchDocument) error {
+	err := index.Put(ctx, doc)
+	if err != nil {
+		return i18n.Wrap(err, i18n.SearchIndex)
+	}
+
+	return nil
+}
+
+func remove(ctx context.Context, index domain.ISearchIndex, kind domain.SearchKind, id uuid.UUID) error {
+	err := index.Remove(ctx, kind, id)
+	if err != nil {
+		return i18n.Wrap(err, i18n.SearchIndex)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package search
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/mocks"
+	"github.com/dlankinl/bmstu-ppo-bl/repository/memory"
+	"github.com/dlankinl/bmstu-ppo-bl/services/activity_field"
+	"github.com/dlankinl/bmstu-ppo-bl/services/company"
+	"github.com/dlankinl/bmstu-ppo-bl/services/skill"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestSearch_Scenario(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+
+	skillRepo := memory.NewSkillRepository()
+	actFieldRepo := memory.NewActivityFieldRepository()
+	compRepo := memory.NewCompanyRepository()
+	index := memory.NewSearchIndex()
+
+	skillSvc := NewSkillService(skill.NewService(skillRepo, logger), index)
+	compSvc := NewCompanyService(company.NewService(compRepo, logger), index)
+	actFieldSvc := NewActivityFieldService(activity_field.NewService(actFieldRepo, compRepo, logger), compSvc, index)
+	searchSvc := NewService(index, skillRepo, actFieldRepo, compRepo, logger)
+
+	ctx := context.Background()
+
+	golang := &domain.Skill{Name: "Go", Description: "Разработка серверных приложений"}
+	require.Nil(t, skillSvc.Create(ctx, golang))
+	management := &domain.Skill{Name: "Management", Description: "managing development teams"}
+	require.Nil(t, skillSvc.Create(ctx, management))
+	it := &domain.ActivityField{Name: "IT", Description: "разработка программного обеспечения", Cost: 5}
+	require.Nil(t, actFieldSvc.Create(ctx, it))
+	bakery := &domain.Company{OwnerID: uuid.New(), ActivityFieldId: it.ID, Name: "Ёжик", City: "Казань"}
+	require.Nil(t, compSvc.Create(ctx, bakery))
+
+	ids := func(query string, kinds ...domain.SearchKind) []uuid.UUID {
+		hits, err := searchSvc.Search(ctx, query, kinds, domain.PageRequest{})
+		require.Nil(t, err)
+
+		res := make([]uuid.UUID, 0)
+		for _, hit := range hits.Items {
+			res = append(res, hit.ID)
+		}
+		return res
+	}
+
+	require.ElementsMatch(t, []uuid.UUID{golang.ID, it.ID}, ids("разработки"))
+	require.Equal(t, []uuid.UUID{it.ID}, ids("разработки", domain.SearchKindActivityField))
+	require.Equal(t, []uuid.UUID{management.ID}, ids("developer"))
+	require.Equal(t, []uuid.UUID{bakery.ID}, ids("ежик"))
+
+	golang.Description = "язык программирования"
+	require.Nil(t, skillSvc.Update(ctx, golang))
+	require.Equal(t, []uuid.UUID{it.ID}, ids("разработка"))
+	require.Equal(t, []uuid.UUID{golang.ID}, ids("Языки"))
+
+	require.Nil(t, compSvc.DeleteById(ctx, bakery.ID))
+	require.Empty(t, ids("ёжик"))
+
+	require.NotNil(t, actFieldSvc.DeleteById(ctx, uuid.New()))
+	require.Equal(t, []uuid.UUID{it.ID}, ids("обеспечение"))
+
+	rebuilt := memory.NewSearchIndex()
+	require.Nil(t, NewService(rebuilt, skillRepo, actFieldRepo, compRepo, logger).Rebuild(ctx))
+	hits, err := rebuilt.Search(ctx, "go management it", nil, domain.PageRequest{})
+	require.Nil(t, err)
+	require.Equal(t, 3, hits.Total)
+}
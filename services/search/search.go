@@ -0,0 +1,94 @@
+package search
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/logger"
+)
+
+type Service struct {
+	index        domain.ISearchIndex
+	skillRepo    domain.ISkillRepository
+	actFieldRepo domain.IActivityFieldRepository
+	companyRepo  domain.ICompanyRepository
+	logger       logger.ILogger
+}
+
+func NewService(
+	index domain.ISearchIndex,
+	skillRepo domain.ISkillRepository,
+	actFieldRepo domain.IActivityFieldRepository,
+	companyRepo domain.ICompanyRepository,
+	logger logger.ILogger,
+) domain.ISearchService {
+	return &Service{
+		index:        index,
+		skillRepo:    skillRepo,
+		actFieldRepo: actFieldRepo,
+		companyRepo:  companyRepo,
+		logger:       logger,
+	}
+}
+
+func (s *Service) Search(ctx context.Context, query string, kinds []domain.SearchKind, req domain.PageRequest) (hits *domain.Page[*domain.SearchHit], err error) {
+	err = domain.ValidateSearchQuery(query, kinds)
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.Search, err)
+		return nil, err
+	}
+
+	hits, err = s.index.Search(ctx, query, kinds, req)
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.Search, err)
+		return nil, i18n.Wrap(err, i18n.Search)
+	}
+
+	return hits, nil
+}
+
+// Rebuild заполняет индекс всеми навыками, сферами деятельности и компаниями из хранилищ.
+func (s *Service) Rebuild(ctx context.Context) (err error) {
+	err = indexPages(ctx, s.index, s.skillRepo.GetAll, domain.SkillDocument)
+	if err == nil {
+		err = indexPages(ctx, s.index, s.actFieldRepo.GetAll, domain.ActivityFieldDocument)
+	}
+	if err == nil {
+		err = indexPages(ctx, s.index, func(ctx context.Context, req domain.PageRequest) (*domain.Page[*domain.Company], error) {
+			return s.companyRepo.GetAll(ctx, domain.CompanyFilter{}, req)
+		}, domain.CompanyDocument)
+	}
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.SearchRebuild, err)
+		return i18n.Wrap(err, i18n.SearchRebuild)
+	}
+
+	return nil
+}
+
+func indexPages[T any](
+	ctx context.Context,
+	index domain.ISearchIndex,
+	fetch func(context.Context, domain.PageRequest) (*domain.Page[T], error),
+	document func(T) *domain.SearchDocument,
+) error {
+	req := domain.PageRequest{Limit: domain.MaxPageSize}
+	for {
+		page, err := fetch(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range page.Items {
+			err = index.Put(ctx, document(item))
+			if err != nil {
+				return err
+			}
+		}
+
+		if page.NextCursor == "" {
+			return nil
+		}
+		req.Cursor = page.NextCursor
+	}
+}
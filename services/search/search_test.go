@@ -0,0 +1,316 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/mocks"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"strings"
+	"testing"
+)
+
+func TestSearchService_Search(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	index := mocks.NewMockISearchIndex(ctrl)
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	svc := NewService(index, nil, nil, nil, logger)
+
+	testCases := []struct {
+		name       string
+		query      string
+		kinds      []domain.SearchKind
+		beforeTest func(index mocks.MockISearchIndex)
+		expected   *domain.Page[*domain.SearchHit]
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name:  "успешный поиск",
+			query: "разработка",
+			kinds: []domain.SearchKind{domain.SearchKindSkill},
+			beforeTest: func(index mocks.MockISearchIndex) {
+				index.EXPECT().
+					Search(context.Background(), "разработка", []domain.SearchKind{domain.SearchKindSkill}, domain.PageRequest{}).
+					Return(&domain.Page[*domain.SearchHit]{
+						Items: []*domain.SearchHit{{Kind: domain.SearchKindSkill, ID: uuid.UUID{1}, Title: "Go", Score: 1}},
+						Total: 1,
+					}, nil)
+			},
+			expected: &domain.Page[*domain.SearchHit]{
+				Items: []*domain.SearchHit{{Kind: domain.SearchKindSkill, ID: uuid.UUID{1}, Title: "Go", Score: 1}},
+				Total: 1,
+			},
+		},
+		{
+			name:    "пустой запрос",
+			query:   "  ",
+			wantErr: true,
+			errStr:  errors.New("поисковый запрос не может быть пустым"),
+		},
+		{
+			name:    "слишком длинный запрос",
+			query:   strings.Repeat("а", domain.MaxSearchLength+1),
+			wantErr: true,
+			errStr:  fmt.Errorf("строка поиска длиннее %d символов", domain.MaxSearchLength),
+		},
+		{
+			name:    "неизвестный тип",
+			query:   "go",
+			kinds:   []domain.SearchKind{"user"},
+			wantErr: true,
+			errStr:  errors.New("неизвестный тип поиска: user"),
+		},
+		{
+			name:  "ошибка индекса",
+			query: "go",
+			beforeTest: func(index mocks.MockISearchIndex) {
+				index.EXPECT().
+					Search(context.Background(), "go", nil, domain.PageRequest{}).
+					Return(nil, fmt.Errorf("index error"))
+			},
+			wantErr: true,
+			errStr:  errors.New("поиск: index error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*index)
+			}
+
+			hits, err := svc.Search(context.Background(), tc.query, tc.kinds, domain.PageRequest{})
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tc.expected, hits)
+			}
+		})
+	}
+}
+
+func TestSearchService_Rebuild(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	index := mocks.NewMockISearchIndex(ctrl)
+	skillRepo := mocks.NewMockISkillRepository(ctrl)
+	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
+	compRepo := mocks.NewMockICompanyRepository(ctrl)
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	svc := NewService(index, skillRepo, actFieldRepo, compRepo, logger)
+
+	ctx := context.Background()
+	skills := []*domain.Skill{
+		{ID: uuid.UUID{1}, Name: "Go", Description: "язык программирования"},
+		{ID: uuid.UUID{2}, Name: "SQL", Description: "запросы к базам данных"},
+	}
+	field := &domain.ActivityField{ID: uuid.UUID{3}, Name: "IT", Description: "информационные технологии"}
+	company := &domain.Company{ID: uuid.UUID{4}, Name: "Рога и копыта", City: "Москва"}
+
+	gomock.InOrder(
+		skillRepo.EXPECT().
+			GetAll(ctx, domain.PageRequest{Limit: domain.MaxPageSize}).
+			Return(&domain.Page[*domain.Skill]{Items: skills[:1], Total: 2, NextCursor: "next"}, nil),
+		index.EXPECT().Put(ctx, domain.SkillDocument(skills[0])).Return(nil),
+		skillRepo.EXPECT().
+			GetAll(ctx, domain.PageRequest{Limit: domain.MaxPageSize, Cursor: "next"}).
+			Return(&domain.Page[*domain.Skill]{Items: skills[1:], Total: 2}, nil),
+		index.EXPECT().Put(ctx, domain.SkillDocument(skills[1])).Return(nil),
+		actFieldRepo.EXPECT().
+			GetAll(ctx, domain.PageRequest{Limit: domain.MaxPageSize}).
+			Return(&domain.Page[*domain.ActivityField]{Items: []*domain.ActivityField{field}, Total: 1}, nil),
+		index.EXPECT().Put(ctx, domain.ActivityFieldDocument(field)).Return(nil),
+		compRepo.EXPECT().
+			GetAll(ctx, domain.CompanyFilter{}, domain.PageRequest{Limit: domain.MaxPageSize}).
+			Return(&domain.Page[*domain.Company]{Items: []*domain.Company{company}, Total: 1}, nil),
+		index.EXPECT().Put(ctx, domain.CompanyDocument(company)).Return(nil),
+	)
+	require.Nil(t, svc.Rebuild(ctx))
+
+	actFieldRepo.EXPECT().
+		GetAll(ctx, domain.PageRequest{Limit: domain.MaxPageSize}).
+		Return(nil, fmt.Errorf("sql error"))
+	skillRepo.EXPECT().
+		GetAll(ctx, domain.PageRequest{Limit: domain.MaxPageSize}).
+		Return(&domain.Page[*domain.Skill]{Items: make([]*domain.Skill, 0)}, nil)
+
+	err := svc.Rebuild(ctx)
+	require.Equal(t, "перестроение поискового индекса: sql error", err.Error())
+}
+
+func TestUserService_DeleteById(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	index := mocks.NewMockISearchIndex(ctrl)
+	userSvc := mocks.NewMockIUserService(ctrl)
+	compSvc := mocks.NewMockICompanyService(ctrl)
+	svc := NewUserService(userSvc, compSvc, index)
+
+	ctx := context.Background()
+	owner := uuid.UUID{1}
+	deleted := &domain.Company{ID: uuid.UUID{2}, OwnerID: owner}
+	kept := &domain.Company{ID: uuid.UUID{3}, OwnerID: owner}
+
+	testCases := []struct {
+		name       string
+		beforeTest func()
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name: "компании удалены вместе с владельцем",
+			beforeTest: func() {
+				gomock.InOrder(
+					compSvc.EXPECT().
+						GetByOwnerId(ctx, owner, domain.PageRequest{Limit: domain.MaxPageSize}).
+						Return(&domain.Page[*domain.Company]{Items: []*domain.Company{deleted, kept}, Total: 2}, nil),
+					userSvc.EXPECT().DeleteById(ctx, owner).Return(nil),
+					compSvc.EXPECT().
+						GetById(ctx, deleted.ID).
+						Return(nil, domain.NewError(domain.ErrNotFound, i18n.StorageCompanyNotFound)),
+					index.EXPECT().Remove(ctx, domain.SearchKindCompany, deleted.ID).Return(nil),
+					compSvc.EXPECT().GetById(ctx, kept.ID).Return(kept, nil),
+				)
+			},
+		},
+		{
+			name: "ошибка удаления пользователя",
+			beforeTest: func() {
+				compSvc.EXPECT().
+					GetByOwnerId(ctx, owner, domain.PageRequest{Limit: domain.MaxPageSize}).
+					Return(&domain.Page[*domain.Company]{Items: []*domain.Company{deleted}, Total: 1}, nil)
+				userSvc.EXPECT().DeleteById(ctx, owner).Return(fmt.Errorf("sql error"))
+			},
+			wantErr: true,
+			errStr:  errors.New("sql error"),
+		},
+		{
+			name: "ошибка получения компаний",
+			beforeTest: func() {
+				compSvc.EXPECT().
+					GetByOwnerId(ctx, owner, domain.PageRequest{Limit: domain.MaxPageSize}).
+					Return(nil, fmt.Errorf("sql error"))
+			},
+			wantErr: true,
+			errStr:  errors.New("обновление поискового индекса: sql error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.beforeTest()
+
+			err := svc.DeleteById(ctx, owner)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestActivityFieldService_DeleteById(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	index := mocks.NewMockISearchIndex(ctrl)
+	actFieldSvc := mocks.NewMockIActivityFieldService(ctrl)
+	compSvc := mocks.NewMockICompanyService(ctrl)
+	svc := NewActivityFieldService(actFieldSvc, compSvc, index)
+
+	ctx := context.Background()
+	field := uuid.UUID{1}
+	filter := domain.CompanyFilter{ActivityFieldId: field}
+	deleted := &domain.Company{ID: uuid.UUID{2}, ActivityFieldId: field}
+	kept := &domain.Company{ID: uuid.UUID{3}, ActivityFieldId: field}
+
+	testCases := []struct {
+		name       string
+		beforeTest func()
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name: "компании удалены вместе со сферой деятельности",
+			beforeTest: func() {
+				gomock.InOrder(
+					compSvc.EXPECT().
+						GetAll(ctx, filter, domain.PageRequest{Limit: domain.MaxPageSize}).
+						Return(&domain.Page[*domain.Company]{Items: []*domain.Company{deleted}, Total: 2, NextCursor: "next"}, nil),
+					compSvc.EXPECT().
+						GetAll(ctx, filter, domain.PageRequest{Limit: domain.MaxPageSize, Cursor: "next"}).
+						Return(&domain.Page[*domain.Company]{Items: []*domain.Company{kept}, Total: 2}, nil),
+					actFieldSvc.EXPECT().DeleteById(ctx, field).Return(nil),
+					index.EXPECT().Remove(ctx, domain.SearchKindActivityField, field).Return(nil),
+					compSvc.EXPECT().
+						GetById(ctx, deleted.ID).
+						Return(nil, domain.NewError(domain.ErrNotFound, i18n.StorageCompanyNotFound)),
+					index.EXPECT().Remove(ctx, domain.SearchKindCompany, deleted.ID).Return(nil),
+					compSvc.EXPECT().GetById(ctx, kept.ID).Return(kept, nil),
+				)
+			},
+		},
+		{
+			name: "ошибка удаления сферы деятельности",
+			beforeTest: func() {
+				compSvc.EXPECT().
+					GetAll(ctx, filter, domain.PageRequest{Limit: domain.MaxPageSize}).
+					Return(&domain.Page[*domain.Company]{Items: []*domain.Company{deleted}, Total: 1}, nil)
+				actFieldSvc.EXPECT().DeleteById(ctx, field).Return(fmt.Errorf("sql error"))
+			},
+			wantErr: true,
+			errStr:  errors.New("sql error"),
+		},
+		{
+			name: "ошибка проверки компании",
+			beforeTest: func() {
+				gomock.InOrder(
+					compSvc.EXPECT().
+						GetAll(ctx, filter, domain.PageRequest{Limit: domain.MaxPageSize}).
+						Return(&domain.Page[*domain.Company]{Items: []*domain.Company{deleted}, Total: 1}, nil),
+					actFieldSvc.EXPECT().DeleteById(ctx, field).Return(nil),
+					index.EXPECT().Remove(ctx, domain.SearchKindActivityField, field).Return(nil),
+					compSvc.EXPECT().GetById(ctx, deleted.ID).Return(nil, fmt.Errorf("sql error")),
+				)
+			},
+			wantErr: true,
+			errStr:  errors.New("обновление поискового индекса: sql error"),
+		},
+		{
+			name: "ошибка получения компаний",
+			beforeTest: func() {
+				compSvc.EXPECT().
+					GetAll(ctx, filter, domain.PageRequest{Limit: domain.MaxPageSize}).
+					Return(nil, fmt.Errorf("sql error"))
+			},
+			wantErr: true,
+			errStr:  errors.New("обновление поискового индекса: sql error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.beforeTest()
+
+			err := svc.DeleteById(ctx, field)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
@@ -2,8 +2,8 @@ package skill
 
 import (
 	"context"
-	"fmt"
 	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
 	"github.com/dlankinl/bmstu-ppo-bl/pkg/logger"
 	"github.com/google/uuid"
 )
@@ -23,71 +23,71 @@ func NewService(
 	}
 }
 
-func (s *Service) Create(skill *domain.Skill) (err error) {
+func (s *Service) Create(ctx context.Context, skill *domain.Skill) (err error) {
 	if skill.Name == "" {
-		s.logger.Infof("должно быть указано название навыка")
-		return fmt.Errorf("должно быть указано название навыка")
+		s.logger.Infof("%v", i18n.SkillNameRequired)
+		return domain.NewValidationError("name", i18n.SkillNameRequired)
 	}
 
 	if skill.Description == "" {
-		s.logger.Infof("должно быть указано описание навыка")
-		return fmt.Errorf("должно быть указано описание навыка")
+		s.logger.Infof("%v", i18n.SkillDescriptionRequired)
+		return domain.NewValidationError("description", i18n.SkillDescriptionRequired)
 	}
 
-	ctx := context.Background()
-
 	err = s.skillRepo.Create(ctx, skill)
 	if err != nil {
-		s.logger.Infof("добавление навыка: %v", err)
-		return fmt.Errorf("добавление навыка: %w", err)
+		s.logger.Infof("%v: %v", i18n.SkillCreate, err)
+		return i18n.Wrap(err, i18n.SkillCreate)
 	}
 
 	return nil
 }
 
-func (s *Service) GetById(id uuid.UUID) (skill *domain.Skill, err error) {
-	ctx := context.Background()
-
+func (s *Service) GetById(ctx context.Context, id uuid.UUID) (skill *domain.Skill, err error) {
 	skill, err = s.skillRepo.GetById(ctx, id)
 	if err != nil {
-		s.logger.Infof("получение навыка по id: %v", err)
-		return nil, fmt.Errorf("получение навыка по id: %w", err)
+		s.logger.Infof("%v: %v", i18n.SkillGet, err)
+		return nil, i18n.Wrap(err, i18n.SkillGet)
 	}
 
 	return skill, nil
 }
 
-func (s *Service) GetAll(page int) (skills []*domain.Skill, err error) {
-	ctx := context.Background()
-
-	skills, err = s.skillRepo.GetAll(ctx, page)
+func (s *Service) GetByIds(ctx context.Context, ids []uuid.UUID) (skills []*domain.Skill, err error) {
+	skills, err = s.skillRepo.GetByIds(ctx, ids)
 	if err != nil {
-		s.logger.Infof("получение списка всех навыков: %v", err)
-		return nil, fmt.Errorf("получение списка всех навыков: %w", err)
+		s.logger.Infof("%v: %v", i18n.SkillGetByIds, err)
+		return nil, i18n.Wrap(err, i18n.SkillGetByIds)
 	}
 
 	return skills, nil
 }
 
-func (s *Service) Update(skill *domain.Skill) (err error) {
-	ctx := context.Background()
+func (s *Service) GetAll(ctx context.Context, req domain.PageRequest) (skills *domain.Page[*domain.Skill], err error) {
+	skills, err = s.skillRepo.GetAll(ctx, req)
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.SkillGetAll, err)
+		return nil, i18n.Wrap(err, i18n.SkillGetAll)
+	}
 
+	return skills, nil
+}
+
+func (s *Service) Update(ctx context.Context, skill *domain.Skill) (err error) {
 	err = s.skillRepo.Update(ctx, skill)
 	if err != nil {
-		s.logger.Infof("обновление информации о навыке: %v", err)
-		return fmt.Errorf("обновление информации о навыке: %w", err)
+		s.logger.Infof("%v: %v", i18n.SkillUpdate, err)
+		return i18n.Wrap(err, i18n.SkillUpdate)
 	}
 
 	return nil
 }
 
-func (s *Service) DeleteById(id uuid.UUID) (err error) {
-	ctx := context.Background()
-
+func (s *Service) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
 	err = s.skillRepo.DeleteById(ctx, id)
 	if err != nil {
-		s.logger.Infof("удаление навыка по id: %v", err)
-		return fmt.Errorf("удаление навыка по id: %w", err)
+		s.logger.Infof("%v: %v", i18n.SkillDelete, err)
+		return i18n.Wrap(err, i18n.SkillDelete)
 	}
 
 	return nil
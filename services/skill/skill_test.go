@@ -18,7 +18,7 @@ func TestSkillService_Create(t *testing.T) {
 
 	skillRepo := mocks.NewMockISkillRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	svc := NewService(skillRepo, logger)
 
 	testCases := []struct {
@@ -113,7 +113,7 @@ func TestSkillService_Create(t *testing.T) {
 				tc.beforeTest(*skillRepo)
 			}
 
-			err := svc.Create(tc.skill)
+			err := svc.Create(context.Background(), tc.skill)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -130,7 +130,7 @@ func TestSkillService_DeleteById(t *testing.T) {
 
 	skillRepo := mocks.NewMockISkillRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	svc := NewService(skillRepo, logger)
 
 	curUuid := uuid.New()
@@ -170,7 +170,7 @@ func TestSkillService_DeleteById(t *testing.T) {
 				tc.beforeTest(*skillRepo)
 			}
 
-			err := svc.DeleteById(tc.id)
+			err := svc.DeleteById(context.Background(), tc.id)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -187,13 +187,13 @@ func TestSkillService_GetAll(t *testing.T) {
 
 	skillRepo := mocks.NewMockISkillRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	svc := NewService(skillRepo, logger)
 
 	testCases := []struct {
 		name       string
 		beforeTest func(skillRepo mocks.MockISkillRepository)
-		expected   []*domain.Skill
+		expected   *domain.Page[*domain.Skill]
 		wantErr    bool
 		errStr     error
 	}{
@@ -201,8 +201,8 @@ func TestSkillService_GetAll(t *testing.T) {
 			name: "успешное получение списка всех навыков",
 			beforeTest: func(skillRepo mocks.MockISkillRepository) {
 				skillRepo.EXPECT().
-					GetAll(context.Background(), 1).
-					Return([]*domain.Skill{
+					GetAll(context.Background(), domain.PageRequest{}).
+					Return(&domain.Page[*domain.Skill]{Items: []*domain.Skill{
 						{
 							ID:          uuid.UUID{1},
 							Name:        "a",
@@ -218,9 +218,9 @@ func TestSkillService_GetAll(t *testing.T) {
 							Name:        "c",
 							Description: "c",
 						},
-					}, nil)
+					}}, nil)
 			},
-			expected: []*domain.Skill{
+			expected: &domain.Page[*domain.Skill]{Items: []*domain.Skill{
 				{
 					ID:          uuid.UUID{1},
 					Name:        "a",
@@ -236,14 +236,14 @@ func TestSkillService_GetAll(t *testing.T) {
 					Name:        "c",
 					Description: "c",
 				},
-			},
+			}},
 			wantErr: false,
 		},
 		{
 			name: "ошибка получения данных в репозитории",
 			beforeTest: func(skillRepo mocks.MockISkillRepository) {
 				skillRepo.EXPECT().
-					GetAll(context.Background(), 1).
+					GetAll(context.Background(), domain.PageRequest{}).
 					Return(nil, fmt.Errorf("sql error"))
 			},
 			wantErr: true,
@@ -256,7 +256,7 @@ func TestSkillService_GetAll(t *testing.T) {
 				tc.beforeTest(*skillRepo)
 			}
 
-			skills, err := svc.GetAll(1)
+			skills, err := svc.GetAll(context.Background(), domain.PageRequest{})
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -274,7 +274,7 @@ func TestSkillService_GetById(t *testing.T) {
 
 	skillRepo := mocks.NewMockISkillRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	svc := NewService(skillRepo, logger)
 
 	testCases := []struct {
@@ -328,7 +328,7 @@ func TestSkillService_GetById(t *testing.T) {
 				tc.beforeTest(*skillRepo)
 			}
 
-			company, err := svc.GetById(tc.id)
+			company, err := svc.GetById(context.Background(), tc.id)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -346,7 +346,7 @@ func TestSkillService_Update(t *testing.T) {
 
 	skillRepo := mocks.NewMockISkillRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	svc := NewService(skillRepo, logger)
 
 	testCases := []struct {
@@ -400,7 +400,7 @@ func TestSkillService_Update(t *testing.T) {
 				tc.beforeTest(*skillRepo)
 			}
 
-			err := svc.Update(tc.skill)
+			err := svc.Update(context.Background(), tc.skill)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -0,0 +1,160 @@
+package tax_schedule
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/logger"
+	"github.com/google/uuid"
+	"slices"
+)
+
+type Service struct {
+	taxRepo      domain.ITaxScheduleRepository
+	jurisdiction string
+	fallback     *domain.TaxSchedule
+	logger       logger.ILogger
+}
+
+// NewService создает сервис шкал юрисдикции jurisdiction. Шкала fallback применяется к годам,
+// для которых в хранилище нет действующей шкалы; при nil такие годы считаются ошибкой.
+func NewService(
+	taxRepo domain.ITaxScheduleRepository,
+	jurisdiction string,
+	fallback *domain.TaxSchedule,
+	logger logger.ILogger,
+) domain.ITaxScheduleService {
+	return &Service{
+		taxRepo:      taxRepo,
+		jurisdiction: jurisdiction,
+		fallback:     fallback,
+		logger:       logger,
+	}
+}
+
+func validate(schedule *domain.TaxSchedule) error {
+	if schedule.Jurisdiction == "" {
+		return domain.NewValidationError("jurisdiction", i18n.TaxScheduleJurisdictionRequired)
+	}
+
+	if schedule.Year <= 0 {
+		return domain.NewValidationError("year", i18n.TaxScheduleYearInvalid)
+	}
+
+	if !slices.Contains(domain.TaxMethods, schedule.Method) {
+		return domain.NewValidationError("method", i18n.TaxScheduleMethodUnknown, schedule.Method)
+	}
+
+	if len(schedule.Brackets) == 0 {
+		return domain.NewValidationError("brackets", i18n.TaxScheduleBracketsEmpty)
+	}
+
+	for _, bracket := range schedule.Brackets {
+		if bracket.Threshold.Currency != domain.DefaultCurrency {
+			return domain.NewValidationError("brackets", i18n.CurrencyUnsupported, bracket.Threshold.Currency)
+		}
+	}
+
+	if schedule.Brackets[0].Threshold.Sign() != 0 {
+		return domain.NewValidationError("brackets", i18n.TaxScheduleFirstThreshold)
+	}
+
+	for i, bracket := range schedule.Brackets {
+		if i > 0 && bracket.Threshold.Cmp(schedule.Brackets[i-1].Threshold) <= 0 {
+			return domain.NewValidationError("brackets", i18n.TaxScheduleThresholdOrder)
+		}
+
+		if bracket.Rate < 0 || bracket.Rate > 100 {
+			return domain.NewValidationError("brackets", i18n.TaxScheduleRateRange)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) Create(ctx context.Context, schedule *domain.TaxSchedule) (err error) {
+	err = validate(schedule)
+	if err != nil {
+		s.logger.Infof("%v", err)
+		return err
+	}
+
+	err = s.taxRepo.Create(ctx, schedule)
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.TaxScheduleCreate, err)
+		return i18n.Wrap(err, i18n.TaxScheduleCreate)
+	}
+
+	return nil
+}
+
+func (s *Service) GetById(ctx context.Context, id uuid.UUID) (schedule *domain.TaxSchedule, err error) {
+	schedule, err = s.taxRepo.GetById(ctx, id)
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.TaxScheduleGet, err)
+		return nil, i18n.Wrap(err, i18n.TaxScheduleGet)
+	}
+
+	return schedule, nil
+}
+
+func (s *Service) GetByJurisdiction(ctx context.Context, jurisdiction string) (schedules []*domain.TaxSchedule, err error) {
+	schedules, err = s.taxRepo.GetByJurisdiction(ctx, jurisdiction)
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.TaxScheduleGetByJurisdiction, err)
+		return nil, i18n.Wrap(err, i18n.TaxScheduleGetByJurisdiction)
+	}
+
+	return schedules, nil
+}
+
+// GetInForce выбирает среди шкал юрисдикции сервиса последнюю вступившую в силу не позже года year.
+// Шкала сферы деятельности имеет приоритет над общей, даже если общая принята позже.
+func (s *Service) GetInForce(ctx context.Context, year int, activityFieldId uuid.UUID) (schedule *domain.TaxSchedule, err error) {
+	schedules, err := s.taxRepo.GetByJurisdiction(ctx, s.jurisdiction)
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.TaxScheduleGetInForce, err)
+		return nil, i18n.Wrap(err, i18n.TaxScheduleGetInForce)
+	}
+
+	var general *domain.TaxSchedule
+	for _, candidate := range schedules {
+		if candidate.Year > year {
+			continue
+		}
+
+		switch candidate.ActivityFieldId {
+		case uuid.Nil:
+			if general == nil || candidate.Year > general.Year {
+				general = candidate
+			}
+		case activityFieldId:
+			if schedule == nil || candidate.Year > schedule.Year {
+				schedule = candidate
+			}
+		}
+	}
+
+	switch {
+	case schedule != nil:
+		return schedule, nil
+	case general != nil:
+		return general, nil
+	case s.fallback != nil:
+		return s.fallback, nil
+	}
+
+	err = domain.NewError(domain.ErrNotFound, i18n.TaxScheduleNotInForce, year)
+	s.logger.Infof("%v", err)
+	return nil, err
+}
+
+func (s *Service) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
+	err = s.taxRepo.DeleteById(ctx, id)
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.TaxScheduleDelete, err)
+		return i18n.Wrap(err, i18n.TaxScheduleDelete)
+	}
+
+	return nil
+}
@@ -0,0 +1,287 @@
+package tax_schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestTaxScheduleService_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taxRepo := mocks.NewMockITaxScheduleRepository(ctrl)
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	svc := NewService(taxRepo, domain.DefaultJurisdiction, nil, logger)
+
+	valid := func() *domain.TaxSchedule {
+		return &domain.TaxSchedule{
+			Jurisdiction: "RU",
+			Year:         2024,
+			Method:       domain.TaxMethodMarginal,
+			Brackets: []domain.TaxBracket{
+				{Threshold: rub(0), Rate: 4},
+				{Threshold: rub(1000000), Rate: 7},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		schedule   func() *domain.TaxSchedule
+		beforeTest func(taxRepo mocks.MockITaxScheduleRepository)
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name:     "успешное добавление",
+			schedule: valid,
+			beforeTest: func(taxRepo mocks.MockITaxScheduleRepository) {
+				taxRepo.EXPECT().
+					Create(context.Background(), valid()).
+					Return(nil)
+			},
+		},
+		{
+			name: "не указана юрисдикция",
+			schedule: func() *domain.TaxSchedule {
+				schedule := valid()
+				schedule.Jurisdiction = ""
+				return schedule
+			},
+			wantErr: true,
+			errStr:  errors.New("не указана юрисдикция"),
+		},
+		{
+			name: "неположительный год",
+			schedule: func() *domain.TaxSchedule {
+				schedule := valid()
+				schedule.Year = 0
+				return schedule
+			},
+			wantErr: true,
+			errStr:  errors.New("год вступления в силу должен быть положительным"),
+		},
+		{
+			name: "неизвестный способ расчета",
+			schedule: func() *domain.TaxSchedule {
+				schedule := valid()
+				schedule.Method = "progressive"
+				return schedule
+			},
+			wantErr: true,
+			errStr:  errors.New("неизвестный способ расчета налога progressive"),
+		},
+		{
+			name: "шкала без ступеней",
+			schedule: func() *domain.TaxSchedule {
+				schedule := valid()
+				schedule.Brackets = nil
+				return schedule
+			},
+			wantErr: true,
+			errStr:  errors.New("шкала должна содержать хотя бы одну ступень"),
+		},
+		{
+			name: "порог в неподдерживаемой валюте",
+			schedule: func() *domain.TaxSchedule {
+				schedule := valid()
+				schedule.Brackets[1].Threshold = domain.NewMoney(1000000, "USD")
+				return schedule
+			},
+			wantErr: true,
+			errStr:  errors.New("валюта USD не поддерживается"),
+		},
+		{
+			name: "ненулевой порог первой ступени",
+			schedule: func() *domain.TaxSchedule {
+				schedule := valid()
+				schedule.Brackets[0].Threshold = rub(100)
+				return schedule
+			},
+			wantErr: true,
+			errStr:  errors.New("порог первой ступени должен быть равен нулю"),
+		},
+		{
+			name: "пороги не возрастают",
+			schedule: func() *domain.TaxSchedule {
+				schedule := valid()
+				schedule.Brackets = append(schedule.Brackets, domain.TaxBracket{Threshold: rub(1000000), Rate: 10})
+				return schedule
+			},
+			wantErr: true,
+			errStr:  errors.New("пороги ступеней должны возрастать"),
+		},
+		{
+			name: "ставка больше 100 процентов",
+			schedule: func() *domain.TaxSchedule {
+				schedule := valid()
+				schedule.Brackets[1].Rate = 101
+				return schedule
+			},
+			wantErr: true,
+			errStr:  errors.New("ставка должна быть от 0 до 100 процентов"),
+		},
+		{
+			name:     "ошибка выполнения запроса в репозитории",
+			schedule: valid,
+			beforeTest: func(taxRepo mocks.MockITaxScheduleRepository) {
+				taxRepo.EXPECT().
+					Create(context.Background(), valid()).
+					Return(errors.New("ошибка выполнения запроса"))
+			},
+			wantErr: true,
+			errStr:  errors.New("создание шкалы налогообложения: ошибка выполнения запроса"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*taxRepo)
+			}
+
+			err := svc.Create(context.Background(), tc.schedule())
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestTaxScheduleService_GetInForce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taxRepo := mocks.NewMockITaxScheduleRepository(ctrl)
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+
+	fieldId := uuid.UUID{1}
+	schedules := []*domain.TaxSchedule{
+		{ID: uuid.UUID{1}, Jurisdiction: "RU", Year: 2018},
+		{ID: uuid.UUID{2}, Jurisdiction: "RU", ActivityFieldId: fieldId, Year: 2020},
+		{ID: uuid.UUID{3}, Jurisdiction: "RU", Year: 2022},
+		{ID: uuid.UUID{4}, Jurisdiction: "RU", ActivityFieldId: uuid.UUID{2}, Year: 2016},
+	}
+	fallback := domain.DefaultTaxSchedule()
+
+	testCases := []struct {
+		name       string
+		fallback   *domain.TaxSchedule
+		year       int
+		fieldId    uuid.UUID
+		beforeTest func(taxRepo mocks.MockITaxScheduleRepository)
+		expected   *domain.TaxSchedule
+		wantErr    bool
+		errStr     error
+	}{
+		{
+			name:     "общая шкала последнего года",
+			year:     2023,
+			fieldId:  uuid.UUID{3},
+			expected: schedules[2],
+		},
+		{
+			name:     "шкала вступает в силу со своего года",
+			year:     2021,
+			fieldId:  uuid.UUID{3},
+			expected: schedules[0],
+		},
+		{
+			name:     "шкала сферы деятельности важнее общей",
+			year:     2023,
+			fieldId:  fieldId,
+			expected: schedules[1],
+		},
+		{
+			name:     "шкала сферы деятельности еще не действует",
+			year:     2019,
+			fieldId:  fieldId,
+			expected: schedules[0],
+		},
+		{
+			name:     "резервная шкала",
+			fallback: fallback,
+			year:     2017,
+			fieldId:  fieldId,
+			expected: fallback,
+		},
+		{
+			name:    "нет действующей шкалы",
+			year:    2017,
+			fieldId: fieldId,
+			wantErr: true,
+			errStr:  errors.New("нет шкалы налогообложения, действующей в 2017 году"),
+		},
+		{
+			name: "ошибка выполнения запроса в репозитории",
+			year: 2023,
+			beforeTest: func(taxRepo mocks.MockITaxScheduleRepository) {
+				taxRepo.EXPECT().
+					GetByJurisdiction(context.Background(), "RU").
+					Return(nil, errors.New("ошибка выполнения запроса"))
+			},
+			wantErr: true,
+			errStr:  errors.New("поиск действующей шкалы налогообложения: ошибка выполнения запроса"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.beforeTest != nil {
+				tc.beforeTest(*taxRepo)
+			} else {
+				taxRepo.EXPECT().
+					GetByJurisdiction(context.Background(), "RU").
+					Return(schedules, nil)
+			}
+			svc := NewService(taxRepo, "RU", tc.fallback, logger)
+
+			schedule, err := svc.GetInForce(context.Background(), tc.year, tc.fieldId)
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+				require.Same(t, tc.expected, schedule)
+			}
+		})
+	}
+}
+
+func TestTaxScheduleService_GetInForce_Log(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taxRepo := mocks.NewMockITaxScheduleRepository(ctrl)
+	taxRepo.EXPECT().
+		GetByJurisdiction(context.Background(), "RU").
+		Return([]*domain.TaxSchedule{}, nil)
+
+	// в журнал попадает тот же текст с годом, что и у возвращаемой ошибки
+	var logged []interface{}
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().
+		Infof("%v", gomock.Any()).
+		Do(func(_ string, args ...interface{}) { logged = args })
+
+	_, err := NewService(taxRepo, "RU", nil, logger).GetInForce(context.Background(), 2017, uuid.UUID{1})
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	require.Len(t, logged, 1)
+	require.Equal(t, "нет шкалы налогообложения, действующей в 2017 году", fmt.Sprint(logged[0]))
+}
+
+func rub(units int64) domain.Money {
+	return domain.NewMoney(units, domain.DefaultCurrency)
+}
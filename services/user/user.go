@@ -2,8 +2,8 @@ package user
 
 import (
 	"context"
-	"fmt"
 	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
 	"github.com/dlankinl/bmstu-ppo-bl/pkg/logger"
 	"github.com/google/uuid"
 	"strings"
@@ -30,98 +30,92 @@ func NewService(
 	}
 }
 
-func (s *Service) Create(user *domain.User) (err error) {
+func (s *Service) Create(ctx context.Context, user *domain.User) (err error) {
 	if user.Gender != "m" && user.Gender != "w" {
-		s.logger.Infof("неизвестный пол")
-		return fmt.Errorf("неизвестный пол")
+		s.logger.Infof("%v", i18n.UserGenderUnknown)
+		return domain.NewValidationError("gender", i18n.UserGenderUnknown)
 	}
 
 	if user.City == "" {
-		s.logger.Infof("должно быть указано название города")
-		return fmt.Errorf("должно быть указано название города")
+		s.logger.Infof("%v", i18n.CityRequired)
+		return domain.NewValidationError("city", i18n.CityRequired)
 	}
 
 	if user.Birthday.IsZero() {
-		s.logger.Infof("должна быть указана дата рождения")
-		return fmt.Errorf("должна быть указана дата рождения")
+		s.logger.Infof("%v", i18n.UserBirthdayRequired)
+		return domain.NewValidationError("birthday", i18n.UserBirthdayRequired)
 	}
 
 	if user.FullName == "" {
-		s.logger.Infof("должны быть указаны ФИО")
-		return fmt.Errorf("должны быть указаны ФИО")
+		s.logger.Infof("%v", i18n.UserFullNameRequired)
+		return domain.NewValidationError("full_name", i18n.UserFullNameRequired)
 	}
 
 	if len(strings.Split(user.FullName, " ")) != 3 {
-		s.logger.Infof("некорректное количество слов (должны быть фамилия, имя и отчество)")
-		return fmt.Errorf("некорректное количество слов (должны быть фамилия, имя и отчество)")
+		s.logger.Infof("%v", i18n.UserFullNameWords)
+		return domain.NewValidationError("full_name", i18n.UserFullNameWords)
 	}
 
-	ctx := context.Background()
-
 	err = s.userRepo.Create(ctx, user)
 	if err != nil {
-		s.logger.Infof("создание пользователя: %v", err)
-		return fmt.Errorf("создание пользователя: %w", err)
+		s.logger.Infof("%v: %v", i18n.UserCreate, err)
+		return i18n.Wrap(err, i18n.UserCreate)
 	}
 
 	return nil
 }
 
-func (s *Service) GetByUsername(username string) (user *domain.User, err error) {
-	ctx := context.Background()
-
+func (s *Service) GetByUsername(ctx context.Context, username string) (user *domain.User, err error) {
 	user, err = s.userRepo.GetByUsername(ctx, username)
 	if err != nil {
-		s.logger.Infof("получение пользователя по username: %v", err)
-		return nil, fmt.Errorf("получение пользователя по username: %w", err)
+		s.logger.Infof("%v: %v", i18n.UserGetByUsername, err)
+		return nil, i18n.Wrap(err, i18n.UserGetByUsername)
 	}
 
 	return user, nil
 }
 
-func (s *Service) GetById(userId uuid.UUID) (user *domain.User, err error) {
-	ctx := context.Background()
-
+func (s *Service) GetById(ctx context.Context, userId uuid.UUID) (user *domain.User, err error) {
 	user, err = s.userRepo.GetById(ctx, userId)
 	if err != nil {
-		s.logger.Infof("получение пользователя по id: %v", err)
-		return nil, fmt.Errorf("получение пользователя по id: %w", err)
+		s.logger.Infof("%v: %v", i18n.UserGet, err)
+		return nil, i18n.Wrap(err, i18n.UserGet)
 	}
 
 	return user, nil
 }
 
-func (s *Service) GetAll(page int) (users []*domain.User, err error) {
-	ctx := context.Background()
+func (s *Service) GetAll(ctx context.Context, filter domain.UserFilter, req domain.PageRequest) (users *domain.Page[*domain.User], err error) {
+	err = filter.Validate()
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.UserGetAll, err)
+		return nil, err
+	}
 
-	users, err = s.userRepo.GetAll(ctx, page)
+	users, err = s.userRepo.GetAll(ctx, filter, req)
 	if err != nil {
-		s.logger.Infof("получение списка всех пользователей: %v", err)
-		return nil, fmt.Errorf("получение списка всех пользователей: %w", err)
+		s.logger.Infof("%v: %v", i18n.UserGetAll, err)
+		return nil, i18n.Wrap(err, i18n.UserGetAll)
 	}
 
 	return users, nil
 }
 
-func (s *Service) Update(user *domain.User) (err error) {
-	ctx := context.Background()
-
+func (s *Service) Update(ctx context.Context, user *domain.User) (err error) {
 	err = s.userRepo.Update(ctx, user)
 	if err != nil {
-		s.logger.Infof("обновление информации о пользователе: %v", err)
-		return fmt.Errorf("обновление информации о пользователе: %w", err)
+		s.logger.Infof("%v: %v", i18n.UserUpdate, err)
+		return i18n.Wrap(err, i18n.UserUpdate)
 	}
 
 	return nil
 }
 
-func (s *Service) DeleteById(id uuid.UUID) (err error) {
-	ctx := context.Background()
-
+func (s *Service) DeleteById(ctx context.Context, id uuid.UUID) (err error) {
 	err = s.userRepo.DeleteById(ctx, id)
 	if err != nil {
-		s.logger.Infof("удаление пользователя по id: %v", err)
-		return fmt.Errorf("удаление пользователя по id: %w", err)
+		s.logger.Infof("%v: %v", i18n.UserDelete, err)
+		return i18n.Wrap(err, i18n.UserDelete)
 	}
 
 	return nil
@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"strings"
 	"testing"
 	"time"
 )
@@ -21,7 +22,7 @@ func TestUserService_DeleteById(t *testing.T) {
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	svc := NewService(userRepo, compRepo, actFieldRepo, logger)
 
 	curUuid := uuid.New()
@@ -61,7 +62,7 @@ func TestUserService_DeleteById(t *testing.T) {
 				tc.beforeTest(*userRepo)
 			}
 
-			err := svc.DeleteById(tc.id)
+			err := svc.DeleteById(context.Background(), tc.id)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -80,13 +81,14 @@ func TestUserService_GetAll(t *testing.T) {
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	svc := NewService(userRepo, compRepo, actFieldRepo, logger)
 
 	testCases := []struct {
 		name       string
+		filter     domain.UserFilter
 		beforeTest func(userRepo mocks.MockIUserRepository)
-		expected   []*domain.User
+		expected   *domain.Page[*domain.User]
 		wantErr    bool
 		errStr     error
 	}{
@@ -94,8 +96,8 @@ func TestUserService_GetAll(t *testing.T) {
 			name: "успешное получение списка всех компаний",
 			beforeTest: func(userRepo mocks.MockIUserRepository) {
 				userRepo.EXPECT().
-					GetAll(context.Background(), 1).
-					Return([]*domain.User{
+					GetAll(context.Background(), domain.UserFilter{}, domain.PageRequest{}).
+					Return(&domain.Page[*domain.User]{Items: []*domain.User{
 						{
 							ID:       uuid.UUID{1},
 							Username: "a",
@@ -120,9 +122,9 @@ func TestUserService_GetAll(t *testing.T) {
 							Birthday: time.Date(3, 3, 3, 3, 3, 3, 3, time.Local),
 							City:     "c",
 						},
-					}, nil)
+					}}, nil)
 			},
-			expected: []*domain.User{
+			expected: &domain.Page[*domain.User]{Items: []*domain.User{
 				{
 					ID:       uuid.UUID{1},
 					Username: "a",
@@ -147,19 +149,53 @@ func TestUserService_GetAll(t *testing.T) {
 					Birthday: time.Date(3, 3, 3, 3, 3, 3, 3, time.Local),
 					City:     "c",
 				},
-			},
+			}},
 			wantErr: false,
 		},
 		{
 			name: "ошибка получения данных в репозитории",
 			beforeTest: func(userRepo mocks.MockIUserRepository) {
 				userRepo.EXPECT().
-					GetAll(context.Background(), 1).
+					GetAll(context.Background(), domain.UserFilter{}, domain.PageRequest{}).
 					Return(nil, fmt.Errorf("sql error"))
 			},
 			wantErr: true,
 			errStr:  errors.New("получение списка всех пользователей: sql error"),
 		},
+		{
+			name:   "фильтр по городу передается в репозиторий",
+			filter: domain.UserFilter{City: "Москва", MinAge: 18, MaxAge: 30},
+			beforeTest: func(userRepo mocks.MockIUserRepository) {
+				userRepo.EXPECT().
+					GetAll(context.Background(), domain.UserFilter{City: "Москва", MinAge: 18, MaxAge: 30}, domain.PageRequest{}).
+					Return(&domain.Page[*domain.User]{Items: []*domain.User{}}, nil)
+			},
+			expected: &domain.Page[*domain.User]{Items: []*domain.User{}},
+		},
+		{
+			name:    "перевернутый диапазон возраста",
+			filter:  domain.UserFilter{MinAge: 40, MaxAge: 30},
+			wantErr: true,
+			errStr:  errors.New("минимальный возраст больше максимального"),
+		},
+		{
+			name:    "отрицательный возраст",
+			filter:  domain.UserFilter{MinAge: -1},
+			wantErr: true,
+			errStr:  errors.New("возраст не может быть отрицательным"),
+		},
+		{
+			name:    "неизвестный пол",
+			filter:  domain.UserFilter{Gender: "x"},
+			wantErr: true,
+			errStr:  errors.New("неизвестный пол"),
+		},
+		{
+			name:    "слишком длинная строка поиска",
+			filter:  domain.UserFilter{Search: strings.Repeat("я", domain.MaxSearchLength+1)},
+			wantErr: true,
+			errStr:  errors.New("строка поиска длиннее 100 символов"),
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -167,7 +203,7 @@ func TestUserService_GetAll(t *testing.T) {
 				tc.beforeTest(*userRepo)
 			}
 
-			users, err := svc.GetAll(1)
+			users, err := svc.GetAll(context.Background(), tc.filter, domain.PageRequest{})
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -187,7 +223,7 @@ func TestUserService_Create(t *testing.T) {
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	svc := NewService(userRepo, compRepo, actFieldRepo, logger)
 
 	testCases := []struct {
@@ -370,7 +406,7 @@ func TestUserService_Create(t *testing.T) {
 				tc.beforeTest(*userRepo)
 			}
 
-			err := svc.Create(tc.user)
+			err := svc.Create(context.Background(), tc.user)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -389,7 +425,7 @@ func TestUserService_GetById(t *testing.T) {
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	svc := NewService(userRepo, compRepo, actFieldRepo, logger)
 
 	testCases := []struct {
@@ -449,7 +485,7 @@ func TestUserService_GetById(t *testing.T) {
 				tc.beforeTest(*userRepo)
 			}
 
-			user, err := svc.GetById(tc.id)
+			user, err := svc.GetById(context.Background(), tc.id)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -469,7 +505,7 @@ func TestUserService_Update(t *testing.T) {
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 	svc := NewService(userRepo, compRepo, actFieldRepo, logger)
 
 	testCases := []struct {
@@ -523,7 +559,7 @@ func TestUserService_Update(t *testing.T) {
 				tc.beforeTest(*userRepo)
 			}
 
-			err := svc.Update(tc.user)
+			err := svc.Update(context.Background(), tc.user)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
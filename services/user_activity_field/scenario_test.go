@@ -0,0 +1,588 @@
+package user_activity_field
+
+import (
+	"context"
+	"fmt"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/mocks"
+	"github.com/dlankinl/bmstu-ppo-bl/repository/memory"
+	"github.com/dlankinl/bmstu-ppo-bl/services/activity_field"
+	"github.com/dlankinl/bmstu-ppo-bl/services/company"
+	"github.com/dlankinl/bmstu-ppo-bl/services/fin_report"
+	"github.com/dlankinl/bmstu-ppo-bl/services/tax_schedule"
+	"github.com/dlankinl/bmstu-ppo-bl/services/user"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestInteractor_CalculateUserRating_Scenario(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+
+	userRepo := memory.NewUserRepository()
+	compRepo := memory.NewCompanyRepository()
+	actFieldRepo := memory.NewActivityFieldRepository()
+	finRepo := memory.NewFinancialReportRepository()
+
+	userSvc := user.NewService(userRepo, compRepo, actFieldRepo, logger)
+	actFieldSvc := activity_field.NewService(actFieldRepo, compRepo, logger)
+	compSvc := company.NewService(compRepo, logger)
+	finSvc := fin_report.NewService(finRepo, memory.NewTxManager(), newRates(t), domain.DefaultCurrency, logger)
+	taxSvc := tax_schedule.NewService(memory.NewTaxScheduleRepository(), domain.DefaultJurisdiction, domain.DefaultTaxSchedule(), logger)
+	interactor := NewInteractor(userSvc, actFieldSvc, compSvc, finSvc, taxSvc, newRates(t), DefaultLossCarryYears, logger)
+
+	ctx := context.Background()
+	prevYear := time.Now().AddDate(-1, 0, 0).Year()
+
+	owner := &domain.User{
+		Username: "ivan",
+		FullName: "Иванов Иван Иванович",
+		Gender:   "m",
+		Birthday: time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC),
+		City:     "Москва",
+	}
+	require.Nil(t, userSvc.Create(ctx, owner))
+
+	it := &domain.ActivityField{Name: "IT", Description: "информационные технологии", Cost: 5}
+	require.Nil(t, actFieldSvc.Create(ctx, it))
+	trade := &domain.ActivityField{Name: "Торговля", Description: "розничная торговля", Cost: 13.5}
+	require.Nil(t, actFieldSvc.Create(ctx, trade))
+
+	profitable := &domain.Company{OwnerID: owner.ID, ActivityFieldId: it.ID, Name: "a", City: "a"}
+	require.Nil(t, compSvc.Create(ctx, profitable))
+	other := &domain.Company{OwnerID: owner.ID, ActivityFieldId: trade.ID, Name: "b", City: "b"}
+	require.Nil(t, compSvc.Create(ctx, other))
+
+	reports := func(comp *domain.Company, revenue, costs [4]int64) *domain.FinancialReportByPeriod {
+		byPeriod := new(domain.FinancialReportByPeriod)
+		for i := range revenue {
+			byPeriod.Reports = append(byPeriod.Reports, domain.FinancialReport{
+				CompanyID: comp.ID,
+				Revenue:   rub(revenue[i]),
+				Costs:     rub(costs[i]),
+				Year:      prevYear,
+				Quarter:   i + 1,
+			})
+		}
+		return byPeriod
+	}
+	require.Nil(t, finSvc.CreateByPeriod(ctx, reports(profitable,
+		[4]int64{32532513, 6743634, 4675424, 14385253},
+		[4]int64{5436438, 9876967, 2436653, 7546424},
+	)))
+	require.Nil(t, finSvc.CreateByPeriod(ctx, reports(other,
+		[4]int64{3253251, 6743634, 4675412, 1438525},
+		[4]int64{543643, 9876967, 2436765, 754642},
+	)))
+
+	rating, err := interactor.CalculateUserRating(ctx, owner.ID)
+	require.Nil(t, err)
+
+	expected := (5.0/13.5 + float32(32532513+6743634+4675424+14385253+3253251+6743634+4675412+1438525-5436438-9876967-2436653-7546424-543643-9876967-2436765-754642)/float32(32532513+6743634+4675424+14385253+3253251+6743634+4675412+1438525)) / 2.0
+	require.InEpsilon(t, expected, rating, eps)
+
+	require.Nil(t, compSvc.DeleteById(ctx, profitable.ID))
+	require.Nil(t, compSvc.DeleteById(ctx, other.ID))
+	_, err = interactor.CalculateUserRating(ctx, owner.ID)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestInteractor_CalculateUserRating_ManyCompanies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+
+	userRepo := memory.NewUserRepository()
+	compRepo := memory.NewCompanyRepository()
+	actFieldRepo := memory.NewActivityFieldRepository()
+	finRepo := memory.NewFinancialReportRepository()
+
+	userSvc := user.NewService(userRepo, compRepo, actFieldRepo, logger)
+	actFieldSvc := activity_field.NewService(actFieldRepo, compRepo, logger)
+	compSvc := company.NewService(compRepo, logger)
+	finSvc := fin_report.NewService(finRepo, memory.NewTxManager(), newRates(t), domain.DefaultCurrency, logger)
+	taxSvc := tax_schedule.NewService(memory.NewTaxScheduleRepository(), domain.DefaultJurisdiction, domain.DefaultTaxSchedule(), logger)
+	interactor := NewInteractor(userSvc, actFieldSvc, compSvc, finSvc, taxSvc, newRates(t), DefaultLossCarryYears, logger)
+
+	ctx := context.Background()
+	prevYear := time.Now().AddDate(-1, 0, 0).Year()
+
+	owner := &domain.User{
+		Username: "ivan",
+		FullName: "Иванов Иван Иванович",
+		Gender:   "m",
+		Birthday: time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC),
+		City:     "Москва",
+	}
+	require.Nil(t, userSvc.Create(ctx, owner))
+	it := &domain.ActivityField{Name: "IT", Description: "информационные технологии", Cost: 5}
+	require.Nil(t, actFieldSvc.Create(ctx, it))
+
+	// единственная прибыльная компания оказывается за пределами первых страниц
+	var last *domain.Company
+	for i := 0; i < domain.MaxPageSize+5; i++ {
+		last = &domain.Company{OwnerID: owner.ID, ActivityFieldId: it.ID, Name: fmt.Sprintf("компания %03d", i), City: "Москва"}
+		require.Nil(t, compSvc.Create(ctx, last))
+	}
+
+	report := new(domain.FinancialReportByPeriod)
+	for quarter := 1; quarter <= 4; quarter++ {
+		report.Reports = append(report.Reports, domain.FinancialReport{
+			CompanyID: last.ID,
+			Revenue:   rub(1000),
+			Costs:     rub(400),
+			Year:      prevYear,
+			Quarter:   quarter,
+		})
+	}
+	require.Nil(t, finSvc.CreateByPeriod(ctx, report))
+
+	rating, err := interactor.CalculateUserRating(ctx, owner.ID)
+	require.Nil(t, err)
+	require.InEpsilon(t, float32(1+0.6)/2, rating, eps)
+
+	userReport, err := interactor.GetUserFinancialReport(ctx, owner.ID, &domain.Period{
+		StartYear:    prevYear,
+		EndYear:      prevYear,
+		StartQuarter: 1,
+		EndQuarter:   4,
+	})
+	require.Nil(t, err)
+	require.Equal(t, rub(4000), userReport.Revenue())
+}
+
+func TestInteractor_GetUserFinancialReport_TaxSchedules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+
+	userRepo := memory.NewUserRepository()
+	compRepo := memory.NewCompanyRepository()
+	actFieldRepo := memory.NewActivityFieldRepository()
+	finRepo := memory.NewFinancialReportRepository()
+
+	userSvc := user.NewService(userRepo, compRepo, actFieldRepo, logger)
+	actFieldSvc := activity_field.NewService(actFieldRepo, compRepo, logger)
+	compSvc := company.NewService(compRepo, logger)
+	finSvc := fin_report.NewService(finRepo, memory.NewTxManager(), newRates(t), domain.DefaultCurrency, logger)
+	taxSvc := tax_schedule.NewService(memory.NewTaxScheduleRepository(), "KZ", nil, logger)
+	interactor := NewInteractor(userSvc, actFieldSvc, compSvc, finSvc, taxSvc, newRates(t), DefaultLossCarryYears, logger)
+
+	ctx := context.Background()
+	year := time.Now().AddDate(-2, 0, 0).Year()
+
+	owner := &domain.User{
+		Username: "ivan",
+		FullName: "Иванов Иван Иванович",
+		Gender:   "m",
+		Birthday: time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC),
+		City:     "Москва",
+	}
+	require.Nil(t, userSvc.Create(ctx, owner))
+	it := &domain.ActivityField{Name: "IT", Description: "информационные технологии", Cost: 5}
+	require.Nil(t, actFieldSvc.Create(ctx, it))
+	trade := &domain.ActivityField{Name: "Торговля", Description: "розничная торговля", Cost: 2}
+	require.Nil(t, actFieldSvc.Create(ctx, trade))
+
+	itCompany := &domain.Company{OwnerID: owner.ID, ActivityFieldId: it.ID, Name: "a", City: "a"}
+	require.Nil(t, compSvc.Create(ctx, itCompany))
+	tradeCompany := &domain.Company{OwnerID: owner.ID, ActivityFieldId: trade.ID, Name: "b", City: "b"}
+	require.Nil(t, compSvc.Create(ctx, tradeCompany))
+
+	// прибыль каждой компании — 2 000 000 в год
+	for _, comp := range []*domain.Company{itCompany, tradeCompany} {
+		report := new(domain.FinancialReportByPeriod)
+		for _, y := range []int{year, year + 1} {
+			for quarter := 1; quarter <= 4; quarter++ {
+				report.Reports = append(report.Reports, domain.FinancialReport{
+					CompanyID: comp.ID,
+					Revenue:   rub(1000000),
+					Costs:     rub(500000),
+					Year:      y,
+					Quarter:   quarter,
+				})
+			}
+		}
+		require.Nil(t, finSvc.CreateByPeriod(ctx, report))
+	}
+
+	period := &domain.Period{StartYear: year, StartQuarter: 1, EndYear: year + 1, EndQuarter: 4}
+	_, err := interactor.GetUserFinancialReport(ctx, owner.ID, period)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+
+	schedules := []*domain.TaxSchedule{
+		{
+			Jurisdiction: "KZ",
+			Year:         year,
+			Method:       domain.TaxMethodFlat,
+			Brackets:     []domain.TaxBracket{{Threshold: rub(0), Rate: 10}},
+		},
+		{
+			Jurisdiction: "KZ",
+			Year:         year + 1,
+			Method:       domain.TaxMethodFlat,
+			Brackets:     []domain.TaxBracket{{Threshold: rub(0), Rate: 20}},
+		},
+		{
+			Jurisdiction:    "KZ",
+			ActivityFieldId: it.ID,
+			Year:            year + 1,
+			Method:          domain.TaxMethodMarginal,
+			Brackets:        []domain.TaxBracket{{Threshold: rub(0), Rate: 0}, {Threshold: rub(1000000), Rate: 5}},
+		},
+		{
+			Jurisdiction: "RU",
+			Year:         year,
+			Method:       domain.TaxMethodFlat,
+			Brackets:     []domain.TaxBracket{{Threshold: rub(0), Rate: 50}},
+		},
+	}
+	for _, schedule := range schedules {
+		require.Nil(t, taxSvc.Create(ctx, schedule))
+	}
+
+	report, err := interactor.GetUserFinancialReport(ctx, owner.ID, period)
+	require.Nil(t, err)
+
+	// первый год — общая шкала для обеих компаний, второй — льготная шкала IT и новая общая для торговли
+	expected := rub(2*200000 + 50000 + 400000)
+	require.Equal(t, expected, report.Taxes)
+	require.NotNil(t, report.TaxLoad)
+	require.InEpsilon(t, float32(expected.Float64()/16000000*100), *report.TaxLoad, eps)
+}
+
+func TestInteractor_Losses_Scenario(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+
+	userRepo := memory.NewUserRepository()
+	compRepo := memory.NewCompanyRepository()
+	actFieldRepo := memory.NewActivityFieldRepository()
+	finRepo := memory.NewFinancialReportRepository()
+
+	userSvc := user.NewService(userRepo, compRepo, actFieldRepo, logger)
+	actFieldSvc := activity_field.NewService(actFieldRepo, compRepo, logger)
+	compSvc := company.NewService(compRepo, logger)
+	finSvc := fin_report.NewService(finRepo, memory.NewTxManager(), newRates(t), domain.DefaultCurrency, logger)
+	taxSvc := tax_schedule.NewService(memory.NewTaxScheduleRepository(), domain.DefaultJurisdiction, domain.DefaultTaxSchedule(), logger)
+	interactor := NewInteractor(userSvc, actFieldSvc, compSvc, finSvc, taxSvc, newRates(t), DefaultLossCarryYears, logger)
+
+	ctx := context.Background()
+	prevYear := time.Now().AddDate(-1, 0, 0).Year()
+
+	owner := &domain.User{
+		Username: "ivan",
+		FullName: "Иванов Иван Иванович",
+		Gender:   "m",
+		Birthday: time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC),
+		City:     "Москва",
+	}
+	require.Nil(t, userSvc.Create(ctx, owner))
+	it := &domain.ActivityField{Name: "IT", Description: "информационные технологии", Cost: 5}
+	require.Nil(t, actFieldSvc.Create(ctx, it))
+	comp := &domain.Company{OwnerID: owner.ID, ActivityFieldId: it.ID, Name: "a", City: "a"}
+	require.Nil(t, compSvc.Create(ctx, comp))
+
+	yearly := func(revenue, costs int64) *domain.FinancialReportByPeriod {
+		report := new(domain.FinancialReportByPeriod)
+		for quarter := 1; quarter <= 4; quarter++ {
+			report.Reports = append(report.Reports, domain.FinancialReport{
+				CompanyID: comp.ID,
+				Revenue:   rub(revenue),
+				Costs:     rub(costs),
+				Year:      prevYear,
+				Quarter:   quarter,
+			})
+		}
+		return report
+	}
+
+	// за прошлый год нет ни выручки, ни расходов
+	_, err := interactor.CalculateUserRating(ctx, owner.ID)
+	require.ErrorIs(t, err, domain.ErrNotEnoughData)
+
+	halfYear := &domain.Period{StartYear: prevYear, StartQuarter: 1, EndYear: prevYear, EndQuarter: 2}
+	report, err := interactor.GetUserFinancialReport(ctx, owner.ID, halfYear)
+	require.Nil(t, err)
+	require.Zero(t, report.Taxes.Amount)
+	require.Nil(t, report.TaxLoad)
+
+	require.Nil(t, finSvc.CreateByPeriod(ctx, yearly(1000, 1500)))
+
+	report, err = interactor.GetUserFinancialReport(ctx, owner.ID, &domain.Period{
+		StartYear: prevYear, StartQuarter: 1, EndYear: prevYear, EndQuarter: 4,
+	})
+	require.Nil(t, err)
+	require.Zero(t, report.Taxes.Amount)
+	require.NotNil(t, report.TaxLoad)
+	require.Zero(t, *report.TaxLoad)
+
+	// рентабельность убыточной компании отрицательна: (5/5 - 0.5) / 2
+	rating, err := interactor.CalculateUserRating(ctx, owner.ID)
+	require.Nil(t, err)
+	require.InEpsilon(t, float32(0.25), rating, eps)
+}
+
+func TestInteractor_CarriedLoss_Scenario(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+
+	userRepo := memory.NewUserRepository()
+	compRepo := memory.NewCompanyRepository()
+	actFieldRepo := memory.NewActivityFieldRepository()
+	finRepo := memory.NewFinancialReportRepository()
+
+	userSvc := user.NewService(userRepo, compRepo, actFieldRepo, logger)
+	actFieldSvc := activity_field.NewService(actFieldRepo, compRepo, logger)
+	compSvc := company.NewService(compRepo, logger)
+	finSvc := fin_report.NewService(finRepo, memory.NewTxManager(), newRates(t), domain.DefaultCurrency, logger)
+	taxSvc := tax_schedule.NewService(memory.NewTaxScheduleRepository(), domain.DefaultJurisdiction, domain.DefaultTaxSchedule(), logger)
+	interactor := NewInteractor(userSvc, actFieldSvc, compSvc, finSvc, taxSvc, newRates(t), DefaultLossCarryYears, logger)
+
+	ctx := context.Background()
+
+	owner := &domain.User{
+		Username: "ivan",
+		FullName: "Иванов Иван Иванович",
+		Gender:   "m",
+		Birthday: time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC),
+		City:     "Москва",
+	}
+	require.Nil(t, userSvc.Create(ctx, owner))
+	it := &domain.ActivityField{Name: "IT", Description: "информационные технологии", Cost: 5}
+	require.Nil(t, actFieldSvc.Create(ctx, it))
+	comp := &domain.Company{OwnerID: owner.ID, ActivityFieldId: it.ID, Name: "a", City: "a"}
+	require.Nil(t, compSvc.Create(ctx, comp))
+
+	// убыток 2022 года 2 000 000 уменьшает прибыль 2023 года 3 000 000
+	for _, year := range []struct {
+		year          int
+		revenue, cost int64
+	}{{2022, 1000000, 1500000}, {2023, 1000000, 250000}} {
+		for quarter := 1; quarter <= 4; quarter++ {
+			require.Nil(t, finSvc.Create(ctx, &domain.FinancialReport{
+				CompanyID: comp.ID,
+				Revenue:   rub(year.revenue),
+				Costs:     rub(year.cost),
+				Year:      year.year,
+				Quarter:   quarter,
+			}))
+		}
+	}
+	expected, err := domain.DefaultTaxSchedule().Calculate(rub(1000000))
+	require.Nil(t, err)
+
+	// налог 2023 года не зависит от того, с какого года начинается период
+	for _, period := range []*domain.Period{
+		{StartYear: 2023, StartQuarter: 1, EndYear: 2023, EndQuarter: 4},
+		{StartYear: 2022, StartQuarter: 3, EndYear: 2023, EndQuarter: 4},
+		{StartYear: 2022, StartQuarter: 1, EndYear: 2023, EndQuarter: 4},
+	} {
+		report, err := interactor.GetUserFinancialReport(ctx, owner.ID, period)
+		require.Nil(t, err)
+		require.Equal(t, expected, report.Taxes, "%+v", period)
+	}
+
+	report, err := interactor.GetUserFinancialReport(ctx, owner.ID, &domain.Period{
+		StartYear: 2023, StartQuarter: 1, EndYear: 2023, EndQuarter: 4,
+	})
+	require.Nil(t, err)
+	// в отчет попадают только отчеты периода
+	require.Len(t, report.Reports, 1)
+	require.Equal(t, 2023, report.Reports[0].Year)
+}
+
+func TestInteractor_GetUserFinancialReport_Currencies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+
+	userRepo := memory.NewUserRepository()
+	compRepo := memory.NewCompanyRepository()
+	actFieldRepo := memory.NewActivityFieldRepository()
+	finRepo := memory.NewFinancialReportRepository()
+
+	userSvc := user.NewService(userRepo, compRepo, actFieldRepo, logger)
+	actFieldSvc := activity_field.NewService(actFieldRepo, compRepo, logger)
+	compSvc := company.NewService(compRepo, logger)
+	taxSvc := tax_schedule.NewService(memory.NewTaxScheduleRepository(), domain.DefaultJurisdiction, domain.DefaultTaxSchedule(), logger)
+	interactor := func(currency string) (*Interactor, domain.IFinancialReportService) {
+		finSvc := fin_report.NewService(finRepo, memory.NewTxManager(), newRates(t), currency, logger)
+		return NewInteractor(userSvc, actFieldSvc, compSvc, finSvc, taxSvc, newRates(t), DefaultLossCarryYears, logger), finSvc
+	}
+
+	ctx := context.Background()
+	owner := &domain.User{
+		Username: "ivan",
+		FullName: "Иванов Иван Иванович",
+		Gender:   "m",
+		Birthday: time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC),
+		City:     "Москва",
+	}
+	require.Nil(t, userSvc.Create(ctx, owner))
+
+	it := &domain.ActivityField{Name: "IT", Description: "информационные технологии", Cost: 5}
+	require.Nil(t, actFieldSvc.Create(ctx, it))
+
+	local := &domain.Company{OwnerID: owner.ID, ActivityFieldId: it.ID, Name: "a", City: "a"}
+	require.Nil(t, compSvc.Create(ctx, local))
+	foreign := &domain.Company{OwnerID: owner.ID, ActivityFieldId: it.ID, Name: "b", City: "b"}
+	require.Nil(t, compSvc.Create(ctx, foreign))
+
+	reports := func(comp *domain.Company, revenue, costs domain.Money) *domain.FinancialReportByPeriod {
+		byPeriod := new(domain.FinancialReportByPeriod)
+		for quarter := firstQuarter; quarter <= lastQuarter; quarter++ {
+			byPeriod.Reports = append(byPeriod.Reports, domain.FinancialReport{
+				CompanyID: comp.ID,
+				Revenue:   revenue,
+				Costs:     costs,
+				Year:      2023,
+				Quarter:   quarter,
+			})
+		}
+		return byPeriod
+	}
+	rubInteractor, finSvc := interactor(domain.DefaultCurrency)
+	require.Nil(t, finSvc.CreateByPeriod(ctx, reports(local, rub(1000000), rub(400000))))
+	require.Nil(t, finSvc.CreateByPeriod(ctx, reports(foreign, domain.NewMoney(10000, "USD"), domain.NewMoney(2000, "USD"))))
+
+	period := &domain.Period{StartYear: 2023, StartQuarter: firstQuarter, EndYear: 2023, EndQuarter: lastQuarter}
+
+	// прибыль в долларах меньше, но по курсам кварталов это 8000 * (75 + 80 + 90 + 100) = 2 760 000 рублей
+	company, err := rubInteractor.GetMostProfitableCompany(ctx, period, []*domain.Company{local, foreign})
+	require.Nil(t, err)
+	require.Equal(t, foreign.ID, company.ID)
+
+	report, err := rubInteractor.GetUserFinancialReport(ctx, owner.ID, period)
+	require.Nil(t, err)
+	require.Equal(t, domain.DefaultCurrency, report.Currency)
+	require.Equal(t, rub(4000000+3450000), report.Revenue())
+	require.Equal(t, rub(2400000+2760000), report.Profit())
+	require.Equal(t, rub(96000+110400), report.Taxes)
+
+	usdInteractor, _ := interactor("USD")
+	report, err = usdInteractor.GetUserFinancialReport(ctx, owner.ID, period)
+	require.Nil(t, err)
+	require.Equal(t, "USD", report.Currency)
+	// рублевые отчеты переводятся по курсу каждого квартала: 13 333.33 + 12 500 + 11 111.11 + 10 000
+	require.Equal(t, domain.Money{Amount: 4694444 + 4000000, Currency: "USD"}, report.Revenue())
+	// налоги считаются в рублях и пересчитываются по курсу IV квартала: (96 000 + 110 400) / 100
+	require.Equal(t, domain.NewMoney(2064, "USD"), report.Taxes)
+}
+
+func TestInteractor_GetUserFinancialReport_Granularities(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+
+	userRepo := memory.NewUserRepository()
+	compRepo := memory.NewCompanyRepository()
+	actFieldRepo := memory.NewActivityFieldRepository()
+	finRepo := memory.NewFinancialReportRepository()
+
+	userSvc := user.NewService(userRepo, compRepo, actFieldRepo, logger)
+	actFieldSvc := activity_field.NewService(actFieldRepo, compRepo, logger)
+	compSvc := company.NewService(compRepo, logger)
+	finSvc := fin_report.NewService(finRepo, memory.NewTxManager(), newRates(t), domain.DefaultCurrency, logger)
+	taxSvc := tax_schedule.NewService(memory.NewTaxScheduleRepository(), domain.DefaultJurisdiction, domain.DefaultTaxSchedule(), logger)
+	interactor := NewInteractor(userSvc, actFieldSvc, compSvc, finSvc, taxSvc, newRates(t), DefaultLossCarryYears, logger)
+
+	ctx := context.Background()
+	owner := &domain.User{
+		Username: "ivan",
+		FullName: "Иванов Иван Иванович",
+		Gender:   "m",
+		Birthday: time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC),
+		City:     "Москва",
+	}
+	require.Nil(t, userSvc.Create(ctx, owner))
+
+	it := &domain.ActivityField{Name: "IT", Description: "информационные технологии", Cost: 5}
+	require.Nil(t, actFieldSvc.Create(ctx, it))
+
+	monthly := &domain.Company{OwnerID: owner.ID, ActivityFieldId: it.ID, Name: "a", City: "a"}
+	require.Nil(t, compSvc.Create(ctx, monthly))
+	annual := &domain.Company{OwnerID: owner.ID, ActivityFieldId: it.ID, Name: "b", City: "b"}
+	require.Nil(t, compSvc.Create(ctx, annual))
+
+	months := new(domain.FinancialReportByPeriod)
+	for month := 1; month <= 12; month++ {
+		months.Reports = append(months.Reports, domain.FinancialReport{
+			CompanyID:   monthly.ID,
+			Revenue:     rub(100000),
+			Costs:       rub(50000),
+			Granularity: domain.GranularityMonth,
+			Year:        2023,
+			Month:       month,
+		})
+	}
+	require.Nil(t, finSvc.CreateByPeriod(ctx, months))
+	require.Nil(t, finSvc.Create(ctx, &domain.FinancialReport{
+		CompanyID:   annual.ID,
+		Revenue:     rub(1200000),
+		Costs:       rub(600000),
+		Granularity: domain.GranularityYear,
+		Year:        2023,
+	}))
+
+	// квартал уже покрыт месячными отчетами
+	err := finSvc.Create(ctx, &domain.FinancialReport{
+		CompanyID:   monthly.ID,
+		Revenue:     rub(300000),
+		Costs:       rub(150000),
+		Granularity: domain.GranularityQuarter,
+		Year:        2023,
+		Quarter:     1,
+	})
+	require.ErrorIs(t, err, domain.ErrConflict)
+
+	period := &domain.Period{StartYear: 2023, StartQuarter: firstQuarter, EndYear: 2023, EndQuarter: lastQuarter}
+
+	// месяцы сворачиваются в кварталы, а кварталы полного года — в год
+	byPeriod, err := finSvc.GetByCompany(ctx, monthly.ID, &domain.Period{StartYear: 2023, StartQuarter: 1, EndYear: 2023, EndQuarter: 2})
+	require.Nil(t, err)
+	require.Len(t, byPeriod.Reports, 2)
+	for i, rep := range byPeriod.Reports {
+		require.Equal(t, domain.GranularityQuarter, rep.Granularity)
+		require.Equal(t, i+1, rep.Quarter)
+		require.Equal(t, rub(300000), rep.Revenue)
+	}
+
+	byPeriod, err = finSvc.GetByCompany(ctx, monthly.ID, period)
+	require.Nil(t, err)
+	require.Len(t, byPeriod.Reports, 1)
+	require.Equal(t, domain.GranularityYear, byPeriod.Reports[0].Granularity)
+	require.Equal(t, rub(1200000), byPeriod.Reports[0].Revenue)
+
+	// годовой отчет не входит в период, который не покрывает весь год
+	byPeriod, err = finSvc.GetByCompany(ctx, annual.ID, &domain.Period{StartYear: 2023, StartQuarter: 1, EndYear: 2023, EndQuarter: 2})
+	require.Nil(t, err)
+	require.Empty(t, byPeriod.Reports)
+
+	// оба года полные: налог 4% с прибыли 600 000 рублей у каждой компании
+	report, err := interactor.GetUserFinancialReport(ctx, owner.ID, period)
+	require.Nil(t, err)
+	require.Equal(t, rub(2400000), report.Revenue())
+	require.Equal(t, rub(1200000), report.Profit())
+	require.Equal(t, rub(24000+24000), report.Taxes)
+}
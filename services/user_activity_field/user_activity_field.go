@@ -1,24 +1,33 @@
 package user_activity_field
 
 import (
-	"fmt"
+	"context"
+	"errors"
 	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
 	"github.com/dlankinl/bmstu-ppo-bl/pkg/logger"
 	"github.com/google/uuid"
+	"sort"
 	"time"
 )
 
 const (
-	quartersInYear = 4
-	firstQuarter   = 1
-	lastQuarter    = 4
+	monthsInYear = 12
+	firstQuarter = 1
+	lastQuarter  = 4
 )
 
+// DefaultLossCarryYears — число лет перед периодом отчета, убыток которых переносится на налоги периода.
+const DefaultLossCarryYears = 10
+
 type Interactor struct {
 	userService     domain.IUserService
 	actFieldService domain.IActivityFieldService
 	compService     domain.ICompanyService
 	finService      domain.IFinancialReportService
+	taxService      domain.ITaxScheduleService
+	rates           domain.IExchangeRateProvider
+	lossCarryYears  int
 	logger          logger.ILogger
 }
 
@@ -27,6 +36,9 @@ func NewInteractor(
 	actFieldSvc domain.IActivityFieldService,
 	compSvc domain.ICompanyService,
 	finSvc domain.IFinancialReportService,
+	taxSvc domain.ITaxScheduleService,
+	rates domain.IExchangeRateProvider,
+	lossCarryYears int,
 	logger logger.ILogger,
 ) *Interactor {
 	return &Interactor{
@@ -34,98 +46,245 @@ func NewInteractor(
 		actFieldService: actFieldSvc,
 		compService:     compSvc,
 		finService:      finSvc,
+		taxService:      taxSvc,
+		rates:           rates,
+		lossCarryYears:  lossCarryYears,
 		logger:          logger,
 	}
 }
 
 type taxesData struct {
-	taxes   float32
-	revenue float32
+	taxes   domain.Money
+	revenue domain.Money
+}
+
+// scheduleLookup возвращает шкалу налогообложения, действующую в году year.
+type scheduleLookup func(year int) (*domain.TaxSchedule, error)
+
+// converter переводит сумму в валюту currency по курсу квартала quarter года year.
+type converter func(amount domain.Money, currency string, year, quarter int) (domain.Money, error)
+
+// fullYears возвращает по возрастанию годы, отчеты которых покрывают все месяцы года. Убыток
+// переносится только между полными годами, неполные годы в расчете не участвуют.
+func fullYears(reports map[int]*domain.FinancialReportByPeriod) []int {
+	years := make([]int, 0, len(reports))
+	for year, v := range reports {
+		if coveredMonths(v.Reports) == monthsInYear {
+			years = append(years, year)
+		}
+	}
+	sort.Ints(years)
+
+	return years
+}
+
+// taxBase возвращает прибыль отчетов в domain.DefaultCurrency, в которой заданы пороги шкал:
+// прибыль каждого отчета переводится по курсу последнего квартала его периода.
+func taxBase(reports []domain.FinancialReport, convert converter) (domain.Money, error) {
+	base := domain.Money{Currency: domain.DefaultCurrency}
+	for _, rep := range reports {
+		profit, err := convert(rep.Revenue.Sub(rep.Costs), domain.DefaultCurrency, rep.Year, rep.LastQuarter())
+		if err != nil {
+			return domain.Money{}, err
+		}
+		base = base.Add(profit)
+	}
+
+	return base, nil
+}
+
+// offsetLoss уменьшает налоговую базу года на накопленный убыток и возвращает облагаемую базу и
+// убыток, который переносится на следующие годы. Убыток года не облагается и добавляется к
+// накопленному.
+func offsetLoss(base, carriedLoss domain.Money) (taxable, carried domain.Money) {
+	if base.Sign() <= 0 {
+		return domain.Money{Currency: base.Currency}, carriedLoss.Sub(base)
+	}
+
+	offset := carriedLoss
+	if base.Cmp(offset) < 0 {
+		offset = base
+	}
+
+	return base.Sub(offset), carriedLoss.Sub(offset)
+}
+
+// hasLoss сообщает, есть ли среди отчетов убыточные.
+func hasLoss(reports []domain.FinancialReport) bool {
+	for _, rep := range reports {
+		if rep.Revenue.Sub(rep.Costs).Sign() < 0 {
+			return true
+		}
+	}
+
+	return false
 }
 
-func calculateTaxes(reports map[int]*domain.FinancialReportByPeriod) (taxes *taxesData) {
+// carriedLoss возвращает убыток полных лет, который остается непогашенным к концу последнего из них.
+// Отчеты передаются в валютах загрузки: год без убыточных отчетов, на который не перенесен убыток,
+// не меняет результат, поэтому его суммы не переводятся и курсы за него не нужны.
+func carriedLoss(reports map[int]*domain.FinancialReportByPeriod, convert converter) (domain.Money, error) {
+	carried := domain.Money{Currency: domain.DefaultCurrency}
+	for _, year := range fullYears(reports) {
+		v := reports[year]
+		if carried.Sign() == 0 && !hasLoss(v.Reports) {
+			continue
+		}
+
+		base, err := taxBase(v.Reports, convert)
+		if err != nil {
+			return domain.Money{}, err
+		}
+		_, carried = offsetLoss(base, carried)
+	}
+
+	return carried, nil
+}
+
+// calculateTaxes считает налоги компании за полные годы в порядке их следования. Убыток года
+// не облагается и переносится на следующие годы: налоговая база прибыльного года уменьшается
+// на накопленный убыток, неиспользованный остаток переносится дальше. Расчет начинается
+// с убытка lossBefore, перенесенного из лет до первого года отчетов.
+//
+// Налоговая база считается в domain.DefaultCurrency, см. taxBase. Налог года переводится в валюту
+// отчетов по курсу последнего квартала, когда он начисляется.
+func calculateTaxes(reports map[int]*domain.FinancialReportByPeriod, lossBefore domain.Money, scheduleFor scheduleLookup, convert converter) (
+	taxes *taxesData, err error) {
 	taxes = new(taxesData)
 
-	for _, v := range reports {
-		if len(v.Reports) == quartersInYear {
-			totalProfit := v.Profit()
-			var taxFare int
-			switch true {
-			case totalProfit < 10000000:
-				taxFare = 4
-			case totalProfit < 50000000:
-				taxFare = 7
-			case totalProfit < 150000000:
-				taxFare = 13
-			case totalProfit < 500000000:
-				taxFare = 20
-			default:
-				taxFare = 30
+	carried := lossBefore
+	for _, year := range fullYears(reports) {
+		v := reports[year]
+
+		base, err := taxBase(v.Reports, convert)
+		if err != nil {
+			return nil, err
+		}
+		base, carried = offsetLoss(base, carried)
+
+		v.Taxes = domain.Money{Currency: v.Currency}
+		if base.Sign() > 0 {
+			schedule, err := scheduleFor(year)
+			if err != nil {
+				return nil, err
 			}
 
-			v.Taxes = totalProfit * (float32(taxFare) / 100)
+			due, err := schedule.Calculate(base)
+			if err != nil {
+				return nil, err
+			}
 
-			taxes.taxes += v.Taxes
-			taxes.revenue += v.Revenue()
+			v.Taxes, err = convert(due, v.Currency, year, lastQuarter)
+			if err != nil {
+				return nil, err
+			}
 		}
+
+		taxes.taxes = taxes.taxes.Add(v.Taxes)
+		taxes.revenue = taxes.revenue.Add(v.Revenue())
 	}
 
-	return taxes
+	return taxes, nil
 }
 
-func findFullYearReports(rep *domain.FinancialReportByPeriod, period *domain.Period) (fullYearReports map[int]*domain.FinancialReportByPeriod) {
-	fullYearReports = make(map[int]*domain.FinancialReportByPeriod)
+type taxScheduleKey struct {
+	year            int
+	activityFieldId uuid.UUID
+}
 
-	var j int
-	for year := period.StartYear; year <= period.EndYear; year++ {
-		startQtr := firstQuarter
-		endQtr := lastQuarter
+// taxSchedules возвращает поиск действующих шкал, который запоминает найденные шкалы
+// на время построения одного отчета.
+func (i *Interactor) taxSchedules(ctx context.Context) func(year int, activityFieldId uuid.UUID) (*domain.TaxSchedule, error) {
+	found := make(map[taxScheduleKey]*domain.TaxSchedule)
 
-		if year == period.StartYear {
-			startQtr = period.StartQuarter
+	return func(year int, activityFieldId uuid.UUID) (*domain.TaxSchedule, error) {
+		key := taxScheduleKey{year: year, activityFieldId: activityFieldId}
+		if schedule, ok := found[key]; ok {
+			return schedule, nil
 		}
-		if year == period.EndYear {
-			endQtr = period.EndQuarter
+
+		schedule, err := i.taxService.GetInForce(ctx, year, activityFieldId)
+		if err != nil {
+			return nil, err
 		}
+		found[key] = schedule
 
-		var totalFinReport domain.FinancialReportByPeriod
-		for quarter := startQtr; quarter <= endQtr; quarter++ {
-			if j < len(rep.Reports) {
-				totalFinReport.Reports = append(totalFinReport.Reports, rep.Reports[j])
-				j++
-			}
+		return schedule, nil
+	}
+}
+
+// coveredMonths возвращает число месяцев, за которые есть отчеты. Сервис отчетов не допускает
+// пересекающихся периодов, поэтому месяцы разных отчетов не повторяются.
+func coveredMonths(reports []domain.FinancialReport) (months int) {
+	for i := range reports {
+		first, last := reports[i].Months()
+		months += last - first + 1
+	}
+
+	return months
+}
+
+// findFullYearReports группирует по годам отчеты лет, целиком входящих в период. Отчеты года
+// могут быть месячными, квартальными и годовыми; год без отчетов за часть месяцев не облагается
+// в calculateTaxes.
+func findFullYearReports(rep *domain.FinancialReportByPeriod, period *domain.Period) (fullYearReports map[int]*domain.FinancialReportByPeriod) {
+	fullYearReports = make(map[int]*domain.FinancialReportByPeriod)
+
+	for _, r := range rep.Reports {
+		if !period.Contains(&domain.FinancialReport{Granularity: domain.GranularityYear, Year: r.Year}) {
+			continue
 		}
 
-		if endQtr-startQtr == quartersInYear-1 {
-			per := &domain.Period{
-				StartYear:    year,
-				EndYear:      year,
-				StartQuarter: startQtr,
-				EndQuarter:   endQtr,
+		totalFinReport, ok := fullYearReports[r.Year]
+		if !ok {
+			totalFinReport = &domain.FinancialReportByPeriod{
+				Currency: rep.Currency,
+				Period: &domain.Period{
+					StartYear:    r.Year,
+					EndYear:      r.Year,
+					StartQuarter: firstQuarter,
+					EndQuarter:   lastQuarter,
+				},
 			}
-
-			totalFinReport.Period = per
-			fullYearReports[year] = &totalFinReport
+			fullYearReports[r.Year] = totalFinReport
 		}
+		totalFinReport.Reports = append(totalFinReport.Reports, r)
 	}
 
 	return fullYearReports
 }
 
-func calcRating(profit, revenue, cost, maxCost float32) float32 {
-	return (cost/maxCost + profit/revenue) / 2.0
+// calcRating усредняет относительный вес сферы деятельности и рентабельность.
+// Рентабельность убыточного бизнеса отрицательна и снижает рейтинг. Без выручки
+// рентабельность не определена, а без сфер деятельности с положительным весом
+// не определен относительный вес, в обоих случаях возвращается ErrNotEnoughData.
+func calcRating(profit, revenue domain.Money, cost, maxCost float32) (float32, error) {
+	if revenue.Sign() <= 0 {
+		return 0, domain.NewError(domain.ErrNotEnoughData, i18n.InteractorNoRevenue)
+	}
+
+	if maxCost <= 0 {
+		return 0, domain.NewError(domain.ErrNotEnoughData, i18n.InteractorNoActivityFieldCost)
+	}
+
+	return (cost/maxCost + float32(profit.Float64()/revenue.Float64())) / 2.0, nil
 }
 
-func (i *Interactor) GetMostProfitableCompany(period *domain.Period, companies []*domain.Company) (company *domain.Company, err error) {
-	var maxProfit float32
+func (i *Interactor) GetMostProfitableCompany(ctx context.Context, period *domain.Period, companies []*domain.Company) (company *domain.Company, err error) {
+	var maxProfit domain.Money
 
+	// самой прибыльной считается компания с наибольшей прибылью, даже если все компании убыточны
 	for _, comp := range companies {
-		rep, err := i.finService.GetByCompany(comp.ID, period)
+		if err = ctx.Err(); err != nil {
+			return nil, i18n.Wrap(err, i18n.InteractorMostProfitable)
+		}
+
+		rep, err := i.finService.GetByCompany(ctx, comp.ID, period)
 		if err != nil {
-			return nil, fmt.Errorf("получение отчета компании: %w", err)
+			return nil, i18n.Wrap(err, i18n.InteractorCompanyReport)
 		}
 
-		if rep.Profit() > maxProfit {
+		if company == nil || rep.Profit().Cmp(maxProfit) > 0 {
 			company = comp
 			maxProfit = rep.Profit()
 		}
@@ -134,10 +293,28 @@ func (i *Interactor) GetMostProfitableCompany(period *domain.Period, companies [
 	return company, nil
 }
 
-func (i *Interactor) CalculateUserRating(id uuid.UUID) (rating float32, err error) {
-	companies, err := i.compService.GetByOwnerId(id, 0)
+func (i *Interactor) ownerCompanies(ctx context.Context, id uuid.UUID) ([]*domain.Company, error) {
+	companies := make([]*domain.Company, 0)
+
+	req := domain.PageRequest{Limit: domain.MaxPageSize}
+	for {
+		page, err := i.compService.GetByOwnerId(ctx, id, req)
+		if err != nil {
+			return nil, err
+		}
+
+		companies = append(companies, page.Items...)
+		if page.NextCursor == "" {
+			return companies, nil
+		}
+		req.Cursor = page.NextCursor
+	}
+}
+
+func (i *Interactor) CalculateUserRating(ctx context.Context, id uuid.UUID) (rating float32, err error) {
+	companies, err := i.ownerCompanies(ctx, id)
 	if err != nil {
-		return 0, fmt.Errorf("получение списка компаний: %w", err)
+		return 0, i18n.Wrap(err, i18n.InteractorCompanies)
 	}
 
 	prevYear := time.Now().AddDate(-1, 0, 0).Year()
@@ -148,72 +325,139 @@ func (i *Interactor) CalculateUserRating(id uuid.UUID) (rating float32, err erro
 		EndQuarter:   lastQuarter,
 	}
 
-	report, err := i.GetUserFinancialReport(id, period)
+	report, err := i.GetUserFinancialReport(ctx, id, period)
 	if err != nil {
-		i.logger.Infof("получение финансового отчета пользователя: %v", err)
-		return 0, fmt.Errorf("получение финансового отчета пользователя: %w", err)
+		i.logger.Infof("%v: %v", i18n.InteractorUserReport, err)
+		return 0, i18n.Wrap(err, i18n.InteractorUserReport)
 	}
 
-	mostProfitableCompany, err := i.GetMostProfitableCompany(period, companies)
+	mostProfitableCompany, err := i.GetMostProfitableCompany(ctx, period, companies)
 	if err != nil {
-		i.logger.Infof("поиск наиболее прибыльной компании: %v", err)
-		return 0, fmt.Errorf("поиск наиболее прибыльной компании: %w", err)
+		i.logger.Infof("%v: %v", i18n.InteractorMostProfitable, err)
+		return 0, i18n.Wrap(err, i18n.InteractorMostProfitable)
 	}
 	if mostProfitableCompany == nil {
-		i.logger.Infof("у предпринимателя не найдены компании")
-		return 0, fmt.Errorf("у предпринимателя не найдены компании")
+		i.logger.Infof("%v", i18n.InteractorNoCompanies)
+		return 0, domain.NewError(domain.ErrNotFound, i18n.InteractorNoCompanies)
 	}
 
-	maxCost, err := i.actFieldService.GetMaxCost()
+	maxCost, err := i.actFieldService.GetMaxCost(ctx)
+	if errors.Is(err, domain.ErrNotFound) {
+		// каталог сфер деятельности пуст
+		i.logger.Infof("%v: %v", i18n.InteractorMaxCost, err)
+		return 0, domain.NewError(domain.ErrNotEnoughData, i18n.InteractorNoActivityFieldCost)
+	}
 	if err != nil {
-		i.logger.Infof("поиск максимального веса: %v", err)
-		return 0, fmt.Errorf("поиск максимального веса: %w", err)
+		i.logger.Infof("%v: %v", i18n.InteractorMaxCost, err)
+		return 0, i18n.Wrap(err, i18n.InteractorMaxCost)
 	}
 
-	cost, err := i.actFieldService.GetCostByCompanyId(mostProfitableCompany.ID)
+	cost, err := i.actFieldService.GetCostByCompanyId(ctx, mostProfitableCompany.ID)
 	if err != nil {
-		i.logger.Infof("получение веса сферы деятельности компании: %v", err)
-		return 0, fmt.Errorf("получение веса сферы деятельности компании: %w", err)
+		i.logger.Infof("%v: %v", i18n.InteractorCompanyCost, err)
+		return 0, i18n.Wrap(err, i18n.InteractorCompanyCost)
 	}
 
-	var totalRevenue, totalProfit float32
-	totalRevenue = report.Revenue()
-	totalProfit = report.Profit()
-
-	rating = calcRating(totalProfit, totalRevenue, cost, maxCost)
+	rating, err = calcRating(report.Profit(), report.Revenue(), cost, maxCost)
+	if err != nil {
+		i.logger.Infof("%v", err)
+		return 0, err
+	}
 
 	return rating, nil
 }
 
-func (i *Interactor) GetUserFinancialReport(id uuid.UUID, period *domain.Period) (report *domain.FinancialReportByPeriod, err error) {
+func (i *Interactor) GetUserFinancialReport(ctx context.Context, id uuid.UUID, period *domain.Period) (report *domain.FinancialReportByPeriod, err error) {
+	if !period.Valid() {
+		i.logger.Infof("%v", i18n.FinReportPeriodOrder)
+		return nil, domain.NewValidationError("period", i18n.FinReportPeriodOrder)
+	}
+
+	// убыток переносится из lossCarryYears лет перед первым полным годом периода, в отчет попадают
+	// только годы и отчеты самого периода
+	from := period.StartYear
+	if period.StartQuarter != firstQuarter {
+		from++
+	}
+	history := &domain.Period{
+		StartYear:    from - i.lossCarryYears,
+		StartQuarter: firstQuarter,
+		EndYear:      from - 1,
+		EndQuarter:   lastQuarter,
+	}
+
 	report = new(domain.FinancialReportByPeriod)
+	convert := func(amount domain.Money, currency string, year, quarter int) (domain.Money, error) {
+		converted, err := i.rates.Convert(ctx, amount, currency, year, quarter)
+		if err != nil {
+			return domain.Money{}, i18n.Wrap(err, i18n.InteractorTaxConvert)
+		}
+
+		return converted, nil
+	}
 
-	companies, err := i.compService.GetByOwnerId(id, 0)
+	companies, err := i.ownerCompanies(ctx, id)
 	if err != nil {
-		i.logger.Infof("получение списка компаний: %v", err)
-		return nil, fmt.Errorf("получение списка компаний: %w", err)
+		i.logger.Infof("%v: %v", i18n.InteractorCompanies, err)
+		return nil, i18n.Wrap(err, i18n.InteractorCompanies)
 	}
 
-	var revenueForTaxLoad float32
+	var revenueForTaxLoad domain.Money
+	scheduleFor := i.taxSchedules(ctx)
 	report.Reports = make([]domain.FinancialReport, 0)
 	for _, comp := range companies {
-		rep, err := i.finService.GetByCompany(comp.ID, period)
+		if err = ctx.Err(); err != nil {
+			i.logger.Infof("%v: %v", i18n.InteractorUserReportBuild, err)
+			return nil, i18n.Wrap(err, i18n.InteractorUserReportBuild)
+		}
+
+		// налоги считаются по несвернутым отчетам, чтобы прибыль каждого квартала переводилась по его курсу
+		rep, err := i.finService.GetDetailedByCompany(ctx, comp.ID, period)
 		if err != nil {
-			i.logger.Infof("получение отчета компании: %v", err)
-			return nil, fmt.Errorf("получение отчета компании: %w", err)
+			i.logger.Infof("%v: %v", i18n.InteractorCompanyReport, err)
+			return nil, i18n.Wrap(err, i18n.InteractorCompanyReport)
+		}
+
+		loss := domain.Money{Currency: domain.DefaultCurrency}
+		if i.lossCarryYears > 0 {
+			past, err := i.finService.GetUnconvertedByCompany(ctx, comp.ID, history)
+			if err != nil {
+				i.logger.Infof("%v: %v", i18n.InteractorCompanyReport, err)
+				return nil, i18n.Wrap(err, i18n.InteractorCompanyReport)
+			}
+
+			loss, err = carriedLoss(findFullYearReports(past, history), convert)
+			if err != nil {
+				i.logger.Infof("%v: %v", i18n.InteractorTaxes, err)
+				return nil, i18n.Wrap(err, i18n.InteractorTaxes)
+			}
 		}
 
-		fullYears := findFullYearReports(rep, period)
+		tax, err := calculateTaxes(findFullYearReports(rep, period), loss, func(year int) (*domain.TaxSchedule, error) {
+			schedule, err := scheduleFor(year, comp.ActivityFieldId)
+			if err != nil {
+				return nil, i18n.Wrap(err, i18n.InteractorTaxSchedule)
+			}
+
+			return schedule, nil
+		}, convert)
+		if err != nil {
+			i.logger.Infof("%v: %v", i18n.InteractorTaxes, err)
+			return nil, i18n.Wrap(err, i18n.InteractorTaxes)
+		}
 
-		tax := calculateTaxes(fullYears)
-		report.Taxes += tax.taxes
-		revenueForTaxLoad += tax.revenue
+		report.Currency = rep.Currency
+		report.Taxes = report.Taxes.Add(tax.taxes)
+		revenueForTaxLoad = revenueForTaxLoad.Add(tax.revenue)
 
-		report.Reports = append(report.Reports, rep.Reports...)
+		report.Reports = append(report.Reports, domain.RollUp(rep.Reports)...)
 	}
 
 	report.Period = period
-	report.TaxLoad = report.Taxes / revenueForTaxLoad * 100
+	if revenueForTaxLoad.Sign() > 0 {
+		taxLoad := float32(report.Taxes.Float64() / revenueForTaxLoad.Float64() * 100)
+		report.TaxLoad = &taxLoad
+	}
 
 	return report, nil
 }
@@ -2,13 +2,19 @@ package user_activity_field
 
 import (
 	"context"
+	"errors"
 	"github.com/dlankinl/bmstu-ppo-bl/domain"
 	"github.com/dlankinl/bmstu-ppo-bl/mocks"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
 	"github.com/dlankinl/bmstu-ppo-bl/services/activity_field"
 	"github.com/dlankinl/bmstu-ppo-bl/services/company"
+	"github.com/dlankinl/bmstu-ppo-bl/services/exchange_rate"
 	"github.com/dlankinl/bmstu-ppo-bl/services/fin_report"
+	"github.com/dlankinl/bmstu-ppo-bl/services/tax_schedule"
 	"github.com/dlankinl/bmstu-ppo-bl/services/user"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -26,14 +32,15 @@ func TestInteractor_CalculateUserRating(t *testing.T) {
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 
 	userSvc := user.NewService(userRepo, compRepo, actFieldRepo, logger)
 	actFieldSvc := activity_field.NewService(actFieldRepo, compRepo, logger)
 	compSvc := company.NewService(compRepo, logger)
-	finSvc := fin_report.NewService(finRepo, logger)
+	finSvc := fin_report.NewService(finRepo, newTxManager(ctrl), newRates(t), domain.DefaultCurrency, logger)
 
-	interactor := NewInteractor(userSvc, actFieldSvc, compSvc, finSvc, logger)
+	interactor := NewInteractor(userSvc, actFieldSvc, compSvc, finSvc, newTaxService(ctrl, logger), newRates(t), DefaultLossCarryYears, logger)
+	prevYear := time.Now().AddDate(-1, 0, 0).Year()
 
 	testCases := []struct {
 		name       string
@@ -53,9 +60,9 @@ func TestInteractor_CalculateUserRating(t *testing.T) {
 			userId: uuid.UUID{1},
 			beforeTest: func(userRepo mocks.MockIUserRepository, finRepo mocks.MockIFinancialReportRepository, compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
 				compRepo.EXPECT().
-					GetByOwnerId(context.Background(), uuid.UUID{1}, 0).
+					GetByOwnerId(context.Background(), uuid.UUID{1}, domain.PageRequest{Limit: domain.MaxPageSize}).
 					Return(
-						[]*domain.Company{
+						&domain.Page[*domain.Company]{Items: []*domain.Company{
 							{
 								ID:      uuid.UUID{1},
 								OwnerID: uuid.UUID{1},
@@ -68,7 +75,7 @@ func TestInteractor_CalculateUserRating(t *testing.T) {
 								Name:    "b",
 								City:    "b",
 							},
-						}, nil).AnyTimes()
+						}}, nil).AnyTimes()
 
 				compRepo.EXPECT().
 					GetById(
@@ -102,51 +109,47 @@ func TestInteractor_CalculateUserRating(t *testing.T) {
 					GetByCompany(
 						context.Background(),
 						uuid.UUID{1},
-						&domain.Period{
-							StartYear:    2023,
-							EndYear:      2023,
-							StartQuarter: 1,
-							EndQuarter:   4,
-						},
+						// отчеты запрашиваются и за прошлый год, и за историю для переноса убытков
+						gomock.Any(),
 					).
 					Return(&domain.FinancialReportByPeriod{
 						Reports: []domain.FinancialReport{
 							{
 								ID:        uuid.UUID{8},
-								Year:      2023,
+								Year:      prevYear,
 								Quarter:   1,
-								Revenue:   32532513,
-								Costs:     5436438,
+								Revenue:   rub(32532513),
+								Costs:     rub(5436438),
 								CompanyID: uuid.UUID{1},
 							},
 							{
 								ID:        uuid.UUID{9},
-								Year:      2023,
+								Year:      prevYear,
 								Quarter:   2,
-								Revenue:   6743634,
-								Costs:     9876967,
+								Revenue:   rub(6743634),
+								Costs:     rub(9876967),
 								CompanyID: uuid.UUID{1},
 							},
 							{
 								ID:        uuid.UUID{10},
-								Year:      2023,
+								Year:      prevYear,
 								Quarter:   3,
-								Revenue:   4675424,
-								Costs:     2436653,
+								Revenue:   rub(4675424),
+								Costs:     rub(2436653),
 								CompanyID: uuid.UUID{1},
 							},
 							{
 								ID:        uuid.UUID{11},
-								Year:      2023,
+								Year:      prevYear,
 								Quarter:   4,
-								Revenue:   14385253,
-								Costs:     7546424,
+								Revenue:   rub(14385253),
+								Costs:     rub(7546424),
 								CompanyID: uuid.UUID{1},
 							},
 						},
 						Period: &domain.Period{
-							StartYear:    2023,
-							EndYear:      2023,
+							StartYear:    prevYear,
+							EndYear:      prevYear,
 							StartQuarter: 1,
 							EndQuarter:   4,
 						},
@@ -156,51 +159,46 @@ func TestInteractor_CalculateUserRating(t *testing.T) {
 					GetByCompany(
 						context.Background(),
 						uuid.UUID{2},
-						&domain.Period{
-							StartYear:    2023,
-							EndYear:      2023,
-							StartQuarter: 1,
-							EndQuarter:   4,
-						},
+						gomock.Any(),
 					).
 					Return(&domain.FinancialReportByPeriod{
 						Reports: []domain.FinancialReport{
 							{
 								ID:        uuid.UUID{8},
-								Year:      2023,
+								Year:      prevYear,
 								Quarter:   1,
-								Revenue:   3253251,
-								Costs:     543643,
+								Revenue:   rub(3253251),
+								Costs:     rub(543643),
 								CompanyID: uuid.UUID{2},
 							},
 							{
 								ID:        uuid.UUID{9},
-								Year:      2023,
+								Year:      prevYear,
 								Quarter:   2,
-								Revenue:   6743634,
-								Costs:     9876967,
+								Revenue:   rub(6743634),
+								Costs:     rub(9876967),
 								CompanyID: uuid.UUID{2},
 							},
 							{
 								ID:        uuid.UUID{10},
-								Year:      2023,
+								Year:      prevYear,
 								Quarter:   3,
-								Revenue:   4675412,
-								Costs:     2436765,
+								Revenue:   rub(4675412),
+								Costs:     rub(2436765),
 								CompanyID: uuid.UUID{2},
 							},
 							{
 								ID:        uuid.UUID{11},
-								Year:      2023,
+								Year:      prevYear,
 								Quarter:   4,
-								Revenue:   1438525,
-								Costs:     754642,
+								Revenue:   rub(1438525),
+								Costs:     rub(754642),
 								CompanyID: uuid.UUID{2},
 							},
 						},
 						Period: &domain.Period{
-							StartYear:    2023,
-							EndYear:      2023,
+							StartYear:    prevYear,
+							EndYear:      prevYear,
 							StartQuarter: 1,
 							EndQuarter:   4,
 						},
@@ -215,7 +213,7 @@ func TestInteractor_CalculateUserRating(t *testing.T) {
 				tc.beforeTest(*userRepo, *finRepo, *compRepo, *actFieldRepo)
 			}
 
-			val, err := interactor.CalculateUserRating(tc.userId)
+			val, err := interactor.CalculateUserRating(context.Background(), tc.userId)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -236,14 +234,14 @@ func TestInteractor_GetMostProfitableCompany(t *testing.T) {
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 
 	userSvc := user.NewService(userRepo, compRepo, actFieldRepo, logger)
 	actFieldSvc := activity_field.NewService(actFieldRepo, compRepo, logger)
 	compSvc := company.NewService(compRepo, logger)
-	finSvc := fin_report.NewService(finRepo, logger)
+	finSvc := fin_report.NewService(finRepo, newTxManager(ctrl), newRates(t), domain.DefaultCurrency, logger)
 
-	interactor := NewInteractor(userSvc, actFieldSvc, compSvc, finSvc, logger)
+	interactor := NewInteractor(userSvc, actFieldSvc, compSvc, finSvc, newTaxService(ctrl, logger), newRates(t), DefaultLossCarryYears, logger)
 
 	testCases := []struct {
 		name       string
@@ -287,32 +285,32 @@ func TestInteractor_GetMostProfitableCompany(t *testing.T) {
 							{
 								ID:        uuid.UUID{1},
 								CompanyID: uuid.UUID{1},
-								Revenue:   100,
-								Costs:     50,
+								Revenue:   rub(100),
+								Costs:     rub(50),
 								Year:      2023,
 								Quarter:   1,
 							},
 							{
 								ID:        uuid.UUID{2},
 								CompanyID: uuid.UUID{1},
-								Revenue:   100,
-								Costs:     50,
+								Revenue:   rub(100),
+								Costs:     rub(50),
 								Year:      2023,
 								Quarter:   2,
 							},
 							{
 								ID:        uuid.UUID{3},
 								CompanyID: uuid.UUID{1},
-								Revenue:   100,
-								Costs:     50,
+								Revenue:   rub(100),
+								Costs:     rub(50),
 								Year:      2023,
 								Quarter:   3,
 							},
 							{
 								ID:        uuid.UUID{4},
 								CompanyID: uuid.UUID{1},
-								Revenue:   100,
-								Costs:     50,
+								Revenue:   rub(100),
+								Costs:     rub(50),
 								Year:      2023,
 								Quarter:   4,
 							},
@@ -335,32 +333,32 @@ func TestInteractor_GetMostProfitableCompany(t *testing.T) {
 							{
 								ID:        uuid.UUID{5},
 								CompanyID: uuid.UUID{2},
-								Revenue:   75,
-								Costs:     50,
+								Revenue:   rub(75),
+								Costs:     rub(50),
 								Year:      2023,
 								Quarter:   1,
 							},
 							{
 								ID:        uuid.UUID{6},
 								CompanyID: uuid.UUID{2},
-								Revenue:   75,
-								Costs:     50,
+								Revenue:   rub(75),
+								Costs:     rub(50),
 								Year:      2023,
 								Quarter:   2,
 							},
 							{
 								ID:        uuid.UUID{7},
 								CompanyID: uuid.UUID{2},
-								Revenue:   75,
-								Costs:     50,
+								Revenue:   rub(75),
+								Costs:     rub(50),
 								Year:      2023,
 								Quarter:   3,
 							},
 							{
 								ID:        uuid.UUID{8},
 								CompanyID: uuid.UUID{2},
-								Revenue:   75,
-								Costs:     50,
+								Revenue:   rub(75),
+								Costs:     rub(50),
 								Year:      2023,
 								Quarter:   4,
 							},
@@ -372,6 +370,29 @@ func TestInteractor_GetMostProfitableCompany(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:   "все компании убыточны",
+			period: &domain.Period{StartYear: 2023, EndYear: 2023, StartQuarter: 1, EndQuarter: 4},
+			companies: []*domain.Company{
+				{ID: uuid.UUID{3}},
+				{ID: uuid.UUID{4}},
+			},
+			beforeTest: func(finRepo mocks.MockIFinancialReportRepository) {
+				finRepo.EXPECT().
+					GetByCompany(context.Background(), uuid.UUID{3}, gomock.Any()).
+					Return(fullYearReport(2023, 100, 300), nil)
+				finRepo.EXPECT().
+					GetByCompany(context.Background(), uuid.UUID{4}, gomock.Any()).
+					Return(fullYearReport(2023, 100, 150), nil)
+			},
+			expected: &domain.Company{ID: uuid.UUID{4}},
+		},
+		{
+			name:      "нет компаний",
+			period:    &domain.Period{StartYear: 2023, EndYear: 2023, StartQuarter: 1, EndQuarter: 4},
+			companies: []*domain.Company{},
+			expected:  nil,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -379,7 +400,7 @@ func TestInteractor_GetMostProfitableCompany(t *testing.T) {
 				tc.beforeTest(*finRepo)
 			}
 
-			company, err := interactor.GetMostProfitableCompany(tc.period, tc.companies)
+			company, err := interactor.GetMostProfitableCompany(context.Background(), tc.period, tc.companies)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -400,14 +421,14 @@ func TestInteractor_GetUserFinancialReport(t *testing.T) {
 	compRepo := mocks.NewMockICompanyRepository(ctrl)
 	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
 
 	userSvc := user.NewService(userRepo, compRepo, actFieldRepo, logger)
 	actFieldSvc := activity_field.NewService(actFieldRepo, compRepo, logger)
 	compSvc := company.NewService(compRepo, logger)
-	finSvc := fin_report.NewService(finRepo, logger)
+	finSvc := fin_report.NewService(finRepo, newTxManager(ctrl), newRates(t), domain.DefaultCurrency, logger)
 
-	interactor := NewInteractor(userSvc, actFieldSvc, compSvc, finSvc, logger)
+	interactor := NewInteractor(userSvc, actFieldSvc, compSvc, finSvc, newTaxService(ctrl, logger), newRates(t), DefaultLossCarryYears, logger)
 
 	testCases := []struct {
 		name       string
@@ -428,9 +449,9 @@ func TestInteractor_GetUserFinancialReport(t *testing.T) {
 			userId: uuid.UUID{1},
 			beforeTest: func(userRepo mocks.MockIUserRepository, finRepo mocks.MockIFinancialReportRepository, compRepo mocks.MockICompanyRepository, actFieldRepo mocks.MockIActivityFieldRepository) {
 				compRepo.EXPECT().
-					GetByOwnerId(context.Background(), uuid.UUID{1}, 0).
+					GetByOwnerId(context.Background(), uuid.UUID{1}, domain.PageRequest{Limit: domain.MaxPageSize}).
 					Return(
-						[]*domain.Company{
+						&domain.Page[*domain.Company]{Items: []*domain.Company{
 							{
 								ID:      uuid.UUID{1},
 								OwnerID: uuid.UUID{1},
@@ -443,7 +464,22 @@ func TestInteractor_GetUserFinancialReport(t *testing.T) {
 								Name:    "b",
 								City:    "b",
 							},
-						}, nil)
+						}}, nil)
+
+				// убыток переносится из отчетов десяти лет до периода
+				finRepo.EXPECT().
+					GetByCompany(
+						context.Background(),
+						gomock.Any(),
+						&domain.Period{
+							StartYear:    2013,
+							EndYear:      2022,
+							StartQuarter: 1,
+							EndQuarter:   4,
+						},
+					).
+					Return(&domain.FinancialReportByPeriod{}, nil).
+					Times(2)
 
 				finRepo.EXPECT().
 					GetByCompany(
@@ -461,40 +497,40 @@ func TestInteractor_GetUserFinancialReport(t *testing.T) {
 							{
 								ID:        uuid.UUID{1},
 								CompanyID: uuid.UUID{1},
-								Revenue:   100,
-								Costs:     50,
+								Revenue:   rub(100),
+								Costs:     rub(50),
 								Year:      2023,
 								Quarter:   1,
 							},
 							{
 								ID:        uuid.UUID{2},
 								CompanyID: uuid.UUID{1},
-								Revenue:   100,
-								Costs:     50,
+								Revenue:   rub(100),
+								Costs:     rub(50),
 								Year:      2023,
 								Quarter:   2,
 							},
 							{
 								ID:        uuid.UUID{3},
 								CompanyID: uuid.UUID{1},
-								Revenue:   100,
-								Costs:     50,
+								Revenue:   rub(100),
+								Costs:     rub(50),
 								Year:      2023,
 								Quarter:   3,
 							},
 							{
 								ID:        uuid.UUID{4},
 								CompanyID: uuid.UUID{1},
-								Revenue:   100,
-								Costs:     50,
+								Revenue:   rub(100),
+								Costs:     rub(50),
 								Year:      2023,
 								Quarter:   4,
 							},
 							{
 								ID:        uuid.UUID{5},
 								CompanyID: uuid.UUID{1},
-								Revenue:   100,
-								Costs:     50,
+								Revenue:   rub(100),
+								Costs:     rub(50),
 								Year:      2024,
 								Quarter:   1,
 							},
@@ -523,40 +559,40 @@ func TestInteractor_GetUserFinancialReport(t *testing.T) {
 							{
 								ID:        uuid.UUID{6},
 								CompanyID: uuid.UUID{2},
-								Revenue:   75,
-								Costs:     50,
+								Revenue:   rub(75),
+								Costs:     rub(50),
 								Year:      2023,
 								Quarter:   1,
 							},
 							{
 								ID:        uuid.UUID{7},
 								CompanyID: uuid.UUID{2},
-								Revenue:   75,
-								Costs:     50,
+								Revenue:   rub(75),
+								Costs:     rub(50),
 								Year:      2023,
 								Quarter:   2,
 							},
 							{
 								ID:        uuid.UUID{8},
 								CompanyID: uuid.UUID{2},
-								Revenue:   75,
-								Costs:     50,
+								Revenue:   rub(75),
+								Costs:     rub(50),
 								Year:      2023,
 								Quarter:   3,
 							},
 							{
 								ID:        uuid.UUID{9},
 								CompanyID: uuid.UUID{2},
-								Revenue:   75,
-								Costs:     50,
+								Revenue:   rub(75),
+								Costs:     rub(50),
 								Year:      2023,
 								Quarter:   4,
 							},
 							{
 								ID:        uuid.UUID{10},
 								CompanyID: uuid.UUID{2},
-								Revenue:   75,
-								Costs:     50,
+								Revenue:   rub(75),
+								Costs:     rub(50),
 								Year:      2024,
 								Quarter:   1,
 							},
@@ -576,84 +612,35 @@ func TestInteractor_GetUserFinancialReport(t *testing.T) {
 				EndQuarter:   1,
 			},
 			expected: &domain.FinancialReportByPeriod{
+				// кварталы полного 2023 года выводятся одним годовым отчетом
 				Reports: []domain.FinancialReport{
 					{
-						ID:        uuid.UUID{1},
-						CompanyID: uuid.UUID{1},
-						Revenue:   100,
-						Costs:     50,
-						Year:      2023,
-						Quarter:   1,
-					},
-					{
-						ID:        uuid.UUID{2},
-						CompanyID: uuid.UUID{1},
-						Revenue:   100,
-						Costs:     50,
-						Year:      2023,
-						Quarter:   2,
-					},
-					{
-						ID:        uuid.UUID{3},
-						CompanyID: uuid.UUID{1},
-						Revenue:   100,
-						Costs:     50,
-						Year:      2023,
-						Quarter:   3,
-					},
-					{
-						ID:        uuid.UUID{4},
-						CompanyID: uuid.UUID{1},
-						Revenue:   100,
-						Costs:     50,
-						Year:      2023,
-						Quarter:   4,
+						CompanyID:   uuid.UUID{1},
+						Revenue:     rub(400),
+						Costs:       rub(200),
+						Granularity: domain.GranularityYear,
+						Year:        2023,
 					},
 					{
 						ID:        uuid.UUID{5},
 						CompanyID: uuid.UUID{1},
-						Revenue:   100,
-						Costs:     50,
+						Revenue:   rub(100),
+						Costs:     rub(50),
 						Year:      2024,
 						Quarter:   1,
 					},
 					{
-						ID:        uuid.UUID{6},
-						CompanyID: uuid.UUID{2},
-						Revenue:   75,
-						Costs:     50,
-						Year:      2023,
-						Quarter:   1,
-					},
-					{
-						ID:        uuid.UUID{7},
-						CompanyID: uuid.UUID{2},
-						Revenue:   75,
-						Costs:     50,
-						Year:      2023,
-						Quarter:   2,
-					},
-					{
-						ID:        uuid.UUID{8},
-						CompanyID: uuid.UUID{2},
-						Revenue:   75,
-						Costs:     50,
-						Year:      2023,
-						Quarter:   3,
-					},
-					{
-						ID:        uuid.UUID{9},
-						CompanyID: uuid.UUID{2},
-						Revenue:   75,
-						Costs:     50,
-						Year:      2023,
-						Quarter:   4,
+						CompanyID:   uuid.UUID{2},
+						Revenue:     rub(300),
+						Costs:       rub(200),
+						Granularity: domain.GranularityYear,
+						Year:        2023,
 					},
 					{
 						ID:        uuid.UUID{10},
 						CompanyID: uuid.UUID{2},
-						Revenue:   75,
-						Costs:     50,
+						Revenue:   rub(75),
+						Costs:     rub(50),
 						Year:      2024,
 						Quarter:   1,
 					},
@@ -664,8 +651,8 @@ func TestInteractor_GetUserFinancialReport(t *testing.T) {
 					StartQuarter: 1,
 					EndQuarter:   1,
 				},
-				Taxes:   float32(((100 - 50) + (75 - 50)) * 4 * 0.04),
-				TaxLoad: float32((((100 - 50) + (75 - 50)) * 4 * 0.04) / ((100 + 75) * 4) * 100),
+				Taxes:   rub(((100 - 50) + (75 - 50)) * 4 * 4 / 100),
+				TaxLoad: percent((((100 - 50) + (75 - 50)) * 4 * 0.04) / ((100 + 75) * 4) * 100),
 			},
 			wantErr: false,
 		},
@@ -676,7 +663,7 @@ func TestInteractor_GetUserFinancialReport(t *testing.T) {
 				tc.beforeTest(*userRepo, *finRepo, *compRepo, *actFieldRepo)
 			}
 
-			report, err := interactor.GetUserFinancialReport(tc.userId, tc.period)
+			report, err := interactor.GetUserFinancialReport(context.Background(), tc.userId, tc.period)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -684,8 +671,9 @@ func TestInteractor_GetUserFinancialReport(t *testing.T) {
 				require.Nil(t, err)
 				require.Equal(t, tc.expected.Reports, report.Reports)
 				require.Equal(t, tc.expected.Period, report.Period)
-				require.InEpsilon(t, tc.expected.Taxes, report.Taxes, eps)
-				require.InEpsilon(t, tc.expected.TaxLoad, report.TaxLoad, eps)
+				require.Equal(t, tc.expected.Taxes, report.Taxes)
+				require.NotNil(t, report.TaxLoad)
+				require.InEpsilon(t, *tc.expected.TaxLoad, *report.TaxLoad, eps)
 			}
 		})
 	}
@@ -694,38 +682,83 @@ func TestInteractor_GetUserFinancialReport(t *testing.T) {
 func Test_calcRating(t *testing.T) {
 	testCases := []struct {
 		name     string
-		profit   float32
-		revenue  float32
+		profit   domain.Money
+		revenue  domain.Money
 		cost     float32
 		maxCost  float32
 		expected float32
+		wantErr  bool
+		errStr   error
 	}{
 		{
 			name:     "успешное вычисление",
-			profit:   100,
-			revenue:  1000,
+			profit:   rub(100),
+			revenue:  rub(1000),
 			cost:     5.0,
 			maxCost:  13.5,
 			expected: (5.0/13.5 + 100.0/1000.0) / 2.0,
 		},
+		{
+			name:     "убыток снижает рейтинг",
+			profit:   rub(-500),
+			revenue:  rub(1000),
+			cost:     5.0,
+			maxCost:  10,
+			expected: (0.5 - 0.5) / 2.0,
+		},
+		{
+			name:     "нулевая прибыль",
+			profit:   rub(0),
+			revenue:  rub(1000),
+			cost:     10,
+			maxCost:  10,
+			expected: 0.5,
+		},
+		{
+			name:    "нет выручки",
+			profit:  rub(-100),
+			revenue: rub(0),
+			cost:    5.0,
+			maxCost: 13.5,
+			wantErr: true,
+			errStr:  errors.New("за период нет выручки, рентабельность не определена"),
+		},
+		{
+			name:    "нет сфер деятельности с положительным весом",
+			profit:  rub(100),
+			revenue: rub(1000),
+			cost:    0,
+			maxCost: 0,
+			wantErr: true,
+			errStr:  errors.New("нет сфер деятельности с положительным весом"),
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			rating := calcRating(tc.profit, tc.revenue, tc.cost, tc.maxCost)
+			rating, err := calcRating(tc.profit, tc.revenue, tc.cost, tc.maxCost)
 
-			require.InEpsilon(t, tc.expected, rating, eps)
+			if tc.wantErr {
+				require.ErrorIs(t, err, domain.ErrNotEnoughData)
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+				require.InDelta(t, tc.expected, rating, eps)
+			}
 		})
 	}
 }
 
 func Test_calculateTaxes(t *testing.T) {
+	rates := newRates(t)
+
 	testCases := []struct {
-		name     string
-		reports  map[int]*domain.FinancialReportByPeriod
-		expected *taxesData
-		//expected float32
-		wantErr bool
-		errStr  error
+		name       string
+		reports    map[int]*domain.FinancialReportByPeriod
+		lossBefore domain.Money
+		schedules  map[int]*domain.TaxSchedule
+		expected   *taxesData
+		wantErr    bool
+		errStr     error
 	}{
 		{
 			name: "успешное вычисление",
@@ -735,24 +768,24 @@ func Test_calculateTaxes(t *testing.T) {
 						{
 							ID:        uuid.UUID{1},
 							CompanyID: uuid.UUID{1},
-							Revenue:   12432532,
-							Costs:     3213214,
+							Revenue:   rub(12432532),
+							Costs:     rub(3213214),
 							Year:      1,
 							Quarter:   2,
 						},
 						{
 							ID:        uuid.UUID{2},
 							CompanyID: uuid.UUID{1},
-							Revenue:   12432532,
-							Costs:     3213214,
+							Revenue:   rub(12432532),
+							Costs:     rub(3213214),
 							Year:      1,
 							Quarter:   3,
 						},
 						{
 							ID:        uuid.UUID{3},
 							CompanyID: uuid.UUID{1},
-							Revenue:   12432532,
-							Costs:     3213214,
+							Revenue:   rub(12432532),
+							Costs:     rub(3213214),
 							Year:      1,
 							Quarter:   4,
 						},
@@ -765,36 +798,37 @@ func Test_calculateTaxes(t *testing.T) {
 					},
 				},
 				2: {
+					Currency: domain.DefaultCurrency,
 					Reports: []domain.FinancialReport{
 						{
 							ID:        uuid.UUID{4},
 							CompanyID: uuid.UUID{1},
-							Revenue:   12432532,
-							Costs:     3213214,
+							Revenue:   rub(12432532),
+							Costs:     rub(3213214),
 							Year:      2,
 							Quarter:   1,
 						},
 						{
 							ID:        uuid.UUID{5},
 							CompanyID: uuid.UUID{1},
-							Revenue:   12432532,
-							Costs:     3213214,
+							Revenue:   rub(12432532),
+							Costs:     rub(3213214),
 							Year:      2,
 							Quarter:   2,
 						},
 						{
 							ID:        uuid.UUID{6},
 							CompanyID: uuid.UUID{1},
-							Revenue:   12432532,
-							Costs:     3213214,
+							Revenue:   rub(12432532),
+							Costs:     rub(3213214),
 							Year:      2,
 							Quarter:   3,
 						},
 						{
 							ID:        uuid.UUID{7},
 							CompanyID: uuid.UUID{1},
-							Revenue:   12432532,
-							Costs:     3213214,
+							Revenue:   rub(12432532),
+							Costs:     rub(3213214),
 							Year:      2,
 							Quarter:   4,
 						},
@@ -807,20 +841,383 @@ func Test_calculateTaxes(t *testing.T) {
 					},
 				},
 			},
+			schedules: map[int]*domain.TaxSchedule{
+				2: domain.DefaultTaxSchedule(),
+			},
+			expected: &taxesData{
+				// 36 877 272 * 7% = 2 581 409.04, доля рубля меньше половины отбрасывается
+				taxes:   rub(2581409),
+				revenue: rub(12432532 * 4),
+			},
+		},
+		{
+			name: "маржинальная шкала",
+			reports: map[int]*domain.FinancialReportByPeriod{
+				2: fullYearReport(2, 15000000, 2500000),
+			},
+			schedules: map[int]*domain.TaxSchedule{
+				2: marginalSchedule(domain.DefaultTaxSchedule()),
+			},
+			expected: &taxesData{
+				taxes:   rub(10000000*4/100 + 40000000*7/100),
+				revenue: rub(15000000 * 4),
+			},
+		},
+		{
+			name: "своя шкала для каждого года",
+			reports: map[int]*domain.FinancialReportByPeriod{
+				2021: fullYearReport(2021, 1000000, 500000),
+				2022: fullYearReport(2022, 1000000, 500000),
+			},
+			schedules: map[int]*domain.TaxSchedule{
+				2021: {Method: domain.TaxMethodFlat, Brackets: []domain.TaxBracket{{Threshold: rub(0), Rate: 6}}},
+				2022: {Method: domain.TaxMethodFlat, Brackets: []domain.TaxBracket{{Threshold: rub(0), Rate: 15}}},
+			},
+			expected: &taxesData{
+				taxes:   rub(2000000*6/100 + 2000000*15/100),
+				revenue: rub(1000000 * 8),
+			},
+		},
+		{
+			name: "убыток переносится на следующий год",
+			reports: map[int]*domain.FinancialReportByPeriod{
+				2021: fullYearReport(2021, 1000000, 1250000),
+				2022: fullYearReport(2022, 1000000, 500000),
+			},
+			schedules: map[int]*domain.TaxSchedule{
+				2022: {Method: domain.TaxMethodFlat, Brackets: []domain.TaxBracket{{Threshold: rub(0), Rate: 10}}},
+			},
+			expected: &taxesData{
+				taxes:   rub((2000000 - 1000000) / 10),
+				revenue: rub(1000000 * 8),
+			},
+		},
+		{
+			name: "остаток убытка переносится дальше",
+			reports: map[int]*domain.FinancialReportByPeriod{
+				2020: fullYearReport(2020, 1000000, 1750000),
+				2021: fullYearReport(2021, 1000000, 750000),
+				2022: fullYearReport(2022, 1000000, 500000),
+			},
+			schedules: map[int]*domain.TaxSchedule{
+				2021: {Method: domain.TaxMethodFlat, Brackets: []domain.TaxBracket{{Threshold: rub(0), Rate: 10}}},
+				2022: {Method: domain.TaxMethodFlat, Brackets: []domain.TaxBracket{{Threshold: rub(0), Rate: 10}}},
+			},
+			expected: &taxesData{
+				taxes:   rub((2000000 - (3000000 - 1000000)) / 10),
+				revenue: rub(1000000 * 12),
+			},
+		},
+		{
+			name: "убыток прошлых лет уменьшает базу",
+			reports: map[int]*domain.FinancialReportByPeriod{
+				2022: fullYearReport(2022, 1000000, 500000),
+			},
+			lossBefore: rub(1000000),
+			schedules: map[int]*domain.TaxSchedule{
+				2022: {Method: domain.TaxMethodFlat, Brackets: []domain.TaxBracket{{Threshold: rub(0), Rate: 10}}},
+			},
+			expected: &taxesData{
+				taxes:   rub((2000000 - 1000000) / 10),
+				revenue: rub(1000000 * 4),
+			},
+		},
+		{
+			name: "год без выручки",
+			reports: map[int]*domain.FinancialReportByPeriod{
+				2021: fullYearReport(2021, 0, 250000),
+				2022: fullYearReport(2022, 1000000, 500000),
+			},
+			schedules: map[int]*domain.TaxSchedule{
+				2022: {Method: domain.TaxMethodFlat, Brackets: []domain.TaxBracket{{Threshold: rub(0), Rate: 10}}},
+			},
+			expected: &taxesData{
+				taxes:   rub((2000000 - 1000000) / 10),
+				revenue: rub(1000000 * 4),
+			},
+		},
+		{
+			name: "убыток неполного года не учитывается",
+			reports: map[int]*domain.FinancialReportByPeriod{
+				2021: {Reports: fullYearReport(2021, 0, 1000000).Reports[:3]},
+				2022: fullYearReport(2022, 1000000, 500000),
+			},
+			schedules: map[int]*domain.TaxSchedule{
+				2022: {Method: domain.TaxMethodFlat, Brackets: []domain.TaxBracket{{Threshold: rub(0), Rate: 10}}},
+			},
+			expected: &taxesData{
+				taxes:   rub(2000000 / 10),
+				revenue: rub(1000000 * 4),
+			},
+		},
+		{
+			name: "прибыль в валюте переводится по курсу квартала",
+			reports: map[int]*domain.FinancialReportByPeriod{
+				2023: usd(fullYearReport(2023, 10000, 5000)),
+			},
+			schedules: map[int]*domain.TaxSchedule{
+				2023: {Method: domain.TaxMethodFlat, Brackets: []domain.TaxBracket{{Threshold: rub(0), Rate: 10}}},
+			},
 			expected: &taxesData{
-				taxes:   (12432532 - 3213214) * 4.0 * 0.07,
-				revenue: 12432532 * 4,
+				// база 5000 * (75 + 80 + 90 + 100) = 1 725 000 рублей, налог пересчитывается по курсу IV квартала
+				taxes:   domain.NewMoney(172500/100, "USD"),
+				revenue: domain.NewMoney(10000*4, "USD"),
+			},
+		},
+		{
+			name: "нет курса для квартала",
+			reports: map[int]*domain.FinancialReportByPeriod{
+				2022: usd(fullYearReport(2022, 10000, 5000)),
+			},
+			schedules: map[int]*domain.TaxSchedule{
+				2022: {Method: domain.TaxMethodFlat, Brackets: []domain.TaxBracket{{Threshold: rub(0), Rate: 10}}},
+			},
+			wantErr: true,
+			errStr:  errors.New("нет курса USD за 1 квартал 2022 года"),
+		},
+		{
+			name: "нет шкалы для года",
+			reports: map[int]*domain.FinancialReportByPeriod{
+				2021: fullYearReport(2021, 1000000, 500000),
+			},
+			schedules: map[int]*domain.TaxSchedule{},
+			wantErr:   true,
+			errStr:    errors.New("нет шкалы налогообложения, действующей в 2021 году"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tax, err := calculateTaxes(tc.reports, tc.lossBefore, func(year int) (*domain.TaxSchedule, error) {
+				schedule, ok := tc.schedules[year]
+				if !ok {
+					return nil, domain.NewError(domain.ErrNotFound, i18n.TaxScheduleNotInForce, year)
+				}
+				return schedule, nil
+			}, func(amount domain.Money, currency string, year, quarter int) (domain.Money, error) {
+				return rates.Convert(context.Background(), amount, currency, year, quarter)
+			})
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tc.expected, tax)
+			}
+		})
+	}
+}
+
+func Test_carriedLoss(t *testing.T) {
+	rates := newRates(t)
+
+	testCases := []struct {
+		name     string
+		reports  map[int]*domain.FinancialReportByPeriod
+		expected domain.Money
+		wantErr  bool
+		errStr   error
+	}{
+		{
+			name: "убыток гасится прибылью следующих лет",
+			reports: map[int]*domain.FinancialReportByPeriod{
+				2020: fullYearReport(2020, 1000000, 1500000),
+				2021: fullYearReport(2021, 1000000, 750000),
+			},
+			expected: rub(2000000 - 1000000),
+		},
+		{
+			name: "прибыль погашает весь убыток",
+			reports: map[int]*domain.FinancialReportByPeriod{
+				2020: fullYearReport(2020, 1000000, 1250000),
+				2021: fullYearReport(2021, 1000000, 500000),
+			},
+			expected: rub(0),
+		},
+		{
+			name: "прибыльный год без убытка не переводится по курсу",
+			reports: map[int]*domain.FinancialReportByPeriod{
+				2022: usd(fullYearReport(2022, 10000, 5000)),
+				2023: fullYearReport(2023, 1000000, 1250000),
+			},
+			expected: rub(1000000),
+		},
+		{
+			name: "прибыль в валюте гасит убыток по курсу квартала",
+			reports: map[int]*domain.FinancialReportByPeriod{
+				2022: fullYearReport(2022, 0, 1000000),
+				2023: usd(fullYearReport(2023, 10000, 5000)),
+			},
+			// 5000 * (75 + 80 + 90 + 100) = 1 725 000 рублей
+			expected: rub(4000000 - 1725000),
+		},
+		{
+			name: "убыток неполного года не учитывается",
+			reports: map[int]*domain.FinancialReportByPeriod{
+				2022: {Reports: fullYearReport(2022, 0, 1000000).Reports[:3]},
+			},
+			expected: rub(0),
+		},
+		{
+			name: "нет курса для убыточного года",
+			reports: map[int]*domain.FinancialReportByPeriod{
+				2022: usd(fullYearReport(2022, 5000, 10000)),
+			},
+			wantErr: true,
+			errStr:  errors.New("нет курса USD за 1 квартал 2022 года"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			loss, err := carriedLoss(tc.reports, func(amount domain.Money, currency string, year, quarter int) (domain.Money, error) {
+				return rates.Convert(context.Background(), amount, currency, year, quarter)
+			})
+
+			if tc.wantErr {
+				require.Equal(t, tc.errStr.Error(), err.Error())
+			} else {
+				require.Nil(t, err)
+				require.Zero(t, tc.expected.Cmp(loss))
+			}
+		})
+	}
+}
+
+func TestTaxSchedule_Calculate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		schedule *domain.TaxSchedule
+		profit   domain.Money
+		expected domain.Money
+	}{
+		{
+			name:     "плоская шкала, первая ступень",
+			schedule: domain.DefaultTaxSchedule(),
+			profit:   rub(9999999),
+			// 399 999.96 округляется до целого рубля
+			expected: rub(400000),
+		},
+		{
+			name:     "плоская шкала, ставка ступени на всю прибыль",
+			schedule: domain.DefaultTaxSchedule(),
+			profit:   rub(10000000),
+			expected: rub(10000000 * 7 / 100),
+		},
+		{
+			name:     "плоская шкала, последняя ступень",
+			schedule: domain.DefaultTaxSchedule(),
+			profit:   rub(600000000),
+			expected: rub(600000000 * 30 / 100),
+		},
+		{
+			name:     "маржинальная шкала, первая ступень",
+			schedule: marginalSchedule(domain.DefaultTaxSchedule()),
+			profit:   rub(5000000),
+			expected: rub(5000000 * 4 / 100),
+		},
+		{
+			name:     "маржинальная шкала, несколько ступеней",
+			schedule: marginalSchedule(domain.DefaultTaxSchedule()),
+			profit:   rub(200000000),
+			expected: rub(10000000*4/100 + 40000000*7/100 + 100000000*13/100 + 50000000*20/100),
+		},
+		{
+			name:     "маржинальная шкала, нулевая прибыль",
+			schedule: marginalSchedule(domain.DefaultTaxSchedule()),
+			profit:   rub(0),
+			expected: rub(0),
+		},
+		{
+			name:     "убыток не облагается",
+			schedule: domain.DefaultTaxSchedule(),
+			profit:   rub(-1000),
+			expected: rub(0),
+		},
+		{
+			name:     "доля рубля меньше половины отбрасывается",
+			schedule: domain.DefaultTaxSchedule(),
+			profit:   domain.Money{Amount: 1249, Currency: domain.DefaultCurrency},
+			expected: rub(0),
+		},
+		{
+			name:     "половина рубля округляется до целого",
+			schedule: domain.DefaultTaxSchedule(),
+			profit:   domain.Money{Amount: 1250, Currency: domain.DefaultCurrency},
+			expected: rub(1),
+		},
+		{
+			name: "дробная ставка и порог за пределами точности float32",
+			schedule: &domain.TaxSchedule{
+				Method:   domain.TaxMethodMarginal,
+				Brackets: []domain.TaxBracket{{Threshold: rub(0), Rate: 4}, {Threshold: rub(16777216), Rate: 6.5}},
 			},
+			// (16 777 216 * 4% + 0.30 * 6.5%) = 671 088.64 + 0.0195
+			profit:   domain.Money{Amount: 1677721630, Currency: domain.DefaultCurrency},
+			expected: rub(671089),
 		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			tax := calculateTaxes(tc.reports)
+			taxes, err := tc.schedule.Calculate(tc.profit)
+			require.Nil(t, err)
+			require.Equal(t, tc.expected, taxes)
+		})
+	}
+}
+
+func rub(units int64) domain.Money {
+	return domain.NewMoney(units, domain.DefaultCurrency)
+}
 
-			require.InEpsilon(t, tc.expected.taxes, tax.taxes, eps)
-			require.InEpsilon(t, tc.expected.revenue, tax.revenue, eps)
+// newRates возвращает таблицу курсов доллара за кварталы 2023 года.
+func newRates(t *testing.T) domain.IExchangeRateProvider {
+	rates, err := exchange_rate.NewTable([]domain.ExchangeRate{
+		{Currency: "USD", Year: 2023, Quarter: 1, Rate: big.NewRat(75, 1)},
+		{Currency: "USD", Year: 2023, Quarter: 2, Rate: big.NewRat(80, 1)},
+		{Currency: "USD", Year: 2023, Quarter: 3, Rate: big.NewRat(90, 1)},
+		{Currency: "USD", Year: 2023, Quarter: 4, Rate: big.NewRat(100, 1)},
+	})
+	require.Nil(t, err)
+
+	return rates
+}
+
+func percent(v float32) *float32 {
+	return &v
+}
+
+func marginalSchedule(schedule *domain.TaxSchedule) *domain.TaxSchedule {
+	schedule.Method = domain.TaxMethodMarginal
+	return schedule
+}
+
+func fullYearReport(year int, revenue, costs int64) *domain.FinancialReportByPeriod {
+	report := &domain.FinancialReportByPeriod{
+		Period:   &domain.Period{StartYear: year, StartQuarter: firstQuarter, EndYear: year, EndQuarter: lastQuarter},
+		Currency: domain.DefaultCurrency,
+	}
+	for quarter := firstQuarter; quarter <= lastQuarter; quarter++ {
+		report.Reports = append(report.Reports, domain.FinancialReport{
+			ID:        uuid.New(),
+			CompanyID: uuid.UUID{1},
+			Revenue:   rub(revenue),
+			Costs:     rub(costs),
+			Year:      year,
+			Quarter:   quarter,
 		})
 	}
+
+	return report
+}
+
+// usd переводит суммы отчетов в доллары без пересчета по курсу.
+func usd(report *domain.FinancialReportByPeriod) *domain.FinancialReportByPeriod {
+	report.Currency = "USD"
+	for i := range report.Reports {
+		report.Reports[i].Revenue.Currency = "USD"
+		report.Reports[i].Costs.Currency = "USD"
+	}
+
+	return report
 }
 
 func Test_findFullYearReports(t *testing.T) {
@@ -837,56 +1234,56 @@ func Test_findFullYearReports(t *testing.T) {
 					{
 						ID:        uuid.UUID{1},
 						CompanyID: uuid.UUID{1},
-						Revenue:   12432532,
-						Costs:     3213214,
+						Revenue:   rub(12432532),
+						Costs:     rub(3213214),
 						Year:      1,
 						Quarter:   2,
 					},
 					{
 						ID:        uuid.UUID{2},
 						CompanyID: uuid.UUID{1},
-						Revenue:   12432532,
-						Costs:     3213214,
+						Revenue:   rub(12432532),
+						Costs:     rub(3213214),
 						Year:      1,
 						Quarter:   3,
 					},
 					{
 						ID:        uuid.UUID{3},
 						CompanyID: uuid.UUID{1},
-						Revenue:   12432532,
-						Costs:     3213214,
+						Revenue:   rub(12432532),
+						Costs:     rub(3213214),
 						Year:      1,
 						Quarter:   4,
 					},
 					{
 						ID:        uuid.UUID{4},
 						CompanyID: uuid.UUID{1},
-						Revenue:   12432532,
-						Costs:     3213214,
+						Revenue:   rub(12432532),
+						Costs:     rub(3213214),
 						Year:      2,
 						Quarter:   1,
 					},
 					{
 						ID:        uuid.UUID{5},
 						CompanyID: uuid.UUID{1},
-						Revenue:   12432532,
-						Costs:     3213214,
+						Revenue:   rub(12432532),
+						Costs:     rub(3213214),
 						Year:      2,
 						Quarter:   2,
 					},
 					{
 						ID:        uuid.UUID{6},
 						CompanyID: uuid.UUID{1},
-						Revenue:   12432532,
-						Costs:     3213214,
+						Revenue:   rub(12432532),
+						Costs:     rub(3213214),
 						Year:      2,
 						Quarter:   3,
 					},
 					{
 						ID:        uuid.UUID{7},
 						CompanyID: uuid.UUID{1},
-						Revenue:   12432532,
-						Costs:     3213214,
+						Revenue:   rub(12432532),
+						Costs:     rub(3213214),
 						Year:      2,
 						Quarter:   4,
 					},
@@ -910,32 +1307,32 @@ func Test_findFullYearReports(t *testing.T) {
 						{
 							ID:        uuid.UUID{4},
 							CompanyID: uuid.UUID{1},
-							Revenue:   12432532,
-							Costs:     3213214,
+							Revenue:   rub(12432532),
+							Costs:     rub(3213214),
 							Year:      2,
 							Quarter:   1,
 						},
 						{
 							ID:        uuid.UUID{5},
 							CompanyID: uuid.UUID{1},
-							Revenue:   12432532,
-							Costs:     3213214,
+							Revenue:   rub(12432532),
+							Costs:     rub(3213214),
 							Year:      2,
 							Quarter:   2,
 						},
 						{
 							ID:        uuid.UUID{6},
 							CompanyID: uuid.UUID{1},
-							Revenue:   12432532,
-							Costs:     3213214,
+							Revenue:   rub(12432532),
+							Costs:     rub(3213214),
 							Year:      2,
 							Quarter:   3,
 						},
 						{
 							ID:        uuid.UUID{7},
 							CompanyID: uuid.UUID{1},
-							Revenue:   12432532,
-							Costs:     3213214,
+							Revenue:   rub(12432532),
+							Costs:     rub(3213214),
 							Year:      2,
 							Quarter:   4,
 						},
@@ -958,3 +1355,108 @@ func Test_findFullYearReports(t *testing.T) {
 		})
 	}
 }
+
+func TestInteractor_GetUserFinancialReport_Cancelled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockIUserRepository(ctrl)
+	finRepo := mocks.NewMockIFinancialReportRepository(ctrl)
+	compRepo := mocks.NewMockICompanyRepository(ctrl)
+	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+
+	userSvc := user.NewService(userRepo, compRepo, actFieldRepo, logger)
+	actFieldSvc := activity_field.NewService(actFieldRepo, compRepo, logger)
+	compSvc := company.NewService(compRepo, logger)
+	finSvc := fin_report.NewService(finRepo, newTxManager(ctrl), newRates(t), domain.DefaultCurrency, logger)
+
+	interactor := NewInteractor(userSvc, actFieldSvc, compSvc, finSvc, newTaxService(ctrl, logger), newRates(t), DefaultLossCarryYears, logger)
+
+	period := &domain.Period{
+		StartYear:    2023,
+		EndYear:      2023,
+		StartQuarter: 1,
+		EndQuarter:   4,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	compRepo.EXPECT().
+		GetByOwnerId(ctx, uuid.UUID{1}, domain.PageRequest{Limit: domain.MaxPageSize}).
+		Return(
+			&domain.Page[*domain.Company]{Items: []*domain.Company{
+				{ID: uuid.UUID{1}, OwnerID: uuid.UUID{1}},
+				{ID: uuid.UUID{2}, OwnerID: uuid.UUID{1}},
+			}}, nil)
+
+	finRepo.EXPECT().
+		GetByCompany(ctx, uuid.UUID{1}, period).
+		Return(&domain.FinancialReportByPeriod{}, nil)
+
+	// отмена приходит во время обработки первой компании, до второй дело дойти не должно
+	history := &domain.Period{StartYear: 2013, StartQuarter: 1, EndYear: 2022, EndQuarter: 4}
+	finRepo.EXPECT().
+		GetByCompany(ctx, uuid.UUID{1}, history).
+		DoAndReturn(func(context.Context, uuid.UUID, *domain.Period) (*domain.FinancialReportByPeriod, error) {
+			cancel()
+			return &domain.FinancialReportByPeriod{}, nil
+		})
+
+	_, err := interactor.GetUserFinancialReport(ctx, uuid.UUID{1}, period)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestInteractor_CalculateUserRating_Cancelled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockIUserRepository(ctrl)
+	finRepo := mocks.NewMockIFinancialReportRepository(ctrl)
+	compRepo := mocks.NewMockICompanyRepository(ctrl)
+	actFieldRepo := mocks.NewMockIActivityFieldRepository(ctrl)
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+
+	userSvc := user.NewService(userRepo, compRepo, actFieldRepo, logger)
+	actFieldSvc := activity_field.NewService(actFieldRepo, compRepo, logger)
+	compSvc := company.NewService(compRepo, logger)
+	finSvc := fin_report.NewService(finRepo, newTxManager(ctrl), newRates(t), domain.DefaultCurrency, logger)
+
+	interactor := NewInteractor(userSvc, actFieldSvc, compSvc, finSvc, newTaxService(ctrl, logger), newRates(t), DefaultLossCarryYears, logger)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	compRepo.EXPECT().
+		GetByOwnerId(ctx, uuid.UUID{1}, domain.PageRequest{Limit: domain.MaxPageSize}).
+		Return(&domain.Page[*domain.Company]{Items: []*domain.Company{{ID: uuid.UUID{1}, OwnerID: uuid.UUID{1}}}}, nil).
+		AnyTimes()
+
+	_, err := interactor.CalculateUserRating(ctx, uuid.UUID{1})
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func newTxManager(ctrl *gomock.Controller) *mocks.MockITxManager {
+	txManager := mocks.NewMockITxManager(ctrl)
+	txManager.EXPECT().
+		WithinTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).AnyTimes()
+
+	return txManager
+}
+
+// newTaxService возвращает сервис без сохраненных шкал, налоги считаются по шкале по умолчанию.
+func newTaxService(ctrl *gomock.Controller, logger *mocks.MockILogger) domain.ITaxScheduleService {
+	taxRepo := mocks.NewMockITaxScheduleRepository(ctrl)
+	taxRepo.EXPECT().
+		GetByJurisdiction(gomock.Any(), domain.DefaultJurisdiction).
+		Return([]*domain.TaxSchedule{}, nil).
+		AnyTimes()
+
+	return tax_schedule.NewService(taxRepo, domain.DefaultJurisdiction, domain.DefaultTaxSchedule(), logger)
+}
@@ -2,8 +2,8 @@ package user_skill
 
 import (
 	"context"
-	"fmt"
 	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
 	"github.com/dlankinl/bmstu-ppo-bl/pkg/logger"
 	"github.com/google/uuid"
 )
@@ -12,6 +12,7 @@ type Service struct {
 	userSkillRepo domain.IUserSkillRepository
 	userRepo      domain.IUserRepository
 	skillRepo     domain.ISkillRepository
+	txManager     domain.ITxManager
 	logger        logger.ILogger
 }
 
@@ -19,102 +20,157 @@ func NewService(
 	userSkillRepo domain.IUserSkillRepository,
 	userRepo domain.IUserRepository,
 	skillRepo domain.ISkillRepository,
+	txManager domain.ITxManager,
 	logger logger.ILogger,
 ) domain.IUserSkillService {
 	return &Service{
 		userSkillRepo: userSkillRepo,
 		userRepo:      userRepo,
 		skillRepo:     skillRepo,
+		txManager:     txManager,
 		logger:        logger,
 	}
 }
 
-func (s *Service) Create(pair *domain.UserSkill) (err error) {
-	ctx := context.Background()
-
+func (s *Service) Create(ctx context.Context, pair *domain.UserSkill) (err error) {
 	err = s.userSkillRepo.Create(ctx, pair)
 	if err != nil {
-		s.logger.Infof("связывание пользователя и навыка: %v", err)
-		return fmt.Errorf("связывание пользователя и навыка: %w", err)
+		s.logger.Infof("%v: %v", i18n.UserSkillCreate, err)
+		return i18n.Wrap(err, i18n.UserSkillCreate)
 	}
 
 	return nil
 }
 
-func (s *Service) Delete(pair *domain.UserSkill) (err error) {
-	ctx := context.Background()
-
+func (s *Service) Delete(ctx context.Context, pair *domain.UserSkill) (err error) {
 	err = s.userSkillRepo.Delete(ctx, pair)
 	if err != nil {
-		s.logger.Infof("удаление связи пользователь-навык: %s", err)
-		return fmt.Errorf("удаление связи пользователь-навык: %w", err)
+		s.logger.Infof("%v: %v", i18n.UserSkillDelete, err)
+		return i18n.Wrap(err, i18n.UserSkillDelete)
 	}
 
 	return nil
 }
 
-func (s *Service) GetSkillsForUser(userId uuid.UUID, page int) (skills []*domain.Skill, err error) {
-	ctx := context.Background()
+func (s *Service) GetSkillsForUser(ctx context.Context, userId uuid.UUID, req domain.PageRequest) (skills *domain.Page[*domain.Skill], err error) {
+	userSkills, err := s.userSkillRepo.GetUserSkillsByUserId(ctx, userId, req)
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.UserSkillGetByUser, err)
+		return nil, i18n.Wrap(err, i18n.UserSkillGetByUser)
+	}
+
+	ids := make([]uuid.UUID, len(userSkills.Items))
+	for i, userSkill := range userSkills.Items {
+		ids[i] = userSkill.SkillId
+	}
 
-	userSkills, err := s.userSkillRepo.GetUserSkillsByUserId(ctx, userId, page)
+	found, err := s.skillRepo.GetByIds(ctx, ids)
 	if err != nil {
-		s.logger.Infof("получение связок пользователь-навык по userId: %v", err)
-		return nil, fmt.Errorf("получение связок пользователь-навык по userId: %w", err)
+		s.logger.Infof("%v: %v", i18n.UserSkillGetSkills, err)
+		return nil, i18n.Wrap(err, i18n.UserSkillGetSkills)
 	}
 
-	skills = make([]*domain.Skill, len(userSkills))
-	for i, userSkill := range userSkills {
-		skill, err := s.skillRepo.GetById(ctx, userSkill.SkillId)
-		if err != nil {
-			s.logger.Infof("получение скилла по skillId: %v", err)
-			return nil, fmt.Errorf("получение скилла по skillId: %w", err)
+	byId := make(map[uuid.UUID]*domain.Skill, len(found))
+	for _, skill := range found {
+		byId[skill.ID] = skill
+	}
+
+	skills = &domain.Page[*domain.Skill]{
+		Items:      make([]*domain.Skill, len(userSkills.Items)),
+		Total:      userSkills.Total,
+		Offset:     userSkills.Offset,
+		Limit:      userSkills.Limit,
+		NextCursor: userSkills.NextCursor,
+	}
+	for i, id := range ids {
+		skill, ok := byId[id]
+		if !ok {
+			err = domain.NewError(domain.ErrNotFound, i18n.StorageSkillNotFound)
+			s.logger.Infof("%v: %v", i18n.UserSkillGetSkill, err)
+			return nil, i18n.Wrap(err, i18n.UserSkillGetSkill)
 		}
 
-		skills[i] = skill
+		skills.Items[i] = skill
 	}
 
 	return skills, nil
 }
 
-func (s *Service) GetUsersForSkill(skillId uuid.UUID, page int) (users []*domain.User, err error) {
-	ctx := context.Background()
+func (s *Service) GetUsersForSkill(ctx context.Context, skillId uuid.UUID, req domain.PageRequest) (users *domain.Page[*domain.User], err error) {
+	userSkills, err := s.userSkillRepo.GetUserSkillsBySkillId(ctx, skillId, req)
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.UserSkillGetBySkill, err)
+		return nil, i18n.Wrap(err, i18n.UserSkillGetBySkill)
+	}
+
+	ids := make([]uuid.UUID, len(userSkills.Items))
+	for i, userSkill := range userSkills.Items {
+		ids[i] = userSkill.UserId
+	}
 
-	userSkills, err := s.userSkillRepo.GetUserSkillsBySkillId(ctx, skillId, page)
+	found, err := s.userRepo.GetByIds(ctx, ids)
 	if err != nil {
-		s.logger.Infof("получение связок пользователь-навык по skillId: %v", err)
-		return nil, fmt.Errorf("получение связок пользователь-навык по skillId: %w", err)
+		s.logger.Infof("%v: %v", i18n.UserSkillGetUsers, err)
+		return nil, i18n.Wrap(err, i18n.UserSkillGetUsers)
+	}
+
+	byId := make(map[uuid.UUID]*domain.User, len(found))
+	for _, user := range found {
+		byId[user.ID] = user
 	}
 
-	users = make([]*domain.User, len(userSkills))
-	for i, userSkill := range userSkills {
-		user, err := s.userRepo.GetById(ctx, userSkill.UserId)
-		if err != nil {
-			s.logger.Infof("получение пользователя по userId: %v", err)
-			return nil, fmt.Errorf("получение пользователя по userId: %w", err)
+	users = &domain.Page[*domain.User]{
+		Items:      make([]*domain.User, len(userSkills.Items)),
+		Total:      userSkills.Total,
+		Offset:     userSkills.Offset,
+		Limit:      userSkills.Limit,
+		NextCursor: userSkills.NextCursor,
+	}
+	for i, id := range ids {
+		user, ok := byId[id]
+		if !ok {
+			err = domain.NewError(domain.ErrNotFound, i18n.StorageUserNotFound)
+			s.logger.Infof("%v: %v", i18n.UserSkillGetUser, err)
+			return nil, i18n.Wrap(err, i18n.UserSkillGetUser)
 		}
 
-		users[i] = user
+		users.Items[i] = user
 	}
 
 	return users, nil
 }
 
-func (s *Service) DeleteSkillsForUser(userId uuid.UUID) (err error) {
-	ctx := context.Background()
-
-	userSkills, err := s.userSkillRepo.GetUserSkillsByUserId(ctx, userId, 0)
-	if err != nil {
-		s.logger.Infof("получение связок пользователь-навык по userId: %v", err)
-		return fmt.Errorf("получение связок пользователь-навык по userId: %w", err)
-	}
+func (s *Service) DeleteSkillsForUser(ctx context.Context, userId uuid.UUID) (err error) {
+	return s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		userSkills := make([]*domain.UserSkill, 0)
+		req := domain.PageRequest{Limit: domain.MaxPageSize}
+		for {
+			page, err := s.userSkillRepo.GetUserSkillsByUserId(ctx, userId, req)
+			if err != nil {
+				s.logger.Infof("%v: %v", i18n.UserSkillGetByUser, err)
+				return i18n.Wrap(err, i18n.UserSkillGetByUser)
+			}
+
+			userSkills = append(userSkills, page.Items...)
+			if page.NextCursor == "" {
+				break
+			}
+			req.Cursor = page.NextCursor
+		}
 
-	for _, userSkill := range userSkills {
-		err = s.userSkillRepo.Delete(ctx, userSkill)
-		if err != nil {
-			s.logger.Infof("удаление пары пользователь-навык: %v", err)
-			return fmt.Errorf("удаление пары пользователь-навык: %w", err)
+		for _, userSkill := range userSkills {
+			if err = ctx.Err(); err != nil {
+				s.logger.Infof("%v: %v", i18n.UserSkillDeleteForUser, err)
+				return i18n.Wrap(err, i18n.UserSkillDeleteForUser)
+			}
+
+			err = s.userSkillRepo.Delete(ctx, userSkill)
+			if err != nil {
+				s.logger.Infof("%v: %v", i18n.UserSkillDeletePair, err)
+				return i18n.Wrap(err, i18n.UserSkillDeletePair)
+			}
 		}
-	}
 
-	return nil
+		return nil
+	})
 }
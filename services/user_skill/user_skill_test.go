@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/dlankinl/bmstu-ppo-bl/domain"
 	"github.com/dlankinl/bmstu-ppo-bl/mocks"
+	"github.com/dlankinl/bmstu-ppo-bl/repository/memory"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -20,8 +21,8 @@ func TestUserSkillService_Create(t *testing.T) {
 	userRepo := mocks.NewMockIUserRepository(ctrl)
 	skillRepo := mocks.NewMockISkillRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
-	svc := NewService(userSkillRepo, userRepo, skillRepo, logger)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	svc := NewService(userSkillRepo, userRepo, skillRepo, newTxManager(ctrl), logger)
 
 	testCases := []struct {
 		name       string
@@ -75,7 +76,7 @@ func TestUserSkillService_Create(t *testing.T) {
 				tc.beforeTest(*userSkillRepo)
 			}
 
-			err := svc.Create(tc.pair)
+			err := svc.Create(context.Background(), tc.pair)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -94,8 +95,8 @@ func TestUserSkillService_Delete(t *testing.T) {
 	userRepo := mocks.NewMockIUserRepository(ctrl)
 	skillRepo := mocks.NewMockISkillRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
-	svc := NewService(userSkillRepo, userRepo, skillRepo, logger)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	svc := NewService(userSkillRepo, userRepo, skillRepo, newTxManager(ctrl), logger)
 
 	testCases := []struct {
 		name       string
@@ -149,7 +150,7 @@ func TestUserSkillService_Delete(t *testing.T) {
 				tc.beforeTest(*userSkillRepo)
 			}
 
-			err := svc.Delete(tc.pair)
+			err := svc.Delete(context.Background(), tc.pair)
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -168,14 +169,14 @@ func TestUserSkillService_GetSkillsForUser(t *testing.T) {
 	userRepo := mocks.NewMockIUserRepository(ctrl)
 	skillRepo := mocks.NewMockISkillRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
-	svc := NewService(userSkillRepo, userRepo, skillRepo, logger)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	svc := NewService(userSkillRepo, userRepo, skillRepo, newTxManager(ctrl), logger)
 
 	testCases := []struct {
 		name       string
 		pairs      []*domain.UserSkill
 		beforeTest func(userSkillRepo mocks.MockIUserSkillRepository, userRepo mocks.MockIUserRepository, skillRepo mocks.MockISkillRepository)
-		expected   []*domain.Skill
+		expected   *domain.Page[*domain.Skill]
 		wantErr    bool
 		errStr     error
 	}{
@@ -200,9 +201,9 @@ func TestUserSkillService_GetSkillsForUser(t *testing.T) {
 					GetUserSkillsByUserId(
 						context.Background(),
 						uuid.UUID{1},
-						1,
+						domain.PageRequest{},
 					).
-					Return([]*domain.UserSkill{
+					Return(&domain.Page[*domain.UserSkill]{Items: []*domain.UserSkill{
 						{
 							UserId:  uuid.UUID{1},
 							SkillId: uuid.UUID{1},
@@ -215,30 +216,20 @@ func TestUserSkillService_GetSkillsForUser(t *testing.T) {
 							UserId:  uuid.UUID{1},
 							SkillId: uuid.UUID{3},
 						},
-					}, nil)
-
-				skillRepo.EXPECT().
-					GetById(
-						context.Background(),
-						uuid.UUID{1},
-					).
-					Return(&domain.Skill{ID: uuid.UUID{1}, Name: "a", Description: "a"}, nil)
-
-				skillRepo.EXPECT().
-					GetById(
-						context.Background(),
-						uuid.UUID{2},
-					).
-					Return(&domain.Skill{ID: uuid.UUID{2}, Name: "b", Description: "b"}, nil)
+					}, Total: 13, Limit: 3, NextCursor: "next"}, nil)
 
 				skillRepo.EXPECT().
-					GetById(
+					GetByIds(
 						context.Background(),
-						uuid.UUID{3},
+						[]uuid.UUID{{1}, {2}, {3}},
 					).
-					Return(&domain.Skill{ID: uuid.UUID{3}, Name: "c", Description: "c"}, nil)
+					Return([]*domain.Skill{
+						{ID: uuid.UUID{3}, Name: "c", Description: "c"},
+						{ID: uuid.UUID{1}, Name: "a", Description: "a"},
+						{ID: uuid.UUID{2}, Name: "b", Description: "b"},
+					}, nil)
 			},
-			expected: []*domain.Skill{
+			expected: &domain.Page[*domain.Skill]{Items: []*domain.Skill{
 				{
 					ID:          uuid.UUID{1},
 					Name:        "a",
@@ -254,7 +245,7 @@ func TestUserSkillService_GetSkillsForUser(t *testing.T) {
 					Name:        "c",
 					Description: "c",
 				},
-			},
+			}, Total: 13, Limit: 3, NextCursor: "next"},
 			wantErr: false,
 		},
 		{
@@ -270,24 +261,56 @@ func TestUserSkillService_GetSkillsForUser(t *testing.T) {
 					GetUserSkillsByUserId(
 						context.Background(),
 						uuid.UUID{1},
-						1,
+						domain.PageRequest{},
 					).
-					Return([]*domain.UserSkill{
+					Return(&domain.Page[*domain.UserSkill]{Items: []*domain.UserSkill{
 						{
 							UserId:  uuid.UUID{1},
 							SkillId: uuid.UUID{1},
 						},
-					}, nil)
+					}}, nil)
 
 				skillRepo.EXPECT().
-					GetById(
+					GetByIds(
 						context.Background(),
-						uuid.UUID{1},
+						[]uuid.UUID{{1}},
 					).
 					Return(nil, fmt.Errorf("sql error"))
 			},
 			wantErr: true,
-			errStr:  errors.New("получение скилла по skillId: sql error"),
+			errStr:  errors.New("получение навыков пользователя: sql error"),
+		},
+		{
+			name: "навык не найден",
+			pairs: []*domain.UserSkill{
+				{
+					UserId:  uuid.UUID{1},
+					SkillId: uuid.UUID{1},
+				},
+			},
+			beforeTest: func(userSkillRepo mocks.MockIUserSkillRepository, userRepo mocks.MockIUserRepository, skillRepo mocks.MockISkillRepository) {
+				userSkillRepo.EXPECT().
+					GetUserSkillsByUserId(
+						context.Background(),
+						uuid.UUID{1},
+						domain.PageRequest{},
+					).
+					Return(&domain.Page[*domain.UserSkill]{Items: []*domain.UserSkill{
+						{
+							UserId:  uuid.UUID{1},
+							SkillId: uuid.UUID{1},
+						},
+					}}, nil)
+
+				skillRepo.EXPECT().
+					GetByIds(
+						context.Background(),
+						[]uuid.UUID{{1}},
+					).
+					Return([]*domain.Skill{}, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("получение скилла по skillId: навык не найден"),
 		},
 		{
 			name: "ошибка при получении данных из репозитория_2",
@@ -302,7 +325,7 @@ func TestUserSkillService_GetSkillsForUser(t *testing.T) {
 					GetUserSkillsByUserId(
 						context.Background(),
 						uuid.UUID{1},
-						1,
+						domain.PageRequest{},
 					).
 					Return(nil, fmt.Errorf("sql error"))
 			},
@@ -316,7 +339,7 @@ func TestUserSkillService_GetSkillsForUser(t *testing.T) {
 				tc.beforeTest(*userSkillRepo, *userRepo, *skillRepo)
 			}
 
-			skills, err := svc.GetSkillsForUser(uuid.UUID{1}, 1)
+			skills, err := svc.GetSkillsForUser(context.Background(), uuid.UUID{1}, domain.PageRequest{})
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -336,14 +359,14 @@ func TestUserSkillService_GetUsersForSkill(t *testing.T) {
 	userRepo := mocks.NewMockIUserRepository(ctrl)
 	skillRepo := mocks.NewMockISkillRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
-	svc := NewService(userSkillRepo, userRepo, skillRepo, logger)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	svc := NewService(userSkillRepo, userRepo, skillRepo, newTxManager(ctrl), logger)
 
 	testCases := []struct {
 		name       string
 		pairs      []*domain.UserSkill
 		beforeTest func(userSkillRepo mocks.MockIUserSkillRepository, userRepo mocks.MockIUserRepository, skillRepo mocks.MockISkillRepository)
-		expected   []*domain.User
+		expected   *domain.Page[*domain.User]
 		wantErr    bool
 		errStr     error
 	}{
@@ -368,9 +391,9 @@ func TestUserSkillService_GetUsersForSkill(t *testing.T) {
 					GetUserSkillsBySkillId(
 						context.Background(),
 						uuid.UUID{1},
-						1,
+						domain.PageRequest{},
 					).
-					Return([]*domain.UserSkill{
+					Return(&domain.Page[*domain.UserSkill]{Items: []*domain.UserSkill{
 						{
 							UserId:  uuid.UUID{1},
 							SkillId: uuid.UUID{1},
@@ -383,30 +406,20 @@ func TestUserSkillService_GetUsersForSkill(t *testing.T) {
 							UserId:  uuid.UUID{3},
 							SkillId: uuid.UUID{1},
 						},
-					}, nil)
+					}, Total: 13, Limit: 3, NextCursor: "next"}, nil)
 
 				userRepo.EXPECT().
-					GetById(
+					GetByIds(
 						context.Background(),
-						uuid.UUID{1},
-					).
-					Return(&domain.User{ID: uuid.UUID{1}, Username: "a", FullName: "a"}, nil)
-
-				userRepo.EXPECT().
-					GetById(
-						context.Background(),
-						uuid.UUID{2},
+						[]uuid.UUID{{1}, {2}, {3}},
 					).
-					Return(&domain.User{ID: uuid.UUID{2}, Username: "b", FullName: "b"}, nil)
-
-				userRepo.EXPECT().
-					GetById(
-						context.Background(),
-						uuid.UUID{3},
-					).
-					Return(&domain.User{ID: uuid.UUID{3}, Username: "c", FullName: "c"}, nil)
+					Return([]*domain.User{
+						{ID: uuid.UUID{3}, Username: "c", FullName: "c"},
+						{ID: uuid.UUID{1}, Username: "a", FullName: "a"},
+						{ID: uuid.UUID{2}, Username: "b", FullName: "b"},
+					}, nil)
 			},
-			expected: []*domain.User{
+			expected: &domain.Page[*domain.User]{Items: []*domain.User{
 				{
 					ID:       uuid.UUID{1},
 					Username: "a",
@@ -422,7 +435,7 @@ func TestUserSkillService_GetUsersForSkill(t *testing.T) {
 					Username: "c",
 					FullName: "c",
 				},
-			},
+			}, Total: 13, Limit: 3, NextCursor: "next"},
 			wantErr: false,
 		},
 		{
@@ -438,24 +451,56 @@ func TestUserSkillService_GetUsersForSkill(t *testing.T) {
 					GetUserSkillsBySkillId(
 						context.Background(),
 						uuid.UUID{1},
-						1,
+						domain.PageRequest{},
 					).
-					Return([]*domain.UserSkill{
+					Return(&domain.Page[*domain.UserSkill]{Items: []*domain.UserSkill{
 						{
 							UserId:  uuid.UUID{1},
 							SkillId: uuid.UUID{1},
 						},
-					}, nil)
+					}}, nil)
 
 				userRepo.EXPECT().
-					GetById(
+					GetByIds(
 						context.Background(),
-						uuid.UUID{1},
+						[]uuid.UUID{{1}},
 					).
 					Return(nil, fmt.Errorf("sql error"))
 			},
 			wantErr: true,
-			errStr:  errors.New("получение пользователя по userId: sql error"),
+			errStr:  errors.New("получение пользователей с навыком: sql error"),
+		},
+		{
+			name: "пользователь не найден",
+			pairs: []*domain.UserSkill{
+				{
+					UserId:  uuid.UUID{1},
+					SkillId: uuid.UUID{1},
+				},
+			},
+			beforeTest: func(userSkillRepo mocks.MockIUserSkillRepository, userRepo mocks.MockIUserRepository, skillRepo mocks.MockISkillRepository) {
+				userSkillRepo.EXPECT().
+					GetUserSkillsBySkillId(
+						context.Background(),
+						uuid.UUID{1},
+						domain.PageRequest{},
+					).
+					Return(&domain.Page[*domain.UserSkill]{Items: []*domain.UserSkill{
+						{
+							UserId:  uuid.UUID{1},
+							SkillId: uuid.UUID{1},
+						},
+					}}, nil)
+
+				userRepo.EXPECT().
+					GetByIds(
+						context.Background(),
+						[]uuid.UUID{{1}},
+					).
+					Return([]*domain.User{}, nil)
+			},
+			wantErr: true,
+			errStr:  errors.New("получение пользователя по userId: пользователь не найден"),
 		},
 		{
 			name: "ошибка при получении данных из репозитория_2",
@@ -470,7 +515,7 @@ func TestUserSkillService_GetUsersForSkill(t *testing.T) {
 					GetUserSkillsBySkillId(
 						context.Background(),
 						uuid.UUID{1},
-						1,
+						domain.PageRequest{},
 					).
 					Return(nil, fmt.Errorf("sql error"))
 			},
@@ -484,7 +529,7 @@ func TestUserSkillService_GetUsersForSkill(t *testing.T) {
 				tc.beforeTest(*userSkillRepo, *userRepo, *skillRepo)
 			}
 
-			users, err := svc.GetUsersForSkill(uuid.UUID{1}, 1)
+			users, err := svc.GetUsersForSkill(context.Background(), uuid.UUID{1}, domain.PageRequest{})
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -504,8 +549,8 @@ func TestUserSkillService_DeleteSkillsForUser(t *testing.T) {
 	userRepo := mocks.NewMockIUserRepository(ctrl)
 	skillRepo := mocks.NewMockISkillRepository(ctrl)
 	logger := mocks.NewMockILogger(ctrl)
-	logger.EXPECT().Infof(gomock.Any()).AnyTimes()
-	svc := NewService(userSkillRepo, userRepo, skillRepo, logger)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	svc := NewService(userSkillRepo, userRepo, skillRepo, newTxManager(ctrl), logger)
 
 	testCases := []struct {
 		name       string
@@ -531,9 +576,9 @@ func TestUserSkillService_DeleteSkillsForUser(t *testing.T) {
 					GetUserSkillsByUserId(
 						context.Background(),
 						uuid.UUID{1},
-						0,
+						domain.PageRequest{Limit: domain.MaxPageSize},
 					).
-					Return([]*domain.UserSkill{
+					Return(&domain.Page[*domain.UserSkill]{Items: []*domain.UserSkill{
 						{
 							UserId:  uuid.UUID{1},
 							SkillId: uuid.UUID{1},
@@ -542,7 +587,7 @@ func TestUserSkillService_DeleteSkillsForUser(t *testing.T) {
 							UserId:  uuid.UUID{1},
 							SkillId: uuid.UUID{2},
 						},
-					}, nil)
+					}}, nil)
 
 				userSkillRepo.EXPECT().
 					Delete(
@@ -573,9 +618,9 @@ func TestUserSkillService_DeleteSkillsForUser(t *testing.T) {
 					GetUserSkillsByUserId(
 						context.Background(),
 						uuid.UUID{1},
-						0,
+						domain.PageRequest{Limit: domain.MaxPageSize},
 					).
-					Return([]*domain.UserSkill{
+					Return(&domain.Page[*domain.UserSkill]{Items: []*domain.UserSkill{
 						{
 							UserId:  uuid.UUID{1},
 							SkillId: uuid.UUID{1},
@@ -584,7 +629,7 @@ func TestUserSkillService_DeleteSkillsForUser(t *testing.T) {
 							UserId:  uuid.UUID{1},
 							SkillId: uuid.UUID{2},
 						},
-					}, nil)
+					}}, nil)
 
 				userSkillRepo.EXPECT().
 					Delete(
@@ -609,7 +654,7 @@ func TestUserSkillService_DeleteSkillsForUser(t *testing.T) {
 					GetUserSkillsByUserId(
 						context.Background(),
 						uuid.UUID{1},
-						0,
+						domain.PageRequest{Limit: domain.MaxPageSize},
 					).
 					Return(nil, fmt.Errorf("sql error"))
 			},
@@ -623,7 +668,7 @@ func TestUserSkillService_DeleteSkillsForUser(t *testing.T) {
 				tc.beforeTest(*userSkillRepo, *userRepo, *skillRepo)
 			}
 
-			err := svc.DeleteSkillsForUser(uuid.UUID{1})
+			err := svc.DeleteSkillsForUser(context.Background(), uuid.UUID{1})
 
 			if tc.wantErr {
 				require.Equal(t, tc.errStr.Error(), err.Error())
@@ -633,3 +678,128 @@ func TestUserSkillService_DeleteSkillsForUser(t *testing.T) {
 		})
 	}
 }
+
+func TestUserSkillService_DeleteSkillsForUser_Cancelled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userSkillRepo := mocks.NewMockIUserSkillRepository(ctrl)
+	userRepo := mocks.NewMockIUserRepository(ctrl)
+	skillRepo := mocks.NewMockISkillRepository(ctrl)
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	svc := NewService(userSkillRepo, userRepo, skillRepo, newTxManager(ctrl), logger)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	userSkillRepo.EXPECT().
+		GetUserSkillsByUserId(ctx, uuid.UUID{1}, domain.PageRequest{Limit: domain.MaxPageSize}).
+		Return(&domain.Page[*domain.UserSkill]{Items: []*domain.UserSkill{
+			{UserId: uuid.UUID{1}, SkillId: uuid.UUID{1}},
+			{UserId: uuid.UUID{1}, SkillId: uuid.UUID{2}},
+		}}, nil)
+
+	userSkillRepo.EXPECT().
+		Delete(ctx, &domain.UserSkill{UserId: uuid.UUID{1}, SkillId: uuid.UUID{1}}).
+		DoAndReturn(func(context.Context, *domain.UserSkill) error {
+			cancel()
+			return nil
+		})
+
+	err := svc.DeleteSkillsForUser(ctx, uuid.UUID{1})
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func newTxManager(ctrl *gomock.Controller) *mocks.MockITxManager {
+	txManager := mocks.NewMockITxManager(ctrl)
+	txManager.EXPECT().
+		WithinTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).AnyTimes()
+
+	return txManager
+}
+
+type failingUserSkillRepository struct {
+	domain.IUserSkillRepository
+	deletes int
+}
+
+func (r *failingUserSkillRepository) Delete(ctx context.Context, pair *domain.UserSkill) error {
+	r.deletes++
+	if r.deletes > 1 {
+		return errors.New("sql error")
+	}
+
+	return r.IUserSkillRepository.Delete(ctx, pair)
+}
+
+func TestUserSkillService_DeleteSkillsForUser_Atomic(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+
+	userSkillRepo := &failingUserSkillRepository{IUserSkillRepository: memory.NewUserSkillRepository()}
+	svc := NewService(userSkillRepo, memory.NewUserRepository(), memory.NewSkillRepository(), memory.NewTxManager(), logger)
+	ctx := context.Background()
+
+	for _, skillId := range []uuid.UUID{{1}, {2}, {3}} {
+		require.Nil(t, userSkillRepo.Create(ctx, &domain.UserSkill{UserId: uuid.UUID{1}, SkillId: skillId}))
+	}
+
+	err := svc.DeleteSkillsForUser(ctx, uuid.UUID{1})
+	require.Equal(t, "удаление пары пользователь-навык: sql error", err.Error())
+
+	pairs, err := userSkillRepo.GetUserSkillsByUserId(ctx, uuid.UUID{1}, domain.PageRequest{})
+	require.Nil(t, err)
+	require.Len(t, pairs.Items, 3)
+}
+
+// countingUserRepository считает обращения к репозиторию пользователей.
+type countingUserRepository struct {
+	domain.IUserRepository
+	getById  int
+	getByIds int
+}
+
+func (r *countingUserRepository) GetById(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	r.getById++
+	return r.IUserRepository.GetById(ctx, id)
+}
+
+func (r *countingUserRepository) GetByIds(ctx context.Context, ids []uuid.UUID) ([]*domain.User, error) {
+	r.getByIds++
+	return r.IUserRepository.GetByIds(ctx, ids)
+}
+
+func TestUserSkillService_GetUsersForSkill_Batch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+
+	userSkillRepo := memory.NewUserSkillRepository()
+	userRepo := &countingUserRepository{IUserRepository: memory.NewUserRepository()}
+	svc := NewService(userSkillRepo, userRepo, memory.NewSkillRepository(), memory.NewTxManager(), logger)
+	ctx := context.Background()
+
+	names := []string{"a", "b", "c"}
+	for _, name := range names {
+		user := &domain.User{Username: name, FullName: name}
+		require.Nil(t, userRepo.Create(ctx, user))
+		require.Nil(t, userSkillRepo.Create(ctx, &domain.UserSkill{UserId: user.ID, SkillId: uuid.UUID{1}}))
+	}
+
+	users, err := svc.GetUsersForSkill(ctx, uuid.UUID{1}, domain.PageRequest{})
+	require.Nil(t, err)
+	require.Len(t, users.Items, len(names))
+
+	// все пользователи страницы загружены одним вызовом
+	require.Equal(t, 0, userRepo.getById)
+	require.Equal(t, 1, userRepo.getByIds)
+}
@@ -0,0 +1,92 @@
+package graphql
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	gographql "github.com/graph-gophers/graphql-go"
+)
+
+type companyResolver struct {
+	company *domain.Company
+}
+
+func (r *companyResolver) ID() gographql.ID {
+	return formatID(r.company.ID)
+}
+
+func (r *companyResolver) Name() string {
+	return r.company.Name
+}
+
+func (r *companyResolver) City() string {
+	return r.company.City
+}
+
+// ActivityField возвращает null, если сфера деятельности компании удалена.
+func (r *companyResolver) ActivityField(ctx context.Context) (*activityFieldResolver, error) {
+	field, found, err := loadersFrom(ctx).activityFields.Load(ctx, r.company.ActivityFieldId)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, nil
+	}
+
+	return &activityFieldResolver{field: field}, nil
+}
+
+type periodInput struct {
+	StartYear    int32
+	StartQuarter int32
+	EndYear      int32
+	EndQuarter   int32
+}
+
+func (r *companyResolver) Reports(ctx context.Context, args struct{ Period periodInput }) (*finReportByPeriodResolver, error) {
+	period := domain.Period{
+		StartYear:    int(args.Period.StartYear),
+		StartQuarter: int(args.Period.StartQuarter),
+		EndYear:      int(args.Period.EndYear),
+		EndQuarter:   int(args.Period.EndQuarter),
+	}
+
+	reports, found, err := loadersFrom(ctx).reportsFor(period).Load(ctx, r.company.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		// сервис не вернул отчеты компании, например если она удалена во время запроса
+		reports = &domain.FinancialReportByPeriod{Period: &period}
+	}
+
+	return &finReportByPeriodResolver{reports: reports}, nil
+}
+
+type companyPageResolver struct {
+	pageInfo
+	items []*companyResolver
+}
+
+func (r *companyPageResolver) Items() []*companyResolver {
+	return r.items
+}
+
+type activityFieldResolver struct {
+	field *domain.ActivityField
+}
+
+func (r *activityFieldResolver) ID() gographql.ID {
+	return formatID(r.field.ID)
+}
+
+func (r *activityFieldResolver) Name() string {
+	return r.field.Name
+}
+
+func (r *activityFieldResolver) Description() string {
+	return r.field.Description
+}
+
+func (r *activityFieldResolver) Cost() float64 {
+	return float64(r.field.Cost)
+}
@@ -0,0 +1,31 @@
+package graphql
+
+import (
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	gographql "github.com/graph-gophers/graphql-go"
+)
+
+type contactResolver struct {
+	contact *domain.Contact
+}
+
+func (r *contactResolver) ID() gographql.ID {
+	return formatID(r.contact.ID)
+}
+
+func (r *contactResolver) Name() string {
+	return r.contact.Name
+}
+
+func (r *contactResolver) Value() string {
+	return r.contact.Value
+}
+
+type contactPageResolver struct {
+	pageInfo
+	items []*contactResolver
+}
+
+func (r *contactPageResolver) Items() []*contactResolver {
+	return r.items
+}
@@ -0,0 +1,78 @@
+package graphql
+
+import (
+	"errors"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	nethttp "net/http"
+)
+
+// Code сопоставляет ошибке сервиса код, который передается в extensions.code.
+func Code(err error) string {
+	switch {
+	case errors.Is(err, domain.ErrValidation):
+		return "BAD_USER_INPUT"
+	case errors.Is(err, domain.ErrUnauthenticated):
+		return "UNAUTHENTICATED"
+	case errors.Is(err, domain.ErrForbidden):
+		return "FORBIDDEN"
+	case errors.Is(err, domain.ErrNotFound):
+		return "NOT_FOUND"
+	case errors.Is(err, domain.ErrConflict):
+		return "CONFLICT"
+	case errors.Is(err, domain.ErrNotEnoughData):
+		return "NOT_ENOUGH_DATA"
+	default:
+		return "INTERNAL"
+	}
+}
+
+// localize переводит ошибку резолвера на язык запроса. Ошибки разбора
+// и валидации запроса формирует библиотека, они остаются без изменений.
+func (s *Server) localize(r *nethttp.Request, queryErr *gqlerrors.QueryError) {
+	if queryErr.ResolverError == nil {
+		return
+	}
+
+	message, extensions := s.describe(r, queryErr.ResolverError)
+	queryErr.Message = message
+	queryErr.Extensions = extensions
+}
+
+func (s *Server) describe(r *nethttp.Request, err error) (string, map[string]any) {
+	locale := i18n.FromContext(r.Context())
+	code := Code(err)
+
+	message := i18n.Localize(err, locale)
+	if code == "INTERNAL" {
+		s.logger.Errorf("graphql: %v", err)
+		message = i18n.Text(locale, i18n.GraphQLInternal)
+	}
+
+	extensions := map[string]any{"code": code}
+
+	var validationErr *domain.ValidationError
+	if errors.As(err, &validationErr) {
+		extensions["field"] = validationErr.Field
+	}
+
+	return message, extensions
+}
+
+// writeError отвечает ошибкой, возникшей до выполнения запроса.
+func (s *Server) writeError(w nethttp.ResponseWriter, r *nethttp.Request, err error) {
+	message, extensions := s.describe(r, err)
+
+	status := nethttp.StatusInternalServerError
+	switch extensions["code"] {
+	case "BAD_USER_INPUT":
+		status = nethttp.StatusBadRequest
+	case "UNAUTHENTICATED":
+		status = nethttp.StatusUnauthorized
+	}
+
+	writeJSON(w, status, &errorsResponse{
+		Errors: []*gqlerrors.QueryError{{Message: message, Extensions: extensions}},
+	})
+}
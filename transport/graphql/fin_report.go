@@ -0,0 +1,107 @@
+package graphql
+
+import (
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	gographql "github.com/graph-gophers/graphql-go"
+)
+
+type finReportByPeriodResolver struct {
+	reports *domain.FinancialReportByPeriod
+}
+
+func (r *finReportByPeriodResolver) Period() *periodResolver {
+	return &periodResolver{period: r.reports.Period}
+}
+
+func (r *finReportByPeriodResolver) Reports() []*finReportResolver {
+	items := make([]*finReportResolver, len(r.reports.Reports))
+	for i := range r.reports.Reports {
+		items[i] = &finReportResolver{report: &r.reports.Reports[i]}
+	}
+
+	return items
+}
+
+func (r *finReportByPeriodResolver) Revenue() (float64, error) {
+	if err := r.reports.CheckCurrency(); err != nil {
+		return 0, err
+	}
+
+	return r.reports.Revenue().Float64(), nil
+}
+
+func (r *finReportByPeriodResolver) Costs() (float64, error) {
+	if err := r.reports.CheckCurrency(); err != nil {
+		return 0, err
+	}
+
+	return r.reports.Costs().Float64(), nil
+}
+
+func (r *finReportByPeriodResolver) Profit() (float64, error) {
+	if err := r.reports.CheckCurrency(); err != nil {
+		return 0, err
+	}
+
+	return r.reports.Profit().Float64(), nil
+}
+
+func (r *finReportByPeriodResolver) Currency() string {
+	return r.reports.Currency
+}
+
+type finReportResolver struct {
+	report *domain.FinancialReport
+}
+
+func (r *finReportResolver) ID() gographql.ID {
+	return formatID(r.report.ID)
+}
+
+func (r *finReportResolver) Granularity() string {
+	return r.report.Granularity
+}
+
+func (r *finReportResolver) Year() int32 {
+	return int32(r.report.Year)
+}
+
+func (r *finReportResolver) Quarter() int32 {
+	return int32(r.report.Quarter)
+}
+
+func (r *finReportResolver) Month() int32 {
+	return int32(r.report.Month)
+}
+
+func (r *finReportResolver) Revenue() float64 {
+	return r.report.Revenue.Float64()
+}
+
+func (r *finReportResolver) Costs() float64 {
+	return r.report.Costs.Float64()
+}
+
+func (r *finReportResolver) Currency() string {
+	return r.report.Revenue.Currency
+}
+
+type periodResolver struct {
+	period *domain.Period
+}
+
+func (r *periodResolver) StartYear() int32 {
+	return int32(r.period.StartYear)
+}
+
+func (r *periodResolver) StartQuarter() int32 {
+	return int32(r.period.StartQuarter)
+}
+
+func (r *periodResolver) EndYear() int32 {
+	return int32(r.period.EndYear)
+}
+
+func (r *periodResolver) EndQuarter() int32 {
+	return int32(r.period.EndQuarter)
+}
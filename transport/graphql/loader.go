@@ -0,0 +1,153 @@
+package graphql
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+	"sync"
+)
+
+type result[V any] struct {
+	value V
+	found bool
+	err   error
+}
+
+// loader загружает значения по ключам пачками в рамках одного запроса.
+// Резолвер списка заранее ставит ключи всех элементов в очередь через Prime,
+// и первый Load забирает их одним вызовом fetch, остальные получают значения из кэша.
+type loader[K comparable, V any] struct {
+	mu      sync.Mutex
+	fetch   func(ctx context.Context, keys []K) (map[K]V, error)
+	pending []K
+	queued  map[K]bool
+	cache   map[K]*result[V]
+}
+
+func newLoader[K comparable, V any](fetch func(ctx context.Context, keys []K) (map[K]V, error)) *loader[K, V] {
+	return &loader[K, V]{
+		fetch:  fetch,
+		queued: make(map[K]bool),
+		cache:  make(map[K]*result[V]),
+	}
+}
+
+func (l *loader[K, V]) Prime(keys ...K) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.enqueue(keys...)
+}
+
+// Load возвращает значение по ключу, found равен false, если fetch его не вернул.
+func (l *loader[K, V]) Load(ctx context.Context, key K) (value V, found bool, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if res, ok := l.cache[key]; ok {
+		return res.value, res.found, res.err
+	}
+
+	l.enqueue(key)
+	keys := l.pending
+	l.pending, l.queued = nil, make(map[K]bool)
+
+	values, err := l.fetch(ctx, keys)
+	for _, k := range keys {
+		v, ok := values[k]
+		l.cache[k] = &result[V]{value: v, found: ok, err: err}
+	}
+
+	res := l.cache[key]
+	return res.value, res.found, res.err
+}
+
+func (l *loader[K, V]) enqueue(keys ...K) {
+	for _, key := range keys {
+		if _, ok := l.cache[key]; ok || l.queued[key] {
+			continue
+		}
+		l.queued[key] = true
+		l.pending = append(l.pending, key)
+	}
+}
+
+// loaders хранит загрузчики одного запроса.
+type loaders struct {
+	services *Services
+
+	activityFields *loader[uuid.UUID, *domain.ActivityField]
+
+	mu        sync.Mutex
+	companies []uuid.UUID
+	reports   map[domain.Period]*loader[uuid.UUID, *domain.FinancialReportByPeriod]
+}
+
+func newLoaders(services *Services) *loaders {
+	l := &loaders{
+		services: services,
+		reports:  make(map[domain.Period]*loader[uuid.UUID, *domain.FinancialReportByPeriod]),
+	}
+
+	l.activityFields = newLoader(func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*domain.ActivityField, error) {
+		fields, err := services.ActivityField.GetByIds(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		byId := make(map[uuid.UUID]*domain.ActivityField, len(fields))
+		for _, field := range fields {
+			byId[field.ID] = field
+		}
+
+		return byId, nil
+	})
+
+	return l
+}
+
+// primeCompanies запоминает компании, отчеты которых могут понадобиться,
+// чтобы отчеты за каждый период загружались одним вызовом.
+func (l *loaders) primeCompanies(companies []*domain.Company) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	ids := make([]uuid.UUID, len(companies))
+	fieldIds := make([]uuid.UUID, len(companies))
+	for i, company := range companies {
+		ids[i] = company.ID
+		fieldIds[i] = company.ActivityFieldId
+	}
+
+	l.companies = append(l.companies, ids...)
+	for _, reports := range l.reports {
+		reports.Prime(ids...)
+	}
+	l.activityFields.Prime(fieldIds...)
+}
+
+func (l *loaders) reportsFor(period domain.Period) *loader[uuid.UUID, *domain.FinancialReportByPeriod] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	reports, ok := l.reports[period]
+	if !ok {
+		reports = newLoader(func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*domain.FinancialReportByPeriod, error) {
+			return l.services.FinReport.GetByCompanies(ctx, ids, &period)
+		})
+		reports.Prime(l.companies...)
+		l.reports[period] = reports
+	}
+
+	return reports
+}
+
+type loadersKey struct{}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
@@ -0,0 +1,65 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+)
+
+func TestLoader(t *testing.T) {
+	var batches [][]int
+	l := newLoader(func(_ context.Context, keys []int) (map[int]string, error) {
+		batches = append(batches, keys)
+
+		values := make(map[int]string)
+		for _, key := range keys {
+			if key > 0 {
+				values[key] = string(rune('a' + key))
+			}
+		}
+		return values, nil
+	})
+
+	l.Prime(1, 2, 2, 3, -1)
+
+	var wg sync.WaitGroup
+	for _, key := range []int{3, 1, 2, -1} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, found, err := l.Load(context.Background(), key)
+			require.Nil(t, err)
+			require.Equal(t, key > 0, found)
+			if found {
+				require.Equal(t, string(rune('a'+key)), value)
+			}
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, [][]int{{1, 2, 3, -1}}, batches)
+
+	// новый ключ загружается отдельной пачкой, уже загруженные берутся из кэша
+	l.Prime(2, 4)
+	value, found, err := l.Load(context.Background(), 5)
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, "f", value)
+	require.Equal(t, [][]int{{1, 2, 3, -1}, {4, 5}}, batches)
+}
+
+func TestLoader_Error(t *testing.T) {
+	calls := 0
+	l := newLoader(func(_ context.Context, keys []int) (map[int]string, error) {
+		calls++
+		return nil, errors.New("sql error")
+	})
+
+	l.Prime(1, 2)
+	_, _, err := l.Load(context.Background(), 1)
+	require.EqualError(t, err, "sql error")
+	_, _, err = l.Load(context.Background(), 2)
+	require.EqualError(t, err, "sql error")
+	require.Equal(t, 1, calls)
+}
@@ -0,0 +1,121 @@
+package graphql
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	gographql "github.com/graph-gophers/graphql-go"
+)
+
+const dateLayout = "2006-01-02"
+
+// resolver — корневой резолвер, состояние запроса хранится в контексте.
+type resolver struct {
+	services *Services
+}
+
+func (r *resolver) User(ctx context.Context, args struct{ ID gographql.ID }) (*userResolver, error) {
+	id, err := parseID(args.ID, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := r.services.User.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userResolver{services: r.services, user: user}, nil
+}
+
+func (r *resolver) UserByUsername(ctx context.Context, args struct{ Username string }) (*userResolver, error) {
+	user, err := r.services.User.GetByUsername(ctx, args.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userResolver{services: r.services, user: user}, nil
+}
+
+func parseID(id gographql.ID, name string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(string(id))
+	if err != nil {
+		return uuid.Nil, domain.NewValidationError(name, i18n.GraphQLArgInvalid, name)
+	}
+
+	return parsed, nil
+}
+
+func formatID(id uuid.UUID) gographql.ID {
+	return gographql.ID(id.String())
+}
+
+type pageInput struct {
+	Limit  *int32
+	Offset *int32
+	Cursor *string
+	Sort   *string
+}
+
+func pageRequest(page *pageInput) (req domain.PageRequest) {
+	if page == nil {
+		return req
+	}
+
+	if page.Limit != nil {
+		req.Limit = int(*page.Limit)
+	}
+	if page.Offset != nil {
+		req.Offset = int(*page.Offset)
+	}
+	if page.Cursor != nil {
+		req.Cursor = *page.Cursor
+	}
+	if page.Sort != nil {
+		req.Sort = *page.Sort
+	}
+
+	return req
+}
+
+type pageArgs struct {
+	Page *pageInput
+}
+
+// pageInfo отдает поля страницы, общие для всех типов *Page.
+type pageInfo struct {
+	total      int
+	offset     int
+	limit      int
+	nextCursor string
+}
+
+func newPageInfo[T any](page *domain.Page[T]) pageInfo {
+	return pageInfo{
+		total:      page.Total,
+		offset:     page.Offset,
+		limit:      page.Limit,
+		nextCursor: page.NextCursor,
+	}
+}
+
+func (p pageInfo) Total() int32 {
+	return int32(p.total)
+}
+
+func (p pageInfo) Offset() int32 {
+	return int32(p.offset)
+}
+
+func (p pageInfo) Limit() int32 {
+	return int32(p.limit)
+}
+
+func (p pageInfo) NextCursor() *string {
+	if p.nextCursor == "" {
+		return nil
+	}
+
+	return &p.nextCursor
+}
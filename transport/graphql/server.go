@@ -0,0 +1,97 @@
+package graphql
+
+import (
+	_ "embed"
+	"encoding/json"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/base"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/logger"
+	gographql "github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	nethttp "net/http"
+)
+
+//go:embed schema.graphql
+var schemaSource string
+
+// Services содержит сервисы, из которых собирается граф профиля.
+// Проверка прав остается на стороне сервисов, как и в транспорте http.
+type Services struct {
+	User          domain.IUserService
+	Company       domain.ICompanyService
+	Contact       domain.IContactsService
+	UserSkill     domain.IUserSkillService
+	ActivityField domain.IActivityFieldService
+	FinReport     domain.IFinancialReportService
+}
+
+type AuthConfig struct {
+	Keys       base.IKeyProvider
+	TokenOpts  base.TokenOptions
+	Revocation base.IRevocationChecker
+}
+
+type Server struct {
+	services *Services
+	auth     AuthConfig
+	logger   logger.ILogger
+	schema   *gographql.Schema
+}
+
+func NewServer(services *Services, auth AuthConfig, logger logger.ILogger) *Server {
+	return &Server{
+		services: services,
+		auth:     auth,
+		logger:   logger,
+		schema:   gographql.MustParseSchema(schemaSource, &resolver{services: services}),
+	}
+}
+
+type request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// ServeHTTP выполняет запрос в формате GraphQL over HTTP: POST с JSON-телом.
+func (s *Server) ServeHTTP(w nethttp.ResponseWriter, r *nethttp.Request) {
+	r = withLocale(r)
+
+	if r.Method != nethttp.MethodPost {
+		w.Header().Set("Allow", nethttp.MethodPost)
+		nethttp.Error(w, nethttp.StatusText(nethttp.StatusMethodNotAllowed), nethttp.StatusMethodNotAllowed)
+		return
+	}
+
+	authenticated, err := s.authenticate(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req request
+	err = json.NewDecoder(authenticated.Body).Decode(&req)
+	if err != nil {
+		s.writeError(w, r, domain.NewValidationError("body", i18n.GraphQLBodyInvalid))
+		return
+	}
+
+	ctx := withLoaders(authenticated.Context(), newLoaders(s.services))
+	resp := s.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+	for _, queryErr := range resp.Errors {
+		s.localize(r, queryErr)
+	}
+
+	writeJSON(w, nethttp.StatusOK, resp)
+}
+
+func writeJSON(w nethttp.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+type errorsResponse struct {
+	Errors []*gqlerrors.QueryError `json:"errors"`
+}
@@ -0,0 +1,398 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/mocks"
+	"github.com/dlankinl/bmstu-ppo-bl/repository/memory"
+	"github.com/dlankinl/bmstu-ppo-bl/services/activity_field"
+	"github.com/dlankinl/bmstu-ppo-bl/services/authz"
+	"github.com/dlankinl/bmstu-ppo-bl/services/company"
+	"github.com/dlankinl/bmstu-ppo-bl/services/contact"
+	"github.com/dlankinl/bmstu-ppo-bl/services/exchange_rate"
+	"github.com/dlankinl/bmstu-ppo-bl/services/fin_report"
+	"github.com/dlankinl/bmstu-ppo-bl/services/user"
+	"github.com/dlankinl/bmstu-ppo-bl/services/user_skill"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	nethttp "net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingActivityFields считает обращения к сервису сфер деятельности.
+type countingActivityFields struct {
+	domain.IActivityFieldService
+	getById  atomic.Int32
+	getByIds atomic.Int32
+}
+
+func (s *countingActivityFields) GetById(ctx context.Context, id uuid.UUID) (*domain.ActivityField, error) {
+	s.getById.Add(1)
+	return s.IActivityFieldService.GetById(ctx, id)
+}
+
+func (s *countingActivityFields) GetByIds(ctx context.Context, ids []uuid.UUID) ([]*domain.ActivityField, error) {
+	s.getByIds.Add(1)
+	return s.IActivityFieldService.GetByIds(ctx, ids)
+}
+
+// countingFinReports считает обращения к сервису финансовых отчетов.
+type countingFinReports struct {
+	domain.IFinancialReportService
+	getByCompany   atomic.Int32
+	getByCompanies atomic.Int32
+}
+
+func (s *countingFinReports) GetByCompany(ctx context.Context, companyId uuid.UUID, period *domain.Period) (*domain.FinancialReportByPeriod, error) {
+	s.getByCompany.Add(1)
+	return s.IFinancialReportService.GetByCompany(ctx, companyId, period)
+}
+
+func (s *countingFinReports) GetByCompanies(ctx context.Context, companyIds []uuid.UUID, period *domain.Period) (map[uuid.UUID]*domain.FinancialReportByPeriod, error) {
+	s.getByCompanies.Add(1)
+	return s.IFinancialReportService.GetByCompanies(ctx, companyIds, period)
+}
+
+type testAPI struct {
+	t      *testing.T
+	server *Server
+
+	users          domain.IUserRepository
+	companies      domain.ICompanyRepository
+	contacts       domain.IContactsRepository
+	skills         domain.ISkillRepository
+	userSkills     domain.IUserSkillRepository
+	activityFields domain.IActivityFieldRepository
+	finReports     domain.IFinancialReportRepository
+
+	fieldCalls  *countingActivityFields
+	reportCalls *countingFinReports
+}
+
+func newTestAPI(t *testing.T) *testAPI {
+	ctrl := gomock.NewController(t)
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Errorf(gomock.Any(), gomock.Any()).AnyTimes()
+
+	a := &testAPI{
+		t:              t,
+		users:          memory.NewUserRepository(),
+		companies:      memory.NewCompanyRepository(),
+		contacts:       memory.NewContactRepository(),
+		skills:         memory.NewSkillRepository(),
+		userSkills:     memory.NewUserSkillRepository(),
+		activityFields: memory.NewActivityFieldRepository(),
+		finReports:     memory.NewFinancialReportRepository(),
+	}
+	txManager := memory.NewTxManager()
+	rates, err := exchange_rate.NewTable(nil)
+	require.Nil(t, err)
+
+	compSvc := company.NewService(a.companies, logger)
+	a.fieldCalls = &countingActivityFields{IActivityFieldService: activity_field.NewService(a.activityFields, a.companies, logger)}
+	a.reportCalls = &countingFinReports{IFinancialReportService: fin_report.NewService(a.finReports, txManager, rates, domain.DefaultCurrency, logger)}
+
+	services := &Services{
+		User:          authz.NewUserService(user.NewService(a.users, a.companies, a.activityFields, logger)),
+		Company:       authz.NewCompanyService(compSvc),
+		Contact:       authz.NewContactService(contact.NewService(a.contacts, logger)),
+		UserSkill:     authz.NewUserSkillService(user_skill.NewService(a.userSkills, a.users, a.skills, txManager, logger)),
+		ActivityField: authz.NewActivityFieldService(a.fieldCalls),
+		FinReport:     authz.NewFinancialReportService(a.reportCalls, compSvc),
+	}
+	a.server = NewServer(services, AuthConfig{}, logger)
+
+	return a
+}
+
+type response struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Path       []any          `json:"path"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+func (a *testAPI) query(query string, variables map[string]any, headers map[string]string) (int, *response) {
+	body, err := json.Marshal(&request{Query: query, Variables: variables})
+	require.Nil(a.t, err)
+
+	req := httptest.NewRequest(nethttp.MethodPost, "/graphql", bytes.NewReader(body))
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	rec := httptest.NewRecorder()
+	a.server.ServeHTTP(rec, req)
+
+	var resp response
+	require.Nil(a.t, json.Unmarshal(rec.Body.Bytes(), &resp))
+
+	return rec.Code, &resp
+}
+
+const profileQuery = `query Profile($id: ID!) {
+	user(id: $id) {
+		username
+		birthday
+		companies(page: {limit: 10, sort: "name"}) {
+			total
+			items {
+				name
+				activityField { name cost }
+				first: reports(period: {startYear: 2021, startQuarter: 1, endYear: 2021, endQuarter: 4}) {
+					revenue
+					profit
+					currency
+					reports { granularity year quarter month currency }
+				}
+				second: reports(period: {startYear: 2022, startQuarter: 1, endYear: 2022, endQuarter: 2}) {
+					revenue
+				}
+			}
+		}
+		skills { total items { name } }
+		contacts { items { name value } }
+	}
+}`
+
+type profile struct {
+	User struct {
+		Username  string
+		Birthday  string
+		Companies struct {
+			Total int
+			Items []struct {
+				Name          string
+				ActivityField *struct {
+					Name string
+					Cost float64
+				}
+				First struct {
+					Revenue  float64
+					Profit   float64
+					Currency string
+					Reports  []struct {
+						Granularity string
+						Year        int
+						Quarter     int
+						Month       int
+						Currency    string
+					}
+				}
+				Second struct {
+					Revenue float64
+				}
+			}
+		}
+		Skills struct {
+			Total int
+			Items []struct{ Name string }
+		}
+		Contacts struct {
+			Items []struct {
+				Name  string
+				Value string
+			}
+		}
+	}
+}
+
+func TestServer_Profile(t *testing.T) {
+	a := newTestAPI(t)
+	ctx := context.Background()
+
+	owner := &domain.User{Username: "owner", FullName: "Иванов Иван", Birthday: time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC), Role: "user"}
+	require.Nil(t, a.users.Create(ctx, owner))
+
+	fields := []*domain.ActivityField{
+		{Name: "IT", Description: "информационные технологии", Cost: 1.5},
+		{Name: "Торговля", Description: "розничная торговля", Cost: 0.75},
+	}
+	for _, field := range fields {
+		require.Nil(t, a.activityFields.Create(ctx, field))
+	}
+
+	names := []string{"Альфа", "Бета", "Гамма", "Дельта"}
+	for i, name := range names {
+		comp := &domain.Company{OwnerID: owner.ID, ActivityFieldId: fields[i%2].ID, Name: name, City: "Москва"}
+		require.Nil(t, a.companies.Create(ctx, comp))
+
+		for _, yq := range [][2]int{{2021, 1}, {2021, 3}, {2022, 2}} {
+			require.Nil(t, a.finReports.Create(ctx, &domain.FinancialReport{
+				CompanyID:   comp.ID,
+				Revenue:     domain.NewMoney(int64(100*(i+1)), domain.DefaultCurrency),
+				Costs:       domain.NewMoney(int64(10*(i+1)), domain.DefaultCurrency),
+				Granularity: domain.GranularityQuarter,
+				Year:        yq[0],
+				Quarter:     yq[1],
+			}))
+		}
+	}
+
+	for _, name := range []string{"Go", "SQL"} {
+		skill := &domain.Skill{Name: name, Description: name}
+		require.Nil(t, a.skills.Create(ctx, skill))
+		require.Nil(t, a.userSkills.Create(ctx, &domain.UserSkill{UserId: owner.ID, SkillId: skill.ID}))
+	}
+	require.Nil(t, a.contacts.Create(ctx, &domain.Contact{OwnerID: owner.ID, Name: "email", Value: "owner@example.com"}))
+
+	status, resp := a.query(profileQuery, map[string]any{"id": owner.ID.String()}, nil)
+	require.Equal(t, nethttp.StatusOK, status)
+	require.Empty(t, resp.Errors)
+
+	var got profile
+	require.Nil(t, json.Unmarshal(resp.Data, &got))
+
+	require.Equal(t, "owner", got.User.Username)
+	require.Equal(t, "1990-05-17", got.User.Birthday)
+	require.Equal(t, 4, got.User.Companies.Total)
+	require.Len(t, got.User.Companies.Items, 4)
+	for i, item := range got.User.Companies.Items {
+		require.Equal(t, names[i], item.Name)
+		require.Equal(t, fields[i%2].Name, item.ActivityField.Name)
+		require.Equal(t, float64(fields[i%2].Cost), item.ActivityField.Cost)
+		require.Len(t, item.First.Reports, 2)
+		require.Equal(t, domain.DefaultCurrency, item.First.Reports[0].Currency)
+		require.Equal(t, domain.GranularityQuarter, item.First.Reports[0].Granularity)
+		require.Zero(t, item.First.Reports[0].Month)
+		require.Equal(t, float64(200*(i+1)), item.First.Revenue)
+		require.Equal(t, float64(180*(i+1)), item.First.Profit)
+		require.Equal(t, domain.DefaultCurrency, item.First.Currency)
+		require.Equal(t, float64(100*(i+1)), item.Second.Revenue)
+	}
+	require.Equal(t, 2, got.User.Skills.Total)
+	require.Len(t, got.User.Skills.Items, 2)
+	require.Len(t, got.User.Contacts.Items, 1)
+	require.Equal(t, "owner@example.com", got.User.Contacts.Items[0].Value)
+
+	// все сферы деятельности загружены одним вызовом, отчеты — одним вызовом на период
+	require.Equal(t, int32(0), a.fieldCalls.getById.Load())
+	require.Equal(t, int32(1), a.fieldCalls.getByIds.Load())
+	require.Equal(t, int32(0), a.reportCalls.getByCompany.Load())
+	require.Equal(t, int32(2), a.reportCalls.getByCompanies.Load())
+}
+
+func TestServer_Errors(t *testing.T) {
+	a := newTestAPI(t)
+
+	testCases := []struct {
+		name      string
+		query     string
+		variables map[string]any
+		code      string
+		field     string
+		message   string
+	}{
+		{
+			name:      "некорректный id",
+			query:     `query($id: ID!) { user(id: $id) { username } }`,
+			variables: map[string]any{"id": "not-a-uuid"},
+			code:      "BAD_USER_INPUT",
+			field:     "id",
+			message:   "invalid value of argument id",
+		},
+		{
+			name:      "пользователь не найден",
+			query:     `query($id: ID!) { user(id: $id) { username } }`,
+			variables: map[string]any{"id": uuid.New().String()},
+			code:      "NOT_FOUND",
+		},
+		{
+			name:    "неверный порядок периода",
+			query:   `{ userByUsername(username: "owner") { companies { items { reports(period: {startYear: 2022, startQuarter: 1, endYear: 2021, endQuarter: 1}) { revenue } } } } }`,
+			code:    "BAD_USER_INPUT",
+			field:   "period",
+			message: "period end must be later than period start",
+		},
+	}
+
+	ctx := context.Background()
+	owner := &domain.User{Username: "owner", FullName: "owner"}
+	require.Nil(t, a.users.Create(ctx, owner))
+	field := &domain.ActivityField{Name: "IT", Description: "IT", Cost: 1}
+	require.Nil(t, a.activityFields.Create(ctx, field))
+	require.Nil(t, a.companies.Create(ctx, &domain.Company{OwnerID: owner.ID, ActivityFieldId: field.ID, Name: "Альфа"}))
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			status, resp := a.query(tc.query, tc.variables, map[string]string{"Accept-Language": "en"})
+			require.Equal(t, nethttp.StatusOK, status)
+			require.Len(t, resp.Errors, 1)
+			require.Equal(t, tc.code, resp.Errors[0].Extensions["code"])
+			if tc.field != "" {
+				require.Equal(t, tc.field, resp.Errors[0].Extensions["field"])
+			}
+			if tc.message != "" {
+				require.Equal(t, tc.message, resp.Errors[0].Message)
+			}
+		})
+	}
+}
+
+func TestServer_Request(t *testing.T) {
+	a := newTestAPI(t)
+
+	status, resp := a.query(`{ user(id: "1") { username } }`, nil, map[string]string{"Authorization": "Basic abc"})
+	require.Equal(t, nethttp.StatusUnauthorized, status)
+	require.Len(t, resp.Errors, 1)
+	require.Equal(t, "UNAUTHENTICATED", resp.Errors[0].Extensions["code"])
+	require.Equal(t, "заголовок Authorization должен содержать Bearer-токен", resp.Errors[0].Message)
+
+	rec := httptest.NewRecorder()
+	a.server.ServeHTTP(rec, httptest.NewRequest(nethttp.MethodPost, "/graphql", bytes.NewBufferString("{")))
+	require.Equal(t, nethttp.StatusBadRequest, rec.Code)
+
+	rec = httptest.NewRecorder()
+	a.server.ServeHTTP(rec, httptest.NewRequest(nethttp.MethodGet, "/graphql", nil))
+	require.Equal(t, nethttp.StatusMethodNotAllowed, rec.Code)
+
+	// синтаксические ошибки формирует библиотека
+	status, resp = a.query(`{ user(id: "1") { unknown } }`, nil, nil)
+	require.Equal(t, nethttp.StatusOK, status)
+	require.NotEmpty(t, resp.Errors)
+	require.Nil(t, resp.Errors[0].Extensions)
+}
+
+func TestFinReportByPeriodResolver_Currency(t *testing.T) {
+	r := &finReportByPeriodResolver{reports: &domain.FinancialReportByPeriod{
+		Currency: domain.DefaultCurrency,
+		Reports: []domain.FinancialReport{
+			{Revenue: domain.NewMoney(10, domain.DefaultCurrency), Costs: domain.NewMoney(5, domain.DefaultCurrency)},
+			{Revenue: domain.NewMoney(10, "USD"), Costs: domain.NewMoney(5, "USD")},
+		},
+	}}
+
+	// суммы в разных валютах возвращаются ошибкой, а не паникой
+	for _, total := range []func() (float64, error){r.Revenue, r.Costs, r.Profit} {
+		_, err := total()
+		require.ErrorIs(t, err, domain.ErrValidation)
+	}
+}
+
+func TestCompanyResolver_ReportsNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	finReports := mocks.NewMockIFinancialReportService(ctrl)
+	finReports.EXPECT().
+		GetByCompanies(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(map[uuid.UUID]*domain.FinancialReportByPeriod{}, nil)
+
+	ctx := withLoaders(context.Background(), newLoaders(&Services{FinReport: finReports}))
+	r := &companyResolver{company: &domain.Company{ID: uuid.New()}}
+
+	reports, err := r.Reports(ctx, struct{ Period periodInput }{Period: periodInput{StartYear: 2021, StartQuarter: 1, EndYear: 2021, EndQuarter: 4}})
+	require.Nil(t, err)
+	require.Equal(t, int32(2021), reports.Period().StartYear())
+	require.Empty(t, reports.Reports())
+
+	revenue, err := reports.Revenue()
+	require.Nil(t, err)
+	require.Zero(t, revenue)
+}
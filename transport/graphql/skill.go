@@ -0,0 +1,31 @@
+package graphql
+
+import (
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	gographql "github.com/graph-gophers/graphql-go"
+)
+
+type skillResolver struct {
+	skill *domain.Skill
+}
+
+func (r *skillResolver) ID() gographql.ID {
+	return formatID(r.skill.ID)
+}
+
+func (r *skillResolver) Name() string {
+	return r.skill.Name
+}
+
+func (r *skillResolver) Description() string {
+	return r.skill.Description
+}
+
+type skillPageResolver struct {
+	pageInfo
+	items []*skillResolver
+}
+
+func (r *skillPageResolver) Items() []*skillResolver {
+	return r.items
+}
@@ -0,0 +1,85 @@
+package graphql
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	gographql "github.com/graph-gophers/graphql-go"
+)
+
+type userResolver struct {
+	services *Services
+	user     *domain.User
+}
+
+func (r *userResolver) ID() gographql.ID {
+	return formatID(r.user.ID)
+}
+
+func (r *userResolver) Username() string {
+	return r.user.Username
+}
+
+func (r *userResolver) FullName() string {
+	return r.user.FullName
+}
+
+func (r *userResolver) Gender() string {
+	return r.user.Gender
+}
+
+func (r *userResolver) Birthday() string {
+	return r.user.Birthday.Format(dateLayout)
+}
+
+func (r *userResolver) City() string {
+	return r.user.City
+}
+
+func (r *userResolver) Role() string {
+	return r.user.Role
+}
+
+func (r *userResolver) Companies(ctx context.Context, args pageArgs) (*companyPageResolver, error) {
+	page, err := r.services.Company.GetByOwnerId(ctx, r.user.ID, pageRequest(args.Page))
+	if err != nil {
+		return nil, err
+	}
+
+	// сферы деятельности и отчеты всех компаний страницы загрузятся одним вызовом
+	loadersFrom(ctx).primeCompanies(page.Items)
+
+	items := make([]*companyResolver, len(page.Items))
+	for i, company := range page.Items {
+		items[i] = &companyResolver{company: company}
+	}
+
+	return &companyPageResolver{pageInfo: newPageInfo(page), items: items}, nil
+}
+
+func (r *userResolver) Skills(ctx context.Context, args pageArgs) (*skillPageResolver, error) {
+	page, err := r.services.UserSkill.GetSkillsForUser(ctx, r.user.ID, pageRequest(args.Page))
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*skillResolver, len(page.Items))
+	for i, skill := range page.Items {
+		items[i] = &skillResolver{skill: skill}
+	}
+
+	return &skillPageResolver{pageInfo: newPageInfo(page), items: items}, nil
+}
+
+func (r *userResolver) Contacts(ctx context.Context, args pageArgs) (*contactPageResolver, error) {
+	page, err := r.services.Contact.GetByOwnerId(ctx, r.user.ID, pageRequest(args.Page))
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*contactResolver, len(page.Items))
+	for i, contact := range page.Items {
+		items[i] = &contactResolver{contact: contact}
+	}
+
+	return &contactPageResolver{pageInfo: newPageInfo(page), items: items}, nil
+}
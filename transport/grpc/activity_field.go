@@ -0,0 +1,109 @@
+package grpc
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/transport/grpc/pb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type activityFieldServer struct {
+	pb.UnimplementedActivityFieldServiceServer
+	svc domain.IActivityFieldService
+}
+
+func (s *activityFieldServer) CreateActivityField(ctx context.Context, req *pb.CreateActivityFieldRequest) (*pb.ActivityField, error) {
+	field, err := toActivityField(req.GetActivityField())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.svc.Create(ctx, field)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromActivityField(field), nil
+}
+
+func (s *activityFieldServer) GetActivityField(ctx context.Context, req *pb.GetActivityFieldRequest) (*pb.ActivityField, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	field, err := s.svc.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromActivityField(field), nil
+}
+
+func (s *activityFieldServer) ListActivityFields(ctx context.Context, req *pb.ListActivityFieldsRequest) (*pb.ListActivityFieldsResponse, error) {
+	fields, err := s.svc.GetAll(ctx, pageRequest(req.GetPage()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListActivityFieldsResponse{
+		ActivityFields: convertItems(fields.Items, fromActivityField),
+		Page:           pageInfo(fields),
+	}, nil
+}
+
+func (s *activityFieldServer) UpdateActivityField(ctx context.Context, req *pb.UpdateActivityFieldRequest) (*pb.ActivityField, error) {
+	field, err := toActivityField(req.GetActivityField())
+	if err != nil {
+		return nil, err
+	}
+
+	err = requireID("id", field.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.svc.Update(ctx, field)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromActivityField(field), nil
+}
+
+func (s *activityFieldServer) DeleteActivityField(ctx context.Context, req *pb.DeleteActivityFieldRequest) (*emptypb.Empty, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.svc.DeleteById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *activityFieldServer) GetCostByCompany(ctx context.Context, req *pb.GetCostByCompanyRequest) (*pb.GetCostByCompanyResponse, error) {
+	companyID, err := parseID("company_id", req.GetCompanyId())
+	if err != nil {
+		return nil, err
+	}
+
+	cost, err := s.svc.GetCostByCompanyId(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetCostByCompanyResponse{Cost: cost}, nil
+}
+
+func (s *activityFieldServer) GetMaxCost(ctx context.Context, _ *pb.GetMaxCostRequest) (*pb.GetMaxCostResponse, error) {
+	cost, err := s.svc.GetMaxCost(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetMaxCostResponse{Cost: cost}, nil
+}
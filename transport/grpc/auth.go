@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/transport/grpc/pb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type authServer struct {
+	pb.UnimplementedAuthServiceServer
+	svc domain.IAuthService
+}
+
+func (s *authServer) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
+	authInfo := &domain.UserAuth{
+		Username: req.GetUsername(),
+		Password: req.GetPassword(),
+		Role:     req.GetRole(),
+	}
+	err := s.svc.Register(ctx, authInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RegisterResponse{Id: formatID(authInfo.ID)}, nil
+}
+
+func (s *authServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+	tokens, err := s.svc.Login(ctx, &domain.UserAuth{
+		Username: req.GetUsername(),
+		Password: req.GetPassword(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LoginResponse{Tokens: fromTokenPair(tokens)}, nil
+}
+
+func (s *authServer) Refresh(ctx context.Context, req *pb.RefreshRequest) (*pb.RefreshResponse, error) {
+	tokens, err := s.svc.Refresh(ctx, req.GetRefreshToken())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RefreshResponse{Tokens: fromTokenPair(tokens)}, nil
+}
+
+func (s *authServer) Logout(ctx context.Context, req *pb.LogoutRequest) (*emptypb.Empty, error) {
+	err := s.svc.Logout(ctx, req.GetRefreshToken())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func fromTokenPair(tokens *domain.TokenPair) *pb.TokenPair {
+	return &pb.TokenPair{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}
+}
@@ -0,0 +1,118 @@
+package grpc
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/transport/grpc/pb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type companyServer struct {
+	pb.UnimplementedCompanyServiceServer
+	svc domain.ICompanyService
+}
+
+func (s *companyServer) CreateCompany(ctx context.Context, req *pb.CreateCompanyRequest) (*pb.Company, error) {
+	company, err := toCompany(req.GetCompany())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.svc.Create(ctx, company)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromCompany(company), nil
+}
+
+func (s *companyServer) GetCompany(ctx context.Context, req *pb.GetCompanyRequest) (*pb.Company, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	company, err := s.svc.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromCompany(company), nil
+}
+
+func (s *companyServer) ListCompaniesByOwner(ctx context.Context, req *pb.ListCompaniesByOwnerRequest) (*pb.ListCompaniesResponse, error) {
+	ownerID, err := parseID("owner_id", req.GetOwnerId())
+	if err != nil {
+		return nil, err
+	}
+
+	companies, err := s.svc.GetByOwnerId(ctx, ownerID, pageRequest(req.GetPage()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListCompaniesResponse{
+		Companies: convertItems(companies.Items, fromCompany),
+		Page:      pageInfo(companies),
+	}, nil
+}
+
+func (s *companyServer) ListCompanies(ctx context.Context, req *pb.ListCompaniesRequest) (*pb.ListCompaniesResponse, error) {
+	filter := domain.CompanyFilter{
+		City:   req.GetFilter().GetCity(),
+		Search: req.GetFilter().GetSearch(),
+	}
+
+	var err error
+	filter.ActivityFieldId, err = parseOptionalID("activity_field_id", req.GetFilter().GetActivityFieldId())
+	if err != nil {
+		return nil, err
+	}
+	filter.OwnerId, err = parseOptionalID("owner_id", req.GetFilter().GetOwnerId())
+	if err != nil {
+		return nil, err
+	}
+
+	companies, err := s.svc.GetAll(ctx, filter, pageRequest(req.GetPage()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListCompaniesResponse{
+		Companies: convertItems(companies.Items, fromCompany),
+		Page:      pageInfo(companies),
+	}, nil
+}
+
+func (s *companyServer) UpdateCompany(ctx context.Context, req *pb.UpdateCompanyRequest) (*pb.Company, error) {
+	company, err := toCompany(req.GetCompany())
+	if err != nil {
+		return nil, err
+	}
+
+	err = requireID("id", company.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.svc.Update(ctx, company)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromCompany(company), nil
+}
+
+func (s *companyServer) DeleteCompany(ctx context.Context, req *pb.DeleteCompanyRequest) (*emptypb.Empty, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.svc.DeleteById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/transport/grpc/pb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type contactServer struct {
+	pb.UnimplementedContactServiceServer
+	svc domain.IContactsService
+}
+
+func (s *contactServer) CreateContact(ctx context.Context, req *pb.CreateContactRequest) (*pb.Contact, error) {
+	contact, err := toContact(req.GetContact())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.svc.Create(ctx, contact)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromContact(contact), nil
+}
+
+func (s *contactServer) GetContact(ctx context.Context, req *pb.GetContactRequest) (*pb.Contact, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	contact, err := s.svc.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromContact(contact), nil
+}
+
+func (s *contactServer) ListContactsByOwner(ctx context.Context, req *pb.ListContactsByOwnerRequest) (*pb.ListContactsResponse, error) {
+	ownerID, err := parseID("owner_id", req.GetOwnerId())
+	if err != nil {
+		return nil, err
+	}
+
+	contacts, err := s.svc.GetByOwnerId(ctx, ownerID, pageRequest(req.GetPage()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListContactsResponse{
+		Contacts: convertItems(contacts.Items, fromContact),
+		Page:     pageInfo(contacts),
+	}, nil
+}
+
+func (s *contactServer) UpdateContact(ctx context.Context, req *pb.UpdateContactRequest) (*pb.Contact, error) {
+	contact, err := toContact(req.GetContact())
+	if err != nil {
+		return nil, err
+	}
+
+	err = requireID("id", contact.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.svc.Update(ctx, contact)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromContact(contact), nil
+}
+
+func (s *contactServer) DeleteContact(ctx context.Context, req *pb.DeleteContactRequest) (*emptypb.Empty, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.svc.DeleteById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,368 @@
+package grpc
+
+import (
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/dlankinl/bmstu-ppo-bl/transport/grpc/pb"
+	"github.com/google/uuid"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// parseID разбирает обязательный идентификатор.
+func parseID(field, value string) (uuid.UUID, error) {
+	if value == "" {
+		return uuid.Nil, domain.NewValidationError(field, i18n.GRPCFieldRequired, field)
+	}
+
+	return parseOptionalID(field, value)
+}
+
+// parseOptionalID разбирает идентификатор, пустая строка дает uuid.Nil.
+func parseOptionalID(field, value string) (uuid.UUID, error) {
+	if value == "" {
+		return uuid.Nil, nil
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, domain.NewValidationError(field, i18n.GRPCFieldInvalid, field)
+	}
+
+	return id, nil
+}
+
+func formatID(id uuid.UUID) string {
+	if id == uuid.Nil {
+		return ""
+	}
+
+	return id.String()
+}
+
+func parseDate(field, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	date, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, domain.NewValidationError(field, i18n.GRPCFieldInvalid, field)
+	}
+
+	return date, nil
+}
+
+func pageRequest(req *pb.PageRequest) domain.PageRequest {
+	return domain.PageRequest{
+		Limit:  int(req.GetLimit()),
+		Offset: int(req.GetOffset()),
+		Cursor: req.GetCursor(),
+		Sort:   req.GetSort(),
+	}
+}
+
+func pageInfo[T any](page *domain.Page[T]) *pb.PageInfo {
+	return &pb.PageInfo{
+		Total:      int32(page.Total),
+		Offset:     int32(page.Offset),
+		Limit:      int32(page.Limit),
+		NextCursor: page.NextCursor,
+	}
+}
+
+func convertItems[S, T any](items []S, convert func(S) T) []T {
+	result := make([]T, 0, len(items))
+	for _, item := range items {
+		result = append(result, convert(item))
+	}
+
+	return result
+}
+
+func toPeriod(period *pb.Period) (*domain.Period, error) {
+	if period == nil {
+		return nil, domain.NewValidationError("period", i18n.GRPCFieldRequired, "period")
+	}
+
+	for _, quarter := range []int32{period.GetStartQuarter(), period.GetEndQuarter()} {
+		if quarter < 1 || quarter > 4 {
+			return nil, domain.NewValidationError("quarter", i18n.FinReportQuarterRange)
+		}
+	}
+
+	return &domain.Period{
+		StartYear:    int(period.GetStartYear()),
+		StartQuarter: int(period.GetStartQuarter()),
+		EndYear:      int(period.GetEndYear()),
+		EndQuarter:   int(period.GetEndQuarter()),
+	}, nil
+}
+
+func fromPeriod(period *domain.Period) *pb.Period {
+	if period == nil {
+		return nil
+	}
+
+	return &pb.Period{
+		StartYear:    int32(period.StartYear),
+		StartQuarter: int32(period.StartQuarter),
+		EndYear:      int32(period.EndYear),
+		EndQuarter:   int32(period.EndQuarter),
+	}
+}
+
+func toUser(user *pb.User) (*domain.User, error) {
+	id, err := parseOptionalID("id", user.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	birthday, err := parseDate("birthday", user.GetBirthday())
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.User{
+		ID:       id,
+		Username: user.GetUsername(),
+		FullName: user.GetFullName(),
+		Gender:   user.GetGender(),
+		Birthday: birthday,
+		City:     user.GetCity(),
+		Role:     user.GetRole(),
+	}, nil
+}
+
+func fromUser(user *domain.User) *pb.User {
+	resp := &pb.User{
+		Id:       formatID(user.ID),
+		Username: user.Username,
+		FullName: user.FullName,
+		Gender:   user.Gender,
+		City:     user.City,
+		Role:     user.Role,
+	}
+	if !user.Birthday.IsZero() {
+		resp.Birthday = user.Birthday.Format(dateLayout)
+	}
+
+	return resp
+}
+
+func toCompany(company *pb.Company) (*domain.Company, error) {
+	id, err := parseOptionalID("id", company.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	ownerID, err := parseOptionalID("owner_id", company.GetOwnerId())
+	if err != nil {
+		return nil, err
+	}
+
+	actFieldID, err := parseOptionalID("activity_field_id", company.GetActivityFieldId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Company{
+		ID:              id,
+		OwnerID:         ownerID,
+		ActivityFieldId: actFieldID,
+		Name:            company.GetName(),
+		City:            company.GetCity(),
+	}, nil
+}
+
+func fromCompany(company *domain.Company) *pb.Company {
+	return &pb.Company{
+		Id:              formatID(company.ID),
+		OwnerId:         formatID(company.OwnerID),
+		ActivityFieldId: formatID(company.ActivityFieldId),
+		Name:            company.Name,
+		City:            company.City,
+	}
+}
+
+func toContact(contact *pb.Contact) (*domain.Contact, error) {
+	id, err := parseOptionalID("id", contact.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	ownerID, err := parseOptionalID("owner_id", contact.GetOwnerId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Contact{
+		ID:      id,
+		OwnerID: ownerID,
+		Name:    contact.GetName(),
+		Value:   contact.GetValue(),
+	}, nil
+}
+
+func fromContact(contact *domain.Contact) *pb.Contact {
+	return &pb.Contact{
+		Id:      formatID(contact.ID),
+		OwnerId: formatID(contact.OwnerID),
+		Name:    contact.Name,
+		Value:   contact.Value,
+	}
+}
+
+func toSkill(skill *pb.Skill) (*domain.Skill, error) {
+	id, err := parseOptionalID("id", skill.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Skill{
+		ID:          id,
+		Name:        skill.GetName(),
+		Description: skill.GetDescription(),
+	}, nil
+}
+
+func fromSkill(skill *domain.Skill) *pb.Skill {
+	return &pb.Skill{
+		Id:          formatID(skill.ID),
+		Name:        skill.Name,
+		Description: skill.Description,
+	}
+}
+
+func toActivityField(field *pb.ActivityField) (*domain.ActivityField, error) {
+	id, err := parseOptionalID("id", field.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.ActivityField{
+		ID:          id,
+		Name:        field.GetName(),
+		Description: field.GetDescription(),
+		Cost:        field.GetCost(),
+	}, nil
+}
+
+func fromActivityField(field *domain.ActivityField) *pb.ActivityField {
+	return &pb.ActivityField{
+		Id:          formatID(field.ID),
+		Name:        field.Name,
+		Description: field.Description,
+		Cost:        field.Cost,
+	}
+}
+
+// toMoney переводит сумму запроса, отсутствующая сумма считается нулевой.
+func toMoney(money *pb.Money) domain.Money {
+	currency := money.GetCurrency()
+	if currency == "" {
+		currency = domain.DefaultCurrency
+	}
+
+	return domain.Money{Amount: money.GetAmount(), Currency: currency}
+}
+
+func fromMoney(money domain.Money) *pb.Money {
+	return &pb.Money{Amount: money.Amount, Currency: money.Currency}
+}
+
+func toFinReport(report *pb.FinancialReport) (*domain.FinancialReport, error) {
+	id, err := parseOptionalID("id", report.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	companyID, err := parseOptionalID("company_id", report.GetCompanyId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.FinancialReport{
+		ID:          id,
+		CompanyID:   companyID,
+		Revenue:     toMoney(report.GetRevenue()),
+		Costs:       toMoney(report.GetCosts()),
+		Granularity: report.GetGranularity(),
+		Year:        int(report.GetYear()),
+		Quarter:     int(report.GetQuarter()),
+		Month:       int(report.GetMonth()),
+	}, nil
+}
+
+func fromFinReport(report *domain.FinancialReport) *pb.FinancialReport {
+	return &pb.FinancialReport{
+		Id:          formatID(report.ID),
+		CompanyId:   formatID(report.CompanyID),
+		Revenue:     fromMoney(report.Revenue),
+		Costs:       fromMoney(report.Costs),
+		Granularity: report.Granularity,
+		Year:        int32(report.Year),
+		Quarter:     int32(report.Quarter),
+		Month:       int32(report.Month),
+	}
+}
+
+func fromFinReportByPeriod(report *domain.FinancialReportByPeriod) *pb.FinancialReportByPeriod {
+	resp := &pb.FinancialReportByPeriod{
+		Reports: make([]*pb.FinancialReport, 0, len(report.Reports)),
+		Period:  fromPeriod(report.Period),
+		Revenue: fromMoney(report.Revenue()),
+		Costs:   fromMoney(report.Costs()),
+		Profit:  fromMoney(report.Profit()),
+		Taxes:   fromMoney(report.Taxes),
+		TaxLoad: report.TaxLoad,
+	}
+	for i := range report.Reports {
+		resp.Reports = append(resp.Reports, fromFinReport(&report.Reports[i]))
+	}
+
+	return resp
+}
+
+var searchKinds = map[pb.SearchKind]domain.SearchKind{
+	pb.SearchKind_SEARCH_KIND_SKILL:          domain.SearchKindSkill,
+	pb.SearchKind_SEARCH_KIND_ACTIVITY_FIELD: domain.SearchKindActivityField,
+	pb.SearchKind_SEARCH_KIND_COMPANY:        domain.SearchKindCompany,
+}
+
+func toSearchKind(kind pb.SearchKind) (domain.SearchKind, error) {
+	searchKind, ok := searchKinds[kind]
+	if !ok {
+		return "", domain.NewValidationError("kinds", i18n.SearchKindUnknown, kind.String())
+	}
+
+	return searchKind, nil
+}
+
+func fromSearchKind(kind domain.SearchKind) pb.SearchKind {
+	for pbKind, searchKind := range searchKinds {
+		if searchKind == kind {
+			return pbKind
+		}
+	}
+
+	return pb.SearchKind_SEARCH_KIND_UNSPECIFIED
+}
+
+func fromSearchHit(hit *domain.SearchHit) *pb.SearchHit {
+	return &pb.SearchHit{
+		Kind:  fromSearchKind(hit.Kind),
+		Id:    formatID(hit.ID),
+		Title: hit.Title,
+		Score: hit.Score,
+	}
+}
+
+func requireID(field string, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return domain.NewValidationError(field, i18n.GRPCFieldRequired, field)
+	}
+
+	return nil
+}
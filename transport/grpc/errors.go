@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Code сопоставляет ошибке сервиса код статуса gRPC.
+func Code(err error) codes.Code {
+	switch {
+	case errors.Is(err, domain.ErrValidation):
+		return codes.InvalidArgument
+	case errors.Is(err, domain.ErrUnauthenticated):
+		return codes.Unauthenticated
+	case errors.Is(err, domain.ErrForbidden):
+		return codes.PermissionDenied
+	case errors.Is(err, domain.ErrNotFound):
+		return codes.NotFound
+	case errors.Is(err, domain.ErrConflict):
+		return codes.AlreadyExists
+	case errors.Is(err, domain.ErrNotEnoughData):
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
+
+// status переводит ошибку сервиса в статус gRPC с локализованным сообщением.
+// Поле с ошибкой валидации передается в деталях BadRequest.
+func (s *Server) status(ctx context.Context, method string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	locale := i18n.FromContext(ctx)
+	code := Code(err)
+
+	if code == codes.Internal {
+		s.logger.Errorf("%v: %v", method, err)
+		return status.Error(code, i18n.Text(locale, i18n.GRPCInternal))
+	}
+
+	st := status.New(code, i18n.Localize(err, locale))
+
+	var validationErr *domain.ValidationError
+	if errors.As(err, &validationErr) {
+		detailed, detailsErr := st.WithDetails(&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+				Field:       validationErr.Field,
+				Description: st.Message(),
+			}},
+		})
+		if detailsErr == nil {
+			st = detailed
+		}
+	}
+
+	return st.Err()
+}
@@ -0,0 +1,114 @@
+package grpc
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/transport/grpc/pb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type finReportServer struct {
+	pb.UnimplementedFinancialReportServiceServer
+	svc domain.IFinancialReportService
+}
+
+func (s *finReportServer) CreateFinancialReport(ctx context.Context, req *pb.CreateFinancialReportRequest) (*pb.FinancialReport, error) {
+	report, err := toFinReport(req.GetReport())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.svc.Create(ctx, report)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromFinReport(report), nil
+}
+
+// CreateFinancialReports сохраняет несколько отчетов одной операцией.
+func (s *finReportServer) CreateFinancialReports(ctx context.Context, req *pb.CreateFinancialReportsRequest) (*pb.FinancialReportByPeriod, error) {
+	byPeriod := &domain.FinancialReportByPeriod{
+		Reports: make([]domain.FinancialReport, 0, len(req.GetReports())),
+	}
+	for _, pbReport := range req.GetReports() {
+		report, err := toFinReport(pbReport)
+		if err != nil {
+			return nil, err
+		}
+		byPeriod.Reports = append(byPeriod.Reports, *report)
+	}
+
+	err := s.svc.CreateByPeriod(ctx, byPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromFinReportByPeriod(byPeriod), nil
+}
+
+func (s *finReportServer) GetFinancialReport(ctx context.Context, req *pb.GetFinancialReportRequest) (*pb.FinancialReport, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.svc.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromFinReport(report), nil
+}
+
+func (s *finReportServer) GetCompanyFinancialReport(ctx context.Context, req *pb.GetCompanyFinancialReportRequest) (*pb.FinancialReportByPeriod, error) {
+	companyID, err := parseID("company_id", req.GetCompanyId())
+	if err != nil {
+		return nil, err
+	}
+
+	period, err := toPeriod(req.GetPeriod())
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.svc.GetByCompany(ctx, companyID, period)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromFinReportByPeriod(report), nil
+}
+
+func (s *finReportServer) UpdateFinancialReport(ctx context.Context, req *pb.UpdateFinancialReportRequest) (*pb.FinancialReport, error) {
+	report, err := toFinReport(req.GetReport())
+	if err != nil {
+		return nil, err
+	}
+
+	err = requireID("id", report.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.svc.Update(ctx, report)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromFinReport(report), nil
+}
+
+func (s *finReportServer) DeleteFinancialReport(ctx context.Context, req *pb.DeleteFinancialReportRequest) (*emptypb.Empty, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.svc.DeleteById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/dlankinl/bmstu-ppo-bl/transport/grpc/pb"
+)
+
+type interactorServer struct {
+	pb.UnimplementedInteractorServiceServer
+	svc       domain.IInteractor
+	companies domain.ICompanyService
+}
+
+// GetMostProfitableCompany загружает компании по id и выбирает самую прибыльную за период.
+func (s *interactorServer) GetMostProfitableCompany(ctx context.Context, req *pb.GetMostProfitableCompanyRequest) (*pb.Company, error) {
+	period, err := toPeriod(req.GetPeriod())
+	if err != nil {
+		return nil, err
+	}
+
+	companies := make([]*domain.Company, 0, len(req.GetCompanyIds()))
+	for _, rawID := range req.GetCompanyIds() {
+		id, err := parseID("company_ids", rawID)
+		if err != nil {
+			return nil, err
+		}
+
+		company, err := s.companies.GetById(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		companies = append(companies, company)
+	}
+
+	company, err := s.svc.GetMostProfitableCompany(ctx, period, companies)
+	if err != nil {
+		return nil, err
+	}
+	if company == nil {
+		return nil, domain.NewError(domain.ErrNotFound, i18n.InteractorNoCompanies)
+	}
+
+	return fromCompany(company), nil
+}
+
+func (s *interactorServer) CalculateUserRating(ctx context.Context, req *pb.CalculateUserRatingRequest) (*pb.CalculateUserRatingResponse, error) {
+	userID, err := parseID("user_id", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	rating, err := s.svc.CalculateUserRating(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CalculateUserRatingResponse{Rating: rating}, nil
+}
+
+func (s *interactorServer) GetUserFinancialReport(ctx context.Context, req *pb.GetUserFinancialReportRequest) (*pb.FinancialReportByPeriod, error) {
+	userID, err := parseID("user_id", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	period, err := toPeriod(req.GetPeriod())
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.svc.GetUserFinancialReport(ctx, userID, period)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromFinReportByPeriod(report), nil
+}
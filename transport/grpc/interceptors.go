@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/base"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/dlankinl/bmstu-ppo-bl/services/authz"
+	gogrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"runtime/debug"
+	"strings"
+	"time"
+)
+
+// recovery журналирует панику вызова и возвращает клиенту внутреннюю ошибку.
+func (s *Server) recovery(ctx context.Context, req any, info *gogrpc.UnaryServerInfo, handler gogrpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			s.logger.Errorf("%v: panic: %v\n%s", info.FullMethod, p, debug.Stack())
+
+			locale := i18n.ParseLocale(metadataValue(ctx, "accept-language"))
+			resp, err = nil, status.Error(codes.Internal, i18n.Text(locale, i18n.GRPCInternal))
+		}
+	}()
+
+	return handler(ctx, req)
+}
+
+func (s *Server) logging(ctx context.Context, req any, info *gogrpc.UnaryServerInfo, handler gogrpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+
+	// подробности внутренних ошибок журналирует mapErrors, здесь только итог вызова
+	s.logger.Infof("%v: %v (%v)", info.FullMethod, status.Code(err), time.Since(start))
+
+	return resp, err
+}
+
+func withLocale(ctx context.Context, req any, _ *gogrpc.UnaryServerInfo, handler gogrpc.UnaryHandler) (any, error) {
+	locale := i18n.ParseLocale(metadataValue(ctx, "accept-language"))
+	return handler(i18n.WithLocale(ctx, locale), req)
+}
+
+func (s *Server) mapErrors(ctx context.Context, req any, info *gogrpc.UnaryServerInfo, handler gogrpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, s.status(ctx, info.FullMethod, err)
+	}
+
+	return resp, nil
+}
+
+// authenticate проверяет Bearer-токен из метаданных authorization и кладет личность
+// пользователя в контекст. Вызов без метаданных выполняется от имени гостя.
+func (s *Server) authenticate(ctx context.Context, req any, _ *gogrpc.UnaryServerInfo, handler gogrpc.UnaryHandler) (any, error) {
+	header := metadataValue(ctx, "authorization")
+	if header == "" {
+		return handler(ctx, req)
+	}
+
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return nil, domain.NewError(domain.ErrUnauthenticated, i18n.GRPCAuthMetadata)
+	}
+
+	payload, err := base.VerifyAuthToken(ctx, strings.TrimSpace(token), s.auth.Keys, s.auth.TokenOpts, s.auth.Revocation)
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.GRPCAuthenticate, err)
+		return nil, domain.WithKind(domain.ErrUnauthenticated, i18n.Wrap(err, i18n.GRPCAuthenticate))
+	}
+
+	return handler(authz.WithIdentity(ctx, authz.IdentityFromPayload(payload)), req)
+}
+
+func metadataValue(ctx context.Context, key string) string {
+	values := metadata.ValueFromIncomingContext(ctx, key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
@@ -0,0 +1,876 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: bl/v1/activity_field.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ActivityField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Cost        float32 `protobuf:"fixed32,4,opt,name=cost,proto3" json:"cost,omitempty"`
+}
+
+func (x *ActivityField) Reset() {
+	*x = ActivityField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bl_v1_activity_field_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivityField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityField) ProtoMessage() {}
+
+func (x *ActivityField) ProtoReflect() protoreflect.Message {
+	mi := &file_bl_v1_activity_field_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityField.ProtoReflect.Descriptor instead.
+func (*ActivityField) Descriptor() ([]byte, []int) {
+	return file_bl_v1_activity_field_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ActivityField) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ActivityField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ActivityField) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ActivityField) GetCost() float32 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+type CreateActivityFieldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivityField *ActivityField `protobuf:"bytes,1,opt,name=activity_field,json=activityField,proto3" json:"activity_field,omitempty"`
+}
+
+func (x *CreateActivityFieldRequest) Reset() {
+	*x = CreateActivityFieldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bl_v1_activity_field_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateActivityFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateActivityFieldRequest) ProtoMessage() {}
+
+func (x *CreateActivityFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bl_v1_activity_field_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateActivityFieldRequest.ProtoReflect.Descriptor instead.
+func (*CreateActivityFieldRequest) Descriptor() ([]byte, []int) {
+	return file_bl_v1_activity_field_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateActivityFieldRequest) GetActivityField() *ActivityField {
+	if x != nil {
+		return x.ActivityField
+	}
+	return nil
+}
+
+type GetActivityFieldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetActivityFieldRequest) Reset() {
+	*x = GetActivityFieldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bl_v1_activity_field_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActivityFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivityFieldRequest) ProtoMessage() {}
+
+func (x *GetActivityFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bl_v1_activity_field_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivityFieldRequest.ProtoReflect.Descriptor instead.
+func (*GetActivityFieldRequest) Descriptor() ([]byte, []int) {
+	return file_bl_v1_activity_field_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetActivityFieldRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListActivityFieldsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page *PageRequest `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *ListActivityFieldsRequest) Reset() {
+	*x = ListActivityFieldsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bl_v1_activity_field_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListActivityFieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActivityFieldsRequest) ProtoMessage() {}
+
+func (x *ListActivityFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bl_v1_activity_field_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActivityFieldsRequest.ProtoReflect.Descriptor instead.
+func (*ListActivityFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_bl_v1_activity_field_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListActivityFieldsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListActivityFieldsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivityFields []*ActivityField `protobuf:"bytes,1,rep,name=activity_fields,json=activityFields,proto3" json:"activity_fields,omitempty"`
+	Page           *PageInfo        `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *ListActivityFieldsResponse) Reset() {
+	*x = ListActivityFieldsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bl_v1_activity_field_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListActivityFieldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActivityFieldsResponse) ProtoMessage() {}
+
+func (x *ListActivityFieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bl_v1_activity_field_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActivityFieldsResponse.ProtoReflect.Descriptor instead.
+func (*ListActivityFieldsResponse) Descriptor() ([]byte, []int) {
+	return file_bl_v1_activity_field_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListActivityFieldsResponse) GetActivityFields() []*ActivityField {
+	if x != nil {
+		return x.ActivityFields
+	}
+	return nil
+}
+
+func (x *ListActivityFieldsResponse) GetPage() *PageInfo {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type UpdateActivityFieldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivityField *ActivityField `protobuf:"bytes,1,opt,name=activity_field,json=activityField,proto3" json:"activity_field,omitempty"`
+}
+
+func (x *UpdateActivityFieldRequest) Reset() {
+	*x = UpdateActivityFieldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bl_v1_activity_field_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateActivityFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateActivityFieldRequest) ProtoMessage() {}
+
+func (x *UpdateActivityFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bl_v1_activity_field_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateActivityFieldRequest.ProtoReflect.Descriptor instead.
+func (*UpdateActivityFieldRequest) Descriptor() ([]byte, []int) {
+	return file_bl_v1_activity_field_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateActivityFieldRequest) GetActivityField() *ActivityField {
+	if x != nil {
+		return x.ActivityField
+	}
+	return nil
+}
+
+type DeleteActivityFieldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteActivityFieldRequest) Reset() {
+	*x = DeleteActivityFieldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bl_v1_activity_field_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteActivityFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteActivityFieldRequest) ProtoMessage() {}
+
+func (x *DeleteActivityFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bl_v1_activity_field_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteActivityFieldRequest.ProtoReflect.Descriptor instead.
+func (*DeleteActivityFieldRequest) Descriptor() ([]byte, []int) {
+	return file_bl_v1_activity_field_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteActivityFieldRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetCostByCompanyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompanyId string `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+}
+
+func (x *GetCostByCompanyRequest) Reset() {
+	*x = GetCostByCompanyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bl_v1_activity_field_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCostByCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCostByCompanyRequest) ProtoMessage() {}
+
+func (x *GetCostByCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bl_v1_activity_field_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCostByCompanyRequest.ProtoReflect.Descriptor instead.
+func (*GetCostByCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_bl_v1_activity_field_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetCostByCompanyRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+type GetCostByCompanyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cost float32 `protobuf:"fixed32,1,opt,name=cost,proto3" json:"cost,omitempty"`
+}
+
+func (x *GetCostByCompanyResponse) Reset() {
+	*x = GetCostByCompanyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bl_v1_activity_field_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCostByCompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCostByCompanyResponse) ProtoMessage() {}
+
+func (x *GetCostByCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bl_v1_activity_field_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCostByCompanyResponse.ProtoReflect.Descriptor instead.
+func (*GetCostByCompanyResponse) Descriptor() ([]byte, []int) {
+	return file_bl_v1_activity_field_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetCostByCompanyResponse) GetCost() float32 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+type GetMaxCostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetMaxCostRequest) Reset() {
+	*x = GetMaxCostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bl_v1_activity_field_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMaxCostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaxCostRequest) ProtoMessage() {}
+
+func (x *GetMaxCostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bl_v1_activity_field_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaxCostRequest.ProtoReflect.Descriptor instead.
+func (*GetMaxCostRequest) Descriptor() ([]byte, []int) {
+	return file_bl_v1_activity_field_proto_rawDescGZIP(), []int{9}
+}
+
+type GetMaxCostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cost float32 `protobuf:"fixed32,1,opt,name=cost,proto3" json:"cost,omitempty"`
+}
+
+func (x *GetMaxCostResponse) Reset() {
+	*x = GetMaxCostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bl_v1_activity_field_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMaxCostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaxCostResponse) ProtoMessage() {}
+
+func (x *GetMaxCostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bl_v1_activity_field_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaxCostResponse.ProtoReflect.Descriptor instead.
+func (*GetMaxCostResponse) Descriptor() ([]byte, []int) {
+	return file_bl_v1_activity_field_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetMaxCostResponse) GetCost() float32 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+var File_bl_v1_activity_field_proto protoreflect.FileDescriptor
+
+var file_bl_v1_activity_field_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x62, 0x6c,
+	0x2e, 0x76, 0x31, 0x1a, 0x12, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x69, 0x0a, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x22,
+	0x59, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a,
+	0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0d, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x29, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x43, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x1a, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0f, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x59, 0x0a,
+	0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x0e, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x2c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x38, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x73,
+	0x74, 0x42, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64,
+	0x22, 0x2e, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74,
+	0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x78, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x28, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x78, 0x43,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x32,
+	0xc5, 0x04, 0x0a, 0x14, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x21, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x48, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1e, 0x2e, 0x62,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x62,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x59, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x20, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a,
+	0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x21, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x50, 0x0a,
+	0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x21, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x53, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x12, 0x1e, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x73, 0x74, 0x42, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x73, 0x74, 0x42, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x78, 0x43, 0x6f,
+	0x73, 0x74, 0x12, 0x18, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x78, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x62,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x78, 0x43, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x6c, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x6c, 0x2f, 0x62,
+	0x6d, 0x73, 0x74, 0x75, 0x2d, 0x70, 0x70, 0x6f, 0x2d, 0x62, 0x6c, 0x2f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_bl_v1_activity_field_proto_rawDescOnce sync.Once
+	file_bl_v1_activity_field_proto_rawDescData = file_bl_v1_activity_field_proto_rawDesc
+)
+
+func file_bl_v1_activity_field_proto_rawDescGZIP() []byte {
+	file_bl_v1_activity_field_proto_rawDescOnce.Do(func() {
+		file_bl_v1_activity_field_proto_rawDescData = protoimpl.X.CompressGZIP(file_bl_v1_activity_field_proto_rawDescData)
+	})
+	return file_bl_v1_activity_field_proto_rawDescData
+}
+
+var file_bl_v1_activity_field_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_bl_v1_activity_field_proto_goTypes = []any{
+	(*ActivityField)(nil),              // 0: bl.v1.ActivityField
+	(*CreateActivityFieldRequest)(nil), // 1: bl.v1.CreateActivityFieldRequest
+	(*GetActivityFieldRequest)(nil),    // 2: bl.v1.GetActivityFieldRequest
+	(*ListActivityFieldsRequest)(nil),  // 3: bl.v1.ListActivityFieldsRequest
+	(*ListActivityFieldsResponse)(nil), // 4: bl.v1.ListActivityFieldsResponse
+	(*UpdateActivityFieldRequest)(nil), // 5: bl.v1.UpdateActivityFieldRequest
+	(*DeleteActivityFieldRequest)(nil), // 6: bl.v1.DeleteActivityFieldRequest
+	(*GetCostByCompanyRequest)(nil),    // 7: bl.v1.GetCostByCompanyRequest
+	(*GetCostByCompanyResponse)(nil),   // 8: bl.v1.GetCostByCompanyResponse
+	(*GetMaxCostRequest)(nil),          // 9: bl.v1.GetMaxCostRequest
+	(*GetMaxCostResponse)(nil),         // 10: bl.v1.GetMaxCostResponse
+	(*PageRequest)(nil),                // 11: bl.v1.PageRequest
+	(*PageInfo)(nil),                   // 12: bl.v1.PageInfo
+	(*emptypb.Empty)(nil),              // 13: google.protobuf.Empty
+}
+var file_bl_v1_activity_field_proto_depIdxs = []int32{
+	0,  // 0: bl.v1.CreateActivityFieldRequest.activity_field:type_name -> bl.v1.ActivityField
+	11, // 1: bl.v1.ListActivityFieldsRequest.page:type_name -> bl.v1.PageRequest
+	0,  // 2: bl.v1.ListActivityFieldsResponse.activity_fields:type_name -> bl.v1.ActivityField
+	12, // 3: bl.v1.ListActivityFieldsResponse.page:type_name -> bl.v1.PageInfo
+	0,  // 4: bl.v1.UpdateActivityFieldRequest.activity_field:type_name -> bl.v1.ActivityField
+	1,  // 5: bl.v1.ActivityFieldService.CreateActivityField:input_type -> bl.v1.CreateActivityFieldRequest
+	2,  // 6: bl.v1.ActivityFieldService.GetActivityField:input_type -> bl.v1.GetActivityFieldRequest
+	3,  // 7: bl.v1.ActivityFieldService.ListActivityFields:input_type -> bl.v1.ListActivityFieldsRequest
+	5,  // 8: bl.v1.ActivityFieldService.UpdateActivityField:input_type -> bl.v1.UpdateActivityFieldRequest
+	6,  // 9: bl.v1.ActivityFieldService.DeleteActivityField:input_type -> bl.v1.DeleteActivityFieldRequest
+	7,  // 10: bl.v1.ActivityFieldService.GetCostByCompany:input_type -> bl.v1.GetCostByCompanyRequest
+	9,  // 11: bl.v1.ActivityFieldService.GetMaxCost:input_type -> bl.v1.GetMaxCostRequest
+	0,  // 12: bl.v1.ActivityFieldService.CreateActivityField:output_type -> bl.v1.ActivityField
+	0,  // 13: bl.v1.ActivityFieldService.GetActivityField:output_type -> bl.v1.ActivityField
+	4,  // 14: bl.v1.ActivityFieldService.ListActivityFields:output_type -> bl.v1.ListActivityFieldsResponse
+	0,  // 15: bl.v1.ActivityFieldService.UpdateActivityField:output_type -> bl.v1.ActivityField
+	13, // 16: bl.v1.ActivityFieldService.DeleteActivityField:output_type -> google.protobuf.Empty
+	8,  // 17: bl.v1.ActivityFieldService.GetCostByCompany:output_type -> bl.v1.GetCostByCompanyResponse
+	10, // 18: bl.v1.ActivityFieldService.GetMaxCost:output_type -> bl.v1.GetMaxCostResponse
+	12, // [12:19] is the sub-list for method output_type
+	5,  // [5:12] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_bl_v1_activity_field_proto_init() }
+func file_bl_v1_activity_field_proto_init() {
+	if File_bl_v1_activity_field_proto != nil {
+		return
+	}
+	file_bl_v1_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_bl_v1_activity_field_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ActivityField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bl_v1_activity_field_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateActivityFieldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bl_v1_activity_field_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*GetActivityFieldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bl_v1_activity_field_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ListActivityFieldsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bl_v1_activity_field_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*ListActivityFieldsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bl_v1_activity_field_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateActivityFieldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bl_v1_activity_field_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteActivityFieldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bl_v1_activity_field_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCostByCompanyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bl_v1_activity_field_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCostByCompanyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bl_v1_activity_field_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*GetMaxCostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bl_v1_activity_field_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*GetMaxCostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_bl_v1_activity_field_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_bl_v1_activity_field_proto_goTypes,
+		DependencyIndexes: file_bl_v1_activity_field_proto_depIdxs,
+		MessageInfos:      file_bl_v1_activity_field_proto_msgTypes,
+	}.Build()
+	File_bl_v1_activity_field_proto = out.File
+	file_bl_v1_activity_field_proto_rawDesc = nil
+	file_bl_v1_activity_field_proto_goTypes = nil
+	file_bl_v1_activity_field_proto_depIdxs = nil
+}
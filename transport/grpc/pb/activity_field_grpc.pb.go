@@ -0,0 +1,339 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: bl/v1/activity_field.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	ActivityFieldService_CreateActivityField_FullMethodName = "/bl.v1.ActivityFieldService/CreateActivityField"
+	ActivityFieldService_GetActivityField_FullMethodName    = "/bl.v1.ActivityFieldService/GetActivityField"
+	ActivityFieldService_ListActivityFields_FullMethodName  = "/bl.v1.ActivityFieldService/ListActivityFields"
+	ActivityFieldService_UpdateActivityField_FullMethodName = "/bl.v1.ActivityFieldService/UpdateActivityField"
+	ActivityFieldService_DeleteActivityField_FullMethodName = "/bl.v1.ActivityFieldService/DeleteActivityField"
+	ActivityFieldService_GetCostByCompany_FullMethodName    = "/bl.v1.ActivityFieldService/GetCostByCompany"
+	ActivityFieldService_GetMaxCost_FullMethodName          = "/bl.v1.ActivityFieldService/GetMaxCost"
+)
+
+// ActivityFieldServiceClient is the client API for ActivityFieldService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ActivityFieldServiceClient interface {
+	CreateActivityField(ctx context.Context, in *CreateActivityFieldRequest, opts ...grpc.CallOption) (*ActivityField, error)
+	GetActivityField(ctx context.Context, in *GetActivityFieldRequest, opts ...grpc.CallOption) (*ActivityField, error)
+	ListActivityFields(ctx context.Context, in *ListActivityFieldsRequest, opts ...grpc.CallOption) (*ListActivityFieldsResponse, error)
+	UpdateActivityField(ctx context.Context, in *UpdateActivityFieldRequest, opts ...grpc.CallOption) (*ActivityField, error)
+	DeleteActivityField(ctx context.Context, in *DeleteActivityFieldRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetCostByCompany(ctx context.Context, in *GetCostByCompanyRequest, opts ...grpc.CallOption) (*GetCostByCompanyResponse, error)
+	GetMaxCost(ctx context.Context, in *GetMaxCostRequest, opts ...grpc.CallOption) (*GetMaxCostResponse, error)
+}
+
+type activityFieldServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewActivityFieldServiceClient(cc grpc.ClientConnInterface) ActivityFieldServiceClient {
+	return &activityFieldServiceClient{cc}
+}
+
+func (c *activityFieldServiceClient) CreateActivityField(ctx context.Context, in *CreateActivityFieldRequest, opts ...grpc.CallOption) (*ActivityField, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActivityField)
+	err := c.cc.Invoke(ctx, ActivityFieldService_CreateActivityField_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityFieldServiceClient) GetActivityField(ctx context.Context, in *GetActivityFieldRequest, opts ...grpc.CallOption) (*ActivityField, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActivityField)
+	err := c.cc.Invoke(ctx, ActivityFieldService_GetActivityField_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityFieldServiceClient) ListActivityFields(ctx context.Context, in *ListActivityFieldsRequest, opts ...grpc.CallOption) (*ListActivityFieldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListActivityFieldsResponse)
+	err := c.cc.Invoke(ctx, ActivityFieldService_ListActivityFields_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityFieldServiceClient) UpdateActivityField(ctx context.Context, in *UpdateActivityFieldRequest, opts ...grpc.CallOption) (*ActivityField, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActivityField)
+	err := c.cc.Invoke(ctx, ActivityFieldService_UpdateActivityField_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityFieldServiceClient) DeleteActivityField(ctx context.Context, in *DeleteActivityFieldRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ActivityFieldService_DeleteActivityField_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityFieldServiceClient) GetCostByCompany(ctx context.Context, in *GetCostByCompanyRequest, opts ...grpc.CallOption) (*GetCostByCompanyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCostByCompanyResponse)
+	err := c.cc.Invoke(ctx, ActivityFieldService_GetCostByCompany_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityFieldServiceClient) GetMaxCost(ctx context.Context, in *GetMaxCostRequest, opts ...grpc.CallOption) (*GetMaxCostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMaxCostResponse)
+	err := c.cc.Invoke(ctx, ActivityFieldService_GetMaxCost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ActivityFieldServiceServer is the server API for ActivityFieldService service.
+// All implementations must embed UnimplementedActivityFieldServiceServer
+// for forward compatibility
+type ActivityFieldServiceServer interface {
+	CreateActivityField(context.Context, *CreateActivityFieldRequest) (*ActivityField, error)
+	GetActivityField(context.Context, *GetActivityFieldRequest) (*ActivityField, error)
+	ListActivityFields(context.Context, *ListActivityFieldsRequest) (*ListActivityFieldsResponse, error)
+	UpdateActivityField(context.Context, *UpdateActivityFieldRequest) (*ActivityField, error)
+	DeleteActivityField(context.Context, *DeleteActivityFieldRequest) (*emptypb.Empty, error)
+	GetCostByCompany(context.Context, *GetCostByCompanyRequest) (*GetCostByCompanyResponse, error)
+	GetMaxCost(context.Context, *GetMaxCostRequest) (*GetMaxCostResponse, error)
+	mustEmbedUnimplementedActivityFieldServiceServer()
+}
+
+// UnimplementedActivityFieldServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedActivityFieldServiceServer struct {
+}
+
+func (UnimplementedActivityFieldServiceServer) CreateActivityField(context.Context, *CreateActivityFieldRequest) (*ActivityField, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateActivityField not implemented")
+}
+func (UnimplementedActivityFieldServiceServer) GetActivityField(context.Context, *GetActivityFieldRequest) (*ActivityField, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActivityField not implemented")
+}
+func (UnimplementedActivityFieldServiceServer) ListActivityFields(context.Context, *ListActivityFieldsRequest) (*ListActivityFieldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListActivityFields not implemented")
+}
+func (UnimplementedActivityFieldServiceServer) UpdateActivityField(context.Context, *UpdateActivityFieldRequest) (*ActivityField, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateActivityField not implemented")
+}
+func (UnimplementedActivityFieldServiceServer) DeleteActivityField(context.Context, *DeleteActivityFieldRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteActivityField not implemented")
+}
+func (UnimplementedActivityFieldServiceServer) GetCostByCompany(context.Context, *GetCostByCompanyRequest) (*GetCostByCompanyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCostByCompany not implemented")
+}
+func (UnimplementedActivityFieldServiceServer) GetMaxCost(context.Context, *GetMaxCostRequest) (*GetMaxCostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaxCost not implemented")
+}
+func (UnimplementedActivityFieldServiceServer) mustEmbedUnimplementedActivityFieldServiceServer() {}
+
+// UnsafeActivityFieldServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ActivityFieldServiceServer will
+// result in compilation errors.
+type UnsafeActivityFieldServiceServer interface {
+	mustEmbedUnimplementedActivityFieldServiceServer()
+}
+
+func RegisterActivityFieldServiceServer(s grpc.ServiceRegistrar, srv ActivityFieldServiceServer) {
+	s.RegisterService(&ActivityFieldService_ServiceDesc, srv)
+}
+
+func _ActivityFieldService_CreateActivityField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateActivityFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityFieldServiceServer).CreateActivityField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityFieldService_CreateActivityField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityFieldServiceServer).CreateActivityField(ctx, req.(*CreateActivityFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityFieldService_GetActivityField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActivityFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityFieldServiceServer).GetActivityField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityFieldService_GetActivityField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityFieldServiceServer).GetActivityField(ctx, req.(*GetActivityFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityFieldService_ListActivityFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListActivityFieldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityFieldServiceServer).ListActivityFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityFieldService_ListActivityFields_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityFieldServiceServer).ListActivityFields(ctx, req.(*ListActivityFieldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityFieldService_UpdateActivityField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateActivityFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityFieldServiceServer).UpdateActivityField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityFieldService_UpdateActivityField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityFieldServiceServer).UpdateActivityField(ctx, req.(*UpdateActivityFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityFieldService_DeleteActivityField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteActivityFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityFieldServiceServer).DeleteActivityField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityFieldService_DeleteActivityField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityFieldServiceServer).DeleteActivityField(ctx, req.(*DeleteActivityFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityFieldService_GetCostByCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCostByCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityFieldServiceServer).GetCostByCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityFieldService_GetCostByCompany_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityFieldServiceServer).GetCostByCompany(ctx, req.(*GetCostByCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityFieldService_GetMaxCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaxCostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityFieldServiceServer).GetMaxCost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityFieldService_GetMaxCost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityFieldServiceServer).GetMaxCost(ctx, req.(*GetMaxCostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ActivityFieldService_ServiceDesc is the grpc.ServiceDesc for ActivityFieldService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ActivityFieldService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bl.v1.ActivityFieldService",
+	HandlerType: (*ActivityFieldServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateActivityField",
+			Handler:    _ActivityFieldService_CreateActivityField_Handler,
+		},
+		{
+			MethodName: "GetActivityField",
+			Handler:    _ActivityFieldService_GetActivityField_Handler,
+		},
+		{
+			MethodName: "ListActivityFields",
+			Handler:    _ActivityFieldService_ListActivityFields_Handler,
+		},
+		{
+			MethodName: "UpdateActivityField",
+			Handler:    _ActivityFieldService_UpdateActivityField_Handler,
+		},
+		{
+			MethodName: "DeleteActivityField",
+			Handler:    _ActivityFieldService_DeleteActivityField_Handler,
+		},
+		{
+			MethodName: "GetCostByCompany",
+			Handler:    _ActivityFieldService_GetCostByCompany_Handler,
+		},
+		{
+			MethodName: "GetMaxCost",
+			Handler:    _ActivityFieldService_GetMaxCost_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bl/v1/activity_field.proto",
+}
@@ -0,0 +1,812 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: bl/v1/fin_report.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FinancialReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CompanyId string `protobuf:"bytes,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Revenue   *Money `protobuf:"bytes,7,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	Costs     *Money `protobuf:"bytes,8,opt,name=costs,proto3" json:"costs,omitempty"`
+	Year      int32  `protobuf:"varint,5,opt,name=year,proto3" json:"year,omitempty"`
+	Quarter   int32  `protobuf:"varint,6,opt,name=quarter,proto3" json:"quarter,omitempty"`
+	// month, quarter или year; пустое значение означает quarter
+	Granularity string `protobuf:"bytes,9,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	// месяц отчета с периодичностью month, квартал таких отчетов заполняет сервис
+	Month int32 `protobuf:"varint,10,opt,name=month,proto3" json:"month,omitempty"`
+}
+
+func (x *FinancialReport) Reset() {
+	*x = FinancialReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bl_v1_fin_report_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FinancialReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinancialReport) ProtoMessage() {}
+
+func (x *FinancialReport) ProtoReflect() protoreflect.Message {
+	mi := &file_bl_v1_fin_report_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinancialReport.ProtoReflect.Descriptor instead.
+func (*FinancialReport) Descriptor() ([]byte, []int) {
+	return file_bl_v1_fin_report_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FinancialReport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FinancialReport) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *FinancialReport) GetRevenue() *Money {
+	if x != nil {
+		return x.Revenue
+	}
+	return nil
+}
+
+func (x *FinancialReport) GetCosts() *Money {
+	if x != nil {
+		return x.Costs
+	}
+	return nil
+}
+
+func (x *FinancialReport) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *FinancialReport) GetQuarter() int32 {
+	if x != nil {
+		return x.Quarter
+	}
+	return 0
+}
+
+func (x *FinancialReport) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *FinancialReport) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+// FinancialReportByPeriod содержит отчеты за период и рассчитанные по ним итоги.
+type FinancialReportByPeriod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reports []*FinancialReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	Period  *Period            `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	Revenue *Money             `protobuf:"bytes,8,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	Costs   *Money             `protobuf:"bytes,9,opt,name=costs,proto3" json:"costs,omitempty"`
+	Profit  *Money             `protobuf:"bytes,10,opt,name=profit,proto3" json:"profit,omitempty"`
+	Taxes   *Money             `protobuf:"bytes,11,opt,name=taxes,proto3" json:"taxes,omitempty"`
+	// tax_load отсутствует, если в периоде нет полного года с выручкой.
+	TaxLoad *float32 `protobuf:"fixed32,7,opt,name=tax_load,json=taxLoad,proto3,oneof" json:"tax_load,omitempty"`
+}
+
+func (x *FinancialReportByPeriod) Reset() {
+	*x = FinancialReportByPeriod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bl_v1_fin_report_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FinancialReportByPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinancialReportByPeriod) ProtoMessage() {}
+
+func (x *FinancialReportByPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_bl_v1_fin_report_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinancialReportByPeriod.ProtoReflect.Descriptor instead.
+func (*FinancialReportByPeriod) Descriptor() ([]byte, []int) {
+	return file_bl_v1_fin_report_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FinancialReportByPeriod) GetReports() []*FinancialReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *FinancialReportByPeriod) GetPeriod() *Period {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *FinancialReportByPeriod) GetRevenue() *Money {
+	if x != nil {
+		return x.Revenue
+	}
+	return nil
+}
+
+func (x *FinancialReportByPeriod) GetCosts() *Money {
+	if x != nil {
+		return x.Costs
+	}
+	return nil
+}
+
+func (x *FinancialReportByPeriod) GetProfit() *Money {
+	if x != nil {
+		return x.Profit
+	}
+	return nil
+}
+
+func (x *FinancialReportByPeriod) GetTaxes() *Money {
+	if x != nil {
+		return x.Taxes
+	}
+	return nil
+}
+
+func (x *FinancialReportByPeriod) GetTaxLoad() float32 {
+	if x != nil && x.TaxLoad != nil {
+		return *x.TaxLoad
+	}
+	return 0
+}
+
+type CreateFinancialReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Report *FinancialReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+}
+
+func (x *CreateFinancialReportRequest) Reset() {
+	*x = CreateFinancialReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bl_v1_fin_report_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFinancialReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFinancialReportRequest) ProtoMessage() {}
+
+func (x *CreateFinancialReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bl_v1_fin_report_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFinancialReportRequest.ProtoReflect.Descriptor instead.
+func (*CreateFinancialReportRequest) Descriptor() ([]byte, []int) {
+	return file_bl_v1_fin_report_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateFinancialReportRequest) GetReport() *FinancialReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// CreateFinancialReportsRequest сохраняет все отчеты одной операцией.
+type CreateFinancialReportsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reports []*FinancialReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+}
+
+func (x *CreateFinancialReportsRequest) Reset() {
+	*x = CreateFinancialReportsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bl_v1_fin_report_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFinancialReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFinancialReportsRequest) ProtoMessage() {}
+
+func (x *CreateFinancialReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bl_v1_fin_report_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFinancialReportsRequest.ProtoReflect.Descriptor instead.
+func (*CreateFinancialReportsRequest) Descriptor() ([]byte, []int) {
+	return file_bl_v1_fin_report_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateFinancialReportsRequest) GetReports() []*FinancialReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+type GetFinancialReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetFinancialReportRequest) Reset() {
+	*x = GetFinancialReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bl_v1_fin_report_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFinancialReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFinancialReportRequest) ProtoMessage() {}
+
+func (x *GetFinancialReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bl_v1_fin_report_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFinancialReportRequest.ProtoReflect.Descriptor instead.
+func (*GetFinancialReportRequest) Descriptor() ([]byte, []int) {
+	return file_bl_v1_fin_report_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetFinancialReportRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetCompanyFinancialReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompanyId string  `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Period    *Period `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+}
+
+func (x *GetCompanyFinancialReportRequest) Reset() {
+	*x = GetCompanyFinancialReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bl_v1_fin_report_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCompanyFinancialReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyFinancialReportRequest) ProtoMessage() {}
+
+func (x *GetCompanyFinancialReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bl_v1_fin_report_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyFinancialReportRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyFinancialReportRequest) Descriptor() ([]byte, []int) {
+	return file_bl_v1_fin_report_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetCompanyFinancialReportRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *GetCompanyFinancialReportRequest) GetPeriod() *Period {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+type UpdateFinancialReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Report *FinancialReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+}
+
+func (x *UpdateFinancialReportRequest) Reset() {
+	*x = UpdateFinancialReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bl_v1_fin_report_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFinancialReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFinancialReportRequest) ProtoMessage() {}
+
+func (x *UpdateFinancialReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bl_v1_fin_report_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFinancialReportRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFinancialReportRequest) Descriptor() ([]byte, []int) {
+	return file_bl_v1_fin_report_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateFinancialReportRequest) GetReport() *FinancialReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type DeleteFinancialReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteFinancialReportRequest) Reset() {
+	*x = DeleteFinancialReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bl_v1_fin_report_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFinancialReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFinancialReportRequest) ProtoMessage() {}
+
+func (x *DeleteFinancialReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bl_v1_fin_report_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFinancialReportRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFinancialReportRequest) Descriptor() ([]byte, []int) {
+	return file_bl_v1_fin_report_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteFinancialReportRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_bl_v1_fin_report_proto protoreflect.FileDescriptor
+
+var file_bl_v1_fin_report_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x1a,
+	0x12, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xfe, 0x01, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x63,
+	0x6f, 0x73, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79,
+	0x65, 0x61, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x61, 0x72, 0x74, 0x65, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x71, 0x75, 0x61, 0x72, 0x74, 0x65, 0x72, 0x12, 0x20, 0x0a,
+	0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x04, 0x10,
+	0x05, 0x22, 0xcd, 0x02, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x30, 0x0a,
+	0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12,
+	0x25, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x22,
+	0x0a, 0x05, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x63, 0x6f, 0x73,
+	0x74, 0x73, 0x12, 0x24, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x06, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x74, 0x61, 0x78, 0x65,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x74, 0x61, 0x78, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x08,
+	0x74, 0x61, 0x78, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00,
+	0x52, 0x07, 0x74, 0x61, 0x78, 0x4c, 0x6f, 0x61, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x74, 0x61, 0x78, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a,
+	0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x06, 0x10,
+	0x07, 0x22, 0x4e, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6e,
+	0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63,
+	0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x22, 0x51, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6e,
+	0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x61,
+	0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x22, 0x2b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6e,
+	0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x68, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x46,
+	0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x4e, 0x0a, 0x1c, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x2e, 0x0a, 0x1c, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x32, 0xb0, 0x04, 0x0a, 0x16,
+	0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x54, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x23, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69,
+	0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e,
+	0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x5e, 0x0a, 0x16,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x4e, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x20, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69,
+	0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e,
+	0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x64, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63,
+	0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x27, 0x2e, 0x62, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x46, 0x69, 0x6e, 0x61,
+	0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6e,
+	0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x54, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x61,
+	0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x23, 0x2e, 0x62, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63,
+	0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69,
+	0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x54, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x23, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x37,
+	0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x6c, 0x61,
+	0x6e, 0x6b, 0x69, 0x6e, 0x6c, 0x2f, 0x62, 0x6d, 0x73, 0x74, 0x75, 0x2d, 0x70, 0x70, 0x6f, 0x2d,
+	0x62, 0x6c, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2f, 0x67, 0x72, 0x70,
+	0x63, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_bl_v1_fin_report_proto_rawDescOnce sync.Once
+	file_bl_v1_fin_report_proto_rawDescData = file_bl_v1_fin_report_proto_rawDesc
+)
+
+func file_bl_v1_fin_report_proto_rawDescGZIP() []byte {
+	file_bl_v1_fin_report_proto_rawDescOnce.Do(func() {
+		file_bl_v1_fin_report_proto_rawDescData = protoimpl.X.CompressGZIP(file_bl_v1_fin_report_proto_rawDescData)
+	})
+	return file_bl_v1_fin_report_proto_rawDescData
+}
+
+var file_bl_v1_fin_report_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_bl_v1_fin_report_proto_goTypes = []any{
+	(*FinancialReport)(nil),                  // 0: bl.v1.FinancialReport
+	(*FinancialReportByPeriod)(nil),          // 1: bl.v1.FinancialReportByPeriod
+	(*CreateFinancialReportRequest)(nil),     // 2: bl.v1.CreateFinancialReportRequest
+	(*CreateFinancialReportsRequest)(nil),    // 3: bl.v1.CreateFinancialReportsRequest
+	(*GetFinancialReportRequest)(nil),        // 4: bl.v1.GetFinancialReportRequest
+	(*GetCompanyFinancialReportRequest)(nil), // 5: bl.v1.GetCompanyFinancialReportRequest
+	(*UpdateFinancialReportRequest)(nil),     // 6: bl.v1.UpdateFinancialReportRequest
+	(*DeleteFinancialReportRequest)(nil),     // 7: bl.v1.DeleteFinancialReportRequest
+	(*Money)(nil),                            // 8: bl.v1.Money
+	(*Period)(nil),                           // 9: bl.v1.Period
+	(*emptypb.Empty)(nil),                    // 10: google.protobuf.Empty
+}
+var file_bl_v1_fin_report_proto_depIdxs = []int32{
+	8,  // 0: bl.v1.FinancialReport.revenue:type_name -> bl.v1.Money
+	8,  // 1: bl.v1.FinancialReport.costs:type_name -> bl.v1.Money
+	0,  // 2: bl.v1.FinancialReportByPeriod.reports:type_name -> bl.v1.FinancialReport
+	9,  // 3: bl.v1.FinancialReportByPeriod.period:type_name -> bl.v1.Period
+	8,  // 4: bl.v1.FinancialReportByPeriod.revenue:type_name -> bl.v1.Money
+	8,  // 5: bl.v1.FinancialReportByPeriod.costs:type_name -> bl.v1.Money
+	8,  // 6: bl.v1.FinancialReportByPeriod.profit:type_name -> bl.v1.Money
+	8,  // 7: bl.v1.FinancialReportByPeriod.taxes:type_name -> bl.v1.Money
+	0,  // 8: bl.v1.CreateFinancialReportRequest.report:type_name -> bl.v1.FinancialReport
+	0,  // 9: bl.v1.CreateFinancialReportsRequest.reports:type_name -> bl.v1.FinancialReport
+	9,  // 10: bl.v1.GetCompanyFinancialReportRequest.period:type_name -> bl.v1.Period
+	0,  // 11: bl.v1.UpdateFinancialReportRequest.report:type_name -> bl.v1.FinancialReport
+	2,  // 12: bl.v1.FinancialReportService.CreateFinancialReport:input_type -> bl.v1.CreateFinancialReportRequest
+	3,  // 13: bl.v1.FinancialReportService.CreateFinancialReports:input_type -> bl.v1.CreateFinancialReportsRequest
+	4,  // 14: bl.v1.FinancialReportService.GetFinancialReport:input_type -> bl.v1.GetFinancialReportRequest
+	5,  // 15: bl.v1.FinancialReportService.GetCompanyFinancialReport:input_type -> bl.v1.GetCompanyFinancialReportRequest
+	6,  // 16: bl.v1.FinancialReportService.UpdateFinancialReport:input_type -> bl.v1.UpdateFinancialReportRequest
+	7,  // 17: bl.v1.FinancialReportService.DeleteFinancialReport:input_type -> bl.v1.DeleteFinancialReportRequest
+	0,  // 18: bl.v1.FinancialReportService.CreateFinancialReport:output_type -> bl.v1.FinancialReport
+	1,  // 19: bl.v1.FinancialReportService.CreateFinancialReports:output_type -> bl.v1.FinancialReportByPeriod
+	0,  // 20: bl.v1.FinancialReportService.GetFinancialReport:output_type -> bl.v1.FinancialReport
+	1,  // 21: bl.v1.FinancialReportService.GetCompanyFinancialReport:output_type -> bl.v1.FinancialReportByPeriod
+	0,  // 22: bl.v1.FinancialReportService.UpdateFinancialReport:output_type -> bl.v1.FinancialReport
+	10, // 23: bl.v1.FinancialReportService.DeleteFinancialReport:output_type -> google.protobuf.Empty
+	18, // [18:24] is the sub-list for method output_type
+	12, // [12:18] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_bl_v1_fin_report_proto_init() }
+func file_bl_v1_fin_report_proto_init() {
+	if File_bl_v1_fin_report_proto != nil {
+		return
+	}
+	file_bl_v1_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_bl_v1_fin_report_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*FinancialReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bl_v1_fin_report_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*FinancialReportByPeriod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bl_v1_fin_report_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateFinancialReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bl_v1_fin_report_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateFinancialReportsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bl_v1_fin_report_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*GetFinancialReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bl_v1_fin_report_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCompanyFinancialReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bl_v1_fin_report_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateFinancialReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bl_v1_fin_report_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteFinancialReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_bl_v1_fin_report_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_bl_v1_fin_report_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_bl_v1_fin_report_proto_goTypes,
+		DependencyIndexes: file_bl_v1_fin_report_proto_depIdxs,
+		MessageInfos:      file_bl_v1_fin_report_proto_msgTypes,
+	}.Build()
+	File_bl_v1_fin_report_proto = out.File
+	file_bl_v1_fin_report_proto_rawDesc = nil
+	file_bl_v1_fin_report_proto_goTypes = nil
+	file_bl_v1_fin_report_proto_depIdxs = nil
+}
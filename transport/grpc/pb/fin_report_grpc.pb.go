@@ -0,0 +1,302 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: bl/v1/fin_report.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	FinancialReportService_CreateFinancialReport_FullMethodName     = "/bl.v1.FinancialReportService/CreateFinancialReport"
+	FinancialReportService_CreateFinancialReports_FullMethodName    = "/bl.v1.FinancialReportService/CreateFinancialReports"
+	FinancialReportService_GetFinancialReport_FullMethodName        = "/bl.v1.FinancialReportService/GetFinancialReport"
+	FinancialReportService_GetCompanyFinancialReport_FullMethodName = "/bl.v1.FinancialReportService/GetCompanyFinancialReport"
+	FinancialReportService_UpdateFinancialReport_FullMethodName     = "/bl.v1.FinancialReportService/UpdateFinancialReport"
+	FinancialReportService_DeleteFinancialReport_FullMethodName     = "/bl.v1.FinancialReportService/DeleteFinancialReport"
+)
+
+// FinancialReportServiceClient is the client API for FinancialReportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FinancialReportServiceClient interface {
+	CreateFinancialReport(ctx context.Context, in *CreateFinancialReportRequest, opts ...grpc.CallOption) (*FinancialReport, error)
+	CreateFinancialReports(ctx context.Context, in *CreateFinancialReportsRequest, opts ...grpc.CallOption) (*FinancialReportByPeriod, error)
+	GetFinancialReport(ctx context.Context, in *GetFinancialReportRequest, opts ...grpc.CallOption) (*FinancialReport, error)
+	GetCompanyFinancialReport(ctx context.Context, in *GetCompanyFinancialReportRequest, opts ...grpc.CallOption) (*FinancialReportByPeriod, error)
+	UpdateFinancialReport(ctx context.Context, in *UpdateFinancialReportRequest, opts ...grpc.CallOption) (*FinancialReport, error)
+	DeleteFinancialReport(ctx context.Context, in *DeleteFinancialReportRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type financialReportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFinancialReportServiceClient(cc grpc.ClientConnInterface) FinancialReportServiceClient {
+	return &financialReportServiceClient{cc}
+}
+
+func (c *financialReportServiceClient) CreateFinancialReport(ctx context.Context, in *CreateFinancialReportRequest, opts ...grpc.CallOption) (*FinancialReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinancialReport)
+	err := c.cc.Invoke(ctx, FinancialReportService_CreateFinancialReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financialReportServiceClient) CreateFinancialReports(ctx context.Context, in *CreateFinancialReportsRequest, opts ...grpc.CallOption) (*FinancialReportByPeriod, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinancialReportByPeriod)
+	err := c.cc.Invoke(ctx, FinancialReportService_CreateFinancialReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financialReportServiceClient) GetFinancialReport(ctx context.Context, in *GetFinancialReportRequest, opts ...grpc.CallOption) (*FinancialReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinancialReport)
+	err := c.cc.Invoke(ctx, FinancialReportService_GetFinancialReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financialReportServiceClient) GetCompanyFinancialReport(ctx context.Context, in *GetCompanyFinancialReportRequest, opts ...grpc.CallOption) (*FinancialReportByPeriod, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinancialReportByPeriod)
+	err := c.cc.Invoke(ctx, FinancialReportService_GetCompanyFinancialReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financialReportServiceClient) UpdateFinancialReport(ctx context.Context, in *UpdateFinancialReportRequest, opts ...grpc.CallOption) (*FinancialReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinancialReport)
+	err := c.cc.Invoke(ctx, FinancialReportService_UpdateFinancialReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financialReportServiceClient) DeleteFinancialReport(ctx context.Context, in *DeleteFinancialReportRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FinancialReportService_DeleteFinancialReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FinancialReportServiceServer is the server API for FinancialReportService service.
+// All implementations must embed UnimplementedFinancialReportServiceServer
+// for forward compatibility
+type FinancialReportServiceServer interface {
+	CreateFinancialReport(context.Context, *CreateFinancialReportRequest) (*FinancialReport, error)
+	CreateFinancialReports(context.Context, *CreateFinancialReportsRequest) (*FinancialReportByPeriod, error)
+	GetFinancialReport(context.Context, *GetFinancialReportRequest) (*FinancialReport, error)
+	GetCompanyFinancialReport(context.Context, *GetCompanyFinancialReportRequest) (*FinancialReportByPeriod, error)
+	UpdateFinancialReport(context.Context, *UpdateFinancialReportRequest) (*FinancialReport, error)
+	DeleteFinancialReport(context.Context, *DeleteFinancialReportRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedFinancialReportServiceServer()
+}
+
+// UnimplementedFinancialReportServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedFinancialReportServiceServer struct {
+}
+
+func (UnimplementedFinancialReportServiceServer) CreateFinancialReport(context.Context, *CreateFinancialReportRequest) (*FinancialReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFinancialReport not implemented")
+}
+func (UnimplementedFinancialReportServiceServer) CreateFinancialReports(context.Context, *CreateFinancialReportsRequest) (*FinancialReportByPeriod, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFinancialReports not implemented")
+}
+func (UnimplementedFinancialReportServiceServer) GetFinancialReport(context.Context, *GetFinancialReportRequest) (*FinancialReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFinancialReport not implemented")
+}
+func (UnimplementedFinancialReportServiceServer) GetCompanyFinancialReport(context.Context, *GetCompanyFinancialReportRequest) (*FinancialReportByPeriod, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompanyFinancialReport not implemented")
+}
+func (UnimplementedFinancialReportServiceServer) UpdateFinancialReport(context.Context, *UpdateFinancialReportRequest) (*FinancialReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFinancialReport not implemented")
+}
+func (UnimplementedFinancialReportServiceServer) DeleteFinancialReport(context.Context, *DeleteFinancialReportRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFinancialReport not implemented")
+}
+func (UnimplementedFinancialReportServiceServer) mustEmbedUnimplementedFinancialReportServiceServer() {
+}
+
+// UnsafeFinancialReportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FinancialReportServiceServer will
+// result in compilation errors.
+type UnsafeFinancialReportServiceServer interface {
+	mustEmbedUnimplementedFinancialReportServiceServer()
+}
+
+func RegisterFinancialReportServiceServer(s grpc.ServiceRegistrar, srv FinancialReportServiceServer) {
+	s.RegisterService(&FinancialReportService_ServiceDesc, srv)
+}
+
+func _FinancialReportService_CreateFinancialReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFinancialReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinancialReportServiceServer).CreateFinancialReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinancialReportService_CreateFinancialReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinancialReportServiceServer).CreateFinancialReport(ctx, req.(*CreateFinancialReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinancialReportService_CreateFinancialReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFinancialReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinancialReportServiceServer).CreateFinancialReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinancialReportService_CreateFinancialReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinancialReportServiceServer).CreateFinancialReports(ctx, req.(*CreateFinancialReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinancialReportService_GetFinancialReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFinancialReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinancialReportServiceServer).GetFinancialReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinancialReportService_GetFinancialReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinancialReportServiceServer).GetFinancialReport(ctx, req.(*GetFinancialReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinancialReportService_GetCompanyFinancialReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyFinancialReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinancialReportServiceServer).GetCompanyFinancialReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinancialReportService_GetCompanyFinancialReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinancialReportServiceServer).GetCompanyFinancialReport(ctx, req.(*GetCompanyFinancialReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinancialReportService_UpdateFinancialReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFinancialReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinancialReportServiceServer).UpdateFinancialReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinancialReportService_UpdateFinancialReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinancialReportServiceServer).UpdateFinancialReport(ctx, req.(*UpdateFinancialReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinancialReportService_DeleteFinancialReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFinancialReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinancialReportServiceServer).DeleteFinancialReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinancialReportService_DeleteFinancialReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinancialReportServiceServer).DeleteFinancialReport(ctx, req.(*DeleteFinancialReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FinancialReportService_ServiceDesc is the grpc.ServiceDesc for FinancialReportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FinancialReportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bl.v1.FinancialReportService",
+	HandlerType: (*FinancialReportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFinancialReport",
+			Handler:    _FinancialReportService_CreateFinancialReport_Handler,
+		},
+		{
+			MethodName: "CreateFinancialReports",
+			Handler:    _FinancialReportService_CreateFinancialReports_Handler,
+		},
+		{
+			MethodName: "GetFinancialReport",
+			Handler:    _FinancialReportService_GetFinancialReport_Handler,
+		},
+		{
+			MethodName: "GetCompanyFinancialReport",
+			Handler:    _FinancialReportService_GetCompanyFinancialReport_Handler,
+		},
+		{
+			MethodName: "UpdateFinancialReport",
+			Handler:    _FinancialReportService_UpdateFinancialReport_Handler,
+		},
+		{
+			MethodName: "DeleteFinancialReport",
+			Handler:    _FinancialReportService_DeleteFinancialReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bl/v1/fin_report.proto",
+}
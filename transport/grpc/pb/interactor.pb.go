@@ -0,0 +1,395 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: bl/v1/interactor.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetMostProfitableCompanyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period     *Period  `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	CompanyIds []string `protobuf:"bytes,2,rep,name=company_ids,json=companyIds,proto3" json:"company_ids,omitempty"`
+}
+
+func (x *GetMostProfitableCompanyRequest) Reset() {
+	*x = GetMostProfitableCompanyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bl_v1_interactor_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMostProfitableCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMostProfitableCompanyRequest) ProtoMessage() {}
+
+func (x *GetMostProfitableCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bl_v1_interactor_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMostProfitableCompanyRequest.ProtoReflect.Descriptor instead.
+func (*GetMostProfitableCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_bl_v1_interactor_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetMostProfitableCompanyRequest) GetPeriod() *Period {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *GetMostProfitableCompanyRequest) GetCompanyIds() []string {
+	if x != nil {
+		return x.CompanyIds
+	}
+	return nil
+}
+
+type CalculateUserRatingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *CalculateUserRatingRequest) Reset() {
+	*x = CalculateUserRatingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bl_v1_interactor_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalculateUserRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateUserRatingRequest) ProtoMessage() {}
+
+func (x *CalculateUserRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bl_v1_interactor_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateUserRatingRequest.ProtoReflect.Descriptor instead.
+func (*CalculateUserRatingRequest) Descriptor() ([]byte, []int) {
+	return file_bl_v1_interactor_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CalculateUserRatingRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CalculateUserRatingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rating float32 `protobuf:"fixed32,1,opt,name=rating,proto3" json:"rating,omitempty"`
+}
+
+func (x *CalculateUserRatingResponse) Reset() {
+	*x = CalculateUserRatingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bl_v1_interactor_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalculateUserRatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateUserRatingResponse) ProtoMessage() {}
+
+func (x *CalculateUserRatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bl_v1_interactor_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateUserRatingResponse.ProtoReflect.Descriptor instead.
+func (*CalculateUserRatingResponse) Descriptor() ([]byte, []int) {
+	return file_bl_v1_interactor_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CalculateUserRatingResponse) GetRating() float32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+type GetUserFinancialReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Period *Period `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+}
+
+func (x *GetUserFinancialReportRequest) Reset() {
+	*x = GetUserFinancialReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bl_v1_interactor_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserFinancialReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserFinancialReportRequest) ProtoMessage() {}
+
+func (x *GetUserFinancialReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bl_v1_interactor_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserFinancialReportRequest.ProtoReflect.Descriptor instead.
+func (*GetUserFinancialReportRequest) Descriptor() ([]byte, []int) {
+	return file_bl_v1_interactor_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetUserFinancialReportRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserFinancialReportRequest) GetPeriod() *Period {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+var File_bl_v1_interactor_proto protoreflect.FileDescriptor
+
+var file_bl_v1_interactor_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x1a,
+	0x12, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f,
+	0x66, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x69, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x73, 0x22, 0x35, 0x0a, 0x1a, 0x43,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x35, 0x0a, 0x1b, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x5f, 0x0a, 0x1d, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x32, 0xa5, 0x02, 0x0a, 0x11, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x52, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x26, 0x2e, 0x62,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x12, 0x5c, 0x0a, 0x13, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x2e, 0x62, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6e,
+	0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x24, 0x2e, 0x62,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6e, 0x61,
+	0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6e,
+	0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x64, 0x6c, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x6c, 0x2f, 0x62, 0x6d, 0x73, 0x74, 0x75, 0x2d,
+	0x70, 0x70, 0x6f, 0x2d, 0x62, 0x6c, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_bl_v1_interactor_proto_rawDescOnce sync.Once
+	file_bl_v1_interactor_proto_rawDescData = file_bl_v1_interactor_proto_rawDesc
+)
+
+func file_bl_v1_interactor_proto_rawDescGZIP() []byte {
+	file_bl_v1_interactor_proto_rawDescOnce.Do(func() {
+		file_bl_v1_interactor_proto_rawDescData = protoimpl.X.CompressGZIP(file_bl_v1_interactor_proto_rawDescData)
+	})
+	return file_bl_v1_interactor_proto_rawDescData
+}
+
+var file_bl_v1_interactor_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_bl_v1_interactor_proto_goTypes = []any{
+	(*GetMostProfitableCompanyRequest)(nil), // 0: bl.v1.GetMostProfitableCompanyRequest
+	(*CalculateUserRatingRequest)(nil),      // 1: bl.v1.CalculateUserRatingRequest
+	(*CalculateUserRatingResponse)(nil),     // 2: bl.v1.CalculateUserRatingResponse
+	(*GetUserFinancialReportRequest)(nil),   // 3: bl.v1.GetUserFinancialReportRequest
+	(*Period)(nil),                          // 4: bl.v1.Period
+	(*Company)(nil),                         // 5: bl.v1.Company
+	(*FinancialReportByPeriod)(nil),         // 6: bl.v1.FinancialReportByPeriod
+}
+var file_bl_v1_interactor_proto_depIdxs = []int32{
+	4, // 0: bl.v1.GetMostProfitableCompanyRequest.period:type_name -> bl.v1.Period
+	4, // 1: bl.v1.GetUserFinancialReportRequest.period:type_name -> bl.v1.Period
+	0, // 2: bl.v1.InteractorService.GetMostProfitableCompany:input_type -> bl.v1.GetMostProfitableCompanyRequest
+	1, // 3: bl.v1.InteractorService.CalculateUserRating:input_type -> bl.v1.CalculateUserRatingRequest
+	3, // 4: bl.v1.InteractorService.GetUserFinancialReport:input_type -> bl.v1.GetUserFinancialReportRequest
+	5, // 5: bl.v1.InteractorService.GetMostProfitableCompany:output_type -> bl.v1.Company
+	2, // 6: bl.v1.InteractorService.CalculateUserRating:output_type -> bl.v1.CalculateUserRatingResponse
+	6, // 7: bl.v1.InteractorService.GetUserFinancialReport:output_type -> bl.v1.FinancialReportByPeriod
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_bl_v1_interactor_proto_init() }
+func file_bl_v1_interactor_proto_init() {
+	if File_bl_v1_interactor_proto != nil {
+		return
+	}
+	file_bl_v1_common_proto_init()
+	file_bl_v1_company_proto_init()
+	file_bl_v1_fin_report_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_bl_v1_interactor_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*GetMostProfitableCompanyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bl_v1_interactor_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*CalculateUserRatingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bl_v1_interactor_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*CalculateUserRatingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bl_v1_interactor_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUserFinancialReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_bl_v1_interactor_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_bl_v1_interactor_proto_goTypes,
+		DependencyIndexes: file_bl_v1_interactor_proto_depIdxs,
+		MessageInfos:      file_bl_v1_interactor_proto_msgTypes,
+	}.Build()
+	File_bl_v1_interactor_proto = out.File
+	file_bl_v1_interactor_proto_rawDesc = nil
+	file_bl_v1_interactor_proto_goTypes = nil
+	file_bl_v1_interactor_proto_depIdxs = nil
+}
@@ -0,0 +1,186 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: bl/v1/interactor.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	InteractorService_GetMostProfitableCompany_FullMethodName = "/bl.v1.InteractorService/GetMostProfitableCompany"
+	InteractorService_CalculateUserRating_FullMethodName      = "/bl.v1.InteractorService/CalculateUserRating"
+	InteractorService_GetUserFinancialReport_FullMethodName   = "/bl.v1.InteractorService/GetUserFinancialReport"
+)
+
+// InteractorServiceClient is the client API for InteractorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type InteractorServiceClient interface {
+	GetMostProfitableCompany(ctx context.Context, in *GetMostProfitableCompanyRequest, opts ...grpc.CallOption) (*Company, error)
+	CalculateUserRating(ctx context.Context, in *CalculateUserRatingRequest, opts ...grpc.CallOption) (*CalculateUserRatingResponse, error)
+	GetUserFinancialReport(ctx context.Context, in *GetUserFinancialReportRequest, opts ...grpc.CallOption) (*FinancialReportByPeriod, error)
+}
+
+type interactorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInteractorServiceClient(cc grpc.ClientConnInterface) InteractorServiceClient {
+	return &interactorServiceClient{cc}
+}
+
+func (c *interactorServiceClient) GetMostProfitableCompany(ctx context.Context, in *GetMostProfitableCompanyRequest, opts ...grpc.CallOption) (*Company, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Company)
+	err := c.cc.Invoke(ctx, InteractorService_GetMostProfitableCompany_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactorServiceClient) CalculateUserRating(ctx context.Context, in *CalculateUserRatingRequest, opts ...grpc.CallOption) (*CalculateUserRatingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalculateUserRatingResponse)
+	err := c.cc.Invoke(ctx, InteractorService_CalculateUserRating_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactorServiceClient) GetUserFinancialReport(ctx context.Context, in *GetUserFinancialReportRequest, opts ...grpc.CallOption) (*FinancialReportByPeriod, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinancialReportByPeriod)
+	err := c.cc.Invoke(ctx, InteractorService_GetUserFinancialReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InteractorServiceServer is the server API for InteractorService service.
+// All implementations must embed UnimplementedInteractorServiceServer
+// for forward compatibility
+type InteractorServiceServer interface {
+	GetMostProfitableCompany(context.Context, *GetMostProfitableCompanyRequest) (*Company, error)
+	CalculateUserRating(context.Context, *CalculateUserRatingRequest) (*CalculateUserRatingResponse, error)
+	GetUserFinancialReport(context.Context, *GetUserFinancialReportRequest) (*FinancialReportByPeriod, error)
+	mustEmbedUnimplementedInteractorServiceServer()
+}
+
+// UnimplementedInteractorServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedInteractorServiceServer struct {
+}
+
+func (UnimplementedInteractorServiceServer) GetMostProfitableCompany(context.Context, *GetMostProfitableCompanyRequest) (*Company, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMostProfitableCompany not implemented")
+}
+func (UnimplementedInteractorServiceServer) CalculateUserRating(context.Context, *CalculateUserRatingRequest) (*CalculateUserRatingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateUserRating not implemented")
+}
+func (UnimplementedInteractorServiceServer) GetUserFinancialReport(context.Context, *GetUserFinancialReportRequest) (*FinancialReportByPeriod, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserFinancialReport not implemented")
+}
+func (UnimplementedInteractorServiceServer) mustEmbedUnimplementedInteractorServiceServer() {}
+
+// UnsafeInteractorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InteractorServiceServer will
+// result in compilation errors.
+type UnsafeInteractorServiceServer interface {
+	mustEmbedUnimplementedInteractorServiceServer()
+}
+
+func RegisterInteractorServiceServer(s grpc.ServiceRegistrar, srv InteractorServiceServer) {
+	s.RegisterService(&InteractorService_ServiceDesc, srv)
+}
+
+func _InteractorService_GetMostProfitableCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMostProfitableCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractorServiceServer).GetMostProfitableCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteractorService_GetMostProfitableCompany_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractorServiceServer).GetMostProfitableCompany(ctx, req.(*GetMostProfitableCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractorService_CalculateUserRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateUserRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractorServiceServer).CalculateUserRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteractorService_CalculateUserRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractorServiceServer).CalculateUserRating(ctx, req.(*CalculateUserRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractorService_GetUserFinancialReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserFinancialReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractorServiceServer).GetUserFinancialReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteractorService_GetUserFinancialReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractorServiceServer).GetUserFinancialReport(ctx, req.(*GetUserFinancialReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InteractorService_ServiceDesc is the grpc.ServiceDesc for InteractorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InteractorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bl.v1.InteractorService",
+	HandlerType: (*InteractorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMostProfitableCompany",
+			Handler:    _InteractorService_GetMostProfitableCompany_Handler,
+		},
+		{
+			MethodName: "CalculateUserRating",
+			Handler:    _InteractorService_CalculateUserRating_Handler,
+		},
+		{
+			MethodName: "GetUserFinancialReport",
+			Handler:    _InteractorService_GetUserFinancialReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bl/v1/interactor.proto",
+}
@@ -0,0 +1,506 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: bl/v1/skill.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	SkillService_CreateSkill_FullMethodName = "/bl.v1.SkillService/CreateSkill"
+	SkillService_GetSkill_FullMethodName    = "/bl.v1.SkillService/GetSkill"
+	SkillService_ListSkills_FullMethodName  = "/bl.v1.SkillService/ListSkills"
+	SkillService_UpdateSkill_FullMethodName = "/bl.v1.SkillService/UpdateSkill"
+	SkillService_DeleteSkill_FullMethodName = "/bl.v1.SkillService/DeleteSkill"
+)
+
+// SkillServiceClient is the client API for SkillService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SkillServiceClient interface {
+	CreateSkill(ctx context.Context, in *CreateSkillRequest, opts ...grpc.CallOption) (*Skill, error)
+	GetSkill(ctx context.Context, in *GetSkillRequest, opts ...grpc.CallOption) (*Skill, error)
+	ListSkills(ctx context.Context, in *ListSkillsRequest, opts ...grpc.CallOption) (*ListSkillsResponse, error)
+	UpdateSkill(ctx context.Context, in *UpdateSkillRequest, opts ...grpc.CallOption) (*Skill, error)
+	DeleteSkill(ctx context.Context, in *DeleteSkillRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type skillServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSkillServiceClient(cc grpc.ClientConnInterface) SkillServiceClient {
+	return &skillServiceClient{cc}
+}
+
+func (c *skillServiceClient) CreateSkill(ctx context.Context, in *CreateSkillRequest, opts ...grpc.CallOption) (*Skill, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Skill)
+	err := c.cc.Invoke(ctx, SkillService_CreateSkill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skillServiceClient) GetSkill(ctx context.Context, in *GetSkillRequest, opts ...grpc.CallOption) (*Skill, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Skill)
+	err := c.cc.Invoke(ctx, SkillService_GetSkill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skillServiceClient) ListSkills(ctx context.Context, in *ListSkillsRequest, opts ...grpc.CallOption) (*ListSkillsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSkillsResponse)
+	err := c.cc.Invoke(ctx, SkillService_ListSkills_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skillServiceClient) UpdateSkill(ctx context.Context, in *UpdateSkillRequest, opts ...grpc.CallOption) (*Skill, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Skill)
+	err := c.cc.Invoke(ctx, SkillService_UpdateSkill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skillServiceClient) DeleteSkill(ctx context.Context, in *DeleteSkillRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SkillService_DeleteSkill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SkillServiceServer is the server API for SkillService service.
+// All implementations must embed UnimplementedSkillServiceServer
+// for forward compatibility
+type SkillServiceServer interface {
+	CreateSkill(context.Context, *CreateSkillRequest) (*Skill, error)
+	GetSkill(context.Context, *GetSkillRequest) (*Skill, error)
+	ListSkills(context.Context, *ListSkillsRequest) (*ListSkillsResponse, error)
+	UpdateSkill(context.Context, *UpdateSkillRequest) (*Skill, error)
+	DeleteSkill(context.Context, *DeleteSkillRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedSkillServiceServer()
+}
+
+// UnimplementedSkillServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSkillServiceServer struct {
+}
+
+func (UnimplementedSkillServiceServer) CreateSkill(context.Context, *CreateSkillRequest) (*Skill, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSkill not implemented")
+}
+func (UnimplementedSkillServiceServer) GetSkill(context.Context, *GetSkillRequest) (*Skill, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSkill not implemented")
+}
+func (UnimplementedSkillServiceServer) ListSkills(context.Context, *ListSkillsRequest) (*ListSkillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSkills not implemented")
+}
+func (UnimplementedSkillServiceServer) UpdateSkill(context.Context, *UpdateSkillRequest) (*Skill, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSkill not implemented")
+}
+func (UnimplementedSkillServiceServer) DeleteSkill(context.Context, *DeleteSkillRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSkill not implemented")
+}
+func (UnimplementedSkillServiceServer) mustEmbedUnimplementedSkillServiceServer() {}
+
+// UnsafeSkillServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SkillServiceServer will
+// result in compilation errors.
+type UnsafeSkillServiceServer interface {
+	mustEmbedUnimplementedSkillServiceServer()
+}
+
+func RegisterSkillServiceServer(s grpc.ServiceRegistrar, srv SkillServiceServer) {
+	s.RegisterService(&SkillService_ServiceDesc, srv)
+}
+
+func _SkillService_CreateSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSkillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).CreateSkill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_CreateSkill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).CreateSkill(ctx, req.(*CreateSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkillService_GetSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSkillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).GetSkill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_GetSkill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).GetSkill(ctx, req.(*GetSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkillService_ListSkills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSkillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).ListSkills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_ListSkills_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).ListSkills(ctx, req.(*ListSkillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkillService_UpdateSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSkillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).UpdateSkill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_UpdateSkill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).UpdateSkill(ctx, req.(*UpdateSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkillService_DeleteSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSkillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkillServiceServer).DeleteSkill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkillService_DeleteSkill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkillServiceServer).DeleteSkill(ctx, req.(*DeleteSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SkillService_ServiceDesc is the grpc.ServiceDesc for SkillService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SkillService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bl.v1.SkillService",
+	HandlerType: (*SkillServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSkill",
+			Handler:    _SkillService_CreateSkill_Handler,
+		},
+		{
+			MethodName: "GetSkill",
+			Handler:    _SkillService_GetSkill_Handler,
+		},
+		{
+			MethodName: "ListSkills",
+			Handler:    _SkillService_ListSkills_Handler,
+		},
+		{
+			MethodName: "UpdateSkill",
+			Handler:    _SkillService_UpdateSkill_Handler,
+		},
+		{
+			MethodName: "DeleteSkill",
+			Handler:    _SkillService_DeleteSkill_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bl/v1/skill.proto",
+}
+
+const (
+	UserSkillService_AddUserSkill_FullMethodName        = "/bl.v1.UserSkillService/AddUserSkill"
+	UserSkillService_DeleteUserSkill_FullMethodName     = "/bl.v1.UserSkillService/DeleteUserSkill"
+	UserSkillService_ListSkillsForUser_FullMethodName   = "/bl.v1.UserSkillService/ListSkillsForUser"
+	UserSkillService_ListUsersForSkill_FullMethodName   = "/bl.v1.UserSkillService/ListUsersForSkill"
+	UserSkillService_DeleteSkillsForUser_FullMethodName = "/bl.v1.UserSkillService/DeleteSkillsForUser"
+)
+
+// UserSkillServiceClient is the client API for UserSkillService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserSkillServiceClient interface {
+	AddUserSkill(ctx context.Context, in *AddUserSkillRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteUserSkill(ctx context.Context, in *DeleteUserSkillRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListSkillsForUser(ctx context.Context, in *ListSkillsForUserRequest, opts ...grpc.CallOption) (*ListSkillsResponse, error)
+	ListUsersForSkill(ctx context.Context, in *ListUsersForSkillRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	DeleteSkillsForUser(ctx context.Context, in *DeleteSkillsForUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type userSkillServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserSkillServiceClient(cc grpc.ClientConnInterface) UserSkillServiceClient {
+	return &userSkillServiceClient{cc}
+}
+
+func (c *userSkillServiceClient) AddUserSkill(ctx context.Context, in *AddUserSkillRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserSkillService_AddUserSkill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userSkillServiceClient) DeleteUserSkill(ctx context.Context, in *DeleteUserSkillRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserSkillService_DeleteUserSkill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userSkillServiceClient) ListSkillsForUser(ctx context.Context, in *ListSkillsForUserRequest, opts ...grpc.CallOption) (*ListSkillsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSkillsResponse)
+	err := c.cc.Invoke(ctx, UserSkillService_ListSkillsForUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userSkillServiceClient) ListUsersForSkill(ctx context.Context, in *ListUsersForSkillRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, UserSkillService_ListUsersForSkill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userSkillServiceClient) DeleteSkillsForUser(ctx context.Context, in *DeleteSkillsForUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserSkillService_DeleteSkillsForUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserSkillServiceServer is the server API for UserSkillService service.
+// All implementations must embed UnimplementedUserSkillServiceServer
+// for forward compatibility
+type UserSkillServiceServer interface {
+	AddUserSkill(context.Context, *AddUserSkillRequest) (*emptypb.Empty, error)
+	DeleteUserSkill(context.Context, *DeleteUserSkillRequest) (*emptypb.Empty, error)
+	ListSkillsForUser(context.Context, *ListSkillsForUserRequest) (*ListSkillsResponse, error)
+	ListUsersForSkill(context.Context, *ListUsersForSkillRequest) (*ListUsersResponse, error)
+	DeleteSkillsForUser(context.Context, *DeleteSkillsForUserRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedUserSkillServiceServer()
+}
+
+// UnimplementedUserSkillServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedUserSkillServiceServer struct {
+}
+
+func (UnimplementedUserSkillServiceServer) AddUserSkill(context.Context, *AddUserSkillRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUserSkill not implemented")
+}
+func (UnimplementedUserSkillServiceServer) DeleteUserSkill(context.Context, *DeleteUserSkillRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserSkill not implemented")
+}
+func (UnimplementedUserSkillServiceServer) ListSkillsForUser(context.Context, *ListSkillsForUserRequest) (*ListSkillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSkillsForUser not implemented")
+}
+func (UnimplementedUserSkillServiceServer) ListUsersForSkill(context.Context, *ListUsersForSkillRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsersForSkill not implemented")
+}
+func (UnimplementedUserSkillServiceServer) DeleteSkillsForUser(context.Context, *DeleteSkillsForUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSkillsForUser not implemented")
+}
+func (UnimplementedUserSkillServiceServer) mustEmbedUnimplementedUserSkillServiceServer() {}
+
+// UnsafeUserSkillServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserSkillServiceServer will
+// result in compilation errors.
+type UnsafeUserSkillServiceServer interface {
+	mustEmbedUnimplementedUserSkillServiceServer()
+}
+
+func RegisterUserSkillServiceServer(s grpc.ServiceRegistrar, srv UserSkillServiceServer) {
+	s.RegisterService(&UserSkillService_ServiceDesc, srv)
+}
+
+func _UserSkillService_AddUserSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddUserSkillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserSkillServiceServer).AddUserSkill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserSkillService_AddUserSkill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserSkillServiceServer).AddUserSkill(ctx, req.(*AddUserSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserSkillService_DeleteUserSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserSkillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserSkillServiceServer).DeleteUserSkill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserSkillService_DeleteUserSkill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserSkillServiceServer).DeleteUserSkill(ctx, req.(*DeleteUserSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserSkillService_ListSkillsForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSkillsForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserSkillServiceServer).ListSkillsForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserSkillService_ListSkillsForUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserSkillServiceServer).ListSkillsForUser(ctx, req.(*ListSkillsForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserSkillService_ListUsersForSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersForSkillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserSkillServiceServer).ListUsersForSkill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserSkillService_ListUsersForSkill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserSkillServiceServer).ListUsersForSkill(ctx, req.(*ListUsersForSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserSkillService_DeleteSkillsForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSkillsForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserSkillServiceServer).DeleteSkillsForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserSkillService_DeleteSkillsForUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserSkillServiceServer).DeleteSkillsForUser(ctx, req.(*DeleteSkillsForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserSkillService_ServiceDesc is the grpc.ServiceDesc for UserSkillService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserSkillService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bl.v1.UserSkillService",
+	HandlerType: (*UserSkillServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddUserSkill",
+			Handler:    _UserSkillService_AddUserSkill_Handler,
+		},
+		{
+			MethodName: "DeleteUserSkill",
+			Handler:    _UserSkillService_DeleteUserSkill_Handler,
+		},
+		{
+			MethodName: "ListSkillsForUser",
+			Handler:    _UserSkillService_ListSkillsForUser_Handler,
+		},
+		{
+			MethodName: "ListUsersForSkill",
+			Handler:    _UserSkillService_ListUsersForSkill_Handler,
+		},
+		{
+			MethodName: "DeleteSkillsForUser",
+			Handler:    _UserSkillService_DeleteSkillsForUser_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bl/v1/skill.proto",
+}
@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/transport/grpc/pb"
+)
+
+type searchServer struct {
+	pb.UnimplementedSearchServiceServer
+	svc domain.ISearchService
+}
+
+func (s *searchServer) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
+	kinds := make([]domain.SearchKind, 0, len(req.GetKinds()))
+	for _, pbKind := range req.GetKinds() {
+		kind, err := toSearchKind(pbKind)
+		if err != nil {
+			return nil, err
+		}
+		kinds = append(kinds, kind)
+	}
+
+	hits, err := s.svc.Search(ctx, req.GetQuery(), kinds, pageRequest(req.GetPage()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SearchResponse{
+		Hits: convertItems(hits.Items, fromSearchHit),
+		Page: pageInfo(hits),
+	}, nil
+}
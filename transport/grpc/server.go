@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/base"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/logger"
+	"github.com/dlankinl/bmstu-ppo-bl/transport/grpc/pb"
+	gogrpc "google.golang.org/grpc"
+)
+
+//go:generate buf generate
+
+// Services содержит сервисы, которые обслуживает gRPC-сервер. Как и в HTTP API,
+// проверка прав остается на стороне сервисов, обернутых в authz.
+type Services struct {
+	Auth          domain.IAuthService
+	User          domain.IUserService
+	Company       domain.ICompanyService
+	Contact       domain.IContactsService
+	Skill         domain.ISkillService
+	UserSkill     domain.IUserSkillService
+	ActivityField domain.IActivityFieldService
+	FinReport     domain.IFinancialReportService
+	Interactor    domain.IInteractor
+	Search        domain.ISearchService
+}
+
+type AuthConfig struct {
+	Keys       base.IKeyProvider
+	TokenOpts  base.TokenOptions
+	Revocation base.IRevocationChecker
+}
+
+type Server struct {
+	*gogrpc.Server
+	services *Services
+	auth     AuthConfig
+	logger   logger.ILogger
+}
+
+func NewServer(services *Services, auth AuthConfig, logger logger.ILogger, opts ...gogrpc.ServerOption) *Server {
+	s := &Server{
+		services: services,
+		auth:     auth,
+		logger:   logger,
+	}
+
+	// порядок важен: паника любого перехватчика или обработчика не останавливает сервер,
+	// журнал видит итоговый код, ошибки переводятся в статус уже с локалью,
+	// а ошибки аутентификации проходят через то же сопоставление
+	opts = append(opts, gogrpc.ChainUnaryInterceptor(s.recovery, s.logging, withLocale, s.mapErrors, s.authenticate))
+	s.Server = gogrpc.NewServer(opts...)
+
+	pb.RegisterAuthServiceServer(s.Server, &authServer{svc: services.Auth})
+	pb.RegisterUserServiceServer(s.Server, &userServer{svc: services.User})
+	pb.RegisterCompanyServiceServer(s.Server, &companyServer{svc: services.Company})
+	pb.RegisterContactServiceServer(s.Server, &contactServer{svc: services.Contact})
+	pb.RegisterSkillServiceServer(s.Server, &skillServer{svc: services.Skill})
+	pb.RegisterUserSkillServiceServer(s.Server, &userSkillServer{svc: services.UserSkill})
+	pb.RegisterActivityFieldServiceServer(s.Server, &activityFieldServer{svc: services.ActivityField})
+	pb.RegisterFinancialReportServiceServer(s.Server, &finReportServer{svc: services.FinReport})
+	pb.RegisterInteractorServiceServer(s.Server, &interactorServer{svc: services.Interactor, companies: services.Company})
+	pb.RegisterSearchServiceServer(s.Server, &searchServer{svc: services.Search})
+
+	return s
+}
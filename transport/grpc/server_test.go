@@ -0,0 +1,605 @@
+package grpc
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/mocks"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/base"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/dlankinl/bmstu-ppo-bl/repository/memory"
+	"github.com/dlankinl/bmstu-ppo-bl/services/activity_field"
+	"github.com/dlankinl/bmstu-ppo-bl/services/auth"
+	"github.com/dlankinl/bmstu-ppo-bl/services/authz"
+	"github.com/dlankinl/bmstu-ppo-bl/services/company"
+	"github.com/dlankinl/bmstu-ppo-bl/services/contact"
+	"github.com/dlankinl/bmstu-ppo-bl/services/exchange_rate"
+	"github.com/dlankinl/bmstu-ppo-bl/services/fin_report"
+	"github.com/dlankinl/bmstu-ppo-bl/services/search"
+	"github.com/dlankinl/bmstu-ppo-bl/services/skill"
+	"github.com/dlankinl/bmstu-ppo-bl/services/tax_schedule"
+	"github.com/dlankinl/bmstu-ppo-bl/services/user"
+	"github.com/dlankinl/bmstu-ppo-bl/services/user_activity_field"
+	"github.com/dlankinl/bmstu-ppo-bl/services/user_skill"
+	"github.com/dlankinl/bmstu-ppo-bl/transport/grpc/pb"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	gogrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+)
+
+type testClient struct {
+	t        *testing.T
+	authRepo domain.IAuthRepository
+	keys     base.IKeyProvider
+
+	auth          pb.AuthServiceClient
+	users         pb.UserServiceClient
+	companies     pb.CompanyServiceClient
+	contacts      pb.ContactServiceClient
+	skills        pb.SkillServiceClient
+	userSkills    pb.UserSkillServiceClient
+	activityField pb.ActivityFieldServiceClient
+	finReports    pb.FinancialReportServiceClient
+	interactor    pb.InteractorServiceClient
+	search        pb.SearchServiceClient
+}
+
+// serve запускает сервер поверх bufconn и возвращает подключенного к нему клиента.
+func serve(t *testing.T, server *Server) *gogrpc.ClientConn {
+	listener := bufconn.Listen(1 << 20)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := gogrpc.NewClient("passthrough:///bufnet",
+		gogrpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		gogrpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return conn
+}
+
+func newTestClient(t *testing.T) *testClient {
+	ctrl := gomock.NewController(t)
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Errorf(gomock.Any(), gomock.Any()).AnyTimes()
+
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	require.Nil(t, err)
+	keys, err := base.NewKeySet(base.NewEd25519Key("test", private))
+	require.Nil(t, err)
+
+	authRepo := memory.NewAuthRepository()
+	tokenRepo := memory.NewRefreshTokenRepository()
+	userRepo := memory.NewUserRepository()
+	compRepo := memory.NewCompanyRepository()
+	actFieldRepo := memory.NewActivityFieldRepository()
+	skillRepo := memory.NewSkillRepository()
+	finRepo := memory.NewFinancialReportRepository()
+	index := memory.NewSearchIndex()
+	txManager := memory.NewTxManager()
+	rates, err := exchange_rate.NewTable([]domain.ExchangeRate{
+		{Currency: "USD", Year: time.Now().Year() - 2, Quarter: 1, Rate: big.NewRat(90, 1)},
+	})
+	require.Nil(t, err)
+
+	userSvc := user.NewService(userRepo, compRepo, actFieldRepo, logger)
+	compSvc := search.NewCompanyService(company.NewService(compRepo, logger), index)
+	actFieldSvc := search.NewActivityFieldService(activity_field.NewService(actFieldRepo, compRepo, logger), compSvc, index)
+	finSvc := fin_report.NewService(finRepo, txManager, rates, domain.DefaultCurrency, logger)
+	taxSvc := tax_schedule.NewService(memory.NewTaxScheduleRepository(), domain.DefaultJurisdiction, domain.DefaultTaxSchedule(), logger)
+
+	services := &Services{
+		Auth:          authz.NewAuthService(auth.NewService(authRepo, tokenRepo, base.NewHashCrypto(), keys, base.TokenOptions{}, logger)),
+		User:          authz.NewUserService(search.NewUserService(userSvc, compSvc, index)),
+		Company:       authz.NewCompanyService(compSvc),
+		Contact:       authz.NewContactService(contact.NewService(memory.NewContactRepository(), logger)),
+		Skill:         authz.NewSkillService(search.NewSkillService(skill.NewService(skillRepo, logger), index)),
+		UserSkill:     authz.NewUserSkillService(user_skill.NewService(memory.NewUserSkillRepository(), userRepo, skillRepo, txManager, logger)),
+		ActivityField: authz.NewActivityFieldService(actFieldSvc),
+		FinReport:     authz.NewFinancialReportService(finSvc, compSvc),
+		Interactor:    user_activity_field.NewInteractor(userSvc, actFieldSvc, compSvc, finSvc, taxSvc, rates, user_activity_field.DefaultLossCarryYears, logger),
+		Search:        search.NewService(index, skillRepo, actFieldRepo, compRepo, logger),
+	}
+
+	conn := serve(t, NewServer(services, AuthConfig{Keys: keys, Revocation: tokenRepo}, logger))
+
+	return &testClient{
+		t:             t,
+		authRepo:      authRepo,
+		keys:          keys,
+		auth:          pb.NewAuthServiceClient(conn),
+		users:         pb.NewUserServiceClient(conn),
+		companies:     pb.NewCompanyServiceClient(conn),
+		contacts:      pb.NewContactServiceClient(conn),
+		skills:        pb.NewSkillServiceClient(conn),
+		userSkills:    pb.NewUserSkillServiceClient(conn),
+		activityField: pb.NewActivityFieldServiceClient(conn),
+		finReports:    pb.NewFinancialReportServiceClient(conn),
+		interactor:    pb.NewInteractorServiceClient(conn),
+		search:        pb.NewSearchServiceClient(conn),
+	}
+}
+
+// ctx возвращает контекст вызова с токеном доступа в метаданных, если он передан.
+func (c *testClient) ctx(token string) context.Context {
+	if token == "" {
+		return context.Background()
+	}
+
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+}
+
+// login регистрирует пользователя напрямую в хранилище и возвращает его id и токен доступа.
+func (c *testClient) login(username, role string) (uuid.UUID, string) {
+	hash, err := base.NewHashCrypto().GenerateHashPass("secret")
+	require.Nil(c.t, err)
+
+	authInfo := &domain.UserAuth{Username: username, HashedPass: hash, Role: role}
+	require.Nil(c.t, c.authRepo.Register(context.Background(), authInfo))
+
+	resp, err := c.auth.Login(context.Background(), &pb.LoginRequest{Username: username, Password: "secret"})
+	require.Nil(c.t, err)
+
+	return authInfo.ID, resp.GetTokens().GetAccessToken()
+}
+
+func violationField(err error) string {
+	for _, detail := range status.Convert(err).Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok && len(badRequest.GetFieldViolations()) > 0 {
+			return badRequest.GetFieldViolations()[0].GetField()
+		}
+	}
+
+	return ""
+}
+
+func TestServer_Auth(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	registered, err := client.auth.Register(ctx, &pb.RegisterRequest{Username: "ivan", Password: "secret", Role: domain.RoleEntrepreneur})
+	require.Nil(t, err)
+	require.NotEmpty(t, registered.GetId())
+
+	_, err = client.auth.Register(ctx, &pb.RegisterRequest{Username: "ivan", Password: "secret"})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = client.auth.Register(ctx, &pb.RegisterRequest{Username: "root", Password: "secret", Role: domain.RoleAdmin})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = client.auth.Login(ctx, &pb.LoginRequest{Username: "ivan", Password: "wrong"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	login, err := client.auth.Login(ctx, &pb.LoginRequest{Username: "ivan", Password: "secret"})
+	require.Nil(t, err)
+
+	profile := &pb.User{Username: "ivan", FullName: "Иванов Иван Иванович", Gender: "m", Birthday: "1990-05-17", City: "Москва", Role: domain.RoleEntrepreneur}
+	created, err := client.users.CreateUser(client.ctx(login.GetTokens().GetAccessToken()), &pb.CreateUserRequest{User: profile})
+	require.Nil(t, err)
+	require.Equal(t, registered.GetId(), created.GetId())
+	require.Equal(t, "1990-05-17", created.GetBirthday())
+
+	refreshed, err := client.auth.Refresh(ctx, &pb.RefreshRequest{RefreshToken: login.GetTokens().GetRefreshToken()})
+	require.Nil(t, err)
+
+	_, err = client.auth.Logout(ctx, &pb.LogoutRequest{RefreshToken: refreshed.GetTokens().GetRefreshToken()})
+	require.Nil(t, err)
+
+	_, err = client.users.GetUser(client.ctx(refreshed.GetTokens().GetAccessToken()), &pb.GetUserRequest{Id: created.GetId()})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestServer_Authenticate(t *testing.T) {
+	client := newTestClient(t)
+	_, token := client.login("admin", domain.RoleAdmin)
+
+	orphan, err := base.GenerateAuthToken(uuid.New(), "admin", domain.RoleAdmin, uuid.New(), client.keys, base.TokenOptions{})
+	require.Nil(t, err)
+
+	testCases := []struct {
+		name   string
+		header string
+		code   codes.Code
+	}{
+		{
+			name: "гость",
+			code: codes.PermissionDenied,
+		},
+		{
+			name:   "администратор",
+			header: "Bearer " + token,
+			code:   codes.OK,
+		},
+		{
+			name:   "схема не Bearer",
+			header: "Basic " + token,
+			code:   codes.Unauthenticated,
+		},
+		{
+			name:   "пустой токен",
+			header: "Bearer ",
+			code:   codes.Unauthenticated,
+		},
+		{
+			name:   "поддельный токен",
+			header: "Bearer abc.def.ghi",
+			code:   codes.Unauthenticated,
+		},
+		{
+			name:   "сессия не найдена",
+			header: "Bearer " + orphan,
+			code:   codes.Unauthenticated,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.header != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", tc.header)
+			}
+
+			_, err := client.skills.CreateSkill(ctx, &pb.CreateSkillRequest{
+				Skill: &pb.Skill{Name: "Go " + tc.name, Description: "язык программирования"},
+			})
+			require.Equal(t, tc.code, status.Code(err), err)
+		})
+	}
+}
+
+func TestServer_Validation(t *testing.T) {
+	client := newTestClient(t)
+	_, token := client.login("admin", domain.RoleAdmin)
+	ctx := client.ctx(token)
+
+	testCases := []struct {
+		name  string
+		call  func() error
+		code  codes.Code
+		field string
+	}{
+		{
+			name: "некорректный id",
+			call: func() error {
+				_, err := client.users.GetUser(ctx, &pb.GetUserRequest{Id: "abc"})
+				return err
+			},
+			code:  codes.InvalidArgument,
+			field: "id",
+		},
+		{
+			name: "не указан id",
+			call: func() error {
+				_, err := client.skills.UpdateSkill(ctx, &pb.UpdateSkillRequest{Skill: &pb.Skill{Name: "Go"}})
+				return err
+			},
+			code:  codes.InvalidArgument,
+			field: "id",
+		},
+		{
+			name: "лимит больше максимального",
+			call: func() error {
+				_, err := client.skills.ListSkills(ctx, &pb.ListSkillsRequest{Page: &pb.PageRequest{Limit: 1000}})
+				return err
+			},
+			code:  codes.InvalidArgument,
+			field: "limit",
+		},
+		{
+			name: "ошибка валидации сервиса",
+			call: func() error {
+				_, err := client.skills.CreateSkill(ctx, &pb.CreateSkillRequest{Skill: &pb.Skill{Name: "Go"}})
+				return err
+			},
+			code:  codes.InvalidArgument,
+			field: "description",
+		},
+		{
+			name: "некорректная дата рождения",
+			call: func() error {
+				_, err := client.users.CreateUser(ctx, &pb.CreateUserRequest{User: &pb.User{Birthday: "17.05.1990"}})
+				return err
+			},
+			code:  codes.InvalidArgument,
+			field: "birthday",
+		},
+		{
+			name: "не указан период",
+			call: func() error {
+				_, err := client.interactor.GetUserFinancialReport(ctx, &pb.GetUserFinancialReportRequest{UserId: uuid.NewString()})
+				return err
+			},
+			code:  codes.InvalidArgument,
+			field: "period",
+		},
+		{
+			name: "квартал вне диапазона",
+			call: func() error {
+				_, err := client.finReports.GetCompanyFinancialReport(ctx, &pb.GetCompanyFinancialReportRequest{
+					CompanyId: uuid.NewString(),
+					Period:    &pb.Period{StartYear: 2023, StartQuarter: 0, EndYear: 2023, EndQuarter: 4},
+				})
+				return err
+			},
+			code:  codes.InvalidArgument,
+			field: "quarter",
+		},
+		{
+			name: "некорректный фильтр",
+			call: func() error {
+				_, err := client.users.ListUsers(ctx, &pb.ListUsersRequest{Filter: &pb.UserFilter{MinAge: 40, MaxAge: 30}})
+				return err
+			},
+			code:  codes.InvalidArgument,
+			field: "age",
+		},
+		{
+			name: "неизвестный тип поиска",
+			call: func() error {
+				_, err := client.search.Search(ctx, &pb.SearchRequest{Query: "go", Kinds: []pb.SearchKind{pb.SearchKind_SEARCH_KIND_UNSPECIFIED}})
+				return err
+			},
+			code:  codes.InvalidArgument,
+			field: "kinds",
+		},
+		{
+			name: "не найдено",
+			call: func() error {
+				_, err := client.skills.GetSkill(ctx, &pb.GetSkillRequest{Id: uuid.NewString()})
+				return err
+			},
+			code: codes.NotFound,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.call()
+
+			require.Equal(t, tc.code, status.Code(err), err)
+			require.NotEmpty(t, status.Convert(err).Message())
+			require.Equal(t, tc.field, violationField(err))
+		})
+	}
+}
+
+func TestServer_Locale(t *testing.T) {
+	client := newTestClient(t)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "accept-language", "en-US,en;q=0.9")
+	_, err := client.skills.GetSkill(ctx, &pb.GetSkillRequest{Id: uuid.NewString()})
+
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Equal(t, "getting skill by id: skill not found", status.Convert(err).Message())
+}
+
+func TestServer_Scenario(t *testing.T) {
+	client := newTestClient(t)
+	_, adminToken := client.login("admin", domain.RoleAdmin)
+	ownerId, ownerToken := client.login("ivan", domain.RoleEntrepreneur)
+	admin, owner, guest := client.ctx(adminToken), client.ctx(ownerToken), context.Background()
+
+	_, err := client.users.CreateUser(owner, &pb.CreateUserRequest{
+		User: &pb.User{Username: "ivan", FullName: "Иванов Иван Иванович", Gender: "m", Birthday: "1990-05-17", City: "Москва", Role: domain.RoleEntrepreneur},
+	})
+	require.Nil(t, err)
+
+	field, err := client.activityField.CreateActivityField(admin, &pb.CreateActivityFieldRequest{
+		ActivityField: &pb.ActivityField{Name: "IT", Description: "разработка программного обеспечения", Cost: 5},
+	})
+	require.Nil(t, err)
+
+	comp, err := client.companies.CreateCompany(owner, &pb.CreateCompanyRequest{
+		Company: &pb.Company{OwnerId: ownerId.String(), ActivityFieldId: field.GetId(), Name: "Рога и копыта", City: "Москва"},
+	})
+	require.Nil(t, err)
+
+	_, err = client.companies.CreateCompany(owner, &pb.CreateCompanyRequest{
+		Company: &pb.Company{OwnerId: uuid.NewString(), ActivityFieldId: field.GetId(), Name: "Чужая", City: "Москва"},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	prevYear := int32(time.Now().AddDate(-1, 0, 0).Year())
+	batch := &pb.CreateFinancialReportsRequest{}
+	for quarter := int32(1); quarter <= 4; quarter++ {
+		batch.Reports = append(batch.Reports, &pb.FinancialReport{
+			CompanyId: comp.GetId(),
+			Revenue:   &pb.Money{Amount: 100025},
+			Costs:     &pb.Money{Amount: 40000},
+			Year:      prevYear,
+			Quarter:   quarter,
+		})
+	}
+	reports, err := client.finReports.CreateFinancialReports(owner, batch)
+	require.Nil(t, err)
+	require.Len(t, reports.GetReports(), 4)
+	require.NotEmpty(t, reports.GetReports()[0].GetId())
+	require.Equal(t, domain.GranularityQuarter, reports.GetReports()[0].GetGranularity())
+
+	// итоги пакета складываются без перевода в валюту отчетности
+	_, err = client.finReports.CreateFinancialReports(owner, &pb.CreateFinancialReportsRequest{
+		Reports: []*pb.FinancialReport{
+			{CompanyId: comp.GetId(), Revenue: &pb.Money{Amount: 10000}, Costs: &pb.Money{Amount: 4000}, Year: prevYear - 1, Quarter: 1},
+			{CompanyId: comp.GetId(), Revenue: &pb.Money{Amount: 100, Currency: "USD"}, Costs: &pb.Money{Amount: 40, Currency: "USD"}, Year: prevYear - 1, Quarter: 2},
+		},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Equal(t, "currency", violationField(err))
+
+	// январь уже входит в квартальный отчет
+	_, err = client.finReports.CreateFinancialReport(owner, &pb.CreateFinancialReportRequest{
+		Report: &pb.FinancialReport{
+			CompanyId:   comp.GetId(),
+			Revenue:     &pb.Money{Amount: 10000},
+			Costs:       &pb.Money{Amount: 4000},
+			Granularity: domain.GranularityMonth,
+			Year:        prevYear,
+			Month:       1,
+		},
+	})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	period := &pb.Period{StartYear: prevYear, StartQuarter: 1, EndYear: prevYear, EndQuarter: 4}
+	report, err := client.interactor.GetUserFinancialReport(guest, &pb.GetUserFinancialReportRequest{UserId: ownerId.String(), Period: period})
+	require.Nil(t, err)
+	require.Equal(t, int64(400100), report.GetRevenue().GetAmount())
+	require.Equal(t, domain.DefaultCurrency, report.GetRevenue().GetCurrency())
+	require.Equal(t, int64(240100), report.GetProfit().GetAmount())
+	require.Equal(t, prevYear, report.GetPeriod().GetStartYear())
+
+	rating, err := client.interactor.CalculateUserRating(guest, &pb.CalculateUserRatingRequest{UserId: ownerId.String()})
+	require.Nil(t, err)
+	require.Greater(t, rating.GetRating(), float32(0))
+
+	best, err := client.interactor.GetMostProfitableCompany(guest, &pb.GetMostProfitableCompanyRequest{Period: period, CompanyIds: []string{comp.GetId()}})
+	require.Nil(t, err)
+	require.Equal(t, comp.GetId(), best.GetId())
+
+	companies, err := client.companies.ListCompanies(guest, &pb.ListCompaniesRequest{
+		Filter: &pb.CompanyFilter{City: "Москва"},
+		Page:   &pb.PageRequest{Limit: 1},
+	})
+	require.Nil(t, err)
+	require.Equal(t, int32(1), companies.GetPage().GetTotal())
+	require.Equal(t, comp.GetName(), companies.GetCompanies()[0].GetName())
+
+	hits, err := client.search.Search(guest, &pb.SearchRequest{Query: "рогов", Kinds: []pb.SearchKind{pb.SearchKind_SEARCH_KIND_COMPANY}})
+	require.Nil(t, err)
+	require.Len(t, hits.GetHits(), 1)
+	require.Equal(t, comp.GetId(), hits.GetHits()[0].GetId())
+	require.Equal(t, pb.SearchKind_SEARCH_KIND_COMPANY, hits.GetHits()[0].GetKind())
+
+	skillResp, err := client.skills.CreateSkill(admin, &pb.CreateSkillRequest{Skill: &pb.Skill{Name: "Go", Description: "язык программирования"}})
+	require.Nil(t, err)
+
+	_, err = client.userSkills.AddUserSkill(owner, &pb.AddUserSkillRequest{UserId: ownerId.String(), SkillId: skillResp.GetId()})
+	require.Nil(t, err)
+
+	users, err := client.userSkills.ListUsersForSkill(guest, &pb.ListUsersForSkillRequest{SkillId: skillResp.GetId()})
+	require.Nil(t, err)
+	require.Equal(t, ownerId.String(), users.GetUsers()[0].GetId())
+
+	_, err = client.contacts.CreateContact(owner, &pb.CreateContactRequest{Contact: &pb.Contact{OwnerId: ownerId.String(), Name: "email", Value: "ivan@example.com"}})
+	require.Nil(t, err)
+
+	_, err = client.companies.DeleteCompany(owner, &pb.DeleteCompanyRequest{Id: comp.GetId()})
+	require.Nil(t, err)
+
+	_, err = client.companies.GetCompany(guest, &pb.GetCompanyRequest{Id: comp.GetId()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestServer_InternalError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	skillSvc := mocks.NewMockISkillService(ctrl)
+	skillSvc.EXPECT().
+		GetAll(gomock.Any(), domain.PageRequest{}).
+		Return(nil, i18n.Wrap(errors.New("connection refused"), i18n.SkillGetAll))
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Errorf(gomock.Any(), gomock.Any()).Times(1)
+
+	conn := serve(t, NewServer(&Services{Skill: skillSvc}, AuthConfig{}, logger))
+
+	_, err := pb.NewSkillServiceClient(conn).ListSkills(context.Background(), &pb.ListSkillsRequest{})
+
+	require.Equal(t, codes.Internal, status.Code(err))
+	require.Equal(t, "внутренняя ошибка сервера", status.Convert(err).Message())
+}
+
+func TestServer_Panic(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	skillSvc := mocks.NewMockISkillService(ctrl)
+	skillSvc.EXPECT().
+		GetAll(gomock.Any(), domain.PageRequest{}).
+		DoAndReturn(func(context.Context, domain.PageRequest) (*domain.Page[*domain.Skill], error) {
+			panic("unexpected")
+		})
+	skillSvc.EXPECT().
+		GetById(gomock.Any(), uuid.UUID{1}).
+		Return(&domain.Skill{ID: uuid.UUID{1}, Name: "Go"}, nil)
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Errorf(gomock.Any(), gomock.Any()).Times(1)
+
+	conn := serve(t, NewServer(&Services{Skill: skillSvc}, AuthConfig{}, logger))
+	client := pb.NewSkillServiceClient(conn)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "accept-language", "en")
+	_, err := client.ListSkills(ctx, &pb.ListSkillsRequest{})
+	require.Equal(t, codes.Internal, status.Code(err))
+	require.Equal(t, "internal server error", status.Convert(err).Message())
+
+	// сервер продолжает обслуживать вызовы после паники
+	skill, err := client.GetSkill(context.Background(), &pb.GetSkillRequest{Id: uuid.UUID{1}.String()})
+	require.Nil(t, err)
+	require.Equal(t, "Go", skill.GetName())
+}
+
+func TestCode(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{
+			name: "валидация",
+			err:  domain.NewValidationError("name", i18n.SkillNameRequired),
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "не найдено",
+			err:  i18n.Wrap(domain.NewError(domain.ErrNotFound, i18n.StorageSkillNotFound), i18n.SkillGet),
+			code: codes.NotFound,
+		},
+		{
+			name: "конфликт",
+			err:  domain.NewError(domain.ErrConflict, i18n.StorageUsernameTaken),
+			code: codes.AlreadyExists,
+		},
+		{
+			name: "нет прав",
+			err:  i18n.Wrap(authz.ErrForbidden, i18n.SkillCreate),
+			code: codes.PermissionDenied,
+		},
+		{
+			name: "не аутентифицирован",
+			err:  domain.NewError(domain.ErrUnauthenticated, i18n.AuthWrongPassword),
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "прочие ошибки",
+			err:  errors.New("sql error"),
+			code: codes.Internal,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.code, Code(tc.err))
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package grpc
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/transport/grpc/pb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type skillServer struct {
+	pb.UnimplementedSkillServiceServer
+	svc domain.ISkillService
+}
+
+func (s *skillServer) CreateSkill(ctx context.Context, req *pb.CreateSkillRequest) (*pb.Skill, error) {
+	skill, err := toSkill(req.GetSkill())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.svc.Create(ctx, skill)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromSkill(skill), nil
+}
+
+func (s *skillServer) GetSkill(ctx context.Context, req *pb.GetSkillRequest) (*pb.Skill, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	skill, err := s.svc.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromSkill(skill), nil
+}
+
+func (s *skillServer) ListSkills(ctx context.Context, req *pb.ListSkillsRequest) (*pb.ListSkillsResponse, error) {
+	skills, err := s.svc.GetAll(ctx, pageRequest(req.GetPage()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListSkillsResponse{
+		Skills: convertItems(skills.Items, fromSkill),
+		Page:   pageInfo(skills),
+	}, nil
+}
+
+func (s *skillServer) UpdateSkill(ctx context.Context, req *pb.UpdateSkillRequest) (*pb.Skill, error) {
+	skill, err := toSkill(req.GetSkill())
+	if err != nil {
+		return nil, err
+	}
+
+	err = requireID("id", skill.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.svc.Update(ctx, skill)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromSkill(skill), nil
+}
+
+func (s *skillServer) DeleteSkill(ctx context.Context, req *pb.DeleteSkillRequest) (*emptypb.Empty, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.svc.DeleteById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,109 @@
+package grpc
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/services/authz"
+	"github.com/dlankinl/bmstu-ppo-bl/transport/grpc/pb"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type userServer struct {
+	pb.UnimplementedUserServiceServer
+	svc domain.IUserService
+}
+
+// CreateUser создает профиль. Без id профиль создается для текущего пользователя.
+func (s *userServer) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.User, error) {
+	user, err := toUser(req.GetUser())
+	if err != nil {
+		return nil, err
+	}
+	if user.ID == uuid.Nil {
+		user.ID = authz.Caller(ctx).UserId
+	}
+
+	err = s.svc.Create(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromUser(user), nil
+}
+
+func (s *userServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.svc.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromUser(user), nil
+}
+
+func (s *userServer) GetUserByUsername(ctx context.Context, req *pb.GetUserByUsernameRequest) (*pb.User, error) {
+	user, err := s.svc.GetByUsername(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	return fromUser(user), nil
+}
+
+func (s *userServer) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	filter := domain.UserFilter{
+		City:   req.GetFilter().GetCity(),
+		Gender: req.GetFilter().GetGender(),
+		MinAge: int(req.GetFilter().GetMinAge()),
+		MaxAge: int(req.GetFilter().GetMaxAge()),
+		Search: req.GetFilter().GetSearch(),
+	}
+
+	users, err := s.svc.GetAll(ctx, filter, pageRequest(req.GetPage()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListUsersResponse{
+		Users: convertItems(users.Items, fromUser),
+		Page:  pageInfo(users),
+	}, nil
+}
+
+func (s *userServer) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.User, error) {
+	user, err := toUser(req.GetUser())
+	if err != nil {
+		return nil, err
+	}
+
+	err = requireID("id", user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.svc.Update(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromUser(user), nil
+}
+
+func (s *userServer) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*emptypb.Empty, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.svc.DeleteById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,103 @@
+package grpc
+
+import (
+	"context"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/transport/grpc/pb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type userSkillServer struct {
+	pb.UnimplementedUserSkillServiceServer
+	svc domain.IUserSkillService
+}
+
+func (s *userSkillServer) AddUserSkill(ctx context.Context, req *pb.AddUserSkillRequest) (*emptypb.Empty, error) {
+	pair, err := toUserSkill(req.GetUserId(), req.GetSkillId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.svc.Create(ctx, pair)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *userSkillServer) DeleteUserSkill(ctx context.Context, req *pb.DeleteUserSkillRequest) (*emptypb.Empty, error) {
+	pair, err := toUserSkill(req.GetUserId(), req.GetSkillId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.svc.Delete(ctx, pair)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *userSkillServer) ListSkillsForUser(ctx context.Context, req *pb.ListSkillsForUserRequest) (*pb.ListSkillsResponse, error) {
+	userID, err := parseID("user_id", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	skills, err := s.svc.GetSkillsForUser(ctx, userID, pageRequest(req.GetPage()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListSkillsResponse{
+		Skills: convertItems(skills.Items, fromSkill),
+		Page:   pageInfo(skills),
+	}, nil
+}
+
+func (s *userSkillServer) ListUsersForSkill(ctx context.Context, req *pb.ListUsersForSkillRequest) (*pb.ListUsersResponse, error) {
+	skillID, err := parseID("skill_id", req.GetSkillId())
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := s.svc.GetUsersForSkill(ctx, skillID, pageRequest(req.GetPage()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListUsersResponse{
+		Users: convertItems(users.Items, fromUser),
+		Page:  pageInfo(users),
+	}, nil
+}
+
+func (s *userSkillServer) DeleteSkillsForUser(ctx context.Context, req *pb.DeleteSkillsForUserRequest) (*emptypb.Empty, error) {
+	userID, err := parseID("user_id", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.svc.DeleteSkillsForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func toUserSkill(userID, skillID string) (*domain.UserSkill, error) {
+	userId, err := parseID("user_id", userID)
+	if err != nil {
+		return nil, err
+	}
+
+	skillId, err := parseID("skill_id", skillID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.UserSkill{UserId: userId, SkillId: skillId}, nil
+}
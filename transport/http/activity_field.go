@@ -0,0 +1,106 @@
+package http
+
+import (
+	"github.com/google/uuid"
+	nethttp "net/http"
+)
+
+func (s *Server) listActivityFields(w nethttp.ResponseWriter, r *nethttp.Request) {
+	page, err := pageRequest(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	fields, err := s.services.ActivityField.GetAll(r.Context(), page)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, newPageResponse(fields, newActivityFieldResponse))
+}
+
+func (s *Server) createActivityField(w nethttp.ResponseWriter, r *nethttp.Request) {
+	var req activityFieldRequest
+	err := decodeBody(r, &req)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	field := req.activityField(uuid.Nil)
+	err = s.services.ActivityField.Create(r.Context(), field)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusCreated, newActivityFieldResponse(field))
+}
+
+func (s *Server) getMaxCost(w nethttp.ResponseWriter, r *nethttp.Request) {
+	cost, err := s.services.ActivityField.GetMaxCost(r.Context())
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, &costResponse{Cost: cost})
+}
+
+func (s *Server) getActivityField(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	field, err := s.services.ActivityField.GetById(r.Context(), id)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, newActivityFieldResponse(field))
+}
+
+func (s *Server) updateActivityField(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req activityFieldRequest
+	err = decodeBody(r, &req)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	field := req.activityField(id)
+	err = s.services.ActivityField.Update(r.Context(), field)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, newActivityFieldResponse(field))
+}
+
+func (s *Server) deleteActivityField(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	err = s.services.ActivityField.DeleteById(r.Context(), id)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(nethttp.StatusNoContent)
+}
@@ -0,0 +1,88 @@
+package http
+
+import (
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	nethttp "net/http"
+)
+
+func (s *Server) register(w nethttp.ResponseWriter, r *nethttp.Request) {
+	var req authRequest
+	err := decodeBody(r, &req)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	authInfo := &domain.UserAuth{
+		Username: req.Username,
+		Password: req.Password,
+		Role:     req.Role,
+	}
+	err = s.services.Auth.Register(r.Context(), authInfo)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusCreated, &registerResponse{ID: authInfo.ID})
+}
+
+func (s *Server) login(w nethttp.ResponseWriter, r *nethttp.Request) {
+	var req authRequest
+	err := decodeBody(r, &req)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	tokens, err := s.services.Auth.Login(r.Context(), &domain.UserAuth{
+		Username: req.Username,
+		Password: req.Password,
+	})
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, &tokenResponse{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	})
+}
+
+func (s *Server) refresh(w nethttp.ResponseWriter, r *nethttp.Request) {
+	var req refreshRequest
+	err := decodeBody(r, &req)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	tokens, err := s.services.Auth.Refresh(r.Context(), req.RefreshToken)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, &tokenResponse{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	})
+}
+
+func (s *Server) logout(w nethttp.ResponseWriter, r *nethttp.Request) {
+	var req refreshRequest
+	err := decodeBody(r, &req)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	err = s.services.Auth.Logout(r.Context(), req.RefreshToken)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(nethttp.StatusNoContent)
+}
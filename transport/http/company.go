@@ -0,0 +1,150 @@
+package http
+
+import (
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+	nethttp "net/http"
+)
+
+func (s *Server) listCompanies(w nethttp.ResponseWriter, r *nethttp.Request) {
+	page, err := pageRequest(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	filter := domain.CompanyFilter{
+		City:   r.URL.Query().Get("city"),
+		Search: r.URL.Query().Get("search"),
+	}
+	filter.ActivityFieldId, err = queryUUID(r, "activity_field_id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	filter.OwnerId, err = queryUUID(r, "owner_id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	companies, err := s.services.Company.GetAll(r.Context(), filter, page)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, newPageResponse(companies, newCompanyResponse))
+}
+
+func (s *Server) createCompany(w nethttp.ResponseWriter, r *nethttp.Request) {
+	var req companyRequest
+	err := decodeBody(r, &req)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	company := req.company(uuid.Nil)
+	err = s.services.Company.Create(r.Context(), company)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusCreated, newCompanyResponse(company))
+}
+
+func (s *Server) getCompany(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	company, err := s.services.Company.GetById(r.Context(), id)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, newCompanyResponse(company))
+}
+
+func (s *Server) updateCompany(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req companyRequest
+	err = decodeBody(r, &req)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	company := req.company(id)
+	err = s.services.Company.Update(r.Context(), company)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, newCompanyResponse(company))
+}
+
+func (s *Server) deleteCompany(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	err = s.services.Company.DeleteById(r.Context(), id)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(nethttp.StatusNoContent)
+}
+
+func (s *Server) getCompanyCost(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	cost, err := s.services.ActivityField.GetCostByCompanyId(r.Context(), id)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, &costResponse{Cost: cost})
+}
+
+func (s *Server) getCompanyFinReport(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	period, err := queryPeriod(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	report, err := s.services.FinReport.GetByCompany(r.Context(), id, period)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, newFinReportByPeriodResponse(report))
+}
@@ -0,0 +1,80 @@
+package http
+
+import (
+	"github.com/google/uuid"
+	nethttp "net/http"
+)
+
+func (s *Server) createContact(w nethttp.ResponseWriter, r *nethttp.Request) {
+	var req contactRequest
+	err := decodeBody(r, &req)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	contact := req.contact(uuid.Nil)
+	err = s.services.Contact.Create(r.Context(), contact)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusCreated, newContactResponse(contact))
+}
+
+func (s *Server) getContact(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	contact, err := s.services.Contact.GetById(r.Context(), id)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, newContactResponse(contact))
+}
+
+func (s *Server) updateContact(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req contactRequest
+	err = decodeBody(r, &req)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	contact := req.contact(id)
+	err = s.services.Contact.Update(r.Context(), contact)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, newContactResponse(contact))
+}
+
+func (s *Server) deleteContact(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	err = s.services.Contact.DeleteById(r.Context(), id)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(nethttp.StatusNoContent)
+}
@@ -0,0 +1,368 @@
+package http
+
+import (
+	"encoding/json"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+)
+
+type pageResponse[T any] struct {
+	Items      []T    `json:"items"`
+	Total      int    `json:"total"`
+	Offset     int    `json:"offset"`
+	Limit      int    `json:"limit"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+func newPageResponse[S, T any](page *domain.Page[S], convert func(S) T) *pageResponse[T] {
+	items := make([]T, 0, len(page.Items))
+	for _, item := range page.Items {
+		items = append(items, convert(item))
+	}
+
+	return &pageResponse[T]{
+		Items:      items,
+		Total:      page.Total,
+		Offset:     page.Offset,
+		Limit:      page.Limit,
+		NextCursor: page.NextCursor,
+	}
+}
+
+type authRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Role     string `json:"role,omitempty"`
+}
+
+type registerResponse struct {
+	ID uuid.UUID `json:"id"`
+}
+
+type refreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+type userRequest struct {
+	ID       uuid.UUID `json:"id"`
+	Username string    `json:"username"`
+	FullName string    `json:"full_name"`
+	Gender   string    `json:"gender"`
+	Birthday string    `json:"birthday" format:"date"`
+	City     string    `json:"city"`
+	Role     string    `json:"role"`
+}
+
+func (r *userRequest) user() (*domain.User, error) {
+	birthday, err := parseDate("birthday", r.Birthday)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.User{
+		ID:       r.ID,
+		Username: r.Username,
+		FullName: r.FullName,
+		Gender:   r.Gender,
+		Birthday: birthday,
+		City:     r.City,
+		Role:     r.Role,
+	}, nil
+}
+
+type userResponse struct {
+	ID       uuid.UUID `json:"id"`
+	Username string    `json:"username"`
+	FullName string    `json:"full_name"`
+	Gender   string    `json:"gender"`
+	Birthday string    `json:"birthday" format:"date"`
+	City     string    `json:"city"`
+	Role     string    `json:"role"`
+}
+
+func newUserResponse(user *domain.User) *userResponse {
+	resp := &userResponse{
+		ID:       user.ID,
+		Username: user.Username,
+		FullName: user.FullName,
+		Gender:   user.Gender,
+		City:     user.City,
+		Role:     user.Role,
+	}
+	if !user.Birthday.IsZero() {
+		resp.Birthday = user.Birthday.Format(dateLayout)
+	}
+
+	return resp
+}
+
+type companyRequest struct {
+	OwnerID         uuid.UUID `json:"owner_id"`
+	ActivityFieldId uuid.UUID `json:"activity_field_id"`
+	Name            string    `json:"name"`
+	City            string    `json:"city"`
+}
+
+func (r *companyRequest) company(id uuid.UUID) *domain.Company {
+	return &domain.Company{
+		ID:              id,
+		OwnerID:         r.OwnerID,
+		ActivityFieldId: r.ActivityFieldId,
+		Name:            r.Name,
+		City:            r.City,
+	}
+}
+
+type companyResponse struct {
+	ID              uuid.UUID `json:"id"`
+	OwnerID         uuid.UUID `json:"owner_id"`
+	ActivityFieldId uuid.UUID `json:"activity_field_id"`
+	Name            string    `json:"name"`
+	City            string    `json:"city"`
+}
+
+func newCompanyResponse(company *domain.Company) *companyResponse {
+	return &companyResponse{
+		ID:              company.ID,
+		OwnerID:         company.OwnerID,
+		ActivityFieldId: company.ActivityFieldId,
+		Name:            company.Name,
+		City:            company.City,
+	}
+}
+
+type contactRequest struct {
+	OwnerID uuid.UUID `json:"owner_id"`
+	Name    string    `json:"name"`
+	Value   string    `json:"value"`
+}
+
+func (r *contactRequest) contact(id uuid.UUID) *domain.Contact {
+	return &domain.Contact{
+		ID:      id,
+		OwnerID: r.OwnerID,
+		Name:    r.Name,
+		Value:   r.Value,
+	}
+}
+
+type contactResponse struct {
+	ID      uuid.UUID `json:"id"`
+	OwnerID uuid.UUID `json:"owner_id"`
+	Name    string    `json:"name"`
+	Value   string    `json:"value"`
+}
+
+func newContactResponse(contact *domain.Contact) *contactResponse {
+	return &contactResponse{
+		ID:      contact.ID,
+		OwnerID: contact.OwnerID,
+		Name:    contact.Name,
+		Value:   contact.Value,
+	}
+}
+
+type skillRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+func (r *skillRequest) skill(id uuid.UUID) *domain.Skill {
+	return &domain.Skill{
+		ID:          id,
+		Name:        r.Name,
+		Description: r.Description,
+	}
+}
+
+type skillResponse struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+}
+
+func newSkillResponse(skill *domain.Skill) *skillResponse {
+	return &skillResponse{
+		ID:          skill.ID,
+		Name:        skill.Name,
+		Description: skill.Description,
+	}
+}
+
+type userSkillRequest struct {
+	SkillId uuid.UUID `json:"skill_id"`
+}
+
+type activityFieldRequest struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Cost        float32 `json:"cost"`
+}
+
+func (r *activityFieldRequest) activityField(id uuid.UUID) *domain.ActivityField {
+	return &domain.ActivityField{
+		ID:          id,
+		Name:        r.Name,
+		Description: r.Description,
+		Cost:        r.Cost,
+	}
+}
+
+type activityFieldResponse struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Cost        float32   `json:"cost"`
+}
+
+func newActivityFieldResponse(field *domain.ActivityField) *activityFieldResponse {
+	return &activityFieldResponse{
+		ID:          field.ID,
+		Name:        field.Name,
+		Description: field.Description,
+		Cost:        field.Cost,
+	}
+}
+
+type costResponse struct {
+	Cost float32 `json:"cost"`
+}
+
+type ratingResponse struct {
+	Rating float32 `json:"rating"`
+}
+
+type finReportRequest struct {
+	CompanyID uuid.UUID   `json:"company_id"`
+	Revenue   json.Number `json:"revenue"`
+	Costs     json.Number `json:"costs"`
+	// Currency — валюта выручки и расходов, по умолчанию domain.DefaultCurrency.
+	Currency    string `json:"currency"`
+	Granularity string `json:"granularity" doc:"периодичность отчета: month, quarter или year, по умолчанию quarter"`
+	Year        int    `json:"year"`
+	Quarter     int    `json:"quarter" doc:"квартал от 1 до 4 для квартального отчета, для месячного определяется по месяцу"`
+	Month       int    `json:"month" doc:"месяц от 1 до 12 для месячного отчета"`
+}
+
+func (r *finReportRequest) finReport(id uuid.UUID) (*domain.FinancialReport, error) {
+	currency := r.Currency
+	if currency == "" {
+		currency = domain.DefaultCurrency
+	}
+
+	revenue, err := parseAmount("revenue", r.Revenue, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	costs, err := parseAmount("costs", r.Costs, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.FinancialReport{
+		ID:          id,
+		CompanyID:   r.CompanyID,
+		Revenue:     revenue,
+		Costs:       costs,
+		Granularity: r.Granularity,
+		Year:        r.Year,
+		Quarter:     r.Quarter,
+		Month:       r.Month,
+	}, nil
+}
+
+type finReportsRequest struct {
+	Reports []finReportRequest `json:"reports"`
+}
+
+type finReportResponse struct {
+	ID          uuid.UUID   `json:"id"`
+	CompanyID   uuid.UUID   `json:"company_id"`
+	Revenue     json.Number `json:"revenue"`
+	Costs       json.Number `json:"costs"`
+	Currency    string      `json:"currency"`
+	Granularity string      `json:"granularity" doc:"month, quarter или year"`
+	Year        int         `json:"year"`
+	Quarter     int         `json:"quarter" doc:"квартал отчета или его месяца, 0 для годового отчета"`
+	Month       int         `json:"month" doc:"месяц месячного отчета, иначе 0"`
+}
+
+func newFinReportResponse(report *domain.FinancialReport) *finReportResponse {
+	return &finReportResponse{
+		ID:          report.ID,
+		CompanyID:   report.CompanyID,
+		Revenue:     json.Number(report.Revenue.Decimal()),
+		Costs:       json.Number(report.Costs.Decimal()),
+		Currency:    report.Revenue.Currency,
+		Granularity: report.Granularity,
+		Year:        report.Year,
+		Quarter:     report.Quarter,
+		Month:       report.Month,
+	}
+}
+
+type periodResponse struct {
+	StartYear    int `json:"start_year"`
+	StartQuarter int `json:"start_quarter"`
+	EndYear      int `json:"end_year"`
+	EndQuarter   int `json:"end_quarter"`
+}
+
+type finReportByPeriodResponse struct {
+	Reports []*finReportResponse `json:"reports"`
+	Period  *periodResponse      `json:"period,omitempty"`
+	Revenue json.Number          `json:"revenue"`
+	Costs   json.Number          `json:"costs"`
+	Profit  json.Number          `json:"profit"`
+	Taxes   json.Number          `json:"taxes"`
+	// Currency — валюта отчетности итогов, пустая для загруженных, но не приведенных к одной валюте отчетов.
+	Currency string   `json:"currency,omitempty"`
+	TaxLoad  *float32 `json:"tax_load,omitempty"`
+}
+
+func newFinReportByPeriodResponse(report *domain.FinancialReportByPeriod) *finReportByPeriodResponse {
+	resp := &finReportByPeriodResponse{
+		Reports:  make([]*finReportResponse, 0, len(report.Reports)),
+		Revenue:  json.Number(report.Revenue().Decimal()),
+		Costs:    json.Number(report.Costs().Decimal()),
+		Profit:   json.Number(report.Profit().Decimal()),
+		Taxes:    json.Number(report.Taxes.Decimal()),
+		Currency: report.Currency,
+		TaxLoad:  report.TaxLoad,
+	}
+	for i := range report.Reports {
+		resp.Reports = append(resp.Reports, newFinReportResponse(&report.Reports[i]))
+	}
+	if report.Period != nil {
+		resp.Period = &periodResponse{
+			StartYear:    report.Period.StartYear,
+			StartQuarter: report.Period.StartQuarter,
+			EndYear:      report.Period.EndYear,
+			EndQuarter:   report.Period.EndQuarter,
+		}
+	}
+
+	return resp
+}
+
+type searchHitResponse struct {
+	Kind  domain.SearchKind `json:"kind"`
+	ID    uuid.UUID         `json:"id"`
+	Title string            `json:"title"`
+	Score float64           `json:"score"`
+}
+
+func newSearchHitResponse(hit *domain.SearchHit) *searchHitResponse {
+	return &searchHitResponse{
+		Kind:  hit.Kind,
+		ID:    hit.ID,
+		Title: hit.Title,
+		Score: hit.Score,
+	}
+}
@@ -0,0 +1,58 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	nethttp "net/http"
+)
+
+type errorResponse struct {
+	Error string `json:"error"`
+	Field string `json:"field,omitempty"`
+}
+
+// StatusCode сопоставляет ошибке сервиса код ответа HTTP.
+func StatusCode(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrValidation):
+		return nethttp.StatusBadRequest
+	case errors.Is(err, domain.ErrUnauthenticated):
+		return nethttp.StatusUnauthorized
+	case errors.Is(err, domain.ErrForbidden):
+		return nethttp.StatusForbidden
+	case errors.Is(err, domain.ErrNotFound):
+		return nethttp.StatusNotFound
+	case errors.Is(err, domain.ErrConflict):
+		return nethttp.StatusConflict
+	case errors.Is(err, domain.ErrNotEnoughData):
+		return nethttp.StatusUnprocessableEntity
+	default:
+		return nethttp.StatusInternalServerError
+	}
+}
+
+func (s *Server) writeError(w nethttp.ResponseWriter, r *nethttp.Request, err error) {
+	locale := i18n.FromContext(r.Context())
+	status := StatusCode(err)
+
+	resp := &errorResponse{Error: i18n.Localize(err, locale)}
+	if status == nethttp.StatusInternalServerError {
+		s.logger.Errorf("%v %v: %v", r.Method, r.URL.Path, err)
+		resp.Error = i18n.Text(locale, i18n.HTTPInternal)
+	}
+
+	var validationErr *domain.ValidationError
+	if errors.As(err, &validationErr) {
+		resp.Field = validationErr.Field
+	}
+
+	writeJSON(w, status, resp)
+}
+
+func writeJSON(w nethttp.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,121 @@
+package http
+
+import (
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+	nethttp "net/http"
+)
+
+func (s *Server) createFinReport(w nethttp.ResponseWriter, r *nethttp.Request) {
+	var req finReportRequest
+	err := decodeBody(r, &req)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	report, err := req.finReport(uuid.Nil)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	err = s.services.FinReport.Create(r.Context(), report)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusCreated, newFinReportResponse(report))
+}
+
+// createFinReports сохраняет несколько отчетов одной операцией.
+func (s *Server) createFinReports(w nethttp.ResponseWriter, r *nethttp.Request) {
+	var req finReportsRequest
+	err := decodeBody(r, &req)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	byPeriod := &domain.FinancialReportByPeriod{
+		Reports: make([]domain.FinancialReport, 0, len(req.Reports)),
+	}
+	for i := range req.Reports {
+		report, err := req.Reports[i].finReport(uuid.Nil)
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+		byPeriod.Reports = append(byPeriod.Reports, *report)
+	}
+
+	err = s.services.FinReport.CreateByPeriod(r.Context(), byPeriod)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusCreated, newFinReportByPeriodResponse(byPeriod))
+}
+
+func (s *Server) getFinReport(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	report, err := s.services.FinReport.GetById(r.Context(), id)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, newFinReportResponse(report))
+}
+
+func (s *Server) updateFinReport(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req finReportRequest
+	err = decodeBody(r, &req)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	report, err := req.finReport(id)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	err = s.services.FinReport.Update(r.Context(), report)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, newFinReportResponse(report))
+}
+
+func (s *Server) deleteFinReport(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	err = s.services.FinReport.DeleteById(r.Context(), id)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(nethttp.StatusNoContent)
+}
@@ -0,0 +1,55 @@
+package http
+
+import (
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/base"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/dlankinl/bmstu-ppo-bl/services/authz"
+	nethttp "net/http"
+	"strings"
+)
+
+func withLocale(r *nethttp.Request) *nethttp.Request {
+	locale := i18n.ParseLocale(r.Header.Get("Accept-Language"))
+	return r.WithContext(i18n.WithLocale(r.Context(), locale))
+}
+
+// withIdentity аутентифицирует запрос перед обработчиком эндпоинта. На публичном эндпоинте
+// ошибка токена не возвращается, запрос выполняется от имени гостя: клиент с истекшим
+// access-токеном должен суметь войти или обновить пару токенов.
+func (s *Server) withIdentity(rt route) nethttp.HandlerFunc {
+	return func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		authenticated, err := s.authenticate(r)
+		if err != nil && !rt.public {
+			s.writeError(w, r, err)
+			return
+		}
+		if err != nil {
+			authenticated = r
+		}
+
+		rt.handler(w, authenticated)
+	}
+}
+
+// authenticate проверяет Bearer-токен и кладет личность пользователя в контекст.
+// Запрос без заголовка Authorization выполняется от имени гостя.
+func (s *Server) authenticate(r *nethttp.Request) (*nethttp.Request, error) {
+	header := r.Header.Get("Authorization")
+	if header == "" {
+		return r, nil
+	}
+
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return nil, domain.NewError(domain.ErrUnauthenticated, i18n.HTTPAuthHeader)
+	}
+
+	payload, err := base.VerifyAuthToken(r.Context(), strings.TrimSpace(token), s.auth.Keys, s.auth.TokenOpts, s.auth.Revocation)
+	if err != nil {
+		s.logger.Infof("%v: %v", i18n.HTTPAuthenticate, err)
+		return nil, domain.WithKind(domain.ErrUnauthenticated, i18n.Wrap(err, i18n.HTTPAuthenticate))
+	}
+
+	return r.WithContext(authz.WithIdentity(r.Context(), authz.IdentityFromPayload(payload))), nil
+}
@@ -0,0 +1,352 @@
+package http
+
+import (
+	"encoding/json"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/google/uuid"
+	nethttp "net/http"
+	"reflect"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	openAPIVersion = "3.0.3"
+	apiTitle       = "bmstu-ppo-bl"
+	apiVersion     = "1.0.0"
+	schemaRefBase  = "#/components/schemas/"
+)
+
+type schema struct {
+	Ref         string             `json:"$ref,omitempty"`
+	Type        string             `json:"type,omitempty"`
+	Format      string             `json:"format,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Enum        []string           `json:"enum,omitempty"`
+	Items       *schema            `json:"items,omitempty"`
+	Properties  map[string]*schema `json:"properties,omitempty"`
+	Required    []string           `json:"required,omitempty"`
+}
+
+func stringSchema(format string) *schema {
+	return &schema{Type: "string", Format: format}
+}
+
+func integerSchema() *schema {
+	return &schema{Type: "integer"}
+}
+
+func arraySchema(items *schema) *schema {
+	return &schema{Type: "array", Items: items}
+}
+
+type openAPI struct {
+	OpenAPI    string                                  `json:"openapi"`
+	Info       openAPIInfo                             `json:"info"`
+	Security   []map[string][]string                   `json:"security"`
+	Paths      map[string]map[string]*openAPIOperation `json:"paths"`
+	Components openAPIComponents                       `json:"components"`
+}
+
+type openAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type openAPIOperation struct {
+	OperationID string                      `json:"operationId"`
+	Tags        []string                    `json:"tags"`
+	Summary     string                      `json:"summary"`
+	Parameters  []*openAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *openAPIRequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*openAPIResponse `json:"responses"`
+}
+
+type openAPIParameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required"`
+	Schema      *schema `json:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Required bool                    `json:"required"`
+	Content  map[string]openAPIMedia `json:"content"`
+}
+
+type openAPIMedia struct {
+	Schema *schema `json:"schema"`
+}
+
+type openAPIResponse struct {
+	Ref         string                  `json:"$ref,omitempty"`
+	Description string                  `json:"description,omitempty"`
+	Content     map[string]openAPIMedia `json:"content,omitempty"`
+}
+
+type openAPISecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme"`
+	BearerFormat string `json:"bearerFormat"`
+}
+
+type openAPIComponents struct {
+	Schemas         map[string]*schema                `json:"schemas"`
+	Responses       map[string]*openAPIResponse       `json:"responses"`
+	SecuritySchemes map[string]*openAPISecurityScheme `json:"securitySchemes"`
+}
+
+// errorResponses перечисляет общие ответы с ошибками, ключ — код статуса.
+var errorResponses = map[int]string{
+	nethttp.StatusBadRequest:          "BadRequest",
+	nethttp.StatusUnauthorized:        "Unauthorized",
+	nethttp.StatusForbidden:           "Forbidden",
+	nethttp.StatusNotFound:            "NotFound",
+	nethttp.StatusConflict:            "Conflict",
+	nethttp.StatusUnprocessableEntity: "NotEnoughData",
+	nethttp.StatusInternalServerError: "InternalError",
+}
+
+var pathParamRe = regexp.MustCompile(`\{(\w+)\}`)
+
+func buildSpec(routes []route) *openAPI {
+	schemas := make(schemaRegistry)
+
+	spec := &openAPI{
+		OpenAPI: openAPIVersion,
+		Info:    openAPIInfo{Title: apiTitle, Version: apiVersion},
+		// токен необязателен: без него запрос выполняется от имени гостя
+		Security: []map[string][]string{{"bearerAuth": {}}, {}},
+		Paths:    make(map[string]map[string]*openAPIOperation),
+		Components: openAPIComponents{
+			Responses: make(map[string]*openAPIResponse),
+			SecuritySchemes: map[string]*openAPISecurityScheme{
+				"bearerAuth": {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			},
+		},
+	}
+
+	errSchema := schemas.ref(reflect.TypeOf(errorResponse{}))
+	for status, name := range errorResponses {
+		spec.Components.Responses[name] = &openAPIResponse{
+			Description: nethttp.StatusText(status),
+			Content:     jsonContent(errSchema),
+		}
+	}
+
+	for _, rt := range routes {
+		if spec.Paths[rt.pattern] == nil {
+			spec.Paths[rt.pattern] = make(map[string]*openAPIOperation)
+		}
+		spec.Paths[rt.pattern][strings.ToLower(rt.method)] = buildOperation(rt, schemas)
+	}
+	spec.Components.Schemas = schemas
+
+	return spec
+}
+
+func buildOperation(rt route, schemas schemaRegistry) *openAPIOperation {
+	op := &openAPIOperation{
+		OperationID: handlerName(rt.handler),
+		Tags:        []string{rt.tag},
+		Summary:     rt.summary,
+		Responses:   make(map[string]*openAPIResponse),
+	}
+
+	for _, match := range pathParamRe.FindAllStringSubmatch(rt.pattern, -1) {
+		paramSchema := stringSchema("uuid")
+		if match[1] == "username" {
+			paramSchema = stringSchema("")
+		}
+		op.Parameters = append(op.Parameters, &openAPIParameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   paramSchema,
+		})
+	}
+	for _, p := range rt.query {
+		op.Parameters = append(op.Parameters, &openAPIParameter{
+			Name:        p.name,
+			In:          "query",
+			Description: p.description,
+			Required:    p.required,
+			Schema:      p.schema,
+		})
+	}
+
+	if rt.body != nil {
+		op.RequestBody = &openAPIRequestBody{
+			Required: true,
+			Content:  jsonContent(schemas.ref(reflect.TypeOf(rt.body))),
+		}
+	}
+
+	success := &openAPIResponse{Description: nethttp.StatusText(rt.status)}
+	if rt.result != nil {
+		success.Content = jsonContent(schemas.ref(reflect.TypeOf(rt.result)))
+	}
+	op.Responses[strconv.Itoa(rt.status)] = success
+
+	for _, status := range operationErrors(rt) {
+		op.Responses[strconv.Itoa(status)] = &openAPIResponse{Ref: "#/components/responses/" + errorResponses[status]}
+	}
+
+	return op
+}
+
+// operationErrors возвращает коды ошибок, которые может вернуть эндпоинт.
+func operationErrors(rt route) []int {
+	statuses := []int{nethttp.StatusBadRequest, nethttp.StatusUnauthorized, nethttp.StatusInternalServerError}
+	if rt.method != nethttp.MethodGet {
+		statuses = append(statuses, nethttp.StatusForbidden)
+	}
+	if strings.Contains(rt.pattern, "{") {
+		statuses = append(statuses, nethttp.StatusNotFound)
+	}
+	if rt.method == nethttp.MethodPost || rt.method == nethttp.MethodPut {
+		statuses = append(statuses, nethttp.StatusConflict)
+	}
+	statuses = append(statuses, rt.errors...)
+	sort.Ints(statuses)
+
+	return statuses
+}
+
+func jsonContent(s *schema) map[string]openAPIMedia {
+	return map[string]openAPIMedia{"application/json": {Schema: s}}
+}
+
+func handlerName(handler nethttp.HandlerFunc) string {
+	name := runtime.FuncForPC(reflect.ValueOf(handler).Pointer()).Name()
+	name = strings.TrimSuffix(name, "-fm")
+
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+type schemaRegistry map[string]*schema
+
+var (
+	uuidType       = reflect.TypeOf(uuid.UUID{})
+	searchKindType = reflect.TypeOf(domain.SearchKind(""))
+	// суммы передаются десятичными числами с двумя знаками после точки
+	decimalType = reflect.TypeOf(json.Number(""))
+)
+
+// ref возвращает схему типа, структуры выносятся в components/schemas.
+func (r schemaRegistry) ref(t reflect.Type) *schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case uuidType:
+		return stringSchema("uuid")
+	case decimalType:
+		return &schema{Type: "number", Format: "decimal"}
+	case searchKindType:
+		kinds := make([]string, 0, len(domain.SearchKinds))
+		for _, kind := range domain.SearchKinds {
+			kinds = append(kinds, string(kind))
+		}
+		return &schema{Type: "string", Enum: kinds}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return stringSchema("")
+	case reflect.Bool:
+		return &schema{Type: "boolean"}
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return integerSchema()
+	case reflect.Float32:
+		return &schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &schema{Type: "number", Format: "double"}
+	case reflect.Slice, reflect.Array:
+		return arraySchema(r.ref(t.Elem()))
+	case reflect.Struct:
+		name := r.schemaName(t)
+		if _, ok := r[name]; !ok {
+			r[name] = &schema{}
+			r[name] = r.object(t)
+		}
+		return &schema{Ref: schemaRefBase + name}
+	}
+
+	return &schema{}
+}
+
+func (r schemaRegistry) object(t reflect.Type) *schema {
+	obj := &schema{
+		Type:       "object",
+		Properties: make(map[string]*schema),
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		prop := r.ref(field.Type)
+		if format := field.Tag.Get("format"); format != "" {
+			prop.Format = format
+		}
+		if doc := field.Tag.Get("doc"); doc != "" {
+			prop.Description = doc
+		}
+		obj.Properties[name] = prop
+
+		// поля запросов проверяют сервисы, поэтому обязательными помечаются только поля ответов
+		if opts != "omitempty" && !strings.HasSuffix(t.Name(), "Request") {
+			obj.Required = append(obj.Required, name)
+		}
+	}
+
+	return obj
+}
+
+// schemaName строит имя схемы по имени DTO: userResponse — User, userRequest — UserInput.
+func (r schemaRegistry) schemaName(t reflect.Type) string {
+	name := t.Name()
+	if generic, _, ok := strings.Cut(name, "["); ok && generic == "pageResponse" {
+		items, _ := t.FieldByName("Items")
+		return r.schemaName(items.Type.Elem()) + "Page"
+	}
+
+	switch {
+	case strings.HasSuffix(name, "Response"):
+		name = strings.TrimSuffix(name, "Response")
+	case strings.HasSuffix(name, "Request"):
+		name = strings.TrimSuffix(name, "Request") + "Input"
+	}
+	if rest, ok := strings.CutPrefix(name, "finReport"); ok {
+		name = "financialReport" + rest
+	}
+
+	runes := []rune(name)
+	runes[0] = unicode.ToUpper(runes[0])
+
+	return string(runes)
+}
+
+func (s *Server) openAPI(w nethttp.ResponseWriter, _ *nethttp.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_, _ = w.Write(s.spec)
+}
+
+func marshalSpec(spec *openAPI) []byte {
+	data, err := json.MarshalIndent(spec, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+
+	return append(data, '\n')
+}
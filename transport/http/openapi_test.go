@@ -0,0 +1,81 @@
+package http
+
+import (
+	"encoding/json"
+	"flag"
+	"github.com/stretchr/testify/require"
+	nethttp "net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "перезаписать openapi.json")
+
+const specFile = "openapi.json"
+
+// TestOpenAPI_Golden сверяет спецификацию с закоммиченным файлом,
+// после изменения API файл обновляется через go test -run TestOpenAPI_Golden -update.
+func TestOpenAPI_Golden(t *testing.T) {
+	spec := NewServer(&Services{}, AuthConfig{}, nil).spec
+
+	if *update {
+		require.Nil(t, os.WriteFile(specFile, spec, 0o644))
+	}
+
+	golden, err := os.ReadFile(specFile)
+	require.Nil(t, err)
+	require.Equal(t, string(golden), string(spec), "спецификация устарела, запустите тест с флагом -update")
+}
+
+func TestOpenAPI_Routes(t *testing.T) {
+	s := NewServer(&Services{}, AuthConfig{}, nil)
+
+	var spec openAPI
+	require.Nil(t, json.Unmarshal(s.spec, &spec))
+
+	operations := 0
+	for path, methods := range spec.Paths {
+		for method, op := range methods {
+			operations++
+
+			target := pathParamRe.ReplaceAllString(path, "00000000-0000-0000-0000-000000000001")
+			req := httptest.NewRequest(strings.ToUpper(method), target, nil)
+			_, pattern := s.mux.Handler(req)
+			require.Equal(t, strings.ToUpper(method)+" "+path, pattern)
+
+			for _, p := range op.Parameters {
+				if p.In == "path" {
+					require.Contains(t, path, "{"+p.Name+"}")
+				}
+			}
+			for _, resp := range op.Responses {
+				if resp.Ref != "" {
+					name := strings.TrimPrefix(resp.Ref, "#/components/responses/")
+					require.Contains(t, spec.Components.Responses, name)
+				}
+			}
+		}
+	}
+	require.Equal(t, len(s.routeTable()), operations)
+
+	for name, sch := range spec.Components.Schemas {
+		for prop, propSchema := range sch.Properties {
+			if propSchema.Ref != "" {
+				require.Contains(t, spec.Components.Schemas, strings.TrimPrefix(propSchema.Ref, schemaRefBase), "%s.%s", name, prop)
+			}
+		}
+	}
+}
+
+func TestOpenAPI_Served(t *testing.T) {
+	a := newTestAPI(t)
+
+	var spec openAPI
+	rec := a.do(nethttp.MethodGet, "/openapi.json", "", nil, &spec)
+	require.Equal(t, nethttp.StatusOK, rec.Code)
+	require.Equal(t, openAPIVersion, spec.OpenAPI)
+	require.Contains(t, spec.Paths, "/users/{id}")
+	require.Contains(t, spec.Components.Schemas, "UserPage")
+}
@@ -0,0 +1,140 @@
+package http
+
+import (
+	"encoding/json"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/google/uuid"
+	"io"
+	nethttp "net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	dateLayout  = "2006-01-02"
+	maxBodySize = 1 << 20
+)
+
+func decodeBody(r *nethttp.Request, dst any) error {
+	decoder := json.NewDecoder(io.LimitReader(r.Body, maxBodySize))
+	decoder.DisallowUnknownFields()
+
+	err := decoder.Decode(dst)
+	if err != nil {
+		return domain.WithKind(domain.ErrValidation, i18n.Wrap(err, i18n.HTTPBodyInvalid))
+	}
+
+	return nil
+}
+
+func pathID(r *nethttp.Request, name string) (uuid.UUID, error) {
+	id, err := uuid.Parse(r.PathValue(name))
+	if err != nil {
+		return uuid.Nil, domain.NewValidationError(name, i18n.HTTPParamInvalid, name)
+	}
+
+	return id, nil
+}
+
+func queryInt(r *nethttp.Request, name string) (int, error) {
+	raw := r.URL.Query().Get(name)
+	if raw == "" {
+		return 0, nil
+	}
+
+	n, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, domain.NewValidationError(name, i18n.HTTPParamInvalid, name)
+	}
+
+	return n, nil
+}
+
+func queryUUID(r *nethttp.Request, name string) (uuid.UUID, error) {
+	raw := r.URL.Query().Get(name)
+	if raw == "" {
+		return uuid.Nil, nil
+	}
+
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, domain.NewValidationError(name, i18n.HTTPParamInvalid, name)
+	}
+
+	return id, nil
+}
+
+func pageRequest(r *nethttp.Request) (req domain.PageRequest, err error) {
+	req.Limit, err = queryInt(r, "limit")
+	if err != nil {
+		return req, err
+	}
+
+	req.Offset, err = queryInt(r, "offset")
+	if err != nil {
+		return req, err
+	}
+
+	req.Cursor = r.URL.Query().Get("cursor")
+	req.Sort = r.URL.Query().Get("sort")
+
+	return req, nil
+}
+
+func queryPeriod(r *nethttp.Request) (*domain.Period, error) {
+	names := []string{"start_year", "start_quarter", "end_year", "end_quarter"}
+
+	values := make([]int, len(names))
+	for i, name := range names {
+		if r.URL.Query().Get(name) == "" {
+			return nil, domain.NewValidationError(name, i18n.HTTPParamRequired, name)
+		}
+
+		n, err := queryInt(r, name)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = n
+	}
+
+	for _, quarter := range []int{values[1], values[3]} {
+		if quarter < 1 || quarter > 4 {
+			return nil, domain.NewValidationError("quarter", i18n.FinReportQuarterRange)
+		}
+	}
+
+	return &domain.Period{
+		StartYear:    values[0],
+		StartQuarter: values[1],
+		EndYear:      values[2],
+		EndQuarter:   values[3],
+	}, nil
+}
+
+func parseDate(field, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	date, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, domain.NewValidationError(field, i18n.HTTPParamInvalid, field)
+	}
+
+	return date, nil
+}
+
+// parseAmount разбирает сумму из тела запроса, отсутствующая сумма считается нулевой.
+func parseAmount(field string, value json.Number, currency string) (domain.Money, error) {
+	if value == "" {
+		return domain.Money{Currency: currency}, nil
+	}
+
+	amount, err := domain.ParseMoney(value.String(), currency)
+	if err != nil {
+		return domain.Money{}, domain.NewValidationError(field, i18n.MoneyInvalid, value)
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,121 @@
+package http
+
+import (
+	nethttp "net/http"
+)
+
+// route описывает эндпоинт. Из этой же таблицы строится спецификация OpenAPI,
+// поэтому новый эндпоинт добавляется только сюда.
+type route struct {
+	method  string
+	pattern string
+	handler nethttp.HandlerFunc
+	tag     string
+	summary string
+	body    any
+	result  any
+	status  int
+	query   []param
+	// errors — коды ошибок эндпоинта помимо общих, которые выводит operationErrors
+	errors []int
+	// public — эндпоинт не требует токена, недействительный токен на нем не проверяется
+	public bool
+}
+
+type param struct {
+	name        string
+	schema      *schema
+	description string
+	required    bool
+}
+
+var (
+	pageParams = []param{
+		{name: "limit", schema: integerSchema(), description: "размер страницы, по умолчанию 10, не больше 100"},
+		{name: "offset", schema: integerSchema(), description: "смещение от начала выборки"},
+		{name: "cursor", schema: stringSchema(""), description: "курсор следующей страницы из next_cursor"},
+		{name: "sort", schema: stringSchema(""), description: "поле сортировки, префикс - задает обратный порядок"},
+	}
+	periodParams = []param{
+		{name: "start_year", schema: integerSchema(), required: true},
+		{name: "start_quarter", schema: integerSchema(), required: true, description: "первый квартал периода, от 1 до 4"},
+		{name: "end_year", schema: integerSchema(), required: true},
+		{name: "end_quarter", schema: integerSchema(), required: true, description: "последний квартал периода включительно, от 1 до 4. Месячный отчет входит в период вместе со своим кварталом, годовой — только если период включает все кварталы года"},
+	}
+	userFilterParams = []param{
+		{name: "city", schema: stringSchema("")},
+		{name: "gender", schema: stringSchema(""), description: "m или w"},
+		{name: "min_age", schema: integerSchema()},
+		{name: "max_age", schema: integerSchema()},
+		{name: "search", schema: stringSchema(""), description: "подстрока имени пользователя или ФИО"},
+	}
+	companyFilterParams = []param{
+		{name: "city", schema: stringSchema("")},
+		{name: "activity_field_id", schema: stringSchema("uuid")},
+		{name: "owner_id", schema: stringSchema("uuid")},
+		{name: "search", schema: stringSchema(""), description: "подстрока названия"},
+	}
+	searchParams = []param{
+		{name: "q", schema: stringSchema(""), required: true, description: "поисковый запрос"},
+		{name: "kind", schema: arraySchema(stringSchema("")), description: "skill, activity_field или company, по умолчанию все"},
+	}
+)
+
+func (s *Server) routeTable() []route {
+	return []route{
+		{method: "POST", pattern: "/auth/register", handler: s.register, tag: "auth", summary: "Регистрация", body: authRequest{}, result: registerResponse{}, status: nethttp.StatusCreated, public: true},
+		{method: "POST", pattern: "/auth/login", handler: s.login, tag: "auth", summary: "Вход по логину и паролю", body: authRequest{}, result: tokenResponse{}, status: nethttp.StatusOK, public: true},
+		{method: "POST", pattern: "/auth/refresh", handler: s.refresh, tag: "auth", summary: "Обновление пары токенов", body: refreshRequest{}, result: tokenResponse{}, status: nethttp.StatusOK, public: true},
+		{method: "POST", pattern: "/auth/logout", handler: s.logout, tag: "auth", summary: "Завершение сессии", body: refreshRequest{}, status: nethttp.StatusNoContent, public: true},
+
+		{method: "GET", pattern: "/users", handler: s.listUsers, tag: "users", summary: "Список пользователей", result: pageResponse[userResponse]{}, status: nethttp.StatusOK, query: append(userFilterParams, pageParams...)},
+		{method: "POST", pattern: "/users", handler: s.createUser, tag: "users", summary: "Создание профиля, без id создается профиль текущего пользователя", body: userRequest{}, result: userResponse{}, status: nethttp.StatusCreated},
+		{method: "GET", pattern: "/usernames/{username}", handler: s.getUserByUsername, tag: "users", summary: "Пользователь по имени", result: userResponse{}, status: nethttp.StatusOK},
+		{method: "GET", pattern: "/users/{id}", handler: s.getUser, tag: "users", summary: "Пользователь по id", result: userResponse{}, status: nethttp.StatusOK},
+		{method: "PUT", pattern: "/users/{id}", handler: s.updateUser, tag: "users", summary: "Изменение профиля", body: userRequest{}, result: userResponse{}, status: nethttp.StatusOK},
+		{method: "DELETE", pattern: "/users/{id}", handler: s.deleteUser, tag: "users", summary: "Удаление пользователя", status: nethttp.StatusNoContent},
+		{method: "GET", pattern: "/users/{id}/rating", handler: s.getUserRating, tag: "users", summary: "Рейтинг предпринимателя за прошлый год", result: ratingResponse{}, status: nethttp.StatusOK, errors: []int{nethttp.StatusUnprocessableEntity}},
+		{method: "GET", pattern: "/users/{id}/financial-report", handler: s.getUserFinReport, tag: "users", summary: "Сводный финансовый отчет по компаниям пользователя", result: finReportByPeriodResponse{}, status: nethttp.StatusOK, query: periodParams},
+		{method: "GET", pattern: "/users/{id}/companies", handler: s.listUserCompanies, tag: "users", summary: "Компании пользователя", result: pageResponse[companyResponse]{}, status: nethttp.StatusOK, query: pageParams},
+		{method: "GET", pattern: "/users/{id}/contacts", handler: s.listUserContacts, tag: "users", summary: "Контакты пользователя", result: pageResponse[contactResponse]{}, status: nethttp.StatusOK, query: pageParams},
+		{method: "GET", pattern: "/users/{id}/skills", handler: s.listUserSkills, tag: "users", summary: "Навыки пользователя", result: pageResponse[skillResponse]{}, status: nethttp.StatusOK, query: pageParams},
+		{method: "POST", pattern: "/users/{id}/skills", handler: s.addUserSkill, tag: "users", summary: "Добавление навыка пользователю", body: userSkillRequest{}, status: nethttp.StatusNoContent},
+		{method: "DELETE", pattern: "/users/{id}/skills", handler: s.deleteUserSkills, tag: "users", summary: "Удаление всех навыков пользователя", status: nethttp.StatusNoContent},
+		{method: "DELETE", pattern: "/users/{id}/skills/{skillId}", handler: s.deleteUserSkill, tag: "users", summary: "Удаление навыка пользователя", status: nethttp.StatusNoContent},
+
+		{method: "GET", pattern: "/companies", handler: s.listCompanies, tag: "companies", summary: "Список компаний", result: pageResponse[companyResponse]{}, status: nethttp.StatusOK, query: append(companyFilterParams, pageParams...)},
+		{method: "POST", pattern: "/companies", handler: s.createCompany, tag: "companies", summary: "Создание компании", body: companyRequest{}, result: companyResponse{}, status: nethttp.StatusCreated},
+		{method: "GET", pattern: "/companies/{id}", handler: s.getCompany, tag: "companies", summary: "Компания по id", result: companyResponse{}, status: nethttp.StatusOK},
+		{method: "PUT", pattern: "/companies/{id}", handler: s.updateCompany, tag: "companies", summary: "Изменение компании", body: companyRequest{}, result: companyResponse{}, status: nethttp.StatusOK},
+		{method: "DELETE", pattern: "/companies/{id}", handler: s.deleteCompany, tag: "companies", summary: "Удаление компании", status: nethttp.StatusNoContent},
+		{method: "GET", pattern: "/companies/{id}/cost", handler: s.getCompanyCost, tag: "companies", summary: "Вес сферы деятельности компании", result: costResponse{}, status: nethttp.StatusOK},
+		{method: "GET", pattern: "/companies/{id}/financial-report", handler: s.getCompanyFinReport, tag: "companies", summary: "Финансовый отчет компании за период, отчеты полных кварталов и лет свернуты", result: finReportByPeriodResponse{}, status: nethttp.StatusOK, query: periodParams},
+
+		{method: "POST", pattern: "/contacts", handler: s.createContact, tag: "contacts", summary: "Создание контакта", body: contactRequest{}, result: contactResponse{}, status: nethttp.StatusCreated},
+		{method: "GET", pattern: "/contacts/{id}", handler: s.getContact, tag: "contacts", summary: "Контакт по id", result: contactResponse{}, status: nethttp.StatusOK},
+		{method: "PUT", pattern: "/contacts/{id}", handler: s.updateContact, tag: "contacts", summary: "Изменение контакта", body: contactRequest{}, result: contactResponse{}, status: nethttp.StatusOK},
+		{method: "DELETE", pattern: "/contacts/{id}", handler: s.deleteContact, tag: "contacts", summary: "Удаление контакта", status: nethttp.StatusNoContent},
+
+		{method: "GET", pattern: "/skills", handler: s.listSkills, tag: "skills", summary: "Список навыков", result: pageResponse[skillResponse]{}, status: nethttp.StatusOK, query: pageParams},
+		{method: "POST", pattern: "/skills", handler: s.createSkill, tag: "skills", summary: "Создание навыка", body: skillRequest{}, result: skillResponse{}, status: nethttp.StatusCreated},
+		{method: "GET", pattern: "/skills/{id}", handler: s.getSkill, tag: "skills", summary: "Навык по id", result: skillResponse{}, status: nethttp.StatusOK},
+		{method: "PUT", pattern: "/skills/{id}", handler: s.updateSkill, tag: "skills", summary: "Изменение навыка", body: skillRequest{}, result: skillResponse{}, status: nethttp.StatusOK},
+		{method: "DELETE", pattern: "/skills/{id}", handler: s.deleteSkill, tag: "skills", summary: "Удаление навыка", status: nethttp.StatusNoContent},
+		{method: "GET", pattern: "/skills/{id}/users", handler: s.listSkillUsers, tag: "skills", summary: "Пользователи с навыком", result: pageResponse[userResponse]{}, status: nethttp.StatusOK, query: pageParams},
+
+		{method: "GET", pattern: "/activity-fields", handler: s.listActivityFields, tag: "activity-fields", summary: "Список сфер деятельности", result: pageResponse[activityFieldResponse]{}, status: nethttp.StatusOK, query: pageParams},
+		{method: "POST", pattern: "/activity-fields", handler: s.createActivityField, tag: "activity-fields", summary: "Создание сферы деятельности", body: activityFieldRequest{}, result: activityFieldResponse{}, status: nethttp.StatusCreated},
+		{method: "GET", pattern: "/activity-fields/max-cost", handler: s.getMaxCost, tag: "activity-fields", summary: "Максимальный вес сферы деятельности", result: costResponse{}, status: nethttp.StatusOK},
+		{method: "GET", pattern: "/activity-fields/{id}", handler: s.getActivityField, tag: "activity-fields", summary: "Сфера деятельности по id", result: activityFieldResponse{}, status: nethttp.StatusOK},
+		{method: "PUT", pattern: "/activity-fields/{id}", handler: s.updateActivityField, tag: "activity-fields", summary: "Изменение сферы деятельности", body: activityFieldRequest{}, result: activityFieldResponse{}, status: nethttp.StatusOK},
+		{method: "DELETE", pattern: "/activity-fields/{id}", handler: s.deleteActivityField, tag: "activity-fields", summary: "Удаление сферы деятельности", status: nethttp.StatusNoContent},
+
+		{method: "POST", pattern: "/financial-reports", handler: s.createFinReport, tag: "financial-reports", summary: "Создание месячного, квартального или годового отчета", body: finReportRequest{}, result: finReportResponse{}, status: nethttp.StatusCreated},
+		{method: "POST", pattern: "/financial-reports/batch", handler: s.createFinReports, tag: "financial-reports", summary: "Создание нескольких отчетов одной операцией", body: finReportsRequest{}, result: finReportByPeriodResponse{}, status: nethttp.StatusCreated},
+		{method: "GET", pattern: "/financial-reports/{id}", handler: s.getFinReport, tag: "financial-reports", summary: "Отчет по id", result: finReportResponse{}, status: nethttp.StatusOK},
+		{method: "PUT", pattern: "/financial-reports/{id}", handler: s.updateFinReport, tag: "financial-reports", summary: "Изменение отчета", body: finReportRequest{}, result: finReportResponse{}, status: nethttp.StatusOK},
+		{method: "DELETE", pattern: "/financial-reports/{id}", handler: s.deleteFinReport, tag: "financial-reports", summary: "Удаление отчета", status: nethttp.StatusNoContent},
+
+		{method: "GET", pattern: "/search", handler: s.search, tag: "search", summary: "Полнотекстовый поиск по навыкам, сферам деятельности и компаниям", result: pageResponse[searchHitResponse]{}, status: nethttp.StatusOK, query: append(searchParams, pageParams...)},
+	}
+}
@@ -0,0 +1,27 @@
+package http
+
+import (
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	nethttp "net/http"
+)
+
+func (s *Server) search(w nethttp.ResponseWriter, r *nethttp.Request) {
+	page, err := pageRequest(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	kinds := make([]domain.SearchKind, 0)
+	for _, kind := range r.URL.Query()["kind"] {
+		kinds = append(kinds, domain.SearchKind(kind))
+	}
+
+	hits, err := s.services.Search.Search(r.Context(), r.URL.Query().Get("q"), kinds, page)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, newPageResponse(hits, newSearchHitResponse))
+}
@@ -0,0 +1,63 @@
+package http
+
+import (
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/base"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/logger"
+	nethttp "net/http"
+)
+
+// Services содержит сервисы, которые обслуживает API. Проверка прав остается
+// на стороне сервисов, поэтому сюда передаются сервисы, обернутые в authz.
+type Services struct {
+	Auth          domain.IAuthService
+	User          domain.IUserService
+	Company       domain.ICompanyService
+	Contact       domain.IContactsService
+	Skill         domain.ISkillService
+	UserSkill     domain.IUserSkillService
+	ActivityField domain.IActivityFieldService
+	FinReport     domain.IFinancialReportService
+	Interactor    domain.IInteractor
+	Search        domain.ISearchService
+}
+
+type AuthConfig struct {
+	Keys       base.IKeyProvider
+	TokenOpts  base.TokenOptions
+	Revocation base.IRevocationChecker
+}
+
+type Server struct {
+	services *Services
+	auth     AuthConfig
+	logger   logger.ILogger
+	mux      *nethttp.ServeMux
+	spec     []byte
+}
+
+func NewServer(services *Services, auth AuthConfig, logger logger.ILogger) *Server {
+	s := &Server{
+		services: services,
+		auth:     auth,
+		logger:   logger,
+		mux:      nethttp.NewServeMux(),
+	}
+	s.routes()
+
+	return s
+}
+
+func (s *Server) ServeHTTP(w nethttp.ResponseWriter, r *nethttp.Request) {
+	s.mux.ServeHTTP(w, withLocale(r))
+}
+
+func (s *Server) routes() {
+	table := s.routeTable()
+	for _, rt := range table {
+		s.mux.HandleFunc(rt.method+" "+rt.pattern, s.withIdentity(rt))
+	}
+
+	s.spec = marshalSpec(buildSpec(table))
+	s.mux.HandleFunc("GET /openapi.json", s.openAPI)
+}
@@ -0,0 +1,577 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/mocks"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/base"
+	"github.com/dlankinl/bmstu-ppo-bl/pkg/i18n"
+	"github.com/dlankinl/bmstu-ppo-bl/repository/memory"
+	"github.com/dlankinl/bmstu-ppo-bl/services/activity_field"
+	"github.com/dlankinl/bmstu-ppo-bl/services/auth"
+	"github.com/dlankinl/bmstu-ppo-bl/services/authz"
+	"github.com/dlankinl/bmstu-ppo-bl/services/company"
+	"github.com/dlankinl/bmstu-ppo-bl/services/contact"
+	"github.com/dlankinl/bmstu-ppo-bl/services/exchange_rate"
+	"github.com/dlankinl/bmstu-ppo-bl/services/fin_report"
+	"github.com/dlankinl/bmstu-ppo-bl/services/search"
+	"github.com/dlankinl/bmstu-ppo-bl/services/skill"
+	"github.com/dlankinl/bmstu-ppo-bl/services/tax_schedule"
+	"github.com/dlankinl/bmstu-ppo-bl/services/user"
+	"github.com/dlankinl/bmstu-ppo-bl/services/user_activity_field"
+	"github.com/dlankinl/bmstu-ppo-bl/services/user_skill"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"math/big"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type testAPI struct {
+	t        *testing.T
+	handler  nethttp.Handler
+	authRepo domain.IAuthRepository
+	keys     base.IKeyProvider
+}
+
+func newTestAPI(t *testing.T) *testAPI {
+	ctrl := gomock.NewController(t)
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Errorf(gomock.Any(), gomock.Any()).AnyTimes()
+
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	require.Nil(t, err)
+	keys, err := base.NewKeySet(base.NewEd25519Key("test", private))
+	require.Nil(t, err)
+
+	authRepo := memory.NewAuthRepository()
+	tokenRepo := memory.NewRefreshTokenRepository()
+	userRepo := memory.NewUserRepository()
+	compRepo := memory.NewCompanyRepository()
+	actFieldRepo := memory.NewActivityFieldRepository()
+	skillRepo := memory.NewSkillRepository()
+	finRepo := memory.NewFinancialReportRepository()
+	index := memory.NewSearchIndex()
+	txManager := memory.NewTxManager()
+	rates, err := exchange_rate.NewTable([]domain.ExchangeRate{
+		{Currency: "USD", Year: time.Now().Year() - 2, Quarter: 1, Rate: big.NewRat(90, 1)},
+	})
+	require.Nil(t, err)
+
+	userSvc := user.NewService(userRepo, compRepo, actFieldRepo, logger)
+	compSvc := search.NewCompanyService(company.NewService(compRepo, logger), index)
+	actFieldSvc := search.NewActivityFieldService(activity_field.NewService(actFieldRepo, compRepo, logger), compSvc, index)
+	finSvc := fin_report.NewService(finRepo, txManager, rates, domain.DefaultCurrency, logger)
+	taxSvc := tax_schedule.NewService(memory.NewTaxScheduleRepository(), domain.DefaultJurisdiction, domain.DefaultTaxSchedule(), logger)
+
+	services := &Services{
+		Auth:          authz.NewAuthService(auth.NewService(authRepo, tokenRepo, base.NewHashCrypto(), keys, base.TokenOptions{}, logger)),
+		User:          authz.NewUserService(search.NewUserService(userSvc, compSvc, index)),
+		Company:       authz.NewCompanyService(compSvc),
+		Contact:       authz.NewContactService(contact.NewService(memory.NewContactRepository(), logger)),
+		Skill:         authz.NewSkillService(search.NewSkillService(skill.NewService(skillRepo, logger), index)),
+		UserSkill:     authz.NewUserSkillService(user_skill.NewService(memory.NewUserSkillRepository(), userRepo, skillRepo, txManager, logger)),
+		ActivityField: authz.NewActivityFieldService(actFieldSvc),
+		FinReport:     authz.NewFinancialReportService(finSvc, compSvc),
+		Interactor:    user_activity_field.NewInteractor(userSvc, actFieldSvc, compSvc, finSvc, taxSvc, rates, user_activity_field.DefaultLossCarryYears, logger),
+		Search:        search.NewService(index, skillRepo, actFieldRepo, compRepo, logger),
+	}
+
+	return &testAPI{
+		t:        t,
+		handler:  NewServer(services, AuthConfig{Keys: keys, Revocation: tokenRepo}, logger),
+		authRepo: authRepo,
+		keys:     keys,
+	}
+}
+
+// do выполняет запрос и раскладывает JSON-ответ в out, если он передан.
+func (a *testAPI) do(method, path, token string, body any, out any) *httptest.ResponseRecorder {
+	var reader bytes.Buffer
+	if body != nil {
+		switch b := body.(type) {
+		case string:
+			reader.WriteString(b)
+		default:
+			require.Nil(a.t, json.NewEncoder(&reader).Encode(body))
+		}
+	}
+
+	req := httptest.NewRequest(method, path, &reader)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	a.handler.ServeHTTP(rec, req)
+
+	if out != nil {
+		require.Nil(a.t, json.NewDecoder(rec.Body).Decode(out), rec.Body.String())
+	}
+
+	return rec
+}
+
+// login регистрирует пользователя напрямую в хранилище и возвращает его id и токен доступа.
+func (a *testAPI) login(username, role string) (uuid.UUID, string) {
+	hash, err := base.NewHashCrypto().GenerateHashPass("secret")
+	require.Nil(a.t, err)
+
+	authInfo := &domain.UserAuth{Username: username, HashedPass: hash, Role: role}
+	require.Nil(a.t, a.authRepo.Register(context.Background(), authInfo))
+
+	var tokens tokenResponse
+	rec := a.do(nethttp.MethodPost, "/auth/login", "", authRequest{Username: username, Password: "secret"}, &tokens)
+	require.Equal(a.t, nethttp.StatusOK, rec.Code)
+
+	return authInfo.ID, tokens.AccessToken
+}
+
+func TestServer_Auth(t *testing.T) {
+	api := newTestAPI(t)
+
+	var registered registerResponse
+	rec := api.do(nethttp.MethodPost, "/auth/register", "", authRequest{Username: "ivan", Password: "secret", Role: domain.RoleEntrepreneur}, &registered)
+	require.Equal(t, nethttp.StatusCreated, rec.Code)
+	require.NotEqual(t, uuid.Nil, registered.ID)
+
+	var errResp errorResponse
+	rec = api.do(nethttp.MethodPost, "/auth/register", "", authRequest{Username: "ivan", Password: "secret"}, &errResp)
+	require.Equal(t, nethttp.StatusConflict, rec.Code)
+
+	rec = api.do(nethttp.MethodPost, "/auth/register", "", authRequest{Username: "root", Password: "secret", Role: domain.RoleAdmin}, &errResp)
+	require.Equal(t, nethttp.StatusForbidden, rec.Code)
+
+	rec = api.do(nethttp.MethodPost, "/auth/login", "", authRequest{Username: "ivan", Password: "wrong"}, &errResp)
+	require.Equal(t, nethttp.StatusUnauthorized, rec.Code)
+
+	var tokens tokenResponse
+	rec = api.do(nethttp.MethodPost, "/auth/login", "", authRequest{Username: "ivan", Password: "secret"}, &tokens)
+	require.Equal(t, nethttp.StatusOK, rec.Code)
+
+	profile := userRequest{FullName: "Иванов Иван Иванович", Gender: "m", Birthday: "1990-05-17", City: "Москва", Username: "ivan", Role: domain.RoleEntrepreneur}
+	var created userResponse
+	rec = api.do(nethttp.MethodPost, "/users", tokens.AccessToken, profile, &created)
+	require.Equal(t, nethttp.StatusCreated, rec.Code)
+	require.Equal(t, registered.ID, created.ID)
+	require.Equal(t, "1990-05-17", created.Birthday)
+
+	var refreshed tokenResponse
+	rec = api.do(nethttp.MethodPost, "/auth/refresh", "", refreshRequest{RefreshToken: tokens.RefreshToken}, &refreshed)
+	require.Equal(t, nethttp.StatusOK, rec.Code)
+
+	rec = api.do(nethttp.MethodPost, "/auth/logout", "", refreshRequest{RefreshToken: refreshed.RefreshToken}, nil)
+	require.Equal(t, nethttp.StatusNoContent, rec.Code)
+
+	rec = api.do(nethttp.MethodGet, "/users/"+created.ID.String(), refreshed.AccessToken, nil, &errResp)
+	require.Equal(t, nethttp.StatusUnauthorized, rec.Code)
+}
+
+func TestServer_Authenticate(t *testing.T) {
+	api := newTestAPI(t)
+	_, token := api.login("admin", domain.RoleAdmin)
+
+	orphan, err := base.GenerateAuthToken(uuid.New(), "admin", domain.RoleAdmin, uuid.New(), api.keys, base.TokenOptions{})
+	require.Nil(t, err)
+
+	testCases := []struct {
+		name   string
+		header string
+		status int
+	}{
+		{
+			name:   "гость",
+			status: nethttp.StatusForbidden,
+		},
+		{
+			name:   "администратор",
+			header: "Bearer " + token,
+			status: nethttp.StatusCreated,
+		},
+		{
+			name:   "схема не Bearer",
+			header: "Basic " + token,
+			status: nethttp.StatusUnauthorized,
+		},
+		{
+			name:   "пустой токен",
+			header: "Bearer ",
+			status: nethttp.StatusUnauthorized,
+		},
+		{
+			name:   "поддельный токен",
+			header: "Bearer abc.def.ghi",
+			status: nethttp.StatusUnauthorized,
+		},
+		{
+			name:   "сессия не найдена",
+			header: "Bearer " + orphan,
+			status: nethttp.StatusUnauthorized,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			body, err := json.Marshal(skillRequest{Name: "Go", Description: "язык программирования"})
+			require.Nil(t, err)
+
+			req := httptest.NewRequest(nethttp.MethodPost, "/skills", bytes.NewReader(body))
+			if tc.header != "" {
+				req.Header.Set("Authorization", tc.header)
+			}
+			rec := httptest.NewRecorder()
+			api.handler.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.status, rec.Code, rec.Body.String())
+			require.Equal(t, "application/json; charset=utf-8", rec.Header().Get("Content-Type"))
+		})
+	}
+}
+
+func TestServer_PublicRoutes(t *testing.T) {
+	api := newTestAPI(t)
+	_, token := api.login("ivan", domain.RoleEntrepreneur)
+
+	var tokens tokenResponse
+	rec := api.do(nethttp.MethodPost, "/auth/login", "", authRequest{Username: "ivan", Password: "secret"}, &tokens)
+	require.Equal(t, nethttp.StatusOK, rec.Code)
+
+	key, err := api.keys.SigningKey()
+	require.Nil(t, err)
+	expired := jwt.NewWithClaims(key.Method, jwt.RegisteredClaims{
+		Subject:   uuid.New().String(),
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Hour)),
+	})
+	expired.Header["kid"] = key.ID
+	expiredToken, err := expired.SignedString(key.Private)
+	require.Nil(t, err)
+
+	// истекший access-токен не мешает обновить пару токенов
+	var refreshed tokenResponse
+	rec = api.do(nethttp.MethodPost, "/auth/refresh", expiredToken, refreshRequest{RefreshToken: tokens.RefreshToken}, &refreshed)
+	require.Equal(t, nethttp.StatusOK, rec.Code)
+	require.NotEmpty(t, refreshed.AccessToken)
+
+	rec = api.do(nethttp.MethodPost, "/auth/login", "abc.def.ghi", authRequest{Username: "ivan", Password: "secret"}, nil)
+	require.Equal(t, nethttp.StatusOK, rec.Code)
+
+	req := httptest.NewRequest(nethttp.MethodGet, "/openapi.json", nil)
+	req.Header.Set("Authorization", "Basic "+token)
+	rec = httptest.NewRecorder()
+	api.handler.ServeHTTP(rec, req)
+	require.Equal(t, nethttp.StatusOK, rec.Code)
+
+	// на остальных эндпоинтах недействительный токен по-прежнему отклоняется
+	var errResp errorResponse
+	rec = api.do(nethttp.MethodGet, "/skills", expiredToken, nil, &errResp)
+	require.Equal(t, nethttp.StatusUnauthorized, rec.Code)
+}
+
+func TestServer_Validation(t *testing.T) {
+	api := newTestAPI(t)
+	_, token := api.login("admin", domain.RoleAdmin)
+
+	testCases := []struct {
+		name   string
+		method string
+		path   string
+		body   any
+		status int
+		field  string
+	}{
+		{
+			name:   "некорректный id в пути",
+			method: nethttp.MethodGet,
+			path:   "/users/abc",
+			status: nethttp.StatusBadRequest,
+			field:  "id",
+		},
+		{
+			name:   "некорректный лимит",
+			method: nethttp.MethodGet,
+			path:   "/skills?limit=abc",
+			status: nethttp.StatusBadRequest,
+			field:  "limit",
+		},
+		{
+			name:   "лимит больше максимального",
+			method: nethttp.MethodGet,
+			path:   "/skills?limit=1000",
+			status: nethttp.StatusBadRequest,
+			field:  "limit",
+		},
+		{
+			name:   "неизвестное поле в теле",
+			method: nethttp.MethodPost,
+			path:   "/skills",
+			body:   `{"name": "Go", "level": 5}`,
+			status: nethttp.StatusBadRequest,
+		},
+		{
+			name:   "тело не JSON",
+			method: nethttp.MethodPost,
+			path:   "/skills",
+			body:   "name=Go",
+			status: nethttp.StatusBadRequest,
+		},
+		{
+			name:   "ошибка валидации сервиса",
+			method: nethttp.MethodPost,
+			path:   "/skills",
+			body:   skillRequest{Name: "Go"},
+			status: nethttp.StatusBadRequest,
+			field:  "description",
+		},
+		{
+			name:   "некорректная дата рождения",
+			method: nethttp.MethodPost,
+			path:   "/users",
+			body:   userRequest{Birthday: "17.05.1990"},
+			status: nethttp.StatusBadRequest,
+			field:  "birthday",
+		},
+		{
+			name:   "сумма с долями копеек",
+			method: nethttp.MethodPost,
+			path:   "/financial-reports",
+			body:   `{"company_id": "` + uuid.NewString() + `", "revenue": 100.125, "costs": 1, "year": 2023, "quarter": 1}`,
+			status: nethttp.StatusBadRequest,
+			field:  "revenue",
+		},
+		{
+			name:   "не указан период",
+			method: nethttp.MethodGet,
+			path:   "/users/" + uuid.NewString() + "/financial-report?start_year=2023",
+			status: nethttp.StatusBadRequest,
+			field:  "start_quarter",
+		},
+		{
+			name:   "квартал вне диапазона",
+			method: nethttp.MethodGet,
+			path:   "/companies/" + uuid.NewString() + "/financial-report?start_year=2023&start_quarter=0&end_year=2023&end_quarter=4",
+			status: nethttp.StatusBadRequest,
+			field:  "quarter",
+		},
+		{
+			name:   "некорректный фильтр",
+			method: nethttp.MethodGet,
+			path:   "/users?min_age=40&max_age=30",
+			status: nethttp.StatusBadRequest,
+			field:  "age",
+		},
+		{
+			name:   "пустой поисковый запрос",
+			method: nethttp.MethodGet,
+			path:   "/search?q=",
+			status: nethttp.StatusBadRequest,
+			field:  "query",
+		},
+		{
+			name:   "не найдено",
+			method: nethttp.MethodGet,
+			path:   "/skills/" + uuid.NewString(),
+			status: nethttp.StatusNotFound,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var resp errorResponse
+			rec := api.do(tc.method, tc.path, token, tc.body, &resp)
+
+			require.Equal(t, tc.status, rec.Code)
+			require.NotEmpty(t, resp.Error)
+			require.Equal(t, tc.field, resp.Field)
+		})
+	}
+}
+
+func TestServer_Locale(t *testing.T) {
+	api := newTestAPI(t)
+
+	req := httptest.NewRequest(nethttp.MethodGet, "/skills/"+uuid.NewString(), nil)
+	req.Header.Set("Accept-Language", "en-US,en;q=0.9")
+	rec := httptest.NewRecorder()
+	api.handler.ServeHTTP(rec, req)
+
+	var resp errorResponse
+	require.Nil(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Equal(t, nethttp.StatusNotFound, rec.Code)
+	require.Equal(t, "getting skill by id: skill not found", resp.Error)
+}
+
+func TestServer_Scenario(t *testing.T) {
+	api := newTestAPI(t)
+	_, adminToken := api.login("admin", domain.RoleAdmin)
+	ownerId, ownerToken := api.login("ivan", domain.RoleEntrepreneur)
+
+	profile := userRequest{Username: "ivan", FullName: "Иванов Иван Иванович", Gender: "m", Birthday: "1990-05-17", City: "Москва", Role: domain.RoleEntrepreneur}
+	rec := api.do(nethttp.MethodPost, "/users", ownerToken, profile, nil)
+	require.Equal(t, nethttp.StatusCreated, rec.Code)
+
+	var field activityFieldResponse
+	rec = api.do(nethttp.MethodPost, "/activity-fields", adminToken, activityFieldRequest{Name: "IT", Description: "разработка программного обеспечения", Cost: 5}, &field)
+	require.Equal(t, nethttp.StatusCreated, rec.Code)
+
+	var comp companyResponse
+	rec = api.do(nethttp.MethodPost, "/companies", ownerToken, companyRequest{OwnerID: ownerId, ActivityFieldId: field.ID, Name: "Рога и копыта", City: "Москва"}, &comp)
+	require.Equal(t, nethttp.StatusCreated, rec.Code)
+
+	rec = api.do(nethttp.MethodPost, "/companies", ownerToken, companyRequest{OwnerID: uuid.New(), ActivityFieldId: field.ID, Name: "Чужая", City: "Москва"}, nil)
+	require.Equal(t, nethttp.StatusForbidden, rec.Code)
+
+	prevYear := time.Now().AddDate(-1, 0, 0).Year()
+	batch := finReportsRequest{}
+	for quarter := 1; quarter <= 4; quarter++ {
+		batch.Reports = append(batch.Reports, finReportRequest{CompanyID: comp.ID, Revenue: "1000.25", Costs: "400", Year: prevYear, Quarter: quarter})
+	}
+	var reports finReportByPeriodResponse
+	rec = api.do(nethttp.MethodPost, "/financial-reports/batch", ownerToken, batch, &reports)
+	require.Equal(t, nethttp.StatusCreated, rec.Code)
+	require.Len(t, reports.Reports, 4)
+	require.NotEqual(t, uuid.Nil, reports.Reports[0].ID)
+	require.Equal(t, domain.GranularityQuarter, reports.Reports[0].Granularity)
+
+	// итоги пакета складываются без перевода в валюту отчетности
+	mixed := finReportsRequest{Reports: []finReportRequest{
+		{CompanyID: comp.ID, Revenue: "100", Costs: "40", Year: prevYear - 1, Quarter: 1},
+		{CompanyID: comp.ID, Revenue: "1", Costs: "0.4", Currency: "USD", Year: prevYear - 1, Quarter: 2},
+	}}
+	var mixedResp errorResponse
+	rec = api.do(nethttp.MethodPost, "/financial-reports/batch", ownerToken, mixed, &mixedResp)
+	require.Equal(t, nethttp.StatusBadRequest, rec.Code)
+	require.Equal(t, "currency", mixedResp.Field)
+
+	// январь уже входит в квартальный отчет
+	january := finReportRequest{CompanyID: comp.ID, Revenue: "100", Costs: "40", Granularity: domain.GranularityMonth, Year: prevYear, Month: 1}
+	rec = api.do(nethttp.MethodPost, "/financial-reports", ownerToken, january, nil)
+	require.Equal(t, nethttp.StatusConflict, rec.Code)
+
+	var report finReportByPeriodResponse
+	path := fmt.Sprintf("/users/%s/financial-report?start_year=%d&start_quarter=1&end_year=%d&end_quarter=4", ownerId, prevYear, prevYear)
+	rec = api.do(nethttp.MethodGet, path, "", nil, &report)
+	require.Equal(t, nethttp.StatusOK, rec.Code)
+	require.Equal(t, json.Number("4001.00"), report.Revenue)
+	require.Equal(t, json.Number("2401.00"), report.Profit)
+	require.Equal(t, domain.DefaultCurrency, report.Currency)
+	require.Equal(t, prevYear, report.Period.StartYear)
+
+	var rating ratingResponse
+	rec = api.do(nethttp.MethodGet, "/users/"+ownerId.String()+"/rating", "", nil, &rating)
+	require.Equal(t, nethttp.StatusOK, rec.Code)
+	require.Greater(t, rating.Rating, float32(0))
+
+	var companies pageResponse[companyResponse]
+	rec = api.do(nethttp.MethodGet, "/companies?city=Москва&limit=1", "", nil, &companies)
+	require.Equal(t, nethttp.StatusOK, rec.Code)
+	require.Equal(t, 1, companies.Total)
+	require.Equal(t, comp, companies.Items[0])
+
+	var hits pageResponse[searchHitResponse]
+	rec = api.do(nethttp.MethodGet, "/search?q=рогов&kind=company", "", nil, &hits)
+	require.Equal(t, nethttp.StatusOK, rec.Code)
+	require.Len(t, hits.Items, 1)
+	require.Equal(t, comp.ID, hits.Items[0].ID)
+
+	var skillResp skillResponse
+	rec = api.do(nethttp.MethodPost, "/skills", adminToken, skillRequest{Name: "Go", Description: "язык программирования"}, &skillResp)
+	require.Equal(t, nethttp.StatusCreated, rec.Code)
+
+	rec = api.do(nethttp.MethodPost, "/users/"+ownerId.String()+"/skills", ownerToken, userSkillRequest{SkillId: skillResp.ID}, nil)
+	require.Equal(t, nethttp.StatusNoContent, rec.Code)
+
+	var users pageResponse[userResponse]
+	rec = api.do(nethttp.MethodGet, "/skills/"+skillResp.ID.String()+"/users", "", nil, &users)
+	require.Equal(t, nethttp.StatusOK, rec.Code)
+	require.Equal(t, ownerId, users.Items[0].ID)
+
+	var contactResp contactResponse
+	rec = api.do(nethttp.MethodPost, "/contacts", ownerToken, contactRequest{OwnerID: ownerId, Name: "email", Value: "ivan@example.com"}, &contactResp)
+	require.Equal(t, nethttp.StatusCreated, rec.Code)
+
+	rec = api.do(nethttp.MethodDelete, "/companies/"+comp.ID.String(), ownerToken, nil, nil)
+	require.Equal(t, nethttp.StatusNoContent, rec.Code)
+
+	rec = api.do(nethttp.MethodGet, "/companies/"+comp.ID.String(), "", nil, nil)
+	require.Equal(t, nethttp.StatusNotFound, rec.Code)
+}
+
+func TestServer_InternalError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	skillSvc := mocks.NewMockISkillService(ctrl)
+	skillSvc.EXPECT().
+		GetAll(gomock.Any(), domain.PageRequest{}).
+		Return(nil, i18n.Wrap(errors.New("connection refused"), i18n.SkillGetAll))
+	logger := mocks.NewMockILogger(ctrl)
+	logger.EXPECT().Errorf(gomock.Any(), gomock.Any()).Times(1)
+
+	server := NewServer(&Services{Skill: skillSvc}, AuthConfig{}, logger)
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(nethttp.MethodGet, "/skills", nil))
+
+	var resp errorResponse
+	require.Nil(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Equal(t, nethttp.StatusInternalServerError, rec.Code)
+	require.Equal(t, "внутренняя ошибка сервера", resp.Error)
+}
+
+func TestStatusCode(t *testing.T) {
+	testCases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{
+			name:   "валидация",
+			err:    domain.NewValidationError("name", i18n.SkillNameRequired),
+			status: nethttp.StatusBadRequest,
+		},
+		{
+			name:   "не найдено",
+			err:    i18n.Wrap(domain.NewError(domain.ErrNotFound, i18n.StorageSkillNotFound), i18n.SkillGet),
+			status: nethttp.StatusNotFound,
+		},
+		{
+			name:   "конфликт",
+			err:    domain.NewError(domain.ErrConflict, i18n.StorageUsernameTaken),
+			status: nethttp.StatusConflict,
+		},
+		{
+			name:   "нет прав",
+			err:    i18n.Wrap(authz.ErrForbidden, i18n.SkillCreate),
+			status: nethttp.StatusForbidden,
+		},
+		{
+			name:   "не аутентифицирован",
+			err:    domain.NewError(domain.ErrUnauthenticated, i18n.AuthWrongPassword),
+			status: nethttp.StatusUnauthorized,
+		},
+		{
+			name:   "недостаточно данных",
+			err:    domain.NewError(domain.ErrNotEnoughData, i18n.InteractorNoRevenue),
+			status: nethttp.StatusUnprocessableEntity,
+		},
+		{
+			name:   "прочие ошибки",
+			err:    errors.New("sql error"),
+			status: nethttp.StatusInternalServerError,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.status, StatusCode(tc.err))
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package http
+
+import (
+	"github.com/google/uuid"
+	nethttp "net/http"
+)
+
+func (s *Server) listSkills(w nethttp.ResponseWriter, r *nethttp.Request) {
+	page, err := pageRequest(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	skills, err := s.services.Skill.GetAll(r.Context(), page)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, newPageResponse(skills, newSkillResponse))
+}
+
+func (s *Server) createSkill(w nethttp.ResponseWriter, r *nethttp.Request) {
+	var req skillRequest
+	err := decodeBody(r, &req)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	skill := req.skill(uuid.Nil)
+	err = s.services.Skill.Create(r.Context(), skill)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusCreated, newSkillResponse(skill))
+}
+
+func (s *Server) getSkill(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	skill, err := s.services.Skill.GetById(r.Context(), id)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, newSkillResponse(skill))
+}
+
+func (s *Server) updateSkill(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req skillRequest
+	err = decodeBody(r, &req)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	skill := req.skill(id)
+	err = s.services.Skill.Update(r.Context(), skill)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, newSkillResponse(skill))
+}
+
+func (s *Server) deleteSkill(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	err = s.services.Skill.DeleteById(r.Context(), id)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(nethttp.StatusNoContent)
+}
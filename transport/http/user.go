@@ -0,0 +1,221 @@
+package http
+
+import (
+	"github.com/dlankinl/bmstu-ppo-bl/domain"
+	"github.com/dlankinl/bmstu-ppo-bl/services/authz"
+	"github.com/google/uuid"
+	nethttp "net/http"
+)
+
+func (s *Server) listUsers(w nethttp.ResponseWriter, r *nethttp.Request) {
+	page, err := pageRequest(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	filter := domain.UserFilter{
+		City:   r.URL.Query().Get("city"),
+		Gender: r.URL.Query().Get("gender"),
+		Search: r.URL.Query().Get("search"),
+	}
+	filter.MinAge, err = queryInt(r, "min_age")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	filter.MaxAge, err = queryInt(r, "max_age")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	users, err := s.services.User.GetAll(r.Context(), filter, page)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, newPageResponse(users, newUserResponse))
+}
+
+// createUser создает профиль. Без id в теле профиль создается для текущего пользователя.
+func (s *Server) createUser(w nethttp.ResponseWriter, r *nethttp.Request) {
+	var req userRequest
+	err := decodeBody(r, &req)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	user, err := req.user()
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	if user.ID == uuid.Nil {
+		user.ID = authz.Caller(r.Context()).UserId
+	}
+
+	err = s.services.User.Create(r.Context(), user)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusCreated, newUserResponse(user))
+}
+
+func (s *Server) getUserByUsername(w nethttp.ResponseWriter, r *nethttp.Request) {
+	user, err := s.services.User.GetByUsername(r.Context(), r.PathValue("username"))
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, newUserResponse(user))
+}
+
+func (s *Server) getUser(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	user, err := s.services.User.GetById(r.Context(), id)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, newUserResponse(user))
+}
+
+func (s *Server) updateUser(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req userRequest
+	err = decodeBody(r, &req)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	user, err := req.user()
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	user.ID = id
+
+	err = s.services.User.Update(r.Context(), user)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, newUserResponse(user))
+}
+
+func (s *Server) deleteUser(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	err = s.services.User.DeleteById(r.Context(), id)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(nethttp.StatusNoContent)
+}
+
+func (s *Server) getUserRating(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	rating, err := s.services.Interactor.CalculateUserRating(r.Context(), id)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, &ratingResponse{Rating: rating})
+}
+
+func (s *Server) getUserFinReport(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	period, err := queryPeriod(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	report, err := s.services.Interactor.GetUserFinancialReport(r.Context(), id, period)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, newFinReportByPeriodResponse(report))
+}
+
+func (s *Server) listUserCompanies(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	page, err := pageRequest(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	companies, err := s.services.Company.GetByOwnerId(r.Context(), id, page)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, newPageResponse(companies, newCompanyResponse))
+}
+
+func (s *Server) listUserContacts(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	page, err := pageRequest(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	contacts, err := s.services.Contact.GetByOwnerId(r.Context(), id, page)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, nethttp.StatusOK, newPageResponse(contacts, newContactResponse))
+}